@@ -0,0 +1,45 @@
+package api
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/doron-cohen/argus/backend/internal/utils"
+)
+
+// RequireToken returns middleware that checks the admin bearer token.
+// Without a configured token, only safe (GET/HEAD) requests are allowed through.
+func RequireToken(token string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if token == "" {
+				if r.Method == http.MethodGet || r.Method == http.MethodHead {
+					next.ServeHTTP(w, r)
+					return
+				}
+				writeAuthError(w, "Admin token is not configured", "ADMIN_TOKEN_NOT_CONFIGURED", http.StatusForbidden)
+				return
+			}
+
+			provided, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+			if !ok || subtle.ConstantTimeCompare([]byte(provided), []byte(token)) != 1 {
+				w.Header().Set("WWW-Authenticate", "Bearer")
+				writeAuthError(w, "Missing or invalid admin token", "UNAUTHORIZED", http.StatusUnauthorized)
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// writeAuthError sends a JSON error response from the auth middleware
+func writeAuthError(w http.ResponseWriter, message, code string, statusCode int) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	_ = json.NewEncoder(w).Encode(Error{
+		Error: utils.ToPointer(message),
+		Code:  utils.ToPointer(code),
+	})
+}
@@ -0,0 +1,601 @@
+// Package client provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.5.0 DO NOT EDIT.
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/oapi-codegen/runtime"
+)
+
+const (
+	BearerAuthScopes = "bearerAuth.Scopes"
+)
+
+// BackfillRequest Derived columns to backfill and how much work to do in this call
+type BackfillRequest struct {
+	// BatchSize Rows updated per batch
+	BatchSize *int `json:"batch_size,omitempty"`
+
+	// Columns Qualified names of the derived columns to populate
+	Columns []string `json:"columns"`
+
+	// MaxBatches Maximum number of batches to run per column in this call
+	MaxBatches *int `json:"max_batches,omitempty"`
+}
+
+// BackfillResponse Backfill progress per column
+type BackfillResponse struct {
+	Results []BackfillResult `json:"results"`
+}
+
+// BackfillResult Progress of a single derived column backfill
+type BackfillResult struct {
+	// Column Qualified name of the derived column
+	Column string `json:"column"`
+
+	// Done Whether every row now has the column populated
+	Done bool `json:"done"`
+
+	// Remaining Rows still lacking the column after this call
+	Remaining int `json:"remaining"`
+
+	// Updated Rows populated by this call
+	Updated int `json:"updated"`
+}
+
+// Error Error response
+type Error struct {
+	// Code Error code
+	Code *string `json:"code,omitempty"`
+
+	// Error Error message
+	Error *string `json:"error,omitempty"`
+}
+
+// IngestedReport A check report along with when the server received it
+type IngestedReport struct {
+	// CheckSlug Unique identifier for the check type
+	CheckSlug string `json:"check_slug"`
+
+	// ComponentId Unique identifier of the component the report is for
+	ComponentId string `json:"component_id"`
+
+	// Id Unique identifier for the report
+	Id string `json:"id"`
+
+	// ReceivedAt When the server received the report
+	ReceivedAt time.Time `json:"received_at"`
+
+	// Status Status of the check execution
+	Status string `json:"status"`
+
+	// Timestamp When the check was executed
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// Pagination Pagination metadata for list responses
+type Pagination struct {
+	// HasMore Whether there are more items available
+	HasMore bool `json:"has_more"`
+
+	// Limit Number of items returned in this response
+	Limit int `json:"limit"`
+
+	// Offset Offset used for this response
+	Offset int `json:"offset"`
+
+	// Total Total number of items available
+	Total int `json:"total"`
+}
+
+// RecentReportsResponse Response containing recently ingested reports with pagination metadata
+type RecentReportsResponse struct {
+	// Pagination Pagination metadata for list responses
+	Pagination Pagination       `json:"pagination"`
+	Reports    []IngestedReport `json:"reports"`
+}
+
+// GetRecentReportsParams defines parameters for GetRecentReports.
+type GetRecentReportsParams struct {
+	// ReceivedAfter Only include reports received at or after this time
+	ReceivedAfter *time.Time `form:"received_after,omitempty" json:"received_after,omitempty"`
+
+	// ReceivedBefore Only include reports received before this time
+	ReceivedBefore *time.Time `form:"received_before,omitempty" json:"received_before,omitempty"`
+
+	// Limit Maximum number of reports to return
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Pagination offset. Offsets past the last report return an empty page with the full total; offsets above the catalog's configured maximum (api.max_offset, 100000 by default) are rejected.
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// BackfillDerivedColumnsJSONRequestBody defines body for BackfillDerivedColumns for application/json ContentType.
+type BackfillDerivedColumnsJSONRequestBody = BackfillRequest
+
+// RequestEditorFn  is the function signature for the RequestEditor callback function
+type RequestEditorFn func(ctx context.Context, req *http.Request) error
+
+// Doer performs HTTP requests.
+//
+// The standard http.Client implements this interface.
+type HttpRequestDoer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// Client which conforms to the OpenAPI3 specification for this service.
+type Client struct {
+	// The endpoint of the server conforming to this interface, with scheme,
+	// https://api.deepmap.com for example. This can contain a path relative
+	// to the server, such as https://api.deepmap.com/dev-test, and all the
+	// paths in the swagger spec will be appended to the server.
+	Server string
+
+	// Doer for performing requests, typically a *http.Client with any
+	// customized settings, such as certificate chains.
+	Client HttpRequestDoer
+
+	// A list of callbacks for modifying requests which are generated before sending over
+	// the network.
+	RequestEditors []RequestEditorFn
+}
+
+// ClientOption allows setting custom parameters during construction
+type ClientOption func(*Client) error
+
+// Creates a new Client, with reasonable defaults
+func NewClient(server string, opts ...ClientOption) (*Client, error) {
+	// create a client with sane default values
+	client := Client{
+		Server: server,
+	}
+	// mutate client and add all optional params
+	for _, o := range opts {
+		if err := o(&client); err != nil {
+			return nil, err
+		}
+	}
+	// ensure the server URL always has a trailing slash
+	if !strings.HasSuffix(client.Server, "/") {
+		client.Server += "/"
+	}
+	// create httpClient, if not already present
+	if client.Client == nil {
+		client.Client = &http.Client{}
+	}
+	return &client, nil
+}
+
+// WithHTTPClient allows overriding the default Doer, which is
+// automatically created using http.Client. This is useful for tests.
+func WithHTTPClient(doer HttpRequestDoer) ClientOption {
+	return func(c *Client) error {
+		c.Client = doer
+		return nil
+	}
+}
+
+// WithRequestEditorFn allows setting up a callback function, which will be
+// called right before sending the request. This can be used to mutate the request.
+func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
+	return func(c *Client) error {
+		c.RequestEditors = append(c.RequestEditors, fn)
+		return nil
+	}
+}
+
+// The interface specification for the client above.
+type ClientInterface interface {
+	// BackfillDerivedColumnsWithBody request with any body
+	BackfillDerivedColumnsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	BackfillDerivedColumns(ctx context.Context, body BackfillDerivedColumnsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetRecentReports request
+	GetRecentReports(ctx context.Context, params *GetRecentReportsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+}
+
+func (c *Client) BackfillDerivedColumnsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewBackfillDerivedColumnsRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) BackfillDerivedColumns(ctx context.Context, body BackfillDerivedColumnsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewBackfillDerivedColumnsRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetRecentReports(ctx context.Context, params *GetRecentReportsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetRecentReportsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewBackfillDerivedColumnsRequest calls the generic BackfillDerivedColumns builder with application/json body
+func NewBackfillDerivedColumnsRequest(server string, body BackfillDerivedColumnsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewBackfillDerivedColumnsRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewBackfillDerivedColumnsRequestWithBody generates requests for BackfillDerivedColumns with any type of body
+func NewBackfillDerivedColumnsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/backfill")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetRecentReportsRequest generates requests for GetRecentReports
+func NewGetRecentReportsRequest(server string, params *GetRecentReportsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/reports/recent")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.ReceivedAfter != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "received_after", runtime.ParamLocationQuery, *params.ReceivedAfter); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.ReceivedBefore != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "received_before", runtime.ParamLocationQuery, *params.ReceivedBefore); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// BackfillDerivedColumnsWithBodyWithResponse request with any body
+	BackfillDerivedColumnsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*BackfillDerivedColumnsResponse, error)
+
+	BackfillDerivedColumnsWithResponse(ctx context.Context, body BackfillDerivedColumnsJSONRequestBody, reqEditors ...RequestEditorFn) (*BackfillDerivedColumnsResponse, error)
+
+	// GetRecentReportsWithResponse request
+	GetRecentReportsWithResponse(ctx context.Context, params *GetRecentReportsParams, reqEditors ...RequestEditorFn) (*GetRecentReportsResponse, error)
+}
+
+type BackfillDerivedColumnsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *BackfillResponse
+	JSON400      *Error
+	JSON401      *Error
+	JSON403      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r BackfillDerivedColumnsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r BackfillDerivedColumnsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetRecentReportsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RecentReportsResponse
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetRecentReportsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetRecentReportsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// BackfillDerivedColumnsWithBodyWithResponse request with arbitrary body returning *BackfillDerivedColumnsResponse
+func (c *ClientWithResponses) BackfillDerivedColumnsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*BackfillDerivedColumnsResponse, error) {
+	rsp, err := c.BackfillDerivedColumnsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseBackfillDerivedColumnsResponse(rsp)
+}
+
+func (c *ClientWithResponses) BackfillDerivedColumnsWithResponse(ctx context.Context, body BackfillDerivedColumnsJSONRequestBody, reqEditors ...RequestEditorFn) (*BackfillDerivedColumnsResponse, error) {
+	rsp, err := c.BackfillDerivedColumns(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseBackfillDerivedColumnsResponse(rsp)
+}
+
+// GetRecentReportsWithResponse request returning *GetRecentReportsResponse
+func (c *ClientWithResponses) GetRecentReportsWithResponse(ctx context.Context, params *GetRecentReportsParams, reqEditors ...RequestEditorFn) (*GetRecentReportsResponse, error) {
+	rsp, err := c.GetRecentReports(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetRecentReportsResponse(rsp)
+}
+
+// ParseBackfillDerivedColumnsResponse parses an HTTP response from a BackfillDerivedColumnsWithResponse call
+func ParseBackfillDerivedColumnsResponse(rsp *http.Response) (*BackfillDerivedColumnsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &BackfillDerivedColumnsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest BackfillResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetRecentReportsResponse parses an HTTP response from a GetRecentReportsWithResponse call
+func ParseGetRecentReportsResponse(rsp *http.Response) (*GetRecentReportsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetRecentReportsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RecentReportsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
@@ -0,0 +1,3 @@
+module github.com/doron-cohen/argus/backend/admin/api/client
+
+go 1.21
@@ -0,0 +1,208 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/doron-cohen/argus/backend/internal/requestlog"
+	"github.com/doron-cohen/argus/backend/internal/storage"
+	"github.com/doron-cohen/argus/backend/internal/utils"
+)
+
+// APIServer implements the admin API interface
+type APIServer struct {
+	Repo *storage.Repository
+	// MaxOffset is the largest offset a listing may request; larger offsets are rejected.
+	// Zero means no limit.
+	MaxOffset int
+}
+
+// NewAPIServer creates a new admin API server rejecting listing offsets above maxOffset
+func NewAPIServer(repo *storage.Repository, maxOffset int) ServerInterface {
+	return &APIServer{Repo: repo, MaxOffset: maxOffset}
+}
+
+// GetRecentReports lists reports by when the server received them
+func (s *APIServer) GetRecentReports(w http.ResponseWriter, r *http.Request, params GetRecentReportsParams) {
+	ctx := r.Context()
+
+	if params.ReceivedAfter != nil && params.ReceivedBefore != nil && !params.ReceivedAfter.Before(*params.ReceivedBefore) {
+		s.sendErrorResponse(w, "received_after must be before received_before", "VALIDATION_ERROR", http.StatusBadRequest)
+		return
+	}
+
+	limit := s.getLimit(params)
+	offset, err := s.getOffset(params)
+	if err != nil {
+		s.sendErrorResponse(w, err.Error(), "VALIDATION_ERROR", http.StatusBadRequest)
+		return
+	}
+
+	reports, total, err := s.Repo.GetReportsByReceivedTime(ctx, params.ReceivedAfter, params.ReceivedBefore, limit, offset)
+	if err != nil {
+		s.sendErrorResponse(w, "Failed to fetch recent reports", "INTERNAL_ERROR", http.StatusInternalServerError)
+		return
+	}
+
+	apiReports := make([]IngestedReport, len(reports))
+	for i, report := range reports {
+		apiReports[i] = IngestedReport{
+			Id:          report.ID.String(),
+			ComponentId: report.Component.ComponentID,
+			CheckSlug:   report.Check.Slug,
+			Status:      string(report.Status),
+			Timestamp:   report.Timestamp,
+			ReceivedAt:  report.CreatedAt,
+		}
+	}
+
+	response := RecentReportsResponse{
+		Reports: apiReports,
+		Pagination: Pagination{
+			Total:   int(total),
+			Limit:   limit,
+			Offset:  offset,
+			HasMore: utils.HasMorePages(offset, limit, len(reports), total),
+		},
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		http.Error(w, "Failed to encode response", http.StatusInternalServerError)
+	}
+}
+
+// Backfill limits
+const (
+	defaultBackfillBatchSize  = 500
+	maxBackfillBatchSize      = 5000
+	defaultBackfillMaxBatches = 10
+	maxBackfillMaxBatches     = 1000
+)
+
+// BackfillDerivedColumns populates derived columns for existing rows in batches
+func (s *APIServer) BackfillDerivedColumns(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req BackfillRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		s.sendErrorResponse(w, "Invalid JSON in request body", "INVALID_JSON", http.StatusBadRequest)
+		return
+	}
+
+	if len(req.Columns) == 0 {
+		s.sendErrorResponse(w, "At least one column is required", "VALIDATION_ERROR", http.StatusBadRequest)
+		return
+	}
+	known := storage.DerivedColumns()
+	for _, column := range req.Columns {
+		if !slices.Contains(known, column) {
+			s.sendErrorResponse(w, fmt.Sprintf("Unknown derived column %q, expected one of: %s", column, strings.Join(known, ", ")), "UNKNOWN_COLUMN", http.StatusBadRequest)
+			return
+		}
+	}
+
+	batchSize := defaultBackfillBatchSize
+	if req.BatchSize != nil {
+		if *req.BatchSize < 1 || *req.BatchSize > maxBackfillBatchSize {
+			s.sendErrorResponse(w, fmt.Sprintf("batch_size must be between 1 and %d", maxBackfillBatchSize), "VALIDATION_ERROR", http.StatusBadRequest)
+			return
+		}
+		batchSize = *req.BatchSize
+	}
+	maxBatches := defaultBackfillMaxBatches
+	if req.MaxBatches != nil {
+		if *req.MaxBatches < 1 || *req.MaxBatches > maxBackfillMaxBatches {
+			s.sendErrorResponse(w, fmt.Sprintf("max_batches must be between 1 and %d", maxBackfillMaxBatches), "VALIDATION_ERROR", http.StatusBadRequest)
+			return
+		}
+		maxBatches = *req.MaxBatches
+	}
+
+	results := make([]BackfillResult, 0, len(req.Columns))
+	for _, column := range req.Columns {
+		result, err := s.backfillColumn(ctx, column, batchSize, maxBatches)
+		if err != nil {
+			requestlog.Logger(ctx).Error("Backfill failed", "column", column, "error", err)
+			s.sendErrorResponse(w, fmt.Sprintf("Failed to backfill %s", column), "INTERNAL_ERROR", http.StatusInternalServerError)
+			return
+		}
+		results = append(results, result)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(BackfillResponse{Results: results}); err != nil {
+		http.Error(w, "Failed to encode response", http.StatusInternalServerError)
+	}
+}
+
+// backfillColumn runs up to maxBatches batches for one column, logging progress as it goes
+func (s *APIServer) backfillColumn(ctx context.Context, column string, batchSize, maxBatches int) (BackfillResult, error) {
+	updated := 0
+	for batch := 1; batch <= maxBatches; batch++ {
+		n, err := s.Repo.BackfillDerivedBatch(ctx, column, batchSize)
+		if err != nil {
+			return BackfillResult{}, err
+		}
+		updated += n
+		if n == 0 {
+			break
+		}
+		requestlog.Logger(ctx).Info("Backfill batch completed", "column", column, "batch", batch, "rows", n, "updated", updated)
+		if n < batchSize {
+			break
+		}
+	}
+
+	remaining, err := s.Repo.CountMissingDerived(ctx, column)
+	if err != nil {
+		return BackfillResult{}, err
+	}
+
+	requestlog.Logger(ctx).Info("Backfill finished", "column", column, "updated", updated, "remaining", remaining)
+	return BackfillResult{
+		Column:    column,
+		Updated:   updated,
+		Remaining: int(remaining),
+		Done:      remaining == 0,
+	}, nil
+}
+
+// getLimit returns the limit parameter with validation
+func (s *APIServer) getLimit(params GetRecentReportsParams) int {
+	if params.Limit != nil && *params.Limit > 0 && *params.Limit <= 100 {
+		return *params.Limit
+	}
+	return 50 // default
+}
+
+// getOffset returns the offset parameter with validation. Offsets past the last report are valid
+// and get an empty page, but offsets beyond MaxOffset are rejected.
+func (s *APIServer) getOffset(params GetRecentReportsParams) (int, error) {
+	if params.Offset == nil || *params.Offset < 0 {
+		return 0, nil // default
+	}
+	if s.MaxOffset > 0 && *params.Offset > s.MaxOffset {
+		return 0, fmt.Errorf("offset cannot exceed %d", s.MaxOffset)
+	}
+	return *params.Offset, nil
+}
+
+// sendErrorResponse sends a JSON error response
+func (s *APIServer) sendErrorResponse(w http.ResponseWriter, message, code string, statusCode int) {
+	errorResponse := Error{
+		Error: utils.ToPointer(message),
+		Code:  utils.ToPointer(code),
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	if err := json.NewEncoder(w).Encode(errorResponse); err != nil {
+		http.Error(w, "Failed to encode error response", http.StatusInternalServerError)
+	}
+}
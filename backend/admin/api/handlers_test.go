@@ -0,0 +1,236 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/doron-cohen/argus/backend/internal/storage"
+	"github.com/glebarez/sqlite"
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+const (
+	testAdminToken = "test-admin-token"
+	testMaxOffset  = 10
+)
+
+// setupTestRouter creates an isolated database and the admin router guarded by token
+func setupTestRouter(t *testing.T, token string) (*storage.Repository, http.Handler) {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	require.NoError(t, err)
+	sqlDB, err := db.DB()
+	require.NoError(t, err)
+	sqlDB.SetMaxOpenConns(1)
+
+	repo := &storage.Repository{DB: db}
+	require.NoError(t, repo.Migrate(t.Context()))
+
+	router := chi.NewRouter()
+	router.Use(RequireToken(token))
+	return repo, HandlerFromMux(NewAPIServer(repo, testMaxOffset), router)
+}
+
+// seedLegacyReports creates reports whose checksum is empty, as if stored before the column existed
+func seedLegacyReports(t *testing.T, repo *storage.Repository, count int) {
+	component := storage.Component{ComponentID: "legacy-service", Name: "Legacy Service"}
+	require.NoError(t, repo.DB.Create(&component).Error)
+	check := storage.Check{Slug: "legacy-check", Name: "Legacy Check"}
+	require.NoError(t, repo.DB.Create(&check).Error)
+
+	for i := 0; i < count; i++ {
+		report := storage.CheckReport{
+			CheckID:     check.ID,
+			ComponentID: component.ID,
+			Status:      storage.CheckStatusPass,
+			Timestamp:   time.Now().Add(-time.Duration(i) * time.Minute),
+		}
+		require.NoError(t, repo.DB.Create(&report).Error)
+	}
+	require.NoError(t, repo.DB.Model(&storage.CheckReport{}).Where("1 = 1").UpdateColumn("checksum", nil).Error)
+}
+
+func postBackfill(handler http.Handler, token, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, "/backfill", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, req)
+	return w
+}
+
+func decodeBackfillResult(t *testing.T, w *httptest.ResponseRecorder) BackfillResult {
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	var response BackfillResponse
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&response))
+	require.Len(t, response.Results, 1)
+	return response.Results[0]
+}
+
+func TestBackfillDerivedColumns_PopulatesNullColumn(t *testing.T) {
+	repo, handler := setupTestRouter(t, testAdminToken)
+	seedLegacyReports(t, repo, 3)
+
+	result := decodeBackfillResult(t, postBackfill(handler, testAdminToken, `{"columns":["check_reports.checksum"]}`))
+	assert.Equal(t, "check_reports.checksum", result.Column)
+	assert.Equal(t, 3, result.Updated)
+	assert.Equal(t, 0, result.Remaining)
+	assert.True(t, result.Done)
+
+	var reports []storage.CheckReport
+	require.NoError(t, repo.DB.Find(&reports).Error)
+	require.Len(t, reports, 3)
+	for _, report := range reports {
+		require.NotNil(t, report.Checksum)
+		expected, err := report.ComputeChecksum()
+		require.NoError(t, err)
+		assert.Equal(t, expected, *report.Checksum)
+	}
+
+	// Running again is a no-op
+	result = decodeBackfillResult(t, postBackfill(handler, testAdminToken, `{"columns":["check_reports.checksum"]}`))
+	assert.Equal(t, 0, result.Updated)
+	assert.True(t, result.Done)
+}
+
+func TestBackfillDerivedColumns_Resumable(t *testing.T) {
+	repo, handler := setupTestRouter(t, testAdminToken)
+	seedLegacyReports(t, repo, 5)
+
+	body := `{"columns":["check_reports.checksum"],"batch_size":2,"max_batches":1}`
+
+	result := decodeBackfillResult(t, postBackfill(handler, testAdminToken, body))
+	assert.Equal(t, 2, result.Updated)
+	assert.Equal(t, 3, result.Remaining)
+	assert.False(t, result.Done)
+
+	result = decodeBackfillResult(t, postBackfill(handler, testAdminToken, body))
+	assert.Equal(t, 2, result.Updated)
+	assert.Equal(t, 1, result.Remaining)
+
+	result = decodeBackfillResult(t, postBackfill(handler, testAdminToken, body))
+	assert.Equal(t, 1, result.Updated)
+	assert.True(t, result.Done)
+}
+
+func TestBackfillDerivedColumns_Validation(t *testing.T) {
+	_, handler := setupTestRouter(t, testAdminToken)
+
+	tests := []struct {
+		name string
+		body string
+		code string
+	}{
+		{"invalid json", `{`, "INVALID_JSON"},
+		{"no columns", `{"columns":[]}`, "VALIDATION_ERROR"},
+		{"unknown column", `{"columns":["components.nope"]}`, "UNKNOWN_COLUMN"},
+		{"batch size too large", `{"columns":["check_reports.checksum"],"batch_size":100000}`, "VALIDATION_ERROR"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := postBackfill(handler, testAdminToken, tt.body)
+			assert.Equal(t, http.StatusBadRequest, w.Code)
+			var response Error
+			require.NoError(t, json.NewDecoder(w.Body).Decode(&response))
+			require.NotNil(t, response.Code)
+			assert.Equal(t, tt.code, *response.Code)
+		})
+	}
+}
+
+func TestGetRecentReports_Pagination(t *testing.T) {
+	repo, handler := setupTestRouter(t, "")
+	seedLegacyReports(t, repo, 5)
+
+	get := func(query string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/reports/recent?"+query, nil))
+		return w
+	}
+
+	tests := []struct {
+		name     string
+		query    string
+		returned int
+		hasMore  bool
+	}{
+		{"FullPageBeforeEnd", "limit=2&offset=0", 2, true},
+		{"FullPageAtEnd", "limit=5&offset=0", 5, false},
+		{"ShortLastPage", "limit=2&offset=4", 1, false},
+		{"PastEnd", "limit=2&offset=8", 0, false},
+		{"AtMaxOffset", fmt.Sprintf("limit=2&offset=%d", testMaxOffset), 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := get(tt.query)
+			require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+			var response RecentReportsResponse
+			require.NoError(t, json.NewDecoder(w.Body).Decode(&response))
+			assert.Len(t, response.Reports, tt.returned)
+			assert.Equal(t, 5, response.Pagination.Total)
+			assert.Equal(t, tt.hasMore, response.Pagination.HasMore)
+		})
+	}
+
+	t.Run("AboveMaxOffset", func(t *testing.T) {
+		w := get(fmt.Sprintf("offset=%d", testMaxOffset+1))
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		var response Error
+		require.NoError(t, json.NewDecoder(w.Body).Decode(&response))
+		require.NotNil(t, response.Code)
+		assert.Equal(t, "VALIDATION_ERROR", *response.Code)
+		assert.Equal(t, fmt.Sprintf("offset cannot exceed %d", testMaxOffset), *response.Error)
+	})
+}
+
+func TestRequireToken(t *testing.T) {
+	body := `{"columns":["check_reports.checksum"]}`
+
+	t.Run("missing token", func(t *testing.T) {
+		_, handler := setupTestRouter(t, testAdminToken)
+		w := postBackfill(handler, "", body)
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+		assert.Equal(t, "Bearer", w.Header().Get("WWW-Authenticate"))
+	})
+
+	t.Run("wrong token", func(t *testing.T) {
+		_, handler := setupTestRouter(t, testAdminToken)
+		w := postBackfill(handler, "wrong", body)
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+
+	t.Run("token required for reads when configured", func(t *testing.T) {
+		_, handler := setupTestRouter(t, testAdminToken)
+		req := httptest.NewRequest(http.MethodGet, "/reports/recent", nil)
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, req)
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+
+	t.Run("maintenance refused without configured token", func(t *testing.T) {
+		_, handler := setupTestRouter(t, "")
+		w := postBackfill(handler, "anything", body)
+		assert.Equal(t, http.StatusForbidden, w.Code)
+		var response Error
+		require.NoError(t, json.NewDecoder(w.Body).Decode(&response))
+		assert.Equal(t, "ADMIN_TOKEN_NOT_CONFIGURED", *response.Code)
+	})
+
+	t.Run("reads open without configured token", func(t *testing.T) {
+		_, handler := setupTestRouter(t, "")
+		req := httptest.NewRequest(http.MethodGet, "/reports/recent", nil)
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, req)
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+}
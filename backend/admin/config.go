@@ -0,0 +1,8 @@
+package admin
+
+// Config holds configuration for the admin API
+type Config struct {
+	// Token is the bearer token required by the admin API. When empty, read-only
+	// admin endpoints stay open and maintenance operations are refused.
+	Token string `yaml:"token"`
+}
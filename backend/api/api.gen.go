@@ -6,6 +6,7 @@ package api
 import (
 	"bytes"
 	"compress/gzip"
+	"context"
 	"encoding/base64"
 	"fmt"
 	"net/http"
@@ -19,6 +20,10 @@ import (
 	"github.com/oapi-codegen/runtime"
 )
 
+const (
+	BearerAuthScopes = "bearerAuth.Scopes"
+)
+
 // Defines values for CheckReportStatus.
 const (
 	CheckReportStatusCompleted CheckReportStatus = "completed"
@@ -30,6 +35,37 @@ const (
 	CheckReportStatusUnknown   CheckReportStatus = "unknown"
 )
 
+// Defines values for ComponentLifecycle.
+const (
+	Deprecated   ComponentLifecycle = "deprecated"
+	Experimental ComponentLifecycle = "experimental"
+	Production   ComponentLifecycle = "production"
+)
+
+// Defines values for LatestReportStatus.
+const (
+	LatestReportStatusCompleted LatestReportStatus = "completed"
+	LatestReportStatusDisabled  LatestReportStatus = "disabled"
+	LatestReportStatusError     LatestReportStatus = "error"
+	LatestReportStatusFail      LatestReportStatus = "fail"
+	LatestReportStatusPass      LatestReportStatus = "pass"
+	LatestReportStatusSkipped   LatestReportStatus = "skipped"
+	LatestReportStatusUnknown   LatestReportStatus = "unknown"
+)
+
+// Defines values for GetComponentHistoryParamsField.
+const (
+	ComponentId    GetComponentHistoryParamsField = "component_id"
+	DependsOn      GetComponentHistoryParamsField = "depends_on"
+	Description    GetComponentHistoryParamsField = "description"
+	ExpectedChecks GetComponentHistoryParamsField = "expected_checks"
+	Labels         GetComponentHistoryParamsField = "labels"
+	Lifecycle      GetComponentHistoryParamsField = "lifecycle"
+	Maintainers    GetComponentHistoryParamsField = "maintainers"
+	Name           GetComponentHistoryParamsField = "name"
+	Team           GetComponentHistoryParamsField = "team"
+)
+
 // Defines values for GetComponentReportsParamsStatus.
 const (
 	GetComponentReportsParamsStatusCompleted GetComponentReportsParamsStatus = "completed"
@@ -41,14 +77,72 @@ const (
 	GetComponentReportsParamsStatusUnknown   GetComponentReportsParamsStatus = "unknown"
 )
 
+// Defines values for GetComponentReportsParamsSort.
+const (
+	Status    GetComponentReportsParamsSort = "status"
+	Timestamp GetComponentReportsParamsSort = "timestamp"
+)
+
+// Defines values for GetComponentReportsParamsOrder.
+const (
+	Asc  GetComponentReportsParamsOrder = "asc"
+	Desc GetComponentReportsParamsOrder = "desc"
+)
+
+// Defines values for GetComponentReportsParamsFormat.
+const (
+	Csv  GetComponentReportsParamsFormat = "csv"
+	Json GetComponentReportsParamsFormat = "json"
+)
+
+// Defines values for StreamComponentReportsParamsStatus.
+const (
+	StreamComponentReportsParamsStatusCompleted StreamComponentReportsParamsStatus = "completed"
+	StreamComponentReportsParamsStatusDisabled  StreamComponentReportsParamsStatus = "disabled"
+	StreamComponentReportsParamsStatusError     StreamComponentReportsParamsStatus = "error"
+	StreamComponentReportsParamsStatusFail      StreamComponentReportsParamsStatus = "fail"
+	StreamComponentReportsParamsStatusPass      StreamComponentReportsParamsStatus = "pass"
+	StreamComponentReportsParamsStatusSkipped   StreamComponentReportsParamsStatus = "skipped"
+	StreamComponentReportsParamsStatusUnknown   StreamComponentReportsParamsStatus = "unknown"
+)
+
+// Defines values for GetComponentReportsTimeSeriesParamsBucket.
+const (
+	N1d GetComponentReportsTimeSeriesParamsBucket = "1d"
+	N1h GetComponentReportsTimeSeriesParamsBucket = "1h"
+)
+
+// Defines values for GetLatestReportsParamsStatus.
+const (
+	GetLatestReportsParamsStatusCompleted GetLatestReportsParamsStatus = "completed"
+	GetLatestReportsParamsStatusDisabled  GetLatestReportsParamsStatus = "disabled"
+	GetLatestReportsParamsStatusError     GetLatestReportsParamsStatus = "error"
+	GetLatestReportsParamsStatusFail      GetLatestReportsParamsStatus = "fail"
+	GetLatestReportsParamsStatusPass      GetLatestReportsParamsStatus = "pass"
+	GetLatestReportsParamsStatusSkipped   GetLatestReportsParamsStatus = "skipped"
+	GetLatestReportsParamsStatusUnknown   GetLatestReportsParamsStatus = "unknown"
+)
+
 // CheckReport A quality check report for a component
 type CheckReport struct {
 	// CheckSlug Unique identifier for the check type
 	CheckSlug string `json:"check_slug"`
 
+	// Details Check-specific details, only present when details are included
+	Details *map[string]interface{} `json:"details,omitempty"`
+
+	// DurationMs How long the check run took in milliseconds, only present when the submission reported it
+	DurationMs *int64 `json:"duration_ms,omitempty"`
+
 	// Id Unique identifier for the report
 	Id string `json:"id"`
 
+	// Metadata Report metadata such as CI job information, only present when metadata is included
+	Metadata *map[string]interface{} `json:"metadata,omitempty"`
+
+	// StartedAt When the check run started, only present when the submission reported it
+	StartedAt *time.Time `json:"started_at,omitempty"`
+
 	// Status Status of the check execution
 	Status CheckReportStatus `json:"status"`
 
@@ -56,139 +150,1730 @@ type CheckReport struct {
 	Timestamp time.Time `json:"timestamp"`
 }
 
-// CheckReportStatus Status of the check execution
-type CheckReportStatus string
+// CheckReportStatus Status of the check execution
+type CheckReportStatus string
+
+// CheckStatusCounts Counts per status: in a summary, the number of checks whose latest report has each status;
+// in a time series bucket, the number of reports with each status
+type CheckStatusCounts struct {
+	Completed int `json:"completed"`
+	Disabled  int `json:"disabled"`
+	Error     int `json:"error"`
+	Fail      int `json:"fail"`
+	Pass      int `json:"pass"`
+	Skipped   int `json:"skipped"`
+	Unknown   int `json:"unknown"`
+}
+
+// CheckUsage A check along with how much it is used
+type CheckUsage struct {
+	// ComponentCount Number of distinct components that reported this check, only present when include_component_count is set
+	ComponentCount *int `json:"component_count,omitempty"`
+
+	// Description What the check verifies
+	Description *string `json:"description,omitempty"`
+
+	// Name Human-readable name of the check
+	Name string `json:"name"`
+
+	// ReportCount Number of reports for this check
+	ReportCount int `json:"report_count"`
+
+	// Slug Unique identifier for the check type
+	Slug string `json:"slug"`
+}
+
+// ChecksResponse Response containing checks with pagination
+type ChecksResponse struct {
+	Checks []CheckUsage `json:"checks"`
+
+	// Pagination Pagination metadata for list responses
+	Pagination Pagination `json:"pagination"`
+}
+
+// Component A component discovered from a source
+type Component struct {
+	// DeletedAt When the component was soft-deleted. Only set on components listed with include_deleted.
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+
+	// DependsOn Identifiers of the components the component's manifest declares it depends on
+	DependsOn *[]string `json:"depends_on,omitempty"`
+
+	// Description Additional context about the component's purpose and functionality
+	Description *string `json:"description,omitempty"`
+
+	// ExpectedChecks Slugs of the checks the component's manifest expects to report on it
+	ExpectedChecks *[]string `json:"expected_checks,omitempty"`
+
+	// Id Unique identifier for the component. If not provided, the name will be used as the identifier.
+	Id *string `json:"id,omitempty"`
+
+	// Labels Free-form key/value labels used to group and filter components
+	Labels *map[string]string `json:"labels,omitempty"`
+
+	// Lifecycle Lifecycle stage of the component
+	Lifecycle *ComponentLifecycle `json:"lifecycle,omitempty"`
+
+	// Name Human-readable name of the component
+	Name string `json:"name"`
+
+	// Owners Ownership information for a component
+	Owners *Owners `json:"owners,omitempty"`
+
+	// ReportSummary Checks of a component counted by the status of their latest report
+	ReportSummary *ComponentReportsSummary `json:"report_summary,omitempty"`
+
+	// SourceRevision Version of the source the component's current data was synced from: the commit hash
+	// for git sources, or a sha256 content hash of the manifest for filesystem and http sources
+	SourceRevision *string `json:"source_revision,omitempty"`
+}
+
+// ComponentLifecycle Lifecycle stage of the component
+type ComponentLifecycle string
+
+// ComponentCount Number of components matching the filters
+type ComponentCount struct {
+	Count int64 `json:"count"`
+}
+
+// ComponentDependenciesResponse Components a component depends on
+type ComponentDependenciesResponse struct {
+	Dependencies []ComponentDependency `json:"dependencies"`
+}
+
+// ComponentDependency A component declared under depends_on
+type ComponentDependency struct {
+	// Component A component discovered from a source
+	Component   *Component `json:"component,omitempty"`
+	ComponentId string     `json:"component_id"`
+
+	// Dangling Whether no live component has the identifier, for example because it was never synced
+	Dangling bool `json:"dangling"`
+}
+
+// ComponentDependentsResponse Components that depend on a component
+type ComponentDependentsResponse struct {
+	Dependents []Component `json:"dependents"`
+}
+
+// ComponentHistoryEntry The fields changed by a single component update
+type ComponentHistoryEntry struct {
+	// ChangedAt When the change was recorded
+	ChangedAt time.Time `json:"changed_at"`
+
+	// Changes Changed fields keyed by field name
+	Changes map[string]FieldChange `json:"changes"`
+
+	// Id Unique identifier for the history entry
+	Id string `json:"id"`
+
+	// Source Source that made the change
+	Source *string `json:"source,omitempty"`
+}
+
+// ComponentHistoryResponse Response containing component history entries with pagination
+type ComponentHistoryResponse struct {
+	Entries []ComponentHistoryEntry `json:"entries"`
+
+	// Pagination Pagination metadata for list responses
+	Pagination Pagination `json:"pagination"`
+}
+
+// ComponentImpactResponse Components at risk when a component fails
+type ComponentImpactResponse struct {
+	Components []ImpactedComponent `json:"components"`
+
+	// MaxDepth The max_depth dependency edges were followed to
+	MaxDepth int `json:"max_depth"`
+}
+
+// ComponentReportsResponse Response containing component reports with pagination
+type ComponentReportsResponse struct {
+	// Pagination Pagination metadata for list responses
+	Pagination Pagination `json:"pagination"`
+
+	// Reports List of check reports for the component
+	Reports []CheckReport `json:"reports"`
+}
+
+// ComponentReportsSummary Checks of a component counted by the status of their latest report
+type ComponentReportsSummary struct {
+	// LatestReportAt Timestamp of the most recent report, null when the component has no reports
+	LatestReportAt *time.Time `json:"latest_report_at"`
+
+	// StatusCounts Counts per status: in a summary, the number of checks whose latest report has each status;
+	// in a time series bucket, the number of reports with each status
+	StatusCounts CheckStatusCounts `json:"status_counts"`
+
+	// TotalChecks Number of checks that have reported for the component
+	TotalChecks int `json:"total_checks"`
+}
+
+// ComponentSearchResponse Response containing matching components with pagination
+type ComponentSearchResponse struct {
+	Components []Component `json:"components"`
+
+	// Pagination Pagination metadata for list responses
+	Pagination Pagination `json:"pagination"`
+}
+
+// ComponentsResponse Response containing a page of components with pagination
+type ComponentsResponse struct {
+	Components []Component `json:"components"`
+
+	// Pagination Pagination metadata for list responses
+	Pagination Pagination `json:"pagination"`
+}
+
+// Error Error response
+type Error struct {
+	// Code Error code
+	Code *string `json:"code,omitempty"`
+
+	// Error Error message
+	Error string `json:"error"`
+}
+
+// FieldChange Old and new value of a changed field
+type FieldChange struct {
+	// New Value after the change
+	New interface{} `json:"new,omitempty"`
+
+	// Old Value before the change
+	Old interface{} `json:"old,omitempty"`
+}
+
+// ImpactedComponent A component that depends on the failing component, directly or transitively
+type ImpactedComponent struct {
+	// Component A component discovered from a source
+	Component Component `json:"component"`
+
+	// Depth Number of dependency edges between the failing component and this one, 1 for direct dependents
+	Depth int `json:"depth"`
+}
+
+// LatestReport defines model for LatestReport.
+type LatestReport struct {
+	// CheckSlug Unique identifier for the check type
+	CheckSlug string `json:"check_slug"`
+
+	// ComponentId Identifier of the component the report is about
+	ComponentId string `json:"component_id"`
+
+	// Details Check-specific details, only present when details are included
+	Details *map[string]interface{} `json:"details,omitempty"`
+
+	// DurationMs How long the check run took in milliseconds, only present when the submission reported it
+	DurationMs *int64 `json:"duration_ms,omitempty"`
+
+	// Id Unique identifier for the report
+	Id string `json:"id"`
+
+	// Metadata Report metadata such as CI job information, only present when metadata is included
+	Metadata *map[string]interface{} `json:"metadata,omitempty"`
+
+	// StartedAt When the check run started, only present when the submission reported it
+	StartedAt *time.Time `json:"started_at,omitempty"`
+
+	// Status Status of the check execution
+	Status LatestReportStatus `json:"status"`
+
+	// Timestamp When the check was executed
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// LatestReportStatus Status of the check execution
+type LatestReportStatus string
+
+// LatestReportsResponse Response containing the latest report of every component and check, with pagination
+type LatestReportsResponse struct {
+	// Pagination Pagination metadata for list responses
+	Pagination Pagination     `json:"pagination"`
+	Reports    []LatestReport `json:"reports"`
+}
+
+// MaintainerInput A maintainer to add to a component
+type MaintainerInput struct {
+	// Identifier Maintainer identifier (email, GitHub handle, or other user identifier)
+	Identifier string `json:"identifier"`
+}
+
+// MaintainersResponse The maintainers of a component after a change
+type MaintainersResponse struct {
+	Maintainers []string `json:"maintainers"`
+}
+
+// ManifestError A problem found in a manifest
+type ManifestError struct {
+	// Field Path of the offending field, when known
+	Field *string `json:"field,omitempty"`
+
+	// Line Line of the problem in the manifest, when known
+	Line *int `json:"line,omitempty"`
+
+	// Message What is wrong
+	Message string `json:"message"`
+}
+
+// ManifestValidation Result of validating a manifest
+type ManifestValidation struct {
+	// Component A component discovered from a source
+	Component *Component `json:"component,omitempty"`
+
+	// Errors Problems found in the manifest, empty when it is valid
+	Errors []ManifestError `json:"errors"`
+
+	// Valid Whether the manifest is valid
+	Valid bool `json:"valid"`
+}
+
+// MissingCheck An expected check without a recent report
+type MissingCheck struct {
+	// LatestReportAt Timestamp of the check's latest report on the component, null when it never reported
+	LatestReportAt *time.Time `json:"latest_report_at"`
+	Slug           string     `json:"slug"`
+}
+
+// MissingChecksResponse Expected checks of a component without a recent report
+type MissingChecksResponse struct {
+	Checks []MissingCheck `json:"checks"`
+
+	// MaxAge The max_age the checks were compared against
+	MaxAge string `json:"max_age"`
+}
+
+// Owners Ownership information for a component
+type Owners struct {
+	// Maintainers List of user identifiers responsible for maintaining this component
+	Maintainers *[]string `json:"maintainers,omitempty"`
+
+	// Team Team responsible for owning this component
+	Team *string `json:"team,omitempty"`
+}
+
+// Pagination Pagination metadata for list responses
+type Pagination struct {
+	// HasMore Whether another page follows, at offset + limit. A page with fewer than limit items is always the last.
+	HasMore bool `json:"has_more"`
+
+	// Limit Number of items returned in this response
+	Limit int `json:"limit"`
+
+	// NextCursor Cursor for the next page of component reports, pass it back as the cursor parameter. Only set when there are more items, latest_per_check is not used and reports are sorted by timestamp descending.
+	NextCursor *string `json:"next_cursor"`
+
+	// Offset Offset used for this response
+	Offset int `json:"offset"`
+
+	// Total Total number of items available
+	Total int `json:"total"`
+}
+
+// ReportStatusBucket Reports counted by status within one time bucket
+type ReportStatusBucket struct {
+	// Start Start of the bucket in UTC, inclusive
+	Start time.Time `json:"start"`
+
+	// StatusCounts Counts per status: in a summary, the number of checks whose latest report has each status;
+	// in a time series bucket, the number of reports with each status
+	StatusCounts CheckStatusCounts `json:"status_counts"`
+}
+
+// ReportTimeSeries Report status counts of a check in consecutive time buckets
+type ReportTimeSeries struct {
+	// Bucket Size of each bucket
+	Bucket string `json:"bucket"`
+
+	// Buckets Buckets in time order, oldest first, with no gaps
+	Buckets   []ReportStatusBucket `json:"buckets"`
+	CheckSlug string               `json:"check_slug"`
+}
+
+// StaleCheck A check without a recent report
+type StaleCheck struct {
+	// Expected Whether the component's manifest lists the check
+	Expected bool `json:"expected"`
+
+	// LatestReportAt Timestamp of the check's latest report on the component, null when it never reported
+	LatestReportAt *time.Time `json:"latest_report_at"`
+	Slug           string     `json:"slug"`
+}
+
+// StaleChecksResponse Checks of a component without a recent report
+type StaleChecksResponse struct {
+	Checks []StaleCheck `json:"checks"`
+
+	// MaxAge The max_age the checks were compared against
+	MaxAge string `json:"max_age"`
+}
+
+// TeamReportsResponse Reports of the components owned by a team, with pagination
+type TeamReportsResponse struct {
+	// Components Identifiers of the team's components, including those without reports
+	Components []string `json:"components"`
+
+	// Pagination Pagination metadata for list responses
+	Pagination Pagination     `json:"pagination"`
+	Reports    []LatestReport `json:"reports"`
+
+	// Team The team the components belong to
+	Team string `json:"team"`
+}
+
+// GetChecksParams defines parameters for GetChecks.
+type GetChecksParams struct {
+	// MinReports Only include checks with at least this many reports
+	MinReports *int `form:"min_reports,omitempty" json:"min_reports,omitempty"`
+
+	// MaxReports Only include checks with at most this many reports
+	MaxReports *int `form:"max_reports,omitempty" json:"max_reports,omitempty"`
+
+	// ComponentId Only include checks with at least one report for this component.
+	// Report and component counts still cover all components.
+	ComponentId *string `form:"component_id,omitempty" json:"component_id,omitempty"`
+
+	// IncludeComponentCount Include the number of distinct components that reported each check
+	IncludeComponentCount *bool `form:"include_component_count,omitempty" json:"include_component_count,omitempty"`
+
+	// Limit Number of checks to return, 50 by default. Limits above the configured maximum, 100 by default, get the default.
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Pagination offset. Offsets past the last item return an empty page with the full total; offsets above the configured maximum (100000 by default) are rejected.
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// GetComponentsParams defines parameters for GetComponents.
+type GetComponentsParams struct {
+	// Maintainer Only return components listing this maintainer. The identifier must match a maintainer
+	// exactly, so @auth-team does not match @auth-team-lead.
+	Maintainer *string `form:"maintainer,omitempty" json:"maintainer,omitempty"`
+
+	// IncludeDeleted Also return soft-deleted components, which carry deleted_at. They are hidden by default.
+	// Cannot be combined with maintainer.
+	IncludeDeleted *bool `form:"include_deleted,omitempty" json:"include_deleted,omitempty"`
+
+	// Limit Number of components to return, 50 by default. Limits above the configured maximum, 100 by default, get the default.
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Pagination offset. Offsets past the last item return an empty page with the full total; offsets above the configured maximum (100000 by default) are rejected.
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// IfNoneMatch ETag of a previous response; a 304 is returned if the components have not changed since
+	IfNoneMatch *string `json:"If-None-Match,omitempty"`
+}
+
+// CountComponentsParams defines parameters for CountComponents.
+type CountComponentsParams struct {
+	// Team Only count components owned by this team, ignoring case and surrounding whitespace
+	Team *string `form:"team,omitempty" json:"team,omitempty"`
+
+	// Label Only count components with this label, as key=value. Repeat to require several labels.
+	Label *[]string `form:"label,omitempty" json:"label,omitempty"`
+}
+
+// GetComponentByIdParams defines parameters for GetComponentById.
+type GetComponentByIdParams struct {
+	// Include Comma-separated list of optional fields to embed in the component.
+	// Supported values are "report_summary", which adds the checks of the component
+	// counted by the status of their latest report.
+	Include *string `form:"include,omitempty" json:"include,omitempty"`
+}
+
+// GetComponentMissingChecksParams defines parameters for GetComponentMissingChecks.
+type GetComponentMissingChecksParams struct {
+	// MaxAge How recent a report must be to count, as a duration such as 24h or 30m
+	MaxAge *string `form:"max_age,omitempty" json:"max_age,omitempty"`
+}
+
+// GetComponentHistoryParams defines parameters for GetComponentHistory.
+type GetComponentHistoryParams struct {
+	// Field Only include entries that changed this field
+	Field *GetComponentHistoryParamsField `form:"field,omitempty" json:"field,omitempty"`
+
+	// Since Only include changes made at or after this timestamp (ISO 8601)
+	Since *time.Time `form:"since,omitempty" json:"since,omitempty"`
+
+	// Limit Number of entries to return, 50 by default. Limits above the configured maximum, 100 by default, get the default.
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Pagination offset. Offsets past the last item return an empty page with the full total; offsets above the configured maximum (100000 by default) are rejected.
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// GetComponentHistoryParamsField defines parameters for GetComponentHistory.
+type GetComponentHistoryParamsField string
+
+// GetComponentImpactParams defines parameters for GetComponentImpact.
+type GetComponentImpactParams struct {
+	// MaxDepth How many dependency edges to follow at most
+	MaxDepth *int `form:"max_depth,omitempty" json:"max_depth,omitempty"`
+}
+
+// GetComponentReportsParams defines parameters for GetComponentReports.
+type GetComponentReportsParams struct {
+	// Status Filter by check status. Repeat the parameter to match any of several statuses, e.g. status=fail&status=error
+	Status *[]GetComponentReportsParamsStatus `form:"status,omitempty" json:"status,omitempty"`
+
+	// CheckSlug Filter by check type. Repeat the parameter to match any of several checks, e.g. check_slug=unit-tests&check_slug=integration-tests
+	CheckSlug *[]string `form:"check_slug,omitempty" json:"check_slug,omitempty"`
+
+	// Since Filter reports since timestamp (ISO 8601)
+	Since *time.Time `form:"since,omitempty" json:"since,omitempty"`
+
+	// Before Filter reports before timestamp (ISO 8601, exclusive). Combine with since for a time window.
+	Before *time.Time `form:"before,omitempty" json:"before,omitempty"`
+
+	// Limit Number of reports to return, 50 by default. Limits above the configured maximum, 100 by default, get the default.
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Pagination offset. Offsets past the last item return an empty page with the full total; offsets above the configured maximum (100000 by default) are rejected.
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Cursor Opaque cursor from a previous response's pagination.next_cursor. Returns the reports
+	// that follow it, newest first. Cannot be combined with offset, latest_per_check or a
+	// sort other than timestamp descending.
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// LatestPerCheck Return only the latest report for each check type
+	LatestPerCheck *bool `form:"latest_per_check,omitempty" json:"latest_per_check,omitempty"`
+
+	// Sort Field to sort reports by. Reports with the same status are ordered newest first.
+	// With latest_per_check the sort applies to the latest report of each check.
+	Sort *GetComponentReportsParamsSort `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Order Sort direction
+	Order *GetComponentReportsParamsOrder `form:"order,omitempty" json:"order,omitempty"`
+
+	// Include Comma-separated list of optional report fields to include in the response.
+	// Supported values are "details" and "metadata".
+	Include *string `form:"include,omitempty" json:"include,omitempty"`
+
+	// IncludeDetails Include each report's details object in the response, same as include=details
+	IncludeDetails *bool `form:"include_details,omitempty" json:"include_details,omitempty"`
+
+	// DetailsFields Comma-separated list of dot-separated key paths to return from each report's details,
+	// e.g. "coverage.total,duration". Requires details to be included. Missing keys are omitted.
+	DetailsFields *string `form:"details_fields,omitempty" json:"details_fields,omitempty"`
+
+	// Format Response format. csv returns the page of reports as CSV with the columns id, check_slug,
+	// status and timestamp, the same as sending "Accept: text/csv". Defaults to json.
+	Format *GetComponentReportsParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// GetComponentReportsParamsStatus defines parameters for GetComponentReports.
+type GetComponentReportsParamsStatus string
+
+// GetComponentReportsParamsSort defines parameters for GetComponentReports.
+type GetComponentReportsParamsSort string
+
+// GetComponentReportsParamsOrder defines parameters for GetComponentReports.
+type GetComponentReportsParamsOrder string
+
+// GetComponentReportsParamsFormat defines parameters for GetComponentReports.
+type GetComponentReportsParamsFormat string
+
+// GetComponentStaleChecksParams defines parameters for GetComponentStaleChecks.
+type GetComponentStaleChecksParams struct {
+	// MaxAge How recent a report must be to count, as a duration such as 24h or 30m
+	MaxAge *string `form:"max_age,omitempty" json:"max_age,omitempty"`
+}
+
+// StreamComponentReportsParams defines parameters for StreamComponentReports.
+type StreamComponentReportsParams struct {
+	// Status Filter by check status. Repeat the parameter to match any of several statuses, e.g. status=fail&status=error
+	Status *[]StreamComponentReportsParamsStatus `form:"status,omitempty" json:"status,omitempty"`
+
+	// CheckSlug Filter by check type. Repeat the parameter to match any of several checks, e.g. check_slug=unit-tests&check_slug=integration-tests
+	CheckSlug *[]string `form:"check_slug,omitempty" json:"check_slug,omitempty"`
+
+	// Since Filter reports since timestamp (ISO 8601)
+	Since *time.Time `form:"since,omitempty" json:"since,omitempty"`
+
+	// Before Filter reports before timestamp (ISO 8601, exclusive). Combine with since for a time window.
+	Before *time.Time `form:"before,omitempty" json:"before,omitempty"`
+}
+
+// StreamComponentReportsParamsStatus defines parameters for StreamComponentReports.
+type StreamComponentReportsParamsStatus string
+
+// GetComponentReportsTimeSeriesParams defines parameters for GetComponentReportsTimeSeries.
+type GetComponentReportsTimeSeriesParams struct {
+	// CheckSlug Check whose reports are counted
+	CheckSlug string `form:"check_slug" json:"check_slug"`
+
+	// Bucket Size of each bucket, an hour or a day
+	Bucket *GetComponentReportsTimeSeriesParamsBucket `form:"bucket,omitempty" json:"bucket,omitempty"`
+
+	// Since Start of the range, rounded down to a bucket boundary. Defaults to 30 buckets before now.
+	// The range may span at most 1000 buckets.
+	Since *time.Time `form:"since,omitempty" json:"since,omitempty"`
+}
+
+// GetComponentReportsTimeSeriesParamsBucket defines parameters for GetComponentReportsTimeSeries.
+type GetComponentReportsTimeSeriesParamsBucket string
+
+// SearchComponentsParams defines parameters for SearchComponents.
+type SearchComponentsParams struct {
+	// Q Text to search for
+	Q string `form:"q" json:"q"`
+
+	// Limit Number of components to return, 50 by default. Limits above the configured maximum, 100 by default, get the default.
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Pagination offset. Offsets past the last item return an empty page with the full total; offsets above the configured maximum (100000 by default) are rejected.
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// ValidateManifestParams defines parameters for ValidateManifest.
+type ValidateManifestParams struct {
+	// Strict Reject unknown fields, like sources configured with strict manifests
+	Strict *bool `form:"strict,omitempty" json:"strict,omitempty"`
+}
+
+// GetLatestReportsParams defines parameters for GetLatestReports.
+type GetLatestReportsParams struct {
+	// Status Only return pairs whose latest report has one of these statuses. Repeat the parameter to
+	// match any of several statuses, e.g. status=fail&status=error
+	Status *[]GetLatestReportsParamsStatus `form:"status,omitempty" json:"status,omitempty"`
+
+	// CheckSlug Only return these checks. Repeat the parameter to match any of several checks.
+	CheckSlug *[]string `form:"check_slug,omitempty" json:"check_slug,omitempty"`
+
+	// Team Only return components owned by this team, ignoring case and surrounding whitespace
+	Team *string `form:"team,omitempty" json:"team,omitempty"`
+
+	// Limit Number of reports to return, 50 by default. Limits above the configured maximum, 100 by default, get the default.
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Pagination offset. Offsets past the last item return an empty page with the full total; offsets above the configured maximum (100000 by default) are rejected.
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// GetLatestReportsParamsStatus defines parameters for GetLatestReports.
+type GetLatestReportsParamsStatus string
+
+// GetTeamReportsParams defines parameters for GetTeamReports.
+type GetTeamReportsParams struct {
+	// CheckSlug Only return reports of this check
+	CheckSlug *string `form:"check_slug,omitempty" json:"check_slug,omitempty"`
+
+	// LatestPerCheck Return only the latest report of each component and check
+	LatestPerCheck *bool `form:"latest_per_check,omitempty" json:"latest_per_check,omitempty"`
+
+	// Limit Number of reports to return, 50 by default. Limits above the configured maximum, 100 by default, get the default.
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Pagination offset. Offsets past the last item return an empty page with the full total; offsets above the configured maximum (100000 by default) are rejected.
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// AddComponentMaintainerJSONRequestBody defines body for AddComponentMaintainer for application/json ContentType.
+type AddComponentMaintainerJSONRequestBody = MaintainerInput
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// List checks with usage
+	// (GET /checks)
+	GetChecks(w http.ResponseWriter, r *http.Request, params GetChecksParams)
+	// Get all components
+	// (GET /components)
+	GetComponents(w http.ResponseWriter, r *http.Request, params GetComponentsParams)
+	// Count components
+	// (GET /components/count)
+	CountComponents(w http.ResponseWriter, r *http.Request, params CountComponentsParams)
+	// Delete a component
+	// (DELETE /components/{componentId})
+	DeleteComponent(w http.ResponseWriter, r *http.Request, componentId string)
+	// Get component by ID
+	// (GET /components/{componentId})
+	GetComponentById(w http.ResponseWriter, r *http.Request, componentId string, params GetComponentByIdParams)
+	// List expected checks without a recent report
+	// (GET /components/{componentId}/checks/missing)
+	GetComponentMissingChecks(w http.ResponseWriter, r *http.Request, componentId string, params GetComponentMissingChecksParams)
+	// List the components a component depends on
+	// (GET /components/{componentId}/dependencies)
+	GetComponentDependencies(w http.ResponseWriter, r *http.Request, componentId string)
+	// List the components that depend on a component
+	// (GET /components/{componentId}/dependents)
+	GetComponentDependents(w http.ResponseWriter, r *http.Request, componentId string)
+	// Get change history for component
+	// (GET /components/{componentId}/history)
+	GetComponentHistory(w http.ResponseWriter, r *http.Request, componentId string, params GetComponentHistoryParams)
+	// List the components at risk when a component fails
+	// (GET /components/{componentId}/impact)
+	GetComponentImpact(w http.ResponseWriter, r *http.Request, componentId string, params GetComponentImpactParams)
+	// Add a maintainer to a component
+	// (POST /components/{componentId}/maintainers)
+	AddComponentMaintainer(w http.ResponseWriter, r *http.Request, componentId string)
+	// Remove a maintainer from a component
+	// (DELETE /components/{componentId}/maintainers/{identifier})
+	RemoveComponentMaintainer(w http.ResponseWriter, r *http.Request, componentId string, identifier string)
+	// Get reports for component
+	// (GET /components/{componentId}/reports)
+	GetComponentReports(w http.ResponseWriter, r *http.Request, componentId string, params GetComponentReportsParams)
+	// List checks that stopped reporting
+	// (GET /components/{componentId}/reports/stale)
+	GetComponentStaleChecks(w http.ResponseWriter, r *http.Request, componentId string, params GetComponentStaleChecksParams)
+	// Stream all reports for component
+	// (GET /components/{componentId}/reports/stream)
+	StreamComponentReports(w http.ResponseWriter, r *http.Request, componentId string, params StreamComponentReportsParams)
+	// Get a rollup of a component's check statuses
+	// (GET /components/{componentId}/reports/summary)
+	GetComponentReportsSummary(w http.ResponseWriter, r *http.Request, componentId string)
+	// Count a check's reports by status over time
+	// (GET /components/{componentId}/reports/timeseries)
+	GetComponentReportsTimeSeries(w http.ResponseWriter, r *http.Request, componentId string, params GetComponentReportsTimeSeriesParams)
+	// Get a single report of a component
+	// (GET /components/{componentId}/reports/{reportId})
+	GetComponentReportById(w http.ResponseWriter, r *http.Request, componentId string, reportId string)
+	// Search components
+	// (GET /components:search)
+	SearchComponents(w http.ResponseWriter, r *http.Request, params SearchComponentsParams)
+	// Validate a component manifest
+	// (POST /manifests/validate)
+	ValidateManifest(w http.ResponseWriter, r *http.Request, params ValidateManifestParams)
+	// Get the latest report of every component and check
+	// (GET /reports/latest)
+	GetLatestReports(w http.ResponseWriter, r *http.Request, params GetLatestReportsParams)
+	// Get the reports of a team's components
+	// (GET /teams/{team}/reports)
+	GetTeamReports(w http.ResponseWriter, r *http.Request, team string, params GetTeamReportsParams)
+}
+
+// Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
+
+type Unimplemented struct{}
+
+// List checks with usage
+// (GET /checks)
+func (_ Unimplemented) GetChecks(w http.ResponseWriter, r *http.Request, params GetChecksParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get all components
+// (GET /components)
+func (_ Unimplemented) GetComponents(w http.ResponseWriter, r *http.Request, params GetComponentsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Count components
+// (GET /components/count)
+func (_ Unimplemented) CountComponents(w http.ResponseWriter, r *http.Request, params CountComponentsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete a component
+// (DELETE /components/{componentId})
+func (_ Unimplemented) DeleteComponent(w http.ResponseWriter, r *http.Request, componentId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get component by ID
+// (GET /components/{componentId})
+func (_ Unimplemented) GetComponentById(w http.ResponseWriter, r *http.Request, componentId string, params GetComponentByIdParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List expected checks without a recent report
+// (GET /components/{componentId}/checks/missing)
+func (_ Unimplemented) GetComponentMissingChecks(w http.ResponseWriter, r *http.Request, componentId string, params GetComponentMissingChecksParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List the components a component depends on
+// (GET /components/{componentId}/dependencies)
+func (_ Unimplemented) GetComponentDependencies(w http.ResponseWriter, r *http.Request, componentId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List the components that depend on a component
+// (GET /components/{componentId}/dependents)
+func (_ Unimplemented) GetComponentDependents(w http.ResponseWriter, r *http.Request, componentId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get change history for component
+// (GET /components/{componentId}/history)
+func (_ Unimplemented) GetComponentHistory(w http.ResponseWriter, r *http.Request, componentId string, params GetComponentHistoryParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List the components at risk when a component fails
+// (GET /components/{componentId}/impact)
+func (_ Unimplemented) GetComponentImpact(w http.ResponseWriter, r *http.Request, componentId string, params GetComponentImpactParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Add a maintainer to a component
+// (POST /components/{componentId}/maintainers)
+func (_ Unimplemented) AddComponentMaintainer(w http.ResponseWriter, r *http.Request, componentId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Remove a maintainer from a component
+// (DELETE /components/{componentId}/maintainers/{identifier})
+func (_ Unimplemented) RemoveComponentMaintainer(w http.ResponseWriter, r *http.Request, componentId string, identifier string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get reports for component
+// (GET /components/{componentId}/reports)
+func (_ Unimplemented) GetComponentReports(w http.ResponseWriter, r *http.Request, componentId string, params GetComponentReportsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List checks that stopped reporting
+// (GET /components/{componentId}/reports/stale)
+func (_ Unimplemented) GetComponentStaleChecks(w http.ResponseWriter, r *http.Request, componentId string, params GetComponentStaleChecksParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Stream all reports for component
+// (GET /components/{componentId}/reports/stream)
+func (_ Unimplemented) StreamComponentReports(w http.ResponseWriter, r *http.Request, componentId string, params StreamComponentReportsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get a rollup of a component's check statuses
+// (GET /components/{componentId}/reports/summary)
+func (_ Unimplemented) GetComponentReportsSummary(w http.ResponseWriter, r *http.Request, componentId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Count a check's reports by status over time
+// (GET /components/{componentId}/reports/timeseries)
+func (_ Unimplemented) GetComponentReportsTimeSeries(w http.ResponseWriter, r *http.Request, componentId string, params GetComponentReportsTimeSeriesParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get a single report of a component
+// (GET /components/{componentId}/reports/{reportId})
+func (_ Unimplemented) GetComponentReportById(w http.ResponseWriter, r *http.Request, componentId string, reportId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Search components
+// (GET /components:search)
+func (_ Unimplemented) SearchComponents(w http.ResponseWriter, r *http.Request, params SearchComponentsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Validate a component manifest
+// (POST /manifests/validate)
+func (_ Unimplemented) ValidateManifest(w http.ResponseWriter, r *http.Request, params ValidateManifestParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get the latest report of every component and check
+// (GET /reports/latest)
+func (_ Unimplemented) GetLatestReports(w http.ResponseWriter, r *http.Request, params GetLatestReportsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get the reports of a team's components
+// (GET /teams/{team}/reports)
+func (_ Unimplemented) GetTeamReports(w http.ResponseWriter, r *http.Request, team string, params GetTeamReportsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+type MiddlewareFunc func(http.Handler) http.Handler
+
+// GetChecks operation middleware
+func (siw *ServerInterfaceWrapper) GetChecks(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetChecksParams
+
+	// ------------- Optional query parameter "min_reports" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "min_reports", r.URL.Query(), &params.MinReports)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "min_reports", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "max_reports" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "max_reports", r.URL.Query(), &params.MaxReports)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "max_reports", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "component_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "component_id", r.URL.Query(), &params.ComponentId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "component_id", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "include_component_count" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "include_component_count", r.URL.Query(), &params.IncludeComponentCount)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "include_component_count", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetChecks(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetComponents operation middleware
+func (siw *ServerInterfaceWrapper) GetComponents(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetComponentsParams
+
+	// ------------- Optional query parameter "maintainer" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "maintainer", r.URL.Query(), &params.Maintainer)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "maintainer", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "include_deleted" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "include_deleted", r.URL.Query(), &params.IncludeDeleted)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "include_deleted", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-None-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-None-Match")]; found {
+		var IfNoneMatch string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-None-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-None-Match", valueList[0], &IfNoneMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-None-Match", Err: err})
+			return
+		}
+
+		params.IfNoneMatch = &IfNoneMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetComponents(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CountComponents operation middleware
+func (siw *ServerInterfaceWrapper) CountComponents(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CountComponentsParams
+
+	// ------------- Optional query parameter "team" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "team", r.URL.Query(), &params.Team)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "label" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "label", r.URL.Query(), &params.Label)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "label", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CountComponents(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteComponent operation middleware
+func (siw *ServerInterfaceWrapper) DeleteComponent(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "componentId" -------------
+	var componentId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "componentId", chi.URLParam(r, "componentId"), &componentId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "componentId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteComponent(w, r, componentId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetComponentById operation middleware
+func (siw *ServerInterfaceWrapper) GetComponentById(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "componentId" -------------
+	var componentId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "componentId", chi.URLParam(r, "componentId"), &componentId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "componentId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetComponentByIdParams
+
+	// ------------- Optional query parameter "include" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "include", r.URL.Query(), &params.Include)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "include", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetComponentById(w, r, componentId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetComponentMissingChecks operation middleware
+func (siw *ServerInterfaceWrapper) GetComponentMissingChecks(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "componentId" -------------
+	var componentId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "componentId", chi.URLParam(r, "componentId"), &componentId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "componentId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetComponentMissingChecksParams
+
+	// ------------- Optional query parameter "max_age" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "max_age", r.URL.Query(), &params.MaxAge)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "max_age", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetComponentMissingChecks(w, r, componentId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetComponentDependencies operation middleware
+func (siw *ServerInterfaceWrapper) GetComponentDependencies(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "componentId" -------------
+	var componentId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "componentId", chi.URLParam(r, "componentId"), &componentId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "componentId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetComponentDependencies(w, r, componentId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetComponentDependents operation middleware
+func (siw *ServerInterfaceWrapper) GetComponentDependents(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "componentId" -------------
+	var componentId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "componentId", chi.URLParam(r, "componentId"), &componentId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "componentId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetComponentDependents(w, r, componentId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetComponentHistory operation middleware
+func (siw *ServerInterfaceWrapper) GetComponentHistory(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "componentId" -------------
+	var componentId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "componentId", chi.URLParam(r, "componentId"), &componentId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "componentId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetComponentHistoryParams
+
+	// ------------- Optional query parameter "field" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "field", r.URL.Query(), &params.Field)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "field", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "since" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "since", r.URL.Query(), &params.Since)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "since", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetComponentHistory(w, r, componentId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetComponentImpact operation middleware
+func (siw *ServerInterfaceWrapper) GetComponentImpact(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "componentId" -------------
+	var componentId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "componentId", chi.URLParam(r, "componentId"), &componentId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "componentId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetComponentImpactParams
+
+	// ------------- Optional query parameter "max_depth" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "max_depth", r.URL.Query(), &params.MaxDepth)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "max_depth", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetComponentImpact(w, r, componentId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// AddComponentMaintainer operation middleware
+func (siw *ServerInterfaceWrapper) AddComponentMaintainer(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "componentId" -------------
+	var componentId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "componentId", chi.URLParam(r, "componentId"), &componentId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "componentId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AddComponentMaintainer(w, r, componentId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RemoveComponentMaintainer operation middleware
+func (siw *ServerInterfaceWrapper) RemoveComponentMaintainer(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "componentId" -------------
+	var componentId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "componentId", chi.URLParam(r, "componentId"), &componentId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "componentId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "identifier" -------------
+	var identifier string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "identifier", chi.URLParam(r, "identifier"), &identifier, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "identifier", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RemoveComponentMaintainer(w, r, componentId, identifier)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetComponentReports operation middleware
+func (siw *ServerInterfaceWrapper) GetComponentReports(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "componentId" -------------
+	var componentId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "componentId", chi.URLParam(r, "componentId"), &componentId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "componentId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetComponentReportsParams
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "check_slug" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "check_slug", r.URL.Query(), &params.CheckSlug)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "check_slug", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "since" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "since", r.URL.Query(), &params.Since)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "since", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "before" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "before", r.URL.Query(), &params.Before)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "before", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "latest_per_check" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "latest_per_check", r.URL.Query(), &params.LatestPerCheck)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "latest_per_check", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", r.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "order" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "order", r.URL.Query(), &params.Order)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "order", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "include" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "include", r.URL.Query(), &params.Include)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "include", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "include_details" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "include_details", r.URL.Query(), &params.IncludeDetails)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "include_details", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "details_fields" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "details_fields", r.URL.Query(), &params.DetailsFields)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "details_fields", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", r.URL.Query(), &params.Format)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "format", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetComponentReports(w, r, componentId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetComponentStaleChecks operation middleware
+func (siw *ServerInterfaceWrapper) GetComponentStaleChecks(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "componentId" -------------
+	var componentId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "componentId", chi.URLParam(r, "componentId"), &componentId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "componentId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetComponentStaleChecksParams
+
+	// ------------- Optional query parameter "max_age" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "max_age", r.URL.Query(), &params.MaxAge)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "max_age", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetComponentStaleChecks(w, r, componentId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// StreamComponentReports operation middleware
+func (siw *ServerInterfaceWrapper) StreamComponentReports(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "componentId" -------------
+	var componentId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "componentId", chi.URLParam(r, "componentId"), &componentId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "componentId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params StreamComponentReportsParams
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "check_slug" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "check_slug", r.URL.Query(), &params.CheckSlug)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "check_slug", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "since" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "since", r.URL.Query(), &params.Since)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "since", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "before" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "before", r.URL.Query(), &params.Before)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "before", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.StreamComponentReports(w, r, componentId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetComponentReportsSummary operation middleware
+func (siw *ServerInterfaceWrapper) GetComponentReportsSummary(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "componentId" -------------
+	var componentId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "componentId", chi.URLParam(r, "componentId"), &componentId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "componentId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetComponentReportsSummary(w, r, componentId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetComponentReportsTimeSeries operation middleware
+func (siw *ServerInterfaceWrapper) GetComponentReportsTimeSeries(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "componentId" -------------
+	var componentId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "componentId", chi.URLParam(r, "componentId"), &componentId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "componentId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetComponentReportsTimeSeriesParams
 
-// Component A component discovered from a source
-type Component struct {
-	// Description Additional context about the component's purpose and functionality
-	Description *string `json:"description,omitempty"`
+	// ------------- Required query parameter "check_slug" -------------
 
-	// Id Unique identifier for the component. If not provided, the name will be used as the identifier.
-	Id *string `json:"id,omitempty"`
+	if paramValue := r.URL.Query().Get("check_slug"); paramValue != "" {
 
-	// Name Human-readable name of the component
-	Name string `json:"name"`
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "check_slug"})
+		return
+	}
 
-	// Owners Ownership information for a component
-	Owners *Owners `json:"owners,omitempty"`
-}
+	err = runtime.BindQueryParameter("form", true, true, "check_slug", r.URL.Query(), &params.CheckSlug)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "check_slug", Err: err})
+		return
+	}
 
-// ComponentReportsResponse Response containing component reports with pagination
-type ComponentReportsResponse struct {
-	// Pagination Pagination metadata for list responses
-	Pagination Pagination `json:"pagination"`
+	// ------------- Optional query parameter "bucket" -------------
 
-	// Reports List of check reports for the component
-	Reports []CheckReport `json:"reports"`
-}
+	err = runtime.BindQueryParameter("form", true, false, "bucket", r.URL.Query(), &params.Bucket)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "bucket", Err: err})
+		return
+	}
 
-// Error Error response
-type Error struct {
-	// Code Error code
-	Code *string `json:"code,omitempty"`
+	// ------------- Optional query parameter "since" -------------
 
-	// Error Error message
-	Error string `json:"error"`
-}
+	err = runtime.BindQueryParameter("form", true, false, "since", r.URL.Query(), &params.Since)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "since", Err: err})
+		return
+	}
 
-// Owners Ownership information for a component
-type Owners struct {
-	// Maintainers List of user identifiers responsible for maintaining this component
-	Maintainers *[]string `json:"maintainers,omitempty"`
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetComponentReportsTimeSeries(w, r, componentId, params)
+	}))
 
-	// Team Team responsible for owning this component
-	Team *string `json:"team,omitempty"`
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-// Pagination Pagination metadata for list responses
-type Pagination struct {
-	// HasMore Whether there are more items available
-	HasMore bool `json:"has_more"`
+	handler.ServeHTTP(w, r)
+}
 
-	// Limit Number of items returned in this response
-	Limit int `json:"limit"`
+// GetComponentReportById operation middleware
+func (siw *ServerInterfaceWrapper) GetComponentReportById(w http.ResponseWriter, r *http.Request) {
 
-	// Offset Offset used for this response
-	Offset int `json:"offset"`
+	var err error
 
-	// Total Total number of items available
-	Total int `json:"total"`
-}
+	// ------------- Path parameter "componentId" -------------
+	var componentId string
 
-// GetComponentReportsParams defines parameters for GetComponentReports.
-type GetComponentReportsParams struct {
-	// Status Filter by check status
-	Status *GetComponentReportsParamsStatus `form:"status,omitempty" json:"status,omitempty"`
+	err = runtime.BindStyledParameterWithOptions("simple", "componentId", chi.URLParam(r, "componentId"), &componentId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "componentId", Err: err})
+		return
+	}
 
-	// CheckSlug Filter by specific check type
-	CheckSlug *string `form:"check_slug,omitempty" json:"check_slug,omitempty"`
+	// ------------- Path parameter "reportId" -------------
+	var reportId string
 
-	// Since Filter reports since timestamp (ISO 8601)
-	Since *time.Time `form:"since,omitempty" json:"since,omitempty"`
+	err = runtime.BindStyledParameterWithOptions("simple", "reportId", chi.URLParam(r, "reportId"), &reportId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "reportId", Err: err})
+		return
+	}
 
-	// Limit Number of reports to return
-	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetComponentReportById(w, r, componentId, reportId)
+	}))
 
-	// Offset Pagination offset
-	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	// LatestPerCheck Return only the latest report for each check type
-	LatestPerCheck *bool `form:"latest_per_check,omitempty" json:"latest_per_check,omitempty"`
+	handler.ServeHTTP(w, r)
 }
 
-// GetComponentReportsParamsStatus defines parameters for GetComponentReports.
-type GetComponentReportsParamsStatus string
+// SearchComponents operation middleware
+func (siw *ServerInterfaceWrapper) SearchComponents(w http.ResponseWriter, r *http.Request) {
 
-// ServerInterface represents all server handlers.
-type ServerInterface interface {
-	// Get all components
-	// (GET /components)
-	GetComponents(w http.ResponseWriter, r *http.Request)
-	// Get component by ID
-	// (GET /components/{componentId})
-	GetComponentById(w http.ResponseWriter, r *http.Request, componentId string)
-	// Get reports for component
-	// (GET /components/{componentId}/reports)
-	GetComponentReports(w http.ResponseWriter, r *http.Request, componentId string, params GetComponentReportsParams)
-}
+	var err error
 
-// Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SearchComponentsParams
 
-type Unimplemented struct{}
+	// ------------- Required query parameter "q" -------------
 
-// Get all components
-// (GET /components)
-func (_ Unimplemented) GetComponents(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	if paramValue := r.URL.Query().Get("q"); paramValue != "" {
 
-// Get component by ID
-// (GET /components/{componentId})
-func (_ Unimplemented) GetComponentById(w http.ResponseWriter, r *http.Request, componentId string) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "q"})
+		return
+	}
 
-// Get reports for component
-// (GET /components/{componentId}/reports)
-func (_ Unimplemented) GetComponentReports(w http.ResponseWriter, r *http.Request, componentId string, params GetComponentReportsParams) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	err = runtime.BindQueryParameter("form", true, true, "q", r.URL.Query(), &params.Q)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "q", Err: err})
+		return
+	}
 
-// ServerInterfaceWrapper converts contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler            ServerInterface
-	HandlerMiddlewares []MiddlewareFunc
-	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
-}
+	// ------------- Optional query parameter "limit" -------------
 
-type MiddlewareFunc func(http.Handler) http.Handler
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
 
-// GetComponents operation middleware
-func (siw *ServerInterfaceWrapper) GetComponents(w http.ResponseWriter, r *http.Request) {
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetComponents(w, r)
+		siw.Handler.SearchComponents(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -198,22 +1883,24 @@ func (siw *ServerInterfaceWrapper) GetComponents(w http.ResponseWriter, r *http.
 	handler.ServeHTTP(w, r)
 }
 
-// GetComponentById operation middleware
-func (siw *ServerInterfaceWrapper) GetComponentById(w http.ResponseWriter, r *http.Request) {
+// ValidateManifest operation middleware
+func (siw *ServerInterfaceWrapper) ValidateManifest(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "componentId" -------------
-	var componentId string
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ValidateManifestParams
 
-	err = runtime.BindStyledParameterWithOptions("simple", "componentId", chi.URLParam(r, "componentId"), &componentId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	// ------------- Optional query parameter "strict" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "strict", r.URL.Query(), &params.Strict)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "componentId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "strict", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetComponentById(w, r, componentId)
+		siw.Handler.ValidateManifest(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -223,22 +1910,13 @@ func (siw *ServerInterfaceWrapper) GetComponentById(w http.ResponseWriter, r *ht
 	handler.ServeHTTP(w, r)
 }
 
-// GetComponentReports operation middleware
-func (siw *ServerInterfaceWrapper) GetComponentReports(w http.ResponseWriter, r *http.Request) {
+// GetLatestReports operation middleware
+func (siw *ServerInterfaceWrapper) GetLatestReports(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "componentId" -------------
-	var componentId string
-
-	err = runtime.BindStyledParameterWithOptions("simple", "componentId", chi.URLParam(r, "componentId"), &componentId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "componentId", Err: err})
-		return
-	}
-
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetComponentReportsParams
+	var params GetLatestReportsParams
 
 	// ------------- Optional query parameter "status" -------------
 
@@ -256,11 +1934,11 @@ func (siw *ServerInterfaceWrapper) GetComponentReports(w http.ResponseWriter, r
 		return
 	}
 
-	// ------------- Optional query parameter "since" -------------
+	// ------------- Optional query parameter "team" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "since", r.URL.Query(), &params.Since)
+	err = runtime.BindQueryParameter("form", true, false, "team", r.URL.Query(), &params.Team)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "since", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team", Err: err})
 		return
 	}
 
@@ -280,6 +1958,42 @@ func (siw *ServerInterfaceWrapper) GetComponentReports(w http.ResponseWriter, r
 		return
 	}
 
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetLatestReports(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetTeamReports operation middleware
+func (siw *ServerInterfaceWrapper) GetTeamReports(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "team" -------------
+	var team string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "team", chi.URLParam(r, "team"), &team, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTeamReportsParams
+
+	// ------------- Optional query parameter "check_slug" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "check_slug", r.URL.Query(), &params.CheckSlug)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "check_slug", Err: err})
+		return
+	}
+
 	// ------------- Optional query parameter "latest_per_check" -------------
 
 	err = runtime.BindQueryParameter("form", true, false, "latest_per_check", r.URL.Query(), &params.LatestPerCheck)
@@ -288,8 +2002,24 @@ func (siw *ServerInterfaceWrapper) GetComponentReports(w http.ResponseWriter, r
 		return
 	}
 
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetComponentReports(w, r, componentId, params)
+		siw.Handler.GetTeamReports(w, r, team, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -412,15 +2142,72 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 		ErrorHandlerFunc:   options.ErrorHandlerFunc,
 	}
 
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/checks", wrapper.GetChecks)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/components", wrapper.GetComponents)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/components/count", wrapper.CountComponents)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/components/{componentId}", wrapper.DeleteComponent)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/components/{componentId}", wrapper.GetComponentById)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/components/{componentId}/checks/missing", wrapper.GetComponentMissingChecks)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/components/{componentId}/dependencies", wrapper.GetComponentDependencies)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/components/{componentId}/dependents", wrapper.GetComponentDependents)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/components/{componentId}/history", wrapper.GetComponentHistory)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/components/{componentId}/impact", wrapper.GetComponentImpact)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/components/{componentId}/maintainers", wrapper.AddComponentMaintainer)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/components/{componentId}/maintainers/{identifier}", wrapper.RemoveComponentMaintainer)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/components/{componentId}/reports", wrapper.GetComponentReports)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/components/{componentId}/reports/stale", wrapper.GetComponentStaleChecks)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/components/{componentId}/reports/stream", wrapper.StreamComponentReports)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/components/{componentId}/reports/summary", wrapper.GetComponentReportsSummary)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/components/{componentId}/reports/timeseries", wrapper.GetComponentReportsTimeSeries)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/components/{componentId}/reports/{reportId}", wrapper.GetComponentReportById)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/components:search", wrapper.SearchComponents)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/manifests/validate", wrapper.ValidateManifest)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/reports/latest", wrapper.GetLatestReports)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/teams/{team}/reports", wrapper.GetTeamReports)
+	})
 
 	return r
 }
@@ -428,32 +2215,146 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+xYbW/bNhD+KwQ3YBsgJ3TqZJ0/Ncu61UDRBG6GASuK4CydbLYSqfAliRf4vw8krTeL",
-	"cRJ0GzZg3ySRvHvu7rkX8Z6msqykQGE0nd5Tna6wBP94tsL08xwrqYx7zVCnileGS0Gn9JRcWyi4WZPU",
-	"bSPK7yO5VARII5ImtFKyQmU4epl+85Uu7HIo8lfBry0SnqEwPOeovDSzwq0Ks66QJhTvoKwKpFNqBTcj",
-	"g9pomlC/OqXaKC6WdJNQnj1HRcDfE398zPDlhLERHv2wGE3G2WQE349PRpPJycnx8WTCGGMxxdqAsXqo",
-	"/L3/TmTesQnvMLV+PaEobEmnH2gF2hmUAy9oQjOuYVFgRhOqP/Oq8k9WfBby1h9SSiqa+CgWaDCjH7s2",
-	"bGUNMBpeojZQVkOYv61QdBDegt6i9Jpb0UfsaDJi49H4+HLMpi/YlLHfHWypSjB0SjMwOHJ6hvo3CVV4",
-	"bbnCzBnMneAOMxoXdnF+bKTIxSdMjbPirOFZhJ8NCUnGdSpvUGFGciVLAkRLq1IcsLMnYiAxy7h7hIKk",
-	"Uhi8MwQW0prgq1rZN5pUVlVSIwGRkdyKNBziZt1z3xsQWYGaWI2KgDUrR8kU3GZ/0n2Siv8BW3Z8Ib8b",
-	"gAdklhMhDamUvOEZZolfF1AiueVFQRboMGUEtF9oZR308Dt8I43qhqcYg+cEDgG+sSWIkULIHKmD1joh",
-	"OkWjVXPa98z7hxXKW4HKh/FrhTmd0q8O29J2uK1rh+dh1y4FPdq9FAuFUM9RV1LoiGn1imcHcMHFssPB",
-	"UF80ueVmRSpYclEHtk/BztIjlly0O70xXv4Q1luujXNxt0zrIS1oQrnB8lH/dZvCpvEXKAXrgVNrTEnX",
-	"qpiTX/siNoDuPxNVe3zQS2SGDx3ya10ezd5dvp6/O3179Xo+P5/H+IP7QJSoNSx3RAqDypUDlwWoSF2K",
-	"9xe7sCvmhfOGwH0E4fuKV4SLUF1dJjzWaUvgnoZRkTUpfPFpM1zXzuYuOZ2GWoojs1lxHc/SDxQKnuIr",
-	"twhifZDKkib01UIuRktuVnbh7G3oNWxGPRIl1CCUQ8yXCOUAn7x9BBq9KMA4rxF3PhqdQSAuejnYR9Gu",
-	"kRINZGDAAymcR2uq6kE0VqCvSqkw2m7NCn02KiSgkLh9xHuLwA3wwlXKrklGWWxQL6QsEHwJKHjJI53w",
-	"nS0XqFy0g0yFxiqBGeEi+K2TYI2OY9Zo4MLgEpUvsXmuMaLi3H8PbSNUlgfERqUaaaCIxNt9JmIHfdQj",
-	"4xjcnbwLWmovNbYkbWiGOelkuJyLDAMXs21+CEeIpW/Z9ZzRq/w6zBw3oLi0ejt5hNHGhA6nllaT04sZ",
-	"TegNKh0UsIPxAfM+r1BAxemUvjhgBy98MTUrT6rD/ui+jIVmjkZxvEECgaIyJ1AUXXi701EqRc6X1r23",
-	"YB2XPetnGZ3SX9Cctaqdn2veT+/pEWOhOguzncygqoptAz/8pENWhX7inp7WdprcHjadTfJQy2sxbhJ6",
-	"/Exc++CEhhVRHe8Jbp+2ZQlqHby3EwO/oavnvnmeZZunxFZXmPKcp52JY7Em3Ghid0fCvdH8cT3LPMUU",
-	"lGh87/jw+JC5d4LbGRS58L8lZkXrGZF2jKXdpA2Fro3Ibun++IXMeyLhhlE+a38t0AAvPL8mbPL386vV",
-	"7Eb4XFqR/eu43aPg7Kf95D7sDK77SR67b9DbMWhI/70sn3cG0/8C0ZNdZD/zwqBy/g3eaP6XB3/+HsW1",
-	"RbVuYTS7W41/3d3DM7C3UXvC/U7MkP6dwbPdV3NIc5Eiaa4ayLez9+fk5Qkbfxe98GDjS8amrL7wiHrY",
-	"Sexhetq1yMOzW43VyO0AtzutxWDUs04Lo4Q7XrpQjxlLaMnF9i02Pu0ZfZvhqTvZxRA0G1sIGeZgC+OP",
-	"NADYUwDMveFEimLt07AAR47u1SNCunqATSHHol7yYq4qVFf+aIxLzZj9zzSd3cuGvZ2grqD/96C2B3Xb",
-	"Q9pt5Zs/AwAA//8lH/VP8RYAAA==",
+	"H4sIAAAAAAAC/+x9/2/btvbov0LoPWAbPrLjpEnX5WLA7c127/KwrUWb7QJvHgJaOra5SqRHUkl9i/7v",
+	"DzwkJUqibLlNu/Y+/7QulqjDw/P9G98kmSg3ggPXKrl8k6hsDSXFf16tIXv1AjZCavO/OahMso1mgieX",
+	"yVPyZ0ULprckM48Ric+RpZCEknrJJE02UmxAaga4Jj58q4pq1V/yF87+rICwHLhmSwYSV9NrcJ/Q2w0k",
+	"aQKvabkpILlMKs70RIPSKkkT/PUyUVoyvkrepkkOmrICv0rznJmP0OJ5AI2WFaQdGHDPE7WBjC1ZRtwa",
+	"KRG82JKNBAVck/s1cP8ToRII41lR5ZCH0BnE3oGkK/y3FpoWyeWTr6cXb9/WwIrFH5BpBLaS1IBwW6o+",
+	"Yn4Q96QQfBXgQlacaCFeEcZJyYqCKcgEz6OQmrdUtSiZUkxwd1KQE6ZDeM8vZrNZmiyFLKlOLhPG9ePz",
+	"Bq+Ma1iBNLCy/JCzs59rndvFxQyenM9mEzj7ZjE5P83PJ/Tr08eT8/PHjy8uzs9ns9ksdqIlaJpTTQ87",
+	"UkvBxL9MVJWtCVXk6pr8IRaEcbtlJngMefVrTA0dM7v9QyxuDVaSRcWKfHJ69iiJHbLS1CD+lkb46d/+",
+	"pJrzdY+/+5EmZ7Oz88nsdHJ6cXM6uzz75vL04v8mwRnnVMNEsxJi2Faa6ipCjS/x70QsA3DhNWQV/p4m",
+	"wKsyufwt2VBl+HJJWZGkSc4UXRSIO/WKbTb4r4q/4uIeX5JSyCRFYVSAhjz5PdyIW6sHo4FdaVpu9iL0",
+	"nioHJeQ7cPRodjmbjcXR2zSR8GfFJORmw8wsHAi4GoUhnL9H6AKFjkXrlaicIO7IJfw72YAkdtFLw/mG",
+	"mMuSym2KO+VVuQBpTgahUOR+LRSQgmpQ2kvotUEEzdZunb/NOS5kICQKJANFFlX2CnR3Tfu+IvdMr8MV",
+	"5rwv5etTvHzToHoWEyY1Xex90pJI+Nhp7DGkt71PIUGFTz2KPeUpdS9snpD3PNghmPfnkDgp/aKc1umq",
+	"bMsKFHUJHuNa3JPSyEOmjYCrFOTRw0RlfpsZIuyv+3NNIjlTmvFMNwaAInpNdSOg9JopC0ZMrDkBe9v5",
+	"pAFNQUu0PYnSUghWXx5QHciDO5BGT6lw0eRFxRU+YywLglyjKqaj4pHTMoLjH6qS8okEmpuzJOahlqRs",
+	"fe4X85WbIfvF4mw/0j1fWoXLVP9Dp2dRov0IVliH4J1YRNR1NjhIzOoFqI3gKoJs/wvJBNeUccZXteQz",
+	"1L2hK8apU0sROxT/xTRYm+t/S1gml8n/OmmI98RZwycBWzWKnUpJt1aY1N/Zs87z5skubhxErdWiSPGr",
+	"Rhnc/2hYEe1PyMlSitKoClHJDHqYyKGA/UZJva7Ro0os9cS9NyXPDBsr0ETwkO8LpgzD40F4vvbvjLZB",
+	"ctgAz9VtjKGvawptjJFQ7AT/+4UiJeVsaRg6h6ygEhRhmrjlieAhRf+WVArkJF8kaaIALaxJRrM1mPOo",
+	"yaUHa5cqdkqjp7X5isQLrzWhC1HpHtybSm6EAkJ5TpYVz+xLTG9bPPgD5XkBKMAloZVeG9RkSET4pvmT",
+	"kOw/nhl6wMPrDWSGDBrG6Fh9RbVqG307cGxXU0QLb3UI3rFNf2vLDQVZJZneTlRG+WF4PswdqeGdkusl",
+	"4UKTjRR3LDd2tvkdJfY9KwqyANSHxlXQ63CtaQv1BrUTBfKOZVESLugCdnmhb2JUH+7lnxJgYtiFvILt",
+	"yR0tKiB2UQufFmQlRbWxJMIKDTJghLavohnI5DLJJDPkUUS9lIItIdtmRUTg/uh/MqbfCnpsF1j/hgQk",
+	"K4EbvxdlTl5ljvpy2EjIaN/KDx96AIUbglV/5GmbO14On5y45yD3qoZn9qlGYTuTfK9K8X+y/ql66V4z",
+	"ihkl9a2EO6ai0uNXkOYHv1H7fI8ds0pKVAXGfUW5veWZ0weX/umSoUuwnhs3mKyYdquplGAoR63p2cVj",
+	"K6S4fdR/tmZ38+aSFaC2SkOJdLjWeuNXmreka/JoeZGd0W/gyeLr/HF2sTyHR/RscZrN8m/gyfJr+nhx",
+	"kZ3nj/b7W0gQO1Xk1T7bKVAYJdXZmrkgi2UjFbGF3YJN2ORsRMykq+qHLR4Pz3eomYBnDHYYQFcN+DTU",
+	"/aFa62r7Zt3x1k8Pqm1fEHf22PrQuK1u91g0VnHnpOI5SBKYBoMey+iNGYAap4O1Pb7AHOiLaspXhfl3",
+	"zHbSa5CEC1Kwu9CEWvc0Soos5D5IFpDRSgFh1tricAfS8W7ISEtaKKhBWghRAI2YleGuAnhHHYkeR3vo",
+	"4tnzIILvjP/m9cqHU99omtN7KO4HprSQ2++5lhGau0H+hyI3nhTlK8jJYmskIeOrIjzHamNs14hrgS/t",
+	"i/KZh/B8JWRC5g8XlUodBDttjl0I/6fZ/BWu0bdGrhxKHIZewdbiB/+fOM8utDiAlua/HO5rm11vyY35",
+	"c5qIIk8uk+cF1WZn9q/R+PhhRt7aHjABPOGHCD0756lvFnvVSzUpaQ7B4ba+u2L60uhEdXlysmJ6XS2m",
+	"mShPtqKSEyFXJxuHAm9JqrHRxprWmmMfQ/sHOtWN7Aowy2C/n+2eO5zZWzz6oXxuD914p/u63NBMj9PI",
+	"mkimXtnIVqiel5iWGlRa45FlgYF8h4RMk5K+vs1ho9dxSVf/TGqNvSWQr8zZggSyFEUh7tHNCAn6Yq+R",
+	"03w3Dfe2E7vOFH5X4mxFqHcQ5bvRjjfwVcwvUroOu3cCcm0/ZHy0yeJir87zMI0n4Y6/0Sdh69yLZYto",
+	"0Wa1oh5djjAJxGQ7x9BDuP311jlIMcV44xMktXMhcLmsOdeU8KoomvxX26DigjSoeDc9apY3TqRPIw5k",
+	"xGzActwhtpI6b1ObBh6Msfzczd+gWlnTO2gC6DGiOoArWwB0d7STbF4Cldn6MNasfaoGP/sjs4fLwZ3y",
+	"72GCs2E8ZSyjHSjHqMEKdLzS/3psfe+zem0E4Z+J9AjsbzuHoZfwt1AGXP988/2Ln5/+ePv9ixfPXkSj",
+	"n7uAKEFh4L+1JNcgTfDWmGsgiU/O7TbZ7FMxLIQmdw+MZ0WOMRUO98SG/6xsDi3xHobQ3u7FjfBtutQg",
+	"Q0P1rTPE448vYClky7CNWuh9Y2SnNx/4jIoIK9GNYdSSFinJmYRMF1tixJ6kXDHN7qDYPpDPP2AYBRnN",
+	"rlG0AH0PMAAvHhPm4ASHlJyitLZbIIFzmu5MTved2rYNFSWgH1HDNsVatCieLZPL3w4xNN4MJn5j3leT",
+	"funFW4O6H8KUTWwcEDPfFcHo7/33NGLVtkseOqaMOSSbI+0g7kCBrWMfMsGabexj6ccwS0cpgBatPJh9",
+	"+RNliBmQ13xTRbm/rB8hWhCaY+5iV7Soce37yzXfCyMAX0JJWZGSfzH9Q7Uga8yLYShbYDSuUq3nv2qT",
+	"ZcEy+LsBh/Kt8c9HOOE1gLtxsoO0rBdWP9ijVpTWtAkqtFEUvNkKWv4W3c3fF2IxsfGHQ5JsPdeu+WZ8",
+	"2zYxMKDbn5KNFIsCSrIUFc9tIZNPJvQ2aNVbb5HnVNeJCLFcAs8NS+LDqfUR6sKZ+nybzFYsT8d4NOPF",
+	"63ySh5rxVvpj6HPRiiJvTMTLU5gi91LwVRxqUlZKm7SkQJguSZhjS0mTPUtJkGHbR8Ieol0H+SstWE7j",
+	"mewXoKoCRd+dewpt2cHzfDdFjaZTxGV6bs9ENaTUPhooN3prD8iWOCGQYx3xNiFHzGW72mDsP4Ql/PiB",
+	"Mfz6NYuE6EkxZeLTqNAjHMeJT/D7ckim16LShLZ97Ifw3PEDX6iuauw47aE3z7RLcngvN+7Cz25mTy5n",
+	"7+nCu5qnZvl25cGoCqZ9B7BD2H/fOoaesB97LAeWL7WoYyBGSFeDugl/DAs/MDKImkVCTuiKMq46lcfn",
+	"61k52x/F9l9O/ZZiuH1WJ+I7jhH+fc02YRH33haEjsaMR/I6doLyrigzRQbmC34VawgyFQ/IPKgWTutk",
+	"SueQgJY9+MT9HtA6WZfYQfUO4nnLQu0qZP9bUzNvACmY0h466Ie911TdlkLCsBCl3JpuGB2x4WiVEmqO",
+	"aalAk/8hBSuZnpKn9hE0s5dwj/KXcvsrQSSjN1Lc061yxrvSrWKeltCopbGxDUq2s47Ari5BV5KDU0NM",
+	"hfGLJkYXrcXk8FrfZpVUMYPpCv9eh/7Ms/1YkQ9/psSUFROmyYKaYl+7Vbs02VBJS9Agg7I9H1CVgL0s",
+	"5jDsflInwm83IG3I0OCPC+3qonjuv4kvKiF9gLhWCWYf1jSbjpHO9kQjjI5/t5+ty1xjyI3i1vXe9NjG",
+	"/Dmob7dnSO8os0CGXvrFbFxkNfG0Uu8lbSg8JtqsF2bDxP/AsvuYhWWRHITgXfjdkDrjaA8anLvC/R6P",
+	"YSNJtJHD+qx67V81lPvLzVVqKzYVu4OhaPpstkcVP3j0vKuJcVNjQtgWf8ZOeQmyrkPoY9hj1S7lg2xI",
+	"9pxkhtSyykSfQlz3Bdpi4BRfsv8gw2LnRH1QDW5P8xjS/Fd6y1liUShqDDhC5iBTIoocK7KYRMfEiEIu",
+	"yIpu1FibN0KREU3U7uN7p2LwVqdMjRC/4dhBvtS0gCET90DD1tvDu433aHWr0WgqXtI/qECOZnSsEaA+",
+	"hN2nvasK6WOa0Q1En6ERbay8EWFOfCBSTy/uuS+E0kDL9MDM1N7SfbPoF4GpqpwOyq0VKxTUpxrJ8/7W",
+	"DSkvTE8sX9V/OcjM/jQCsYPmvsNW94QWYHuEW3UaiS8s2ktE2noBrdzdyODv26Z+/6XZmlOEQCVIU25d",
+	"95WjVMQ/N+Cstd4kb80axomLCPbn187h4gaGFdqdvrulk1vGTpc7KpmolK8+Nl9i2pZ+y1WlyNPn10ma",
+	"3NkS6uQymU1PpzO0PjfA6YaZEuXpbPoId63XuJeTRlKsQA+4jbQobBSwdu6NTnYGW1Gt0qDnjvJtbTxL",
+	"WIIEnoG1DfDl6Zz/05byL/yD1i4hWpAl46YKFs1hIYmkEoqttY7tl1MC09UUhY77yLezObd6q1daYHXG",
+	"AiDoI/5SLDVwkkmg3qbfboT6CiPpi+2ch0kZA5JZOxRurXqFQDRPsRjcyAkkpus8uUz+BfqqaX5yDorC",
+	"5FXHDTAui5UK0Gr0opoUQBECplrI7eTamFnmzwqwKtA2FCQl47fN05Y3MU7AOCurcqCR8xDYSrEPtNkA",
+	"aPT1BwbNok1wCAc4tIMG0zl39jHmstoVQYoobZplkBuRAxp2nHYK/zsiOrbhTrVys+Oe7OqpFLe/ds/y",
+	"/obUhuX6dlwMwoEW1RiwQTh3f6WPcNGDlFzMDL/lsKRVoafkR1YyjUnUO9/iwZdsVRnBUtLXhg5ScjoL",
+	"X0rJCiw3+lW6AYjYzrzjGiGz0zFkFgSArO87JdZvVyYioeuICzrabreEcheib0I35rmlMWrRo/6bW2w3",
+	"BsiXp6ZyN0TCVxiUkPAHWpjTEdxWe+wNBtxa+MpOpvs9TZoY1+Wb5Gw2S9AI4tqlOuhmU7imo5M/lDUt",
+	"mg/t9YUbow11ZdQKRvRVvkv1/AFBcAmQ/pevOSYnCKKSBNIbATj/8ADUWSIMTGEWyHz74uNsPlYFZJ6r",
+	"e8CsZZD1z+dtmpy0TeRVPPajJYM7INSqWLHsCNleo2/AHI0F1Fe47RKtfUrXcWunvbcOMDch9Sm5aXW2",
+	"2Jwl1gESGjw35/CamsKelChB/o6qAY3aXICNMdp3ml8mBdC8q1L+TothZdJ87TBV8rRQXhq3Wp1bzsn9",
+	"mhnFQaXcEvf7LdW4/S1KnjXLc+ChKJ/zK8rN1hZoty8Y9/3RAf7mfGA3nR7quJgazCeOaoE7KqHPWwn1",
+	"sPH9DV3Z2MjGNJMar8hrqb8RSh7NzgkL0xY9r986CELXxYaK8Ybf1kBzkA3s18vJz4LD5CfDujt57n3V",
+	"5QM0joUfuOP5lBrncHp39j8HKud+tW9EU9QNAg1qA+cwaC0nL4HnZJ48zTLY6EuyE8p5MudaIJ+NKR6u",
+	"83I99n6m1yDvmbKUvaAS5hwx1SIP26Po5H5WMCcIzUe6VW8VL0Apl3oT0qfq7BwXK+Ms8eAhGjKNhfaw",
+	"nQljm3AHLTfSrOSc09T14aErHBOOXdozp/Nodt7/4M0Y2ne1jfaoG1mBnKYQMk66fBDZ6m7w/nLT7ZMx",
+	"n/4FumPwdE2nk7onO2pAYfaoK9ZcmVBGNS3Eqg4sNjYNlCkRG9s4aYqPjQ1kg5AoTTXQcs6FtPrfvGIH",
+	"MkzJdz1TATUAkpFN38UiIAjkgSYZLheN0KJJZoO0bMWFDZJRN0NEVVIaI9n88X7NNKgNzWAoZBjTUC5Q",
+	"eIBBFYfX8Q5TFnkpodhO+i2WuE/JC9gA1dYmwSglUUYK0MLjuh3/1Qzkt/VcCxwrsSlEvtOXx4WSNKZX",
+	"9saIld4ishBRH9b9a08ziDBSY8xFGm6SozwJ5MlVhwp70uRN/e/r/G0zHynW+Vt7Bq0AJ7nG4Ut0swEq",
+	"XUi6XR0PPN8IhqKB54Rp1Sqj4GLOTSAfpN1HnpJFk/TAV3wTrnn8FWzc1NUqt0UwZlJAMxKEahehc2Nu",
+	"1Jy3oZFgFrPzkARHcLDAxCyDoT9kPpvvpHnJONHiFfCYGLPC7yqsvNolxvr92zsHt8TChyZAH4keXudJ",
+	"mNywEuAQa/R8R2dvbWQgY51+eLp21XtESMIcjwUHYcF49OHB+FmEn0ULrHGBXF7act7/78EflwtDkg+z",
+	"YL/9/vb3UBh915MeBvp9caB6OHH9FvoQWpGqy1A7Iz//2F7nnw2LphF2LOlEgQFfQ15Hx7zV5gdUaEGg",
+	"XEBdGh6mNV5WG5cFQJvDyt95Z47TPPHBHprnKsxxdVEx587EG9Uk3Q1ltb+6Owj0QX3rkS71Lm70o7eP",
+	"QeDGi2lx6/V3uw0Pl28+Ka3o3513PmAiX+qGJ/k0cZAFFkFSTPCuleAqDV2lSb82AxdqVx8ZW6Td7znn",
+	"DgzCRQ2CkG5MMXRK4w0zGtdvKUGtUxeGUE2wznD8UDrZf7ZVlf/5CjszAN5VMlGH37oZSAvrWqH/RIkf",
+	"JF+POT87XxscP5qV3dqiZDjpbKuJIiFI997HlDnxzooIJ3Z7KwaqwFKcme8ZA8NwH91H8jg+CsggSwbj",
+	"zm+P5OxOuNsjNw+ZGNsdPJcGQdw5D6O4T8PGcZzBGoqV+Eg4Vs/MpYr4OW3pnDdo2FtFE5se+Bl7YR/A",
+	"bonOVYwQbfhcHyFHtg3YtsNGA4MoxzGtPoxlreHQZ9TWYzvZtjPWdz9L6YdmqGa45OfKS7tTX8NzIo88",
+	"NZandgzY3M1XLlK4v7zEfBAjiHVwUSzdTEc3VtCNN2wNbDAMZLNvKiUc7uvWk+mcf28K63AAY9Aw4Z1y",
+	"sx+fVvM5NCZN90p70o3ap+ncmMDP17RvFWf6qYot/GCKxA/5aWAM21jjhrx/p4HGD+fGB9rEmXYWdFke",
+	"N708bc1O6A6Lb83pT4cGtozEQYvcKGaP/cAipoLWwi+vXz4jTx7PTr+KtrnMTlstajH8+FqGBj/jLv0Z",
+	"rqWpz+9YSHOs5hw54XSU7vRS+VjX+UmF9FBU1RpzKeRoxcxwWtqB/umw9dQZlLaWolqt3cijZgnnTDYd",
+	"KL3pZvSebm1JPFNumFkYxmZ1/8iUoH4PYt+N+yp4Btigbx5XayEx6p0zpan9hefhl1Gr2HBfPWwWCy7M",
+	"OqZosl1KEdjudm6tAzP0sPdZDXZW3edgyEdDgfGz08Lhz7ecdIYRDcX6/IC5iJy8wK5GpzVm6W4V8lGE",
+	"Zmf+8vv6Gx855GdRfRShO6IHu4dm7xaqnckuG8MDsZuXmmH+ZWyI3JSE/alG5mjJytKNvDACSmZUQW4l",
+	"mZmwj12JTW1kuKoilHAxEZspCabBEciZEZX11A0uiCv6MLX895JpbYvHsQyDDFZh9MTc07yZwPlTWAD/",
+	"GQUB/6xA6X+IfPtwOYTOcMK3b992oXr7IVMYkTmAEab5xRWyBnSMOvUvkFURtjjWu3yq9S7npx8BHS8s",
+	"W5KFyLdEC0EKKlfwKSiUseU2VvJ3hpCOttib99TJm4YrdtYIvoBS3MFedYOP+bGJDXRuIqS9o87ekhqq",
+	"k/E6wYLxOauFdNz0V4y7IMq/xEpYyH3syChwPzzAa3DGV/vnv0Y20aqveuAqw2BfdifY1nBPW2RwlMTH",
+	"ysP3E4W1YArkjbsmdqw8DIad7E4t/FnhfaWRy1FitYw7/fYXwTySz1JsNTM9LDZsaKVpdlhDE2sjWvjG",
+	"WY7pGN//YF8CP+XD/u+3xkGaV7PZ2WP3B38nQdAk4W4ar+8hGNEjUV9hH2mSeLib/vfOn96HSPP8gWhs",
+	"jUpphpF924wws+gMfsJgh6XNesjZ0I22/WfHIbw1Fu3wzpRBVHnGc11sn2AepQOov3eiD2lK4LUbT/jV",
+	"lFzZVmqbbrC7s8LFvEnuGc/F/TS+tyd792aBeNAkkd/fMUn0X9Zt/WxD/6zqUatOnfYar82d3jXapsHc",
+	"VyO+bIlrc30GdutQ7QO7THfS7GRoooDdWGSCq+GMOVc4S9CNOaQ8PrF1cBSBhfewHkC7N99J2b04wzBs",
+	"M4kHpfm4cTzd/R04hwfv3TGciAipJc8WVUlzlxwamLSsUyFhHqJT9vBv83wP67iA+QSagzZYH78+JJwA",
+	"1hJatSKOimA73TBCxUl9tMHl3OHf3Lpj8vQvDZA20cTaV+YnVGUDoCGeBmAzywdg2UXwj7+nD9AC4omr",
+	"7gTxNQauF8Sz5HAriGtkmCfoOM4T30E/T7rH455M/RPv0cAxONsKicNu6gvlmyyIncLX3VNqCZYqv+lv",
+	"3fOHjblqXjqErYZOJhc6+OsrMDpArwNVaKVmdJ/pnKOlNk9w3A1dwRS1Reor3udJEBPxyNHCyEa3m3xK",
+	"vEf7CraOjUum667s5jgHvjFwqu5rt5bSDhWM9sCItSumJFN3dbODtWTtYIe6A1SRq5e/NoIpE0VVckVY",
+	"ngZmbDrnXljxvJHvaSPLqCLKSvpg3oSG1/okU3cGmd9ZPkUkGte1hyN1N1R5hFuJlh6ZhZIU3/09/Wvq",
+	"FrtTUHHUvdt3e6gty9MAoxafaY3LOZ+dfvMku1icTb7OT2HyNX2UTb5ZnsPkbPE4f0JPs0dwsUwbryA1",
+	"jlIau5ISMbtjJsRw+MG74cfCkE+oMCQMchwYTzlRmhYwGFXp50y/qEd62mrotknBFI4Ed2Ze3b7lSzvc",
+	"m8wYmt1esXhP11XTnBV0frXvKO3m2ud8U1TKPe+KRMI+NMJs37mHoN05ZrcVawqb83dqCgsGSx9bwj7L",
+	"lrDYaPAIu+JjA8N4jy1fn9BgRDemQphgoSNmR/ajBKZ0c7KjEvMl/uwmVDWOVouJm7kptki90Fj5oYxz",
+	"VzAOZs4HK7Fi4/+8fPZzOufBxNwNSGIe6rrmN1jcgQm8wOO/bA+QwvYYzFHNeR0tqaFxneLE4JPnbgTX",
+	"oipeGRldjwPBq3Y2bAMGimjFvMXBMYx+DKMfw+jHMPpnFEY/zIh4PeH5O4xW9hcwRPLfbUGsUiI4oArw",
+	"URcv/I/+16diVzh9b5yE93LD/ILj5wo2ntiY+S9po+bvcTZGN2j8rRFudSSoniPmN+WniO3zdpyyf1nP",
+	"lTl2gw/hZkyghXjCOPJbMKGTSFEU1aZzD5NniNrUGsl7qKvqq9r2sJ9sriwSzV3NcaZ0DMk4WbLXkLcu",
+	"ckuNmJhzLQFvbKdSqynxt6zZsANbccBs0S83V2QtKqlw5izdWjvc3oFhvn6/FgUQid1BnnHn3H4ovEHe",
+	"GRHCX9EozTELDjgU3cHVvfbI5TjdTF7z45z/B6Rz+dVIcRDchvf5jgCzF75heChEjpvC1QKrY1PusRff",
+	"A6jINX8poRzJBZOvhl76F/9FjSZ/J14s6IJvec/h1KD3dFyzbevWRyTRlOBEWMhJLu65rYp1tLowP1C5",
+	"bScDHs1q4nTWJRf30zm/8SuSkm6J2lBe335zOpvVL3XzCB/eRv6QyqR3u2S0djpyv+QGpMNI+9rGoyn5",
+	"aQ2LpfVVjE19QG3amVeR6MZptjf2H260bFSz/ROMkx3El9xwx+vvUn93cTN8zfzgU51GBfnsc31nk4ut",
+	"1XfD1bcp1+DV86kR/+PUx+c9OnIQsvqWyAasi4sZPDmfzSZw9s1icn6an0/o16ePJ+fnjx9fXJyfmwqk",
+	"OLj+pD8dq3e3r3vTIOBjCyBH6Nff2S9/VMkjfKTkkzavXf9IE77eUad9qYDKbD0sYBjP+0OUGm5IiaHg",
+	"lARvGSzh7TzOdrWFCag30jnPqIIJ4wq4YprdQYHlUwqthU7H9u4W7ZcI+Pg5+DfwWmPxFr5n7Pd2xZSv",
+	"ho/bEn++nxQ53qBzHPzxblEHS+a7Epc/Hcf47wnyWY7vzvH3BQTqBPdANQz3gT+n0l2J4Z/Fdhi7gPXi",
+	"qW2/xjvJ0toPV9peqUH5VptDmpLvMbe4kWJRQImTMHwpRBrwApaamu8VGGbH7KBLsSiQJKOcaCgK9P2v",
+	"rvH/bfTEAzXnfjaSj+n7u8ZKkKv4zSK/ur395NbYJ1JfIKMRl2eyQKuUFOwV+OvkQr7F3RkZmekazJEV",
+	"hvatw64xG90dvqVl0S7lcvf9XpK70zk3IFyS0OSc83rA1aU5ybzCGtf9dVkfs4nc4tcdKRM8xj32snH7",
+	"JGHKEvdHEx7WiAwahTNRFTlxJeoSqAXl7OwvxorrZuxIlV8bQRCWBtjXrITxjpwN1o+bq+fKAhrjrXtb",
+	"FgYPkdU3lElCMymUCm8oSgkla6CFifWVVEv22rssNtK4LMDo6eD29jx+D1EBS01EpaMG2pwvQqhCixDn",
+	"61gQTYhswEsM7zM/6F5Js+14/dqaqiCsq6AOJQ9mqec8mqY+JNs/5/+t+f4Q6RaffpzTO+T8p4Np+78o",
+	"RT9wVemnezHWsSns6ECMV3Ut8brztslQhPaugTx6Et34SrwTa0hJW0PAMLs6eWP+c0Av/C5zwLbn9a5g",
+	"raUXdaLLVeXgFYQ5VeuFoDJvzbQep8NJtFXtW7S95zxWL2m++YVqllft22O50kDzbhnkU3zNhqHFPVdz",
+	"zkW4xMrwdFO8PZTAvAFajrUs7jG5+oCCvh3ZdXL+fYf+OskX5K9RP/k2xnfNXT5YT2bdjRhhgffvzawl",
+	"qn3/kBurj7ryqCv36YRAXOzSlC9C5osJuL9ad37iWjMQXrSPPbP62/83ABr/KjlhyAAA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -0,0 +1,83 @@
+package client
+
+import (
+	"fmt"
+	"net/url"
+	"path"
+)
+
+// The generated request builders resolve operation paths (e.g. "/components")
+// relative to the server URL, so the server URL must already include the
+// mount prefix and API version: "http://localhost:8080/api/catalog/v1".
+// NewCatalogClient builds that URL from a host so callers don't have to.
+const (
+	// DefaultBasePath is where the server mounts the catalog API
+	DefaultBasePath = "/api/catalog"
+	// DefaultVersion is the catalog API version this client was generated from
+	DefaultVersion = "v1"
+)
+
+// catalogOptions holds the settings used by NewCatalogClient
+type catalogOptions struct {
+	basePath      string
+	version       string
+	clientOptions []ClientOption
+}
+
+// CatalogOption configures NewCatalogClient
+type CatalogOption func(*catalogOptions)
+
+// WithBasePath overrides the path the catalog API is mounted under (default "/api/catalog")
+func WithBasePath(basePath string) CatalogOption {
+	return func(o *catalogOptions) {
+		o.basePath = basePath
+	}
+}
+
+// WithVersion overrides the API version segment (default "v1")
+func WithVersion(version string) CatalogOption {
+	return func(o *catalogOptions) {
+		o.version = version
+	}
+}
+
+// WithClientOptions passes options (e.g. WithHTTPClient) through to the generated client
+func WithClientOptions(opts ...ClientOption) CatalogOption {
+	return func(o *catalogOptions) {
+		o.clientOptions = append(o.clientOptions, opts...)
+	}
+}
+
+// BaseURL joins a host URL with a base path and version, tolerating
+// leading and trailing slashes on any part. Any path already on the host is kept.
+func BaseURL(host, basePath, version string) (string, error) {
+	u, err := url.Parse(host)
+	if err != nil {
+		return "", fmt.Errorf("invalid host URL %q: %w", host, err)
+	}
+	if u.Scheme == "" || u.Host == "" {
+		return "", fmt.Errorf("invalid host URL %q: scheme and host are required", host)
+	}
+
+	u.Path = path.Join("/", u.Path, basePath, version)
+	return u.String(), nil
+}
+
+// NewCatalogClient creates a catalog API client for the server at host
+// (e.g. "http://localhost:8080"), targeting the default mount and version unless overridden.
+func NewCatalogClient(host string, opts ...CatalogOption) (*ClientWithResponses, error) {
+	options := catalogOptions{
+		basePath: DefaultBasePath,
+		version:  DefaultVersion,
+	}
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	server, err := BaseURL(host, options.basePath, options.version)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewClientWithResponses(server, options.clientOptions...)
+}
@@ -4,6 +4,7 @@
 package client
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
@@ -16,6 +17,10 @@ import (
 	"github.com/oapi-codegen/runtime"
 )
 
+const (
+	BearerAuthScopes = "bearerAuth.Scopes"
+)
+
 // Defines values for CheckReportStatus.
 const (
 	CheckReportStatusCompleted CheckReportStatus = "completed"
@@ -27,6 +32,37 @@ const (
 	CheckReportStatusUnknown   CheckReportStatus = "unknown"
 )
 
+// Defines values for ComponentLifecycle.
+const (
+	Deprecated   ComponentLifecycle = "deprecated"
+	Experimental ComponentLifecycle = "experimental"
+	Production   ComponentLifecycle = "production"
+)
+
+// Defines values for LatestReportStatus.
+const (
+	LatestReportStatusCompleted LatestReportStatus = "completed"
+	LatestReportStatusDisabled  LatestReportStatus = "disabled"
+	LatestReportStatusError     LatestReportStatus = "error"
+	LatestReportStatusFail      LatestReportStatus = "fail"
+	LatestReportStatusPass      LatestReportStatus = "pass"
+	LatestReportStatusSkipped   LatestReportStatus = "skipped"
+	LatestReportStatusUnknown   LatestReportStatus = "unknown"
+)
+
+// Defines values for GetComponentHistoryParamsField.
+const (
+	ComponentId    GetComponentHistoryParamsField = "component_id"
+	DependsOn      GetComponentHistoryParamsField = "depends_on"
+	Description    GetComponentHistoryParamsField = "description"
+	ExpectedChecks GetComponentHistoryParamsField = "expected_checks"
+	Labels         GetComponentHistoryParamsField = "labels"
+	Lifecycle      GetComponentHistoryParamsField = "lifecycle"
+	Maintainers    GetComponentHistoryParamsField = "maintainers"
+	Name           GetComponentHistoryParamsField = "name"
+	Team           GetComponentHistoryParamsField = "team"
+)
+
 // Defines values for GetComponentReportsParamsStatus.
 const (
 	GetComponentReportsParamsStatusCompleted GetComponentReportsParamsStatus = "completed"
@@ -38,14 +74,72 @@ const (
 	GetComponentReportsParamsStatusUnknown   GetComponentReportsParamsStatus = "unknown"
 )
 
+// Defines values for GetComponentReportsParamsSort.
+const (
+	Status    GetComponentReportsParamsSort = "status"
+	Timestamp GetComponentReportsParamsSort = "timestamp"
+)
+
+// Defines values for GetComponentReportsParamsOrder.
+const (
+	Asc  GetComponentReportsParamsOrder = "asc"
+	Desc GetComponentReportsParamsOrder = "desc"
+)
+
+// Defines values for GetComponentReportsParamsFormat.
+const (
+	Csv  GetComponentReportsParamsFormat = "csv"
+	Json GetComponentReportsParamsFormat = "json"
+)
+
+// Defines values for StreamComponentReportsParamsStatus.
+const (
+	StreamComponentReportsParamsStatusCompleted StreamComponentReportsParamsStatus = "completed"
+	StreamComponentReportsParamsStatusDisabled  StreamComponentReportsParamsStatus = "disabled"
+	StreamComponentReportsParamsStatusError     StreamComponentReportsParamsStatus = "error"
+	StreamComponentReportsParamsStatusFail      StreamComponentReportsParamsStatus = "fail"
+	StreamComponentReportsParamsStatusPass      StreamComponentReportsParamsStatus = "pass"
+	StreamComponentReportsParamsStatusSkipped   StreamComponentReportsParamsStatus = "skipped"
+	StreamComponentReportsParamsStatusUnknown   StreamComponentReportsParamsStatus = "unknown"
+)
+
+// Defines values for GetComponentReportsTimeSeriesParamsBucket.
+const (
+	N1d GetComponentReportsTimeSeriesParamsBucket = "1d"
+	N1h GetComponentReportsTimeSeriesParamsBucket = "1h"
+)
+
+// Defines values for GetLatestReportsParamsStatus.
+const (
+	GetLatestReportsParamsStatusCompleted GetLatestReportsParamsStatus = "completed"
+	GetLatestReportsParamsStatusDisabled  GetLatestReportsParamsStatus = "disabled"
+	GetLatestReportsParamsStatusError     GetLatestReportsParamsStatus = "error"
+	GetLatestReportsParamsStatusFail      GetLatestReportsParamsStatus = "fail"
+	GetLatestReportsParamsStatusPass      GetLatestReportsParamsStatus = "pass"
+	GetLatestReportsParamsStatusSkipped   GetLatestReportsParamsStatus = "skipped"
+	GetLatestReportsParamsStatusUnknown   GetLatestReportsParamsStatus = "unknown"
+)
+
 // CheckReport A quality check report for a component
 type CheckReport struct {
 	// CheckSlug Unique identifier for the check type
 	CheckSlug string `json:"check_slug"`
 
+	// Details Check-specific details, only present when details are included
+	Details *map[string]interface{} `json:"details,omitempty"`
+
+	// DurationMs How long the check run took in milliseconds, only present when the submission reported it
+	DurationMs *int64 `json:"duration_ms,omitempty"`
+
 	// Id Unique identifier for the report
 	Id string `json:"id"`
 
+	// Metadata Report metadata such as CI job information, only present when metadata is included
+	Metadata *map[string]interface{} `json:"metadata,omitempty"`
+
+	// StartedAt When the check run started, only present when the submission reported it
+	StartedAt *time.Time `json:"started_at,omitempty"`
+
 	// Status Status of the check execution
 	Status CheckReportStatus `json:"status"`
 
@@ -56,19 +150,138 @@ type CheckReport struct {
 // CheckReportStatus Status of the check execution
 type CheckReportStatus string
 
+// CheckStatusCounts Counts per status: in a summary, the number of checks whose latest report has each status;
+// in a time series bucket, the number of reports with each status
+type CheckStatusCounts struct {
+	Completed int `json:"completed"`
+	Disabled  int `json:"disabled"`
+	Error     int `json:"error"`
+	Fail      int `json:"fail"`
+	Pass      int `json:"pass"`
+	Skipped   int `json:"skipped"`
+	Unknown   int `json:"unknown"`
+}
+
+// CheckUsage A check along with how much it is used
+type CheckUsage struct {
+	// ComponentCount Number of distinct components that reported this check, only present when include_component_count is set
+	ComponentCount *int `json:"component_count,omitempty"`
+
+	// Description What the check verifies
+	Description *string `json:"description,omitempty"`
+
+	// Name Human-readable name of the check
+	Name string `json:"name"`
+
+	// ReportCount Number of reports for this check
+	ReportCount int `json:"report_count"`
+
+	// Slug Unique identifier for the check type
+	Slug string `json:"slug"`
+}
+
+// ChecksResponse Response containing checks with pagination
+type ChecksResponse struct {
+	Checks []CheckUsage `json:"checks"`
+
+	// Pagination Pagination metadata for list responses
+	Pagination Pagination `json:"pagination"`
+}
+
 // Component A component discovered from a source
 type Component struct {
+	// DeletedAt When the component was soft-deleted. Only set on components listed with include_deleted.
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+
+	// DependsOn Identifiers of the components the component's manifest declares it depends on
+	DependsOn *[]string `json:"depends_on,omitempty"`
+
 	// Description Additional context about the component's purpose and functionality
 	Description *string `json:"description,omitempty"`
 
+	// ExpectedChecks Slugs of the checks the component's manifest expects to report on it
+	ExpectedChecks *[]string `json:"expected_checks,omitempty"`
+
 	// Id Unique identifier for the component. If not provided, the name will be used as the identifier.
 	Id *string `json:"id,omitempty"`
 
+	// Labels Free-form key/value labels used to group and filter components
+	Labels *map[string]string `json:"labels,omitempty"`
+
+	// Lifecycle Lifecycle stage of the component
+	Lifecycle *ComponentLifecycle `json:"lifecycle,omitempty"`
+
 	// Name Human-readable name of the component
 	Name string `json:"name"`
 
 	// Owners Ownership information for a component
 	Owners *Owners `json:"owners,omitempty"`
+
+	// ReportSummary Checks of a component counted by the status of their latest report
+	ReportSummary *ComponentReportsSummary `json:"report_summary,omitempty"`
+
+	// SourceRevision Version of the source the component's current data was synced from: the commit hash
+	// for git sources, or a sha256 content hash of the manifest for filesystem and http sources
+	SourceRevision *string `json:"source_revision,omitempty"`
+}
+
+// ComponentLifecycle Lifecycle stage of the component
+type ComponentLifecycle string
+
+// ComponentCount Number of components matching the filters
+type ComponentCount struct {
+	Count int64 `json:"count"`
+}
+
+// ComponentDependenciesResponse Components a component depends on
+type ComponentDependenciesResponse struct {
+	Dependencies []ComponentDependency `json:"dependencies"`
+}
+
+// ComponentDependency A component declared under depends_on
+type ComponentDependency struct {
+	// Component A component discovered from a source
+	Component   *Component `json:"component,omitempty"`
+	ComponentId string     `json:"component_id"`
+
+	// Dangling Whether no live component has the identifier, for example because it was never synced
+	Dangling bool `json:"dangling"`
+}
+
+// ComponentDependentsResponse Components that depend on a component
+type ComponentDependentsResponse struct {
+	Dependents []Component `json:"dependents"`
+}
+
+// ComponentHistoryEntry The fields changed by a single component update
+type ComponentHistoryEntry struct {
+	// ChangedAt When the change was recorded
+	ChangedAt time.Time `json:"changed_at"`
+
+	// Changes Changed fields keyed by field name
+	Changes map[string]FieldChange `json:"changes"`
+
+	// Id Unique identifier for the history entry
+	Id string `json:"id"`
+
+	// Source Source that made the change
+	Source *string `json:"source,omitempty"`
+}
+
+// ComponentHistoryResponse Response containing component history entries with pagination
+type ComponentHistoryResponse struct {
+	Entries []ComponentHistoryEntry `json:"entries"`
+
+	// Pagination Pagination metadata for list responses
+	Pagination Pagination `json:"pagination"`
+}
+
+// ComponentImpactResponse Components at risk when a component fails
+type ComponentImpactResponse struct {
+	Components []ImpactedComponent `json:"components"`
+
+	// MaxDepth The max_depth dependency edges were followed to
+	MaxDepth int `json:"max_depth"`
 }
 
 // ComponentReportsResponse Response containing component reports with pagination
@@ -80,6 +293,35 @@ type ComponentReportsResponse struct {
 	Reports []CheckReport `json:"reports"`
 }
 
+// ComponentReportsSummary Checks of a component counted by the status of their latest report
+type ComponentReportsSummary struct {
+	// LatestReportAt Timestamp of the most recent report, null when the component has no reports
+	LatestReportAt *time.Time `json:"latest_report_at"`
+
+	// StatusCounts Counts per status: in a summary, the number of checks whose latest report has each status;
+	// in a time series bucket, the number of reports with each status
+	StatusCounts CheckStatusCounts `json:"status_counts"`
+
+	// TotalChecks Number of checks that have reported for the component
+	TotalChecks int `json:"total_checks"`
+}
+
+// ComponentSearchResponse Response containing matching components with pagination
+type ComponentSearchResponse struct {
+	Components []Component `json:"components"`
+
+	// Pagination Pagination metadata for list responses
+	Pagination Pagination `json:"pagination"`
+}
+
+// ComponentsResponse Response containing a page of components with pagination
+type ComponentsResponse struct {
+	Components []Component `json:"components"`
+
+	// Pagination Pagination metadata for list responses
+	Pagination Pagination `json:"pagination"`
+}
+
 // Error Error response
 type Error struct {
 	// Code Error code
@@ -89,6 +331,114 @@ type Error struct {
 	Error string `json:"error"`
 }
 
+// FieldChange Old and new value of a changed field
+type FieldChange struct {
+	// New Value after the change
+	New interface{} `json:"new,omitempty"`
+
+	// Old Value before the change
+	Old interface{} `json:"old,omitempty"`
+}
+
+// ImpactedComponent A component that depends on the failing component, directly or transitively
+type ImpactedComponent struct {
+	// Component A component discovered from a source
+	Component Component `json:"component"`
+
+	// Depth Number of dependency edges between the failing component and this one, 1 for direct dependents
+	Depth int `json:"depth"`
+}
+
+// LatestReport defines model for LatestReport.
+type LatestReport struct {
+	// CheckSlug Unique identifier for the check type
+	CheckSlug string `json:"check_slug"`
+
+	// ComponentId Identifier of the component the report is about
+	ComponentId string `json:"component_id"`
+
+	// Details Check-specific details, only present when details are included
+	Details *map[string]interface{} `json:"details,omitempty"`
+
+	// DurationMs How long the check run took in milliseconds, only present when the submission reported it
+	DurationMs *int64 `json:"duration_ms,omitempty"`
+
+	// Id Unique identifier for the report
+	Id string `json:"id"`
+
+	// Metadata Report metadata such as CI job information, only present when metadata is included
+	Metadata *map[string]interface{} `json:"metadata,omitempty"`
+
+	// StartedAt When the check run started, only present when the submission reported it
+	StartedAt *time.Time `json:"started_at,omitempty"`
+
+	// Status Status of the check execution
+	Status LatestReportStatus `json:"status"`
+
+	// Timestamp When the check was executed
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// LatestReportStatus Status of the check execution
+type LatestReportStatus string
+
+// LatestReportsResponse Response containing the latest report of every component and check, with pagination
+type LatestReportsResponse struct {
+	// Pagination Pagination metadata for list responses
+	Pagination Pagination     `json:"pagination"`
+	Reports    []LatestReport `json:"reports"`
+}
+
+// MaintainerInput A maintainer to add to a component
+type MaintainerInput struct {
+	// Identifier Maintainer identifier (email, GitHub handle, or other user identifier)
+	Identifier string `json:"identifier"`
+}
+
+// MaintainersResponse The maintainers of a component after a change
+type MaintainersResponse struct {
+	Maintainers []string `json:"maintainers"`
+}
+
+// ManifestError A problem found in a manifest
+type ManifestError struct {
+	// Field Path of the offending field, when known
+	Field *string `json:"field,omitempty"`
+
+	// Line Line of the problem in the manifest, when known
+	Line *int `json:"line,omitempty"`
+
+	// Message What is wrong
+	Message string `json:"message"`
+}
+
+// ManifestValidation Result of validating a manifest
+type ManifestValidation struct {
+	// Component A component discovered from a source
+	Component *Component `json:"component,omitempty"`
+
+	// Errors Problems found in the manifest, empty when it is valid
+	Errors []ManifestError `json:"errors"`
+
+	// Valid Whether the manifest is valid
+	Valid bool `json:"valid"`
+}
+
+// MissingCheck An expected check without a recent report
+type MissingCheck struct {
+	// LatestReportAt Timestamp of the check's latest report on the component, null when it never reported
+	LatestReportAt *time.Time `json:"latest_report_at"`
+	Slug           string     `json:"slug"`
+}
+
+// MissingChecksResponse Expected checks of a component without a recent report
+type MissingChecksResponse struct {
+	Checks []MissingCheck `json:"checks"`
+
+	// MaxAge The max_age the checks were compared against
+	MaxAge string `json:"max_age"`
+}
+
 // Owners Ownership information for a component
 type Owners struct {
 	// Maintainers List of user identifiers responsible for maintaining this component
@@ -100,12 +450,15 @@ type Owners struct {
 
 // Pagination Pagination metadata for list responses
 type Pagination struct {
-	// HasMore Whether there are more items available
+	// HasMore Whether another page follows, at offset + limit. A page with fewer than limit items is always the last.
 	HasMore bool `json:"has_more"`
 
 	// Limit Number of items returned in this response
 	Limit int `json:"limit"`
 
+	// NextCursor Cursor for the next page of component reports, pass it back as the cursor parameter. Only set when there are more items, latest_per_check is not used and reports are sorted by timestamp descending.
+	NextCursor *string `json:"next_cursor"`
+
 	// Offset Offset used for this response
 	Offset int `json:"offset"`
 
@@ -113,30 +466,307 @@ type Pagination struct {
 	Total int `json:"total"`
 }
 
+// ReportStatusBucket Reports counted by status within one time bucket
+type ReportStatusBucket struct {
+	// Start Start of the bucket in UTC, inclusive
+	Start time.Time `json:"start"`
+
+	// StatusCounts Counts per status: in a summary, the number of checks whose latest report has each status;
+	// in a time series bucket, the number of reports with each status
+	StatusCounts CheckStatusCounts `json:"status_counts"`
+}
+
+// ReportTimeSeries Report status counts of a check in consecutive time buckets
+type ReportTimeSeries struct {
+	// Bucket Size of each bucket
+	Bucket string `json:"bucket"`
+
+	// Buckets Buckets in time order, oldest first, with no gaps
+	Buckets   []ReportStatusBucket `json:"buckets"`
+	CheckSlug string               `json:"check_slug"`
+}
+
+// StaleCheck A check without a recent report
+type StaleCheck struct {
+	// Expected Whether the component's manifest lists the check
+	Expected bool `json:"expected"`
+
+	// LatestReportAt Timestamp of the check's latest report on the component, null when it never reported
+	LatestReportAt *time.Time `json:"latest_report_at"`
+	Slug           string     `json:"slug"`
+}
+
+// StaleChecksResponse Checks of a component without a recent report
+type StaleChecksResponse struct {
+	Checks []StaleCheck `json:"checks"`
+
+	// MaxAge The max_age the checks were compared against
+	MaxAge string `json:"max_age"`
+}
+
+// TeamReportsResponse Reports of the components owned by a team, with pagination
+type TeamReportsResponse struct {
+	// Components Identifiers of the team's components, including those without reports
+	Components []string `json:"components"`
+
+	// Pagination Pagination metadata for list responses
+	Pagination Pagination     `json:"pagination"`
+	Reports    []LatestReport `json:"reports"`
+
+	// Team The team the components belong to
+	Team string `json:"team"`
+}
+
+// GetChecksParams defines parameters for GetChecks.
+type GetChecksParams struct {
+	// MinReports Only include checks with at least this many reports
+	MinReports *int `form:"min_reports,omitempty" json:"min_reports,omitempty"`
+
+	// MaxReports Only include checks with at most this many reports
+	MaxReports *int `form:"max_reports,omitempty" json:"max_reports,omitempty"`
+
+	// ComponentId Only include checks with at least one report for this component.
+	// Report and component counts still cover all components.
+	ComponentId *string `form:"component_id,omitempty" json:"component_id,omitempty"`
+
+	// IncludeComponentCount Include the number of distinct components that reported each check
+	IncludeComponentCount *bool `form:"include_component_count,omitempty" json:"include_component_count,omitempty"`
+
+	// Limit Number of checks to return, 50 by default. Limits above the configured maximum, 100 by default, get the default.
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Pagination offset. Offsets past the last item return an empty page with the full total; offsets above the configured maximum (100000 by default) are rejected.
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// GetComponentsParams defines parameters for GetComponents.
+type GetComponentsParams struct {
+	// Maintainer Only return components listing this maintainer. The identifier must match a maintainer
+	// exactly, so @auth-team does not match @auth-team-lead.
+	Maintainer *string `form:"maintainer,omitempty" json:"maintainer,omitempty"`
+
+	// IncludeDeleted Also return soft-deleted components, which carry deleted_at. They are hidden by default.
+	// Cannot be combined with maintainer.
+	IncludeDeleted *bool `form:"include_deleted,omitempty" json:"include_deleted,omitempty"`
+
+	// Limit Number of components to return, 50 by default. Limits above the configured maximum, 100 by default, get the default.
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Pagination offset. Offsets past the last item return an empty page with the full total; offsets above the configured maximum (100000 by default) are rejected.
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// IfNoneMatch ETag of a previous response; a 304 is returned if the components have not changed since
+	IfNoneMatch *string `json:"If-None-Match,omitempty"`
+}
+
+// CountComponentsParams defines parameters for CountComponents.
+type CountComponentsParams struct {
+	// Team Only count components owned by this team, ignoring case and surrounding whitespace
+	Team *string `form:"team,omitempty" json:"team,omitempty"`
+
+	// Label Only count components with this label, as key=value. Repeat to require several labels.
+	Label *[]string `form:"label,omitempty" json:"label,omitempty"`
+}
+
+// GetComponentByIdParams defines parameters for GetComponentById.
+type GetComponentByIdParams struct {
+	// Include Comma-separated list of optional fields to embed in the component.
+	// Supported values are "report_summary", which adds the checks of the component
+	// counted by the status of their latest report.
+	Include *string `form:"include,omitempty" json:"include,omitempty"`
+}
+
+// GetComponentMissingChecksParams defines parameters for GetComponentMissingChecks.
+type GetComponentMissingChecksParams struct {
+	// MaxAge How recent a report must be to count, as a duration such as 24h or 30m
+	MaxAge *string `form:"max_age,omitempty" json:"max_age,omitempty"`
+}
+
+// GetComponentHistoryParams defines parameters for GetComponentHistory.
+type GetComponentHistoryParams struct {
+	// Field Only include entries that changed this field
+	Field *GetComponentHistoryParamsField `form:"field,omitempty" json:"field,omitempty"`
+
+	// Since Only include changes made at or after this timestamp (ISO 8601)
+	Since *time.Time `form:"since,omitempty" json:"since,omitempty"`
+
+	// Limit Number of entries to return, 50 by default. Limits above the configured maximum, 100 by default, get the default.
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Pagination offset. Offsets past the last item return an empty page with the full total; offsets above the configured maximum (100000 by default) are rejected.
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// GetComponentHistoryParamsField defines parameters for GetComponentHistory.
+type GetComponentHistoryParamsField string
+
+// GetComponentImpactParams defines parameters for GetComponentImpact.
+type GetComponentImpactParams struct {
+	// MaxDepth How many dependency edges to follow at most
+	MaxDepth *int `form:"max_depth,omitempty" json:"max_depth,omitempty"`
+}
+
 // GetComponentReportsParams defines parameters for GetComponentReports.
 type GetComponentReportsParams struct {
-	// Status Filter by check status
-	Status *GetComponentReportsParamsStatus `form:"status,omitempty" json:"status,omitempty"`
+	// Status Filter by check status. Repeat the parameter to match any of several statuses, e.g. status=fail&status=error
+	Status *[]GetComponentReportsParamsStatus `form:"status,omitempty" json:"status,omitempty"`
 
-	// CheckSlug Filter by specific check type
-	CheckSlug *string `form:"check_slug,omitempty" json:"check_slug,omitempty"`
+	// CheckSlug Filter by check type. Repeat the parameter to match any of several checks, e.g. check_slug=unit-tests&check_slug=integration-tests
+	CheckSlug *[]string `form:"check_slug,omitempty" json:"check_slug,omitempty"`
 
 	// Since Filter reports since timestamp (ISO 8601)
 	Since *time.Time `form:"since,omitempty" json:"since,omitempty"`
 
-	// Limit Number of reports to return
+	// Before Filter reports before timestamp (ISO 8601, exclusive). Combine with since for a time window.
+	Before *time.Time `form:"before,omitempty" json:"before,omitempty"`
+
+	// Limit Number of reports to return, 50 by default. Limits above the configured maximum, 100 by default, get the default.
 	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
 
-	// Offset Pagination offset
+	// Offset Pagination offset. Offsets past the last item return an empty page with the full total; offsets above the configured maximum (100000 by default) are rejected.
 	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
 
+	// Cursor Opaque cursor from a previous response's pagination.next_cursor. Returns the reports
+	// that follow it, newest first. Cannot be combined with offset, latest_per_check or a
+	// sort other than timestamp descending.
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+
 	// LatestPerCheck Return only the latest report for each check type
 	LatestPerCheck *bool `form:"latest_per_check,omitempty" json:"latest_per_check,omitempty"`
+
+	// Sort Field to sort reports by. Reports with the same status are ordered newest first.
+	// With latest_per_check the sort applies to the latest report of each check.
+	Sort *GetComponentReportsParamsSort `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Order Sort direction
+	Order *GetComponentReportsParamsOrder `form:"order,omitempty" json:"order,omitempty"`
+
+	// Include Comma-separated list of optional report fields to include in the response.
+	// Supported values are "details" and "metadata".
+	Include *string `form:"include,omitempty" json:"include,omitempty"`
+
+	// IncludeDetails Include each report's details object in the response, same as include=details
+	IncludeDetails *bool `form:"include_details,omitempty" json:"include_details,omitempty"`
+
+	// DetailsFields Comma-separated list of dot-separated key paths to return from each report's details,
+	// e.g. "coverage.total,duration". Requires details to be included. Missing keys are omitted.
+	DetailsFields *string `form:"details_fields,omitempty" json:"details_fields,omitempty"`
+
+	// Format Response format. csv returns the page of reports as CSV with the columns id, check_slug,
+	// status and timestamp, the same as sending "Accept: text/csv". Defaults to json.
+	Format *GetComponentReportsParamsFormat `form:"format,omitempty" json:"format,omitempty"`
 }
 
 // GetComponentReportsParamsStatus defines parameters for GetComponentReports.
 type GetComponentReportsParamsStatus string
 
+// GetComponentReportsParamsSort defines parameters for GetComponentReports.
+type GetComponentReportsParamsSort string
+
+// GetComponentReportsParamsOrder defines parameters for GetComponentReports.
+type GetComponentReportsParamsOrder string
+
+// GetComponentReportsParamsFormat defines parameters for GetComponentReports.
+type GetComponentReportsParamsFormat string
+
+// GetComponentStaleChecksParams defines parameters for GetComponentStaleChecks.
+type GetComponentStaleChecksParams struct {
+	// MaxAge How recent a report must be to count, as a duration such as 24h or 30m
+	MaxAge *string `form:"max_age,omitempty" json:"max_age,omitempty"`
+}
+
+// StreamComponentReportsParams defines parameters for StreamComponentReports.
+type StreamComponentReportsParams struct {
+	// Status Filter by check status. Repeat the parameter to match any of several statuses, e.g. status=fail&status=error
+	Status *[]StreamComponentReportsParamsStatus `form:"status,omitempty" json:"status,omitempty"`
+
+	// CheckSlug Filter by check type. Repeat the parameter to match any of several checks, e.g. check_slug=unit-tests&check_slug=integration-tests
+	CheckSlug *[]string `form:"check_slug,omitempty" json:"check_slug,omitempty"`
+
+	// Since Filter reports since timestamp (ISO 8601)
+	Since *time.Time `form:"since,omitempty" json:"since,omitempty"`
+
+	// Before Filter reports before timestamp (ISO 8601, exclusive). Combine with since for a time window.
+	Before *time.Time `form:"before,omitempty" json:"before,omitempty"`
+}
+
+// StreamComponentReportsParamsStatus defines parameters for StreamComponentReports.
+type StreamComponentReportsParamsStatus string
+
+// GetComponentReportsTimeSeriesParams defines parameters for GetComponentReportsTimeSeries.
+type GetComponentReportsTimeSeriesParams struct {
+	// CheckSlug Check whose reports are counted
+	CheckSlug string `form:"check_slug" json:"check_slug"`
+
+	// Bucket Size of each bucket, an hour or a day
+	Bucket *GetComponentReportsTimeSeriesParamsBucket `form:"bucket,omitempty" json:"bucket,omitempty"`
+
+	// Since Start of the range, rounded down to a bucket boundary. Defaults to 30 buckets before now.
+	// The range may span at most 1000 buckets.
+	Since *time.Time `form:"since,omitempty" json:"since,omitempty"`
+}
+
+// GetComponentReportsTimeSeriesParamsBucket defines parameters for GetComponentReportsTimeSeries.
+type GetComponentReportsTimeSeriesParamsBucket string
+
+// SearchComponentsParams defines parameters for SearchComponents.
+type SearchComponentsParams struct {
+	// Q Text to search for
+	Q string `form:"q" json:"q"`
+
+	// Limit Number of components to return, 50 by default. Limits above the configured maximum, 100 by default, get the default.
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Pagination offset. Offsets past the last item return an empty page with the full total; offsets above the configured maximum (100000 by default) are rejected.
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// ValidateManifestParams defines parameters for ValidateManifest.
+type ValidateManifestParams struct {
+	// Strict Reject unknown fields, like sources configured with strict manifests
+	Strict *bool `form:"strict,omitempty" json:"strict,omitempty"`
+}
+
+// GetLatestReportsParams defines parameters for GetLatestReports.
+type GetLatestReportsParams struct {
+	// Status Only return pairs whose latest report has one of these statuses. Repeat the parameter to
+	// match any of several statuses, e.g. status=fail&status=error
+	Status *[]GetLatestReportsParamsStatus `form:"status,omitempty" json:"status,omitempty"`
+
+	// CheckSlug Only return these checks. Repeat the parameter to match any of several checks.
+	CheckSlug *[]string `form:"check_slug,omitempty" json:"check_slug,omitempty"`
+
+	// Team Only return components owned by this team, ignoring case and surrounding whitespace
+	Team *string `form:"team,omitempty" json:"team,omitempty"`
+
+	// Limit Number of reports to return, 50 by default. Limits above the configured maximum, 100 by default, get the default.
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Pagination offset. Offsets past the last item return an empty page with the full total; offsets above the configured maximum (100000 by default) are rejected.
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// GetLatestReportsParamsStatus defines parameters for GetLatestReports.
+type GetLatestReportsParamsStatus string
+
+// GetTeamReportsParams defines parameters for GetTeamReports.
+type GetTeamReportsParams struct {
+	// CheckSlug Only return reports of this check
+	CheckSlug *string `form:"check_slug,omitempty" json:"check_slug,omitempty"`
+
+	// LatestPerCheck Return only the latest report of each component and check
+	LatestPerCheck *bool `form:"latest_per_check,omitempty" json:"latest_per_check,omitempty"`
+
+	// Limit Number of reports to return, 50 by default. Limits above the configured maximum, 100 by default, get the default.
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Pagination offset. Offsets past the last item return an empty page with the full total; offsets above the configured maximum (100000 by default) are rejected.
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// AddComponentMaintainerJSONRequestBody defines body for AddComponentMaintainer for application/json ContentType.
+type AddComponentMaintainerJSONRequestBody = MaintainerInput
+
 // RequestEditorFn  is the function signature for the RequestEditor callback function
 type RequestEditorFn func(ctx context.Context, req *http.Request) error
 
@@ -210,30 +840,89 @@ func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
 
 // The interface specification for the client above.
 type ClientInterface interface {
+	// GetChecks request
+	GetChecks(ctx context.Context, params *GetChecksParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetComponents request
-	GetComponents(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+	GetComponents(ctx context.Context, params *GetComponentsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CountComponents request
+	CountComponents(ctx context.Context, params *CountComponentsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteComponent request
+	DeleteComponent(ctx context.Context, componentId string, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// GetComponentById request
-	GetComponentById(ctx context.Context, componentId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+	GetComponentById(ctx context.Context, componentId string, params *GetComponentByIdParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	// GetComponentReports request
-	GetComponentReports(ctx context.Context, componentId string, params *GetComponentReportsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
-}
+	// GetComponentMissingChecks request
+	GetComponentMissingChecks(ctx context.Context, componentId string, params *GetComponentMissingChecksParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-func (c *Client) GetComponents(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetComponentsRequest(c.Server)
-	if err != nil {
-		return nil, err
-	}
-	req = req.WithContext(ctx)
+	// GetComponentDependencies request
+	GetComponentDependencies(ctx context.Context, componentId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetComponentDependents request
+	GetComponentDependents(ctx context.Context, componentId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetComponentHistory request
+	GetComponentHistory(ctx context.Context, componentId string, params *GetComponentHistoryParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetComponentImpact request
+	GetComponentImpact(ctx context.Context, componentId string, params *GetComponentImpactParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AddComponentMaintainerWithBody request with any body
+	AddComponentMaintainerWithBody(ctx context.Context, componentId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	AddComponentMaintainer(ctx context.Context, componentId string, body AddComponentMaintainerJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RemoveComponentMaintainer request
+	RemoveComponentMaintainer(ctx context.Context, componentId string, identifier string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetComponentReports request
+	GetComponentReports(ctx context.Context, componentId string, params *GetComponentReportsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetComponentStaleChecks request
+	GetComponentStaleChecks(ctx context.Context, componentId string, params *GetComponentStaleChecksParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// StreamComponentReports request
+	StreamComponentReports(ctx context.Context, componentId string, params *StreamComponentReportsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetComponentReportsSummary request
+	GetComponentReportsSummary(ctx context.Context, componentId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetComponentReportsTimeSeries request
+	GetComponentReportsTimeSeries(ctx context.Context, componentId string, params *GetComponentReportsTimeSeriesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetComponentReportById request
+	GetComponentReportById(ctx context.Context, componentId string, reportId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SearchComponents request
+	SearchComponents(ctx context.Context, params *SearchComponentsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ValidateManifestWithBody request with any body
+	ValidateManifestWithBody(ctx context.Context, params *ValidateManifestParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetLatestReports request
+	GetLatestReports(ctx context.Context, params *GetLatestReportsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetTeamReports request
+	GetTeamReports(ctx context.Context, team string, params *GetTeamReportsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+}
+
+func (c *Client) GetChecks(ctx context.Context, params *GetChecksParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetChecksRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
 	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetComponentById(ctx context.Context, componentId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetComponentByIdRequest(c.Server, componentId)
+func (c *Client) GetComponents(ctx context.Context, params *GetComponentsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetComponentsRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -244,8 +933,8 @@ func (c *Client) GetComponentById(ctx context.Context, componentId string, reqEd
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetComponentReports(ctx context.Context, componentId string, params *GetComponentReportsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetComponentReportsRequest(c.Server, componentId, params)
+func (c *Client) CountComponents(ctx context.Context, params *CountComponentsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCountComponentsRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -256,84 +945,256 @@ func (c *Client) GetComponentReports(ctx context.Context, componentId string, pa
 	return c.Client.Do(req)
 }
 
-// NewGetComponentsRequest generates requests for GetComponents
-func NewGetComponentsRequest(server string) (*http.Request, error) {
-	var err error
+func (c *Client) DeleteComponent(ctx context.Context, componentId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteComponentRequest(c.Server, componentId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) GetComponentById(ctx context.Context, componentId string, params *GetComponentByIdParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetComponentByIdRequest(c.Server, componentId, params)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	operationPath := fmt.Sprintf("/components")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+func (c *Client) GetComponentMissingChecks(ctx context.Context, componentId string, params *GetComponentMissingChecksParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetComponentMissingChecksRequest(c.Server, componentId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) GetComponentDependencies(ctx context.Context, componentId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetComponentDependenciesRequest(c.Server, componentId)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+func (c *Client) GetComponentDependents(ctx context.Context, componentId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetComponentDependentsRequest(c.Server, componentId)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	return req, nil
+func (c *Client) GetComponentHistory(ctx context.Context, componentId string, params *GetComponentHistoryParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetComponentHistoryRequest(c.Server, componentId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewGetComponentByIdRequest generates requests for GetComponentById
-func NewGetComponentByIdRequest(server string, componentId string) (*http.Request, error) {
-	var err error
+func (c *Client) GetComponentImpact(ctx context.Context, componentId string, params *GetComponentImpactParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetComponentImpactRequest(c.Server, componentId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	var pathParam0 string
+func (c *Client) AddComponentMaintainerWithBody(ctx context.Context, componentId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddComponentMaintainerRequestWithBody(c.Server, componentId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "componentId", runtime.ParamLocationPath, componentId)
+func (c *Client) AddComponentMaintainer(ctx context.Context, componentId string, body AddComponentMaintainerJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddComponentMaintainerRequest(c.Server, componentId, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) RemoveComponentMaintainer(ctx context.Context, componentId string, identifier string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRemoveComponentMaintainerRequest(c.Server, componentId, identifier)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	operationPath := fmt.Sprintf("/components/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+func (c *Client) GetComponentReports(ctx context.Context, componentId string, params *GetComponentReportsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetComponentReportsRequest(c.Server, componentId, params)
+	if err != nil {
+		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) GetComponentStaleChecks(ctx context.Context, componentId string, params *GetComponentStaleChecksParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetComponentStaleChecksRequest(c.Server, componentId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) StreamComponentReports(ctx context.Context, componentId string, params *StreamComponentReportsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewStreamComponentReportsRequest(c.Server, componentId, params)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+func (c *Client) GetComponentReportsSummary(ctx context.Context, componentId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetComponentReportsSummaryRequest(c.Server, componentId)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	return req, nil
+func (c *Client) GetComponentReportsTimeSeries(ctx context.Context, componentId string, params *GetComponentReportsTimeSeriesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetComponentReportsTimeSeriesRequest(c.Server, componentId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewGetComponentReportsRequest generates requests for GetComponentReports
-func NewGetComponentReportsRequest(server string, componentId string, params *GetComponentReportsParams) (*http.Request, error) {
-	var err error
+func (c *Client) GetComponentReportById(ctx context.Context, componentId string, reportId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetComponentReportByIdRequest(c.Server, componentId, reportId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	var pathParam0 string
+func (c *Client) SearchComponents(ctx context.Context, params *SearchComponentsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSearchComponentsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "componentId", runtime.ParamLocationPath, componentId)
+func (c *Client) ValidateManifestWithBody(ctx context.Context, params *ValidateManifestParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewValidateManifestRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetLatestReports(ctx context.Context, params *GetLatestReportsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetLatestReportsRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetTeamReports(ctx context.Context, team string, params *GetTeamReportsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetTeamReportsRequest(c.Server, team, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewGetChecksRequest generates requests for GetChecks
+func NewGetChecksRequest(server string, params *GetChecksParams) (*http.Request, error) {
+	var err error
 
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/components/%s/reports", pathParam0)
+	operationPath := fmt.Sprintf("/checks")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -346,9 +1207,9 @@ func NewGetComponentReportsRequest(server string, componentId string, params *Ge
 	if params != nil {
 		queryValues := queryURL.Query()
 
-		if params.Status != nil {
+		if params.MinReports != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "status", runtime.ParamLocationQuery, *params.Status); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "min_reports", runtime.ParamLocationQuery, *params.MinReports); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -362,9 +1223,9 @@ func NewGetComponentReportsRequest(server string, componentId string, params *Ge
 
 		}
 
-		if params.CheckSlug != nil {
+		if params.MaxReports != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "check_slug", runtime.ParamLocationQuery, *params.CheckSlug); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "max_reports", runtime.ParamLocationQuery, *params.MaxReports); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -378,9 +1239,9 @@ func NewGetComponentReportsRequest(server string, componentId string, params *Ge
 
 		}
 
-		if params.Since != nil {
+		if params.ComponentId != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "since", runtime.ParamLocationQuery, *params.Since); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "component_id", runtime.ParamLocationQuery, *params.ComponentId); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -394,9 +1255,9 @@ func NewGetComponentReportsRequest(server string, componentId string, params *Ge
 
 		}
 
-		if params.Limit != nil {
+		if params.IncludeComponentCount != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "include_component_count", runtime.ParamLocationQuery, *params.IncludeComponentCount); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -410,9 +1271,9 @@ func NewGetComponentReportsRequest(server string, componentId string, params *Ge
 
 		}
 
-		if params.Offset != nil {
+		if params.Limit != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -426,9 +1287,9 @@ func NewGetComponentReportsRequest(server string, componentId string, params *Ge
 
 		}
 
-		if params.LatestPerCheck != nil {
+		if params.Offset != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "latest_per_check", runtime.ParamLocationQuery, *params.LatestPerCheck); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -453,217 +1314,3369 @@ func NewGetComponentReportsRequest(server string, componentId string, params *Ge
 	return req, nil
 }
 
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
-	}
-	for _, r := range additionalEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
-	}
-	return nil
-}
-
-// ClientWithResponses builds on ClientInterface to offer response payloads
-type ClientWithResponses struct {
-	ClientInterface
-}
+// NewGetComponentsRequest generates requests for GetComponents
+func NewGetComponentsRequest(server string, params *GetComponentsParams) (*http.Request, error) {
+	var err error
 
-// NewClientWithResponses creates a new ClientWithResponses, which wraps
-// Client with return type handling
-func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
-	client, err := NewClient(server, opts...)
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	return &ClientWithResponses{client}, nil
-}
 
-// WithBaseURL overrides the baseURL.
-func WithBaseURL(baseURL string) ClientOption {
+	operationPath := fmt.Sprintf("/components")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Maintainer != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "maintainer", runtime.ParamLocationQuery, *params.Maintainer); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.IncludeDeleted != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "include_deleted", runtime.ParamLocationQuery, *params.IncludeDeleted); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.IfNoneMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-None-Match", runtime.ParamLocationHeader, *params.IfNoneMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-None-Match", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewCountComponentsRequest generates requests for CountComponents
+func NewCountComponentsRequest(server string, params *CountComponentsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/components/count")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Team != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "team", runtime.ParamLocationQuery, *params.Team); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Label != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "label", runtime.ParamLocationQuery, *params.Label); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeleteComponentRequest generates requests for DeleteComponent
+func NewDeleteComponentRequest(server string, componentId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "componentId", runtime.ParamLocationPath, componentId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/components/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetComponentByIdRequest generates requests for GetComponentById
+func NewGetComponentByIdRequest(server string, componentId string, params *GetComponentByIdParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "componentId", runtime.ParamLocationPath, componentId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/components/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Include != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "include", runtime.ParamLocationQuery, *params.Include); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetComponentMissingChecksRequest generates requests for GetComponentMissingChecks
+func NewGetComponentMissingChecksRequest(server string, componentId string, params *GetComponentMissingChecksParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "componentId", runtime.ParamLocationPath, componentId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/components/%s/checks/missing", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.MaxAge != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "max_age", runtime.ParamLocationQuery, *params.MaxAge); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetComponentDependenciesRequest generates requests for GetComponentDependencies
+func NewGetComponentDependenciesRequest(server string, componentId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "componentId", runtime.ParamLocationPath, componentId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/components/%s/dependencies", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetComponentDependentsRequest generates requests for GetComponentDependents
+func NewGetComponentDependentsRequest(server string, componentId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "componentId", runtime.ParamLocationPath, componentId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/components/%s/dependents", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetComponentHistoryRequest generates requests for GetComponentHistory
+func NewGetComponentHistoryRequest(server string, componentId string, params *GetComponentHistoryParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "componentId", runtime.ParamLocationPath, componentId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/components/%s/history", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Field != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "field", runtime.ParamLocationQuery, *params.Field); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Since != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "since", runtime.ParamLocationQuery, *params.Since); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetComponentImpactRequest generates requests for GetComponentImpact
+func NewGetComponentImpactRequest(server string, componentId string, params *GetComponentImpactParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "componentId", runtime.ParamLocationPath, componentId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/components/%s/impact", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.MaxDepth != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "max_depth", runtime.ParamLocationQuery, *params.MaxDepth); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAddComponentMaintainerRequest calls the generic AddComponentMaintainer builder with application/json body
+func NewAddComponentMaintainerRequest(server string, componentId string, body AddComponentMaintainerJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAddComponentMaintainerRequestWithBody(server, componentId, "application/json", bodyReader)
+}
+
+// NewAddComponentMaintainerRequestWithBody generates requests for AddComponentMaintainer with any type of body
+func NewAddComponentMaintainerRequestWithBody(server string, componentId string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "componentId", runtime.ParamLocationPath, componentId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/components/%s/maintainers", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewRemoveComponentMaintainerRequest generates requests for RemoveComponentMaintainer
+func NewRemoveComponentMaintainerRequest(server string, componentId string, identifier string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "componentId", runtime.ParamLocationPath, componentId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "identifier", runtime.ParamLocationPath, identifier)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/components/%s/maintainers/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetComponentReportsRequest generates requests for GetComponentReports
+func NewGetComponentReportsRequest(server string, componentId string, params *GetComponentReportsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "componentId", runtime.ParamLocationPath, componentId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/components/%s/reports", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Status != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "status", runtime.ParamLocationQuery, *params.Status); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.CheckSlug != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "check_slug", runtime.ParamLocationQuery, *params.CheckSlug); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Since != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "since", runtime.ParamLocationQuery, *params.Since); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Before != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "before", runtime.ParamLocationQuery, *params.Before); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Cursor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cursor", runtime.ParamLocationQuery, *params.Cursor); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.LatestPerCheck != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "latest_per_check", runtime.ParamLocationQuery, *params.LatestPerCheck); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Sort != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sort", runtime.ParamLocationQuery, *params.Sort); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Order != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "order", runtime.ParamLocationQuery, *params.Order); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Include != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "include", runtime.ParamLocationQuery, *params.Include); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.IncludeDetails != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "include_details", runtime.ParamLocationQuery, *params.IncludeDetails); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.DetailsFields != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "details_fields", runtime.ParamLocationQuery, *params.DetailsFields); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Format != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "format", runtime.ParamLocationQuery, *params.Format); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetComponentStaleChecksRequest generates requests for GetComponentStaleChecks
+func NewGetComponentStaleChecksRequest(server string, componentId string, params *GetComponentStaleChecksParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "componentId", runtime.ParamLocationPath, componentId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/components/%s/reports/stale", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.MaxAge != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "max_age", runtime.ParamLocationQuery, *params.MaxAge); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewStreamComponentReportsRequest generates requests for StreamComponentReports
+func NewStreamComponentReportsRequest(server string, componentId string, params *StreamComponentReportsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "componentId", runtime.ParamLocationPath, componentId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/components/%s/reports/stream", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Status != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "status", runtime.ParamLocationQuery, *params.Status); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.CheckSlug != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "check_slug", runtime.ParamLocationQuery, *params.CheckSlug); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Since != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "since", runtime.ParamLocationQuery, *params.Since); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Before != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "before", runtime.ParamLocationQuery, *params.Before); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetComponentReportsSummaryRequest generates requests for GetComponentReportsSummary
+func NewGetComponentReportsSummaryRequest(server string, componentId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "componentId", runtime.ParamLocationPath, componentId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/components/%s/reports/summary", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetComponentReportsTimeSeriesRequest generates requests for GetComponentReportsTimeSeries
+func NewGetComponentReportsTimeSeriesRequest(server string, componentId string, params *GetComponentReportsTimeSeriesParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "componentId", runtime.ParamLocationPath, componentId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/components/%s/reports/timeseries", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "check_slug", runtime.ParamLocationQuery, params.CheckSlug); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if params.Bucket != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "bucket", runtime.ParamLocationQuery, *params.Bucket); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Since != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "since", runtime.ParamLocationQuery, *params.Since); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetComponentReportByIdRequest generates requests for GetComponentReportById
+func NewGetComponentReportByIdRequest(server string, componentId string, reportId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "componentId", runtime.ParamLocationPath, componentId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "reportId", runtime.ParamLocationPath, reportId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/components/%s/reports/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSearchComponentsRequest generates requests for SearchComponents
+func NewSearchComponentsRequest(server string, params *SearchComponentsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/components:search")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "q", runtime.ParamLocationQuery, params.Q); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewValidateManifestRequestWithBody generates requests for ValidateManifest with any type of body
+func NewValidateManifestRequestWithBody(server string, params *ValidateManifestParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/manifests/validate")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Strict != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "strict", runtime.ParamLocationQuery, *params.Strict); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetLatestReportsRequest generates requests for GetLatestReports
+func NewGetLatestReportsRequest(server string, params *GetLatestReportsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/reports/latest")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Status != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "status", runtime.ParamLocationQuery, *params.Status); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.CheckSlug != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "check_slug", runtime.ParamLocationQuery, *params.CheckSlug); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Team != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "team", runtime.ParamLocationQuery, *params.Team); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetTeamReportsRequest generates requests for GetTeamReports
+func NewGetTeamReportsRequest(server string, team string, params *GetTeamReportsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "team", runtime.ParamLocationPath, team)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/teams/%s/reports", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.CheckSlug != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "check_slug", runtime.ParamLocationQuery, *params.CheckSlug); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.LatestPerCheck != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "latest_per_check", runtime.ParamLocationQuery, *params.LatestPerCheck); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
 	return func(c *Client) error {
 		newBaseURL, err := url.Parse(baseURL)
 		if err != nil {
 			return err
 		}
-		c.Server = newBaseURL.String()
-		return nil
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// GetChecksWithResponse request
+	GetChecksWithResponse(ctx context.Context, params *GetChecksParams, reqEditors ...RequestEditorFn) (*GetChecksResponse, error)
+
+	// GetComponentsWithResponse request
+	GetComponentsWithResponse(ctx context.Context, params *GetComponentsParams, reqEditors ...RequestEditorFn) (*GetComponentsResponse, error)
+
+	// CountComponentsWithResponse request
+	CountComponentsWithResponse(ctx context.Context, params *CountComponentsParams, reqEditors ...RequestEditorFn) (*CountComponentsResponse, error)
+
+	// DeleteComponentWithResponse request
+	DeleteComponentWithResponse(ctx context.Context, componentId string, reqEditors ...RequestEditorFn) (*DeleteComponentResponse, error)
+
+	// GetComponentByIdWithResponse request
+	GetComponentByIdWithResponse(ctx context.Context, componentId string, params *GetComponentByIdParams, reqEditors ...RequestEditorFn) (*GetComponentByIdResponse, error)
+
+	// GetComponentMissingChecksWithResponse request
+	GetComponentMissingChecksWithResponse(ctx context.Context, componentId string, params *GetComponentMissingChecksParams, reqEditors ...RequestEditorFn) (*GetComponentMissingChecksResponse, error)
+
+	// GetComponentDependenciesWithResponse request
+	GetComponentDependenciesWithResponse(ctx context.Context, componentId string, reqEditors ...RequestEditorFn) (*GetComponentDependenciesResponse, error)
+
+	// GetComponentDependentsWithResponse request
+	GetComponentDependentsWithResponse(ctx context.Context, componentId string, reqEditors ...RequestEditorFn) (*GetComponentDependentsResponse, error)
+
+	// GetComponentHistoryWithResponse request
+	GetComponentHistoryWithResponse(ctx context.Context, componentId string, params *GetComponentHistoryParams, reqEditors ...RequestEditorFn) (*GetComponentHistoryResponse, error)
+
+	// GetComponentImpactWithResponse request
+	GetComponentImpactWithResponse(ctx context.Context, componentId string, params *GetComponentImpactParams, reqEditors ...RequestEditorFn) (*GetComponentImpactResponse, error)
+
+	// AddComponentMaintainerWithBodyWithResponse request with any body
+	AddComponentMaintainerWithBodyWithResponse(ctx context.Context, componentId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddComponentMaintainerResponse, error)
+
+	AddComponentMaintainerWithResponse(ctx context.Context, componentId string, body AddComponentMaintainerJSONRequestBody, reqEditors ...RequestEditorFn) (*AddComponentMaintainerResponse, error)
+
+	// RemoveComponentMaintainerWithResponse request
+	RemoveComponentMaintainerWithResponse(ctx context.Context, componentId string, identifier string, reqEditors ...RequestEditorFn) (*RemoveComponentMaintainerResponse, error)
+
+	// GetComponentReportsWithResponse request
+	GetComponentReportsWithResponse(ctx context.Context, componentId string, params *GetComponentReportsParams, reqEditors ...RequestEditorFn) (*GetComponentReportsResponse, error)
+
+	// GetComponentStaleChecksWithResponse request
+	GetComponentStaleChecksWithResponse(ctx context.Context, componentId string, params *GetComponentStaleChecksParams, reqEditors ...RequestEditorFn) (*GetComponentStaleChecksResponse, error)
+
+	// StreamComponentReportsWithResponse request
+	StreamComponentReportsWithResponse(ctx context.Context, componentId string, params *StreamComponentReportsParams, reqEditors ...RequestEditorFn) (*StreamComponentReportsResponse, error)
+
+	// GetComponentReportsSummaryWithResponse request
+	GetComponentReportsSummaryWithResponse(ctx context.Context, componentId string, reqEditors ...RequestEditorFn) (*GetComponentReportsSummaryResponse, error)
+
+	// GetComponentReportsTimeSeriesWithResponse request
+	GetComponentReportsTimeSeriesWithResponse(ctx context.Context, componentId string, params *GetComponentReportsTimeSeriesParams, reqEditors ...RequestEditorFn) (*GetComponentReportsTimeSeriesResponse, error)
+
+	// GetComponentReportByIdWithResponse request
+	GetComponentReportByIdWithResponse(ctx context.Context, componentId string, reportId string, reqEditors ...RequestEditorFn) (*GetComponentReportByIdResponse, error)
+
+	// SearchComponentsWithResponse request
+	SearchComponentsWithResponse(ctx context.Context, params *SearchComponentsParams, reqEditors ...RequestEditorFn) (*SearchComponentsResponse, error)
+
+	// ValidateManifestWithBodyWithResponse request with any body
+	ValidateManifestWithBodyWithResponse(ctx context.Context, params *ValidateManifestParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ValidateManifestResponse, error)
+
+	// GetLatestReportsWithResponse request
+	GetLatestReportsWithResponse(ctx context.Context, params *GetLatestReportsParams, reqEditors ...RequestEditorFn) (*GetLatestReportsResponse, error)
+
+	// GetTeamReportsWithResponse request
+	GetTeamReportsWithResponse(ctx context.Context, team string, params *GetTeamReportsParams, reqEditors ...RequestEditorFn) (*GetTeamReportsResponse, error)
+}
+
+type GetChecksResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ChecksResponse
+	JSON400      *Error
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetChecksResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetChecksResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetComponentsResponse struct {
+	Body                         []byte
+	HTTPResponse                 *http.Response
+	JSON200                      *[]Component
+	ApplicationvndArgusV2JSON200 *ComponentsResponse
+	JSON400                      *Error
+	JSON500                      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetComponentsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetComponentsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CountComponentsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ComponentCount
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r CountComponentsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CountComponentsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteComponentResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *Error
+	JSON403      *Error
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteComponentResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteComponentResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetComponentByIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Component
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetComponentByIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetComponentByIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetComponentMissingChecksResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *MissingChecksResponse
+	JSON400      *Error
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetComponentMissingChecksResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetComponentMissingChecksResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetComponentDependenciesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ComponentDependenciesResponse
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetComponentDependenciesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetComponentDependenciesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetComponentDependentsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ComponentDependentsResponse
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetComponentDependentsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetComponentDependentsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetComponentHistoryResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ComponentHistoryResponse
+	JSON400      *Error
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetComponentHistoryResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetComponentHistoryResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetComponentImpactResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ComponentImpactResponse
+	JSON400      *Error
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetComponentImpactResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetComponentImpactResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AddComponentMaintainerResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *MaintainersResponse
+	JSON400      *Error
+	JSON401      *Error
+	JSON403      *Error
+	JSON404      *Error
+	JSON413      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r AddComponentMaintainerResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AddComponentMaintainerResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RemoveComponentMaintainerResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *Error
+	JSON403      *Error
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r RemoveComponentMaintainerResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RemoveComponentMaintainerResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetComponentReportsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ComponentReportsResponse
+	JSON400      *Error
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetComponentReportsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetComponentReportsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetComponentStaleChecksResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *StaleChecksResponse
+	JSON400      *Error
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetComponentStaleChecksResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetComponentStaleChecksResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type StreamComponentReportsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *Error
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r StreamComponentReportsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r StreamComponentReportsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetComponentReportsSummaryResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ComponentReportsSummary
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetComponentReportsSummaryResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetComponentReportsSummaryResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetComponentReportsTimeSeriesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ReportTimeSeries
+	JSON400      *Error
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetComponentReportsTimeSeriesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetComponentReportsTimeSeriesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetComponentReportByIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *CheckReport
+	JSON400      *Error
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetComponentReportByIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetComponentReportByIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SearchComponentsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ComponentSearchResponse
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r SearchComponentsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SearchComponentsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ValidateManifestResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ManifestValidation
+	JSON400      *Error
+	JSON422      *ManifestValidation
+}
+
+// Status returns HTTPResponse.Status
+func (r ValidateManifestResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ValidateManifestResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetLatestReportsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *LatestReportsResponse
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetLatestReportsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetLatestReportsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetTeamReportsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TeamReportsResponse
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetTeamReportsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetTeamReportsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// GetChecksWithResponse request returning *GetChecksResponse
+func (c *ClientWithResponses) GetChecksWithResponse(ctx context.Context, params *GetChecksParams, reqEditors ...RequestEditorFn) (*GetChecksResponse, error) {
+	rsp, err := c.GetChecks(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetChecksResponse(rsp)
+}
+
+// GetComponentsWithResponse request returning *GetComponentsResponse
+func (c *ClientWithResponses) GetComponentsWithResponse(ctx context.Context, params *GetComponentsParams, reqEditors ...RequestEditorFn) (*GetComponentsResponse, error) {
+	rsp, err := c.GetComponents(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetComponentsResponse(rsp)
+}
+
+// CountComponentsWithResponse request returning *CountComponentsResponse
+func (c *ClientWithResponses) CountComponentsWithResponse(ctx context.Context, params *CountComponentsParams, reqEditors ...RequestEditorFn) (*CountComponentsResponse, error) {
+	rsp, err := c.CountComponents(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCountComponentsResponse(rsp)
+}
+
+// DeleteComponentWithResponse request returning *DeleteComponentResponse
+func (c *ClientWithResponses) DeleteComponentWithResponse(ctx context.Context, componentId string, reqEditors ...RequestEditorFn) (*DeleteComponentResponse, error) {
+	rsp, err := c.DeleteComponent(ctx, componentId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteComponentResponse(rsp)
+}
+
+// GetComponentByIdWithResponse request returning *GetComponentByIdResponse
+func (c *ClientWithResponses) GetComponentByIdWithResponse(ctx context.Context, componentId string, params *GetComponentByIdParams, reqEditors ...RequestEditorFn) (*GetComponentByIdResponse, error) {
+	rsp, err := c.GetComponentById(ctx, componentId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetComponentByIdResponse(rsp)
+}
+
+// GetComponentMissingChecksWithResponse request returning *GetComponentMissingChecksResponse
+func (c *ClientWithResponses) GetComponentMissingChecksWithResponse(ctx context.Context, componentId string, params *GetComponentMissingChecksParams, reqEditors ...RequestEditorFn) (*GetComponentMissingChecksResponse, error) {
+	rsp, err := c.GetComponentMissingChecks(ctx, componentId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetComponentMissingChecksResponse(rsp)
+}
+
+// GetComponentDependenciesWithResponse request returning *GetComponentDependenciesResponse
+func (c *ClientWithResponses) GetComponentDependenciesWithResponse(ctx context.Context, componentId string, reqEditors ...RequestEditorFn) (*GetComponentDependenciesResponse, error) {
+	rsp, err := c.GetComponentDependencies(ctx, componentId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetComponentDependenciesResponse(rsp)
+}
+
+// GetComponentDependentsWithResponse request returning *GetComponentDependentsResponse
+func (c *ClientWithResponses) GetComponentDependentsWithResponse(ctx context.Context, componentId string, reqEditors ...RequestEditorFn) (*GetComponentDependentsResponse, error) {
+	rsp, err := c.GetComponentDependents(ctx, componentId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetComponentDependentsResponse(rsp)
+}
+
+// GetComponentHistoryWithResponse request returning *GetComponentHistoryResponse
+func (c *ClientWithResponses) GetComponentHistoryWithResponse(ctx context.Context, componentId string, params *GetComponentHistoryParams, reqEditors ...RequestEditorFn) (*GetComponentHistoryResponse, error) {
+	rsp, err := c.GetComponentHistory(ctx, componentId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetComponentHistoryResponse(rsp)
+}
+
+// GetComponentImpactWithResponse request returning *GetComponentImpactResponse
+func (c *ClientWithResponses) GetComponentImpactWithResponse(ctx context.Context, componentId string, params *GetComponentImpactParams, reqEditors ...RequestEditorFn) (*GetComponentImpactResponse, error) {
+	rsp, err := c.GetComponentImpact(ctx, componentId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetComponentImpactResponse(rsp)
+}
+
+// AddComponentMaintainerWithBodyWithResponse request with arbitrary body returning *AddComponentMaintainerResponse
+func (c *ClientWithResponses) AddComponentMaintainerWithBodyWithResponse(ctx context.Context, componentId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddComponentMaintainerResponse, error) {
+	rsp, err := c.AddComponentMaintainerWithBody(ctx, componentId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddComponentMaintainerResponse(rsp)
+}
+
+func (c *ClientWithResponses) AddComponentMaintainerWithResponse(ctx context.Context, componentId string, body AddComponentMaintainerJSONRequestBody, reqEditors ...RequestEditorFn) (*AddComponentMaintainerResponse, error) {
+	rsp, err := c.AddComponentMaintainer(ctx, componentId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddComponentMaintainerResponse(rsp)
+}
+
+// RemoveComponentMaintainerWithResponse request returning *RemoveComponentMaintainerResponse
+func (c *ClientWithResponses) RemoveComponentMaintainerWithResponse(ctx context.Context, componentId string, identifier string, reqEditors ...RequestEditorFn) (*RemoveComponentMaintainerResponse, error) {
+	rsp, err := c.RemoveComponentMaintainer(ctx, componentId, identifier, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRemoveComponentMaintainerResponse(rsp)
+}
+
+// GetComponentReportsWithResponse request returning *GetComponentReportsResponse
+func (c *ClientWithResponses) GetComponentReportsWithResponse(ctx context.Context, componentId string, params *GetComponentReportsParams, reqEditors ...RequestEditorFn) (*GetComponentReportsResponse, error) {
+	rsp, err := c.GetComponentReports(ctx, componentId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetComponentReportsResponse(rsp)
+}
+
+// GetComponentStaleChecksWithResponse request returning *GetComponentStaleChecksResponse
+func (c *ClientWithResponses) GetComponentStaleChecksWithResponse(ctx context.Context, componentId string, params *GetComponentStaleChecksParams, reqEditors ...RequestEditorFn) (*GetComponentStaleChecksResponse, error) {
+	rsp, err := c.GetComponentStaleChecks(ctx, componentId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetComponentStaleChecksResponse(rsp)
+}
+
+// StreamComponentReportsWithResponse request returning *StreamComponentReportsResponse
+func (c *ClientWithResponses) StreamComponentReportsWithResponse(ctx context.Context, componentId string, params *StreamComponentReportsParams, reqEditors ...RequestEditorFn) (*StreamComponentReportsResponse, error) {
+	rsp, err := c.StreamComponentReports(ctx, componentId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseStreamComponentReportsResponse(rsp)
+}
+
+// GetComponentReportsSummaryWithResponse request returning *GetComponentReportsSummaryResponse
+func (c *ClientWithResponses) GetComponentReportsSummaryWithResponse(ctx context.Context, componentId string, reqEditors ...RequestEditorFn) (*GetComponentReportsSummaryResponse, error) {
+	rsp, err := c.GetComponentReportsSummary(ctx, componentId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetComponentReportsSummaryResponse(rsp)
+}
+
+// GetComponentReportsTimeSeriesWithResponse request returning *GetComponentReportsTimeSeriesResponse
+func (c *ClientWithResponses) GetComponentReportsTimeSeriesWithResponse(ctx context.Context, componentId string, params *GetComponentReportsTimeSeriesParams, reqEditors ...RequestEditorFn) (*GetComponentReportsTimeSeriesResponse, error) {
+	rsp, err := c.GetComponentReportsTimeSeries(ctx, componentId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetComponentReportsTimeSeriesResponse(rsp)
+}
+
+// GetComponentReportByIdWithResponse request returning *GetComponentReportByIdResponse
+func (c *ClientWithResponses) GetComponentReportByIdWithResponse(ctx context.Context, componentId string, reportId string, reqEditors ...RequestEditorFn) (*GetComponentReportByIdResponse, error) {
+	rsp, err := c.GetComponentReportById(ctx, componentId, reportId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetComponentReportByIdResponse(rsp)
+}
+
+// SearchComponentsWithResponse request returning *SearchComponentsResponse
+func (c *ClientWithResponses) SearchComponentsWithResponse(ctx context.Context, params *SearchComponentsParams, reqEditors ...RequestEditorFn) (*SearchComponentsResponse, error) {
+	rsp, err := c.SearchComponents(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSearchComponentsResponse(rsp)
+}
+
+// ValidateManifestWithBodyWithResponse request with arbitrary body returning *ValidateManifestResponse
+func (c *ClientWithResponses) ValidateManifestWithBodyWithResponse(ctx context.Context, params *ValidateManifestParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ValidateManifestResponse, error) {
+	rsp, err := c.ValidateManifestWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseValidateManifestResponse(rsp)
+}
+
+// GetLatestReportsWithResponse request returning *GetLatestReportsResponse
+func (c *ClientWithResponses) GetLatestReportsWithResponse(ctx context.Context, params *GetLatestReportsParams, reqEditors ...RequestEditorFn) (*GetLatestReportsResponse, error) {
+	rsp, err := c.GetLatestReports(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetLatestReportsResponse(rsp)
+}
+
+// GetTeamReportsWithResponse request returning *GetTeamReportsResponse
+func (c *ClientWithResponses) GetTeamReportsWithResponse(ctx context.Context, team string, params *GetTeamReportsParams, reqEditors ...RequestEditorFn) (*GetTeamReportsResponse, error) {
+	rsp, err := c.GetTeamReports(ctx, team, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetTeamReportsResponse(rsp)
+}
+
+// ParseGetChecksResponse parses an HTTP response from a GetChecksWithResponse call
+func ParseGetChecksResponse(rsp *http.Response) (*GetChecksResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetChecksResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ChecksResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetComponentsResponse parses an HTTP response from a GetComponentsWithResponse call
+func ParseGetComponentsResponse(rsp *http.Response) (*GetComponentsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetComponentsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 200:
+		var dest []Component
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/vnd.argus.v2+json" && rsp.StatusCode == 200:
+		var dest ComponentsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationvndArgusV2JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCountComponentsResponse parses an HTTP response from a CountComponentsWithResponse call
+func ParseCountComponentsResponse(rsp *http.Response) (*CountComponentsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CountComponentsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ComponentCount
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteComponentResponse parses an HTTP response from a DeleteComponentWithResponse call
+func ParseDeleteComponentResponse(rsp *http.Response) (*DeleteComponentResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteComponentResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetComponentByIdResponse parses an HTTP response from a GetComponentByIdWithResponse call
+func ParseGetComponentByIdResponse(rsp *http.Response) (*GetComponentByIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetComponentByIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Component
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetComponentMissingChecksResponse parses an HTTP response from a GetComponentMissingChecksWithResponse call
+func ParseGetComponentMissingChecksResponse(rsp *http.Response) (*GetComponentMissingChecksResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetComponentMissingChecksResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest MissingChecksResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetComponentDependenciesResponse parses an HTTP response from a GetComponentDependenciesWithResponse call
+func ParseGetComponentDependenciesResponse(rsp *http.Response) (*GetComponentDependenciesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetComponentDependenciesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ComponentDependenciesResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetComponentDependentsResponse parses an HTTP response from a GetComponentDependentsWithResponse call
+func ParseGetComponentDependentsResponse(rsp *http.Response) (*GetComponentDependentsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetComponentDependentsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ComponentDependentsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetComponentHistoryResponse parses an HTTP response from a GetComponentHistoryWithResponse call
+func ParseGetComponentHistoryResponse(rsp *http.Response) (*GetComponentHistoryResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetComponentHistoryResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ComponentHistoryResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetComponentImpactResponse parses an HTTP response from a GetComponentImpactWithResponse call
+func ParseGetComponentImpactResponse(rsp *http.Response) (*GetComponentImpactResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetComponentImpactResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ComponentImpactResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAddComponentMaintainerResponse parses an HTTP response from a AddComponentMaintainerWithResponse call
+func ParseAddComponentMaintainerResponse(rsp *http.Response) (*AddComponentMaintainerResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AddComponentMaintainerResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest MaintainersResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 413:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON413 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRemoveComponentMaintainerResponse parses an HTTP response from a RemoveComponentMaintainerWithResponse call
+func ParseRemoveComponentMaintainerResponse(rsp *http.Response) (*RemoveComponentMaintainerResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RemoveComponentMaintainerResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetComponentReportsResponse parses an HTTP response from a GetComponentReportsWithResponse call
+func ParseGetComponentReportsResponse(rsp *http.Response) (*GetComponentReportsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetComponentReportsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ComponentReportsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case rsp.StatusCode == 200:
+		// Content-type (text/csv) unsupported
+
+	}
+
+	return response, nil
+}
+
+// ParseGetComponentStaleChecksResponse parses an HTTP response from a GetComponentStaleChecksWithResponse call
+func ParseGetComponentStaleChecksResponse(rsp *http.Response) (*GetComponentStaleChecksResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetComponentStaleChecksResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest StaleChecksResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
 	}
+
+	return response, nil
 }
 
-// ClientWithResponsesInterface is the interface specification for the client with responses above.
-type ClientWithResponsesInterface interface {
-	// GetComponentsWithResponse request
-	GetComponentsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetComponentsResponse, error)
+// ParseStreamComponentReportsResponse parses an HTTP response from a StreamComponentReportsWithResponse call
+func ParseStreamComponentReportsResponse(rsp *http.Response) (*StreamComponentReportsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
 
-	// GetComponentByIdWithResponse request
-	GetComponentByIdWithResponse(ctx context.Context, componentId string, reqEditors ...RequestEditorFn) (*GetComponentByIdResponse, error)
+	response := &StreamComponentReportsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
 
-	// GetComponentReportsWithResponse request
-	GetComponentReportsWithResponse(ctx context.Context, componentId string, params *GetComponentReportsParams, reqEditors ...RequestEditorFn) (*GetComponentReportsResponse, error)
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
 
-type GetComponentsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]Component
-	JSON500      *Error
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
 
-// Status returns HTTPResponse.Status
-func (r GetComponentsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetComponentsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
 	}
-	return 0
-}
 
-type GetComponentByIdResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Component
-	JSON404      *Error
-	JSON500      *Error
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r GetComponentByIdResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseGetComponentReportsSummaryResponse parses an HTTP response from a GetComponentReportsSummaryWithResponse call
+func ParseGetComponentReportsSummaryResponse(rsp *http.Response) (*GetComponentReportsSummaryResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetComponentByIdResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &GetComponentReportsSummaryResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type GetComponentReportsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *ComponentReportsResponse
-	JSON404      *Error
-	JSON500      *Error
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ComponentReportsSummary
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// Status returns HTTPResponse.Status
-func (r GetComponentReportsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetComponentReportsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
 	}
-	return 0
+
+	return response, nil
 }
 
-// GetComponentsWithResponse request returning *GetComponentsResponse
-func (c *ClientWithResponses) GetComponentsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetComponentsResponse, error) {
-	rsp, err := c.GetComponents(ctx, reqEditors...)
+// ParseGetComponentReportsTimeSeriesResponse parses an HTTP response from a GetComponentReportsTimeSeriesWithResponse call
+func ParseGetComponentReportsTimeSeriesResponse(rsp *http.Response) (*GetComponentReportsTimeSeriesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetComponentsResponse(rsp)
+
+	response := &GetComponentReportsTimeSeriesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ReportTimeSeries
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
 }
 
-// GetComponentByIdWithResponse request returning *GetComponentByIdResponse
-func (c *ClientWithResponses) GetComponentByIdWithResponse(ctx context.Context, componentId string, reqEditors ...RequestEditorFn) (*GetComponentByIdResponse, error) {
-	rsp, err := c.GetComponentById(ctx, componentId, reqEditors...)
+// ParseGetComponentReportByIdResponse parses an HTTP response from a GetComponentReportByIdWithResponse call
+func ParseGetComponentReportByIdResponse(rsp *http.Response) (*GetComponentReportByIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetComponentByIdResponse(rsp)
+
+	response := &GetComponentReportByIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CheckReport
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
 }
 
-// GetComponentReportsWithResponse request returning *GetComponentReportsResponse
-func (c *ClientWithResponses) GetComponentReportsWithResponse(ctx context.Context, componentId string, params *GetComponentReportsParams, reqEditors ...RequestEditorFn) (*GetComponentReportsResponse, error) {
-	rsp, err := c.GetComponentReports(ctx, componentId, params, reqEditors...)
+// ParseSearchComponentsResponse parses an HTTP response from a SearchComponentsWithResponse call
+func ParseSearchComponentsResponse(rsp *http.Response) (*SearchComponentsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetComponentReportsResponse(rsp)
+
+	response := &SearchComponentsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ComponentSearchResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
 }
 
-// ParseGetComponentsResponse parses an HTTP response from a GetComponentsWithResponse call
-func ParseGetComponentsResponse(rsp *http.Response) (*GetComponentsResponse, error) {
+// ParseValidateManifestResponse parses an HTTP response from a ValidateManifestWithResponse call
+func ParseValidateManifestResponse(rsp *http.Response) (*ValidateManifestResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetComponentsResponse{
+	response := &ValidateManifestResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []Component
+		var dest ManifestValidation
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON500 = &dest
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest ManifestValidation
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseGetComponentByIdResponse parses an HTTP response from a GetComponentByIdWithResponse call
-func ParseGetComponentByIdResponse(rsp *http.Response) (*GetComponentByIdResponse, error) {
+// ParseGetLatestReportsResponse parses an HTTP response from a GetLatestReportsWithResponse call
+func ParseGetLatestReportsResponse(rsp *http.Response) (*GetLatestReportsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetComponentByIdResponse{
+	response := &GetLatestReportsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Component
+		var dest LatestReportsResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON404 = &dest
+		response.JSON400 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest Error
@@ -677,33 +4690,33 @@ func ParseGetComponentByIdResponse(rsp *http.Response) (*GetComponentByIdRespons
 	return response, nil
 }
 
-// ParseGetComponentReportsResponse parses an HTTP response from a GetComponentReportsWithResponse call
-func ParseGetComponentReportsResponse(rsp *http.Response) (*GetComponentReportsResponse, error) {
+// ParseGetTeamReportsResponse parses an HTTP response from a GetTeamReportsWithResponse call
+func ParseGetTeamReportsResponse(rsp *http.Response) (*GetTeamReportsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetComponentReportsResponse{
+	response := &GetTeamReportsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ComponentReportsResponse
+		var dest TeamReportsResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON404 = &dest
+		response.JSON400 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest Error
@@ -0,0 +1,117 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/doron-cohen/argus/backend/api/client"
+	"github.com/doron-cohen/argus/backend/internal/storage"
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBaseURL(t *testing.T) {
+	tests := []struct {
+		name     string
+		host     string
+		basePath string
+		version  string
+		expected string
+	}{
+		{"defaults", "http://localhost:8080", client.DefaultBasePath, client.DefaultVersion, "http://localhost:8080/api/catalog/v1"},
+		{"trailing and leading slashes", "http://localhost:8080/", "/api/catalog/", "/v1/", "http://localhost:8080/api/catalog/v1"},
+		{"host with path prefix", "https://example.com/argus", "/api/catalog", "v2", "https://example.com/argus/api/catalog/v2"},
+		{"empty base path", "http://localhost:8080", "", "v1", "http://localhost:8080/v1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			baseURL, err := client.BaseURL(tt.host, tt.basePath, tt.version)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, baseURL)
+		})
+	}
+
+	t.Run("missing scheme", func(t *testing.T) {
+		_, err := client.BaseURL("localhost:8080", client.DefaultBasePath, client.DefaultVersion)
+		assert.Error(t, err)
+	})
+}
+
+func TestNewCatalogClient_MountedPrefix(t *testing.T) {
+	repo, server := setupTestEnvironment(t)
+	defer cleanupTestEnvironment(t, repo)
+
+	component := storage.Component{ComponentID: "mounted-prefix-service", Name: "Mounted Prefix Service"}
+	require.NoError(t, repo.DB.Create(&component).Error)
+
+	t.Run("default mount", func(t *testing.T) {
+		mux := chi.NewRouter()
+		mux.Mount("/api/catalog/v1", Handler(server))
+		srv := httptest.NewServer(mux)
+		defer srv.Close()
+
+		catalogClient, err := client.NewCatalogClient(srv.URL)
+		require.NoError(t, err)
+
+		resp, err := catalogClient.GetComponentByIdWithResponse(context.Background(), "mounted-prefix-service", nil)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, resp.StatusCode())
+		require.NotNil(t, resp.JSON200)
+		assert.Equal(t, "Mounted Prefix Service", resp.JSON200.Name)
+	})
+
+	t.Run("custom mount and version", func(t *testing.T) {
+		mux := chi.NewRouter()
+		mux.Mount("/internal/catalog/v2", Handler(server))
+		srv := httptest.NewServer(mux)
+		defer srv.Close()
+
+		catalogClient, err := client.NewCatalogClient(srv.URL+"/",
+			client.WithBasePath("/internal/catalog/"),
+			client.WithVersion("v2"),
+			client.WithClientOptions(client.WithHTTPClient(srv.Client())),
+		)
+		require.NoError(t, err)
+
+		resp, err := catalogClient.GetComponentsWithResponse(context.Background(), nil)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, resp.StatusCode())
+		require.NotNil(t, resp.JSON200)
+		assert.NotEmpty(t, *resp.JSON200)
+	})
+}
+
+func TestCatalogClient_DeleteComponent(t *testing.T) {
+	repo, server := setupTestEnvironment(t)
+	defer cleanupTestEnvironment(t, repo)
+
+	component := storage.Component{ComponentID: "client-delete-service", Name: "Client Delete Service"}
+	require.NoError(t, repo.DB.Create(&component).Error)
+
+	mux := chi.NewRouter()
+	mux.Mount("/api/catalog/v1", Handler(server))
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	catalogClient, err := client.NewCatalogClient(srv.URL)
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	resp, err := catalogClient.DeleteComponentWithResponse(ctx, "client-delete-service")
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNoContent, resp.StatusCode())
+
+	get, err := catalogClient.GetComponentByIdWithResponse(ctx, "client-delete-service", nil)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, get.StatusCode())
+
+	resp, err = catalogClient.DeleteComponentWithResponse(ctx, "client-delete-service")
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode())
+	require.NotNil(t, resp.JSON404)
+	assert.Equal(t, "Component not found", resp.JSON404.Error)
+}
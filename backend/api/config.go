@@ -0,0 +1,59 @@
+package api
+
+import "fmt"
+
+// Default page limits of the paginated catalog endpoints, used when none are configured
+const (
+	DefaultPageLimit    = 50
+	DefaultMaxPageLimit = 100
+	DefaultMaxOffset    = 100000
+)
+
+// Config holds configuration for the catalog API
+type Config struct {
+	// DefaultPageLimit is the page size of paginated endpoints when a request sets no limit
+	DefaultPageLimit int `yaml:"default_page_limit"`
+	// MaxPageLimit is the largest limit a request may set; larger limits get the default page size
+	MaxPageLimit int `yaml:"max_page_limit"`
+	// MaxOffset is the largest offset a request may set; larger offsets are rejected
+	MaxOffset int `yaml:"max_offset"`
+}
+
+// Validate checks the page limits and maximum offset are positive and the default page limit does
+// not exceed the maximum
+func (c Config) Validate() error {
+	if c.DefaultPageLimit < 1 {
+		return fmt.Errorf("default_page_limit must be positive, got %d", c.DefaultPageLimit)
+	}
+	if c.MaxPageLimit < 1 {
+		return fmt.Errorf("max_page_limit must be positive, got %d", c.MaxPageLimit)
+	}
+	if c.DefaultPageLimit > c.MaxPageLimit {
+		return fmt.Errorf("default_page_limit %d cannot exceed max_page_limit %d", c.DefaultPageLimit, c.MaxPageLimit)
+	}
+	if c.MaxOffset < 1 {
+		return fmt.Errorf("max_offset must be positive, got %d", c.MaxOffset)
+	}
+	return nil
+}
+
+// pageLimits returns the configured default and maximum page limits, falling back to
+// DefaultPageLimit and DefaultMaxPageLimit for unset values
+func (c Config) pageLimits() (defaultLimit int, maxLimit int) {
+	defaultLimit, maxLimit = c.DefaultPageLimit, c.MaxPageLimit
+	if maxLimit <= 0 {
+		maxLimit = DefaultMaxPageLimit
+	}
+	if defaultLimit <= 0 {
+		defaultLimit = min(DefaultPageLimit, maxLimit)
+	}
+	return defaultLimit, maxLimit
+}
+
+// maxOffset returns the configured maximum offset, falling back to DefaultMaxOffset when unset
+func (c Config) maxOffset() int {
+	if c.MaxOffset <= 0 {
+		return DefaultMaxOffset
+	}
+	return c.MaxOffset
+}
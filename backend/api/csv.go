@@ -0,0 +1,43 @@
+package api
+
+import (
+	"encoding/csv"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// csvMediaType selects the CSV reports response, see GetComponentReports
+const csvMediaType = "text/csv"
+
+// reportsCSVHeader names the columns of a CSV reports response
+var reportsCSVHeader = []string{"id", "check_slug", "status", "timestamp"}
+
+// wantsReportsCSV reports whether a reports request asked for CSV, through the format
+// parameter or the Accept header. An explicit format wins over the header.
+func wantsReportsCSV(r *http.Request, format *GetComponentReportsParamsFormat) bool {
+	if format != nil {
+		return *format == Csv
+	}
+	return strings.Contains(r.Header.Get("Accept"), csvMediaType)
+}
+
+// writeReportsCSV streams reports to the response as CSV rows. The CSV writer sends its
+// small buffer on to the client whenever it fills, so the document is never built in memory.
+func writeReportsCSV(w http.ResponseWriter, reports []CheckReport) error {
+	w.Header().Set("Content-Type", csvMediaType+"; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+
+	writer := csv.NewWriter(w)
+	if err := writer.Write(reportsCSVHeader); err != nil {
+		return err
+	}
+	for _, report := range reports {
+		record := []string{report.Id, report.CheckSlug, string(report.Status), report.Timestamp.UTC().Format(time.RFC3339Nano)}
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
@@ -0,0 +1,44 @@
+package api
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/doron-cohen/argus/backend/internal/storage"
+	"github.com/google/uuid"
+)
+
+// encodeReportCursor encodes a report cursor as an opaque URL-safe token
+func encodeReportCursor(cursor storage.ReportCursor) string {
+	raw := cursor.Timestamp.UTC().Format(time.RFC3339Nano) + "|" + cursor.ID.String()
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// decodeReportCursor parses a token produced by encodeReportCursor
+func decodeReportCursor(token string) (*storage.ReportCursor, error) {
+	invalid := fmt.Errorf("invalid cursor %q", token)
+
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, invalid
+	}
+
+	timestampPart, idPart, ok := strings.Cut(string(raw), "|")
+	if !ok {
+		return nil, invalid
+	}
+
+	timestamp, err := time.Parse(time.RFC3339Nano, timestampPart)
+	if err != nil {
+		return nil, invalid
+	}
+
+	id, err := uuid.Parse(idPart)
+	if err != nil {
+		return nil, invalid
+	}
+
+	return &storage.ReportCursor{Timestamp: timestamp, ID: id}, nil
+}
@@ -0,0 +1,91 @@
+package api
+
+import (
+	"fmt"
+	"strings"
+)
+
+// reportIncludes holds the optional report fields requested by a client
+type reportIncludes struct {
+	details  bool
+	metadata bool
+}
+
+// parseReportIncludes parses a comma-separated list of optional report fields
+func parseReportIncludes(raw string) (reportIncludes, error) {
+	var includes reportIncludes
+	for _, field := range strings.Split(raw, ",") {
+		switch strings.TrimSpace(field) {
+		case "details":
+			includes.details = true
+		case "metadata":
+			includes.metadata = true
+		default:
+			return reportIncludes{}, fmt.Errorf("invalid include value %q, expected details or metadata", strings.TrimSpace(field))
+		}
+	}
+	return includes, nil
+}
+
+// parseDetailsFields splits a comma-separated list of dot-separated key paths
+func parseDetailsFields(raw string) ([][]string, error) {
+	var paths [][]string
+	for _, field := range strings.Split(raw, ",") {
+		field = strings.TrimSpace(field)
+		if field == "" {
+			return nil, fmt.Errorf("details_fields contains an empty field")
+		}
+
+		path := strings.Split(field, ".")
+		for _, key := range path {
+			if key == "" {
+				return nil, fmt.Errorf("invalid details field %q", field)
+			}
+		}
+		paths = append(paths, path)
+	}
+	return paths, nil
+}
+
+// projectDetails returns a copy of details containing only the requested key paths.
+// Nesting is preserved and paths that do not resolve are omitted.
+func projectDetails(details map[string]interface{}, paths [][]string) map[string]interface{} {
+	projected := make(map[string]interface{})
+	for _, path := range paths {
+		value, ok := lookupPath(details, path)
+		if !ok {
+			continue
+		}
+		setPath(projected, path, value)
+	}
+	return projected
+}
+
+// lookupPath resolves a key path through nested objects
+func lookupPath(details map[string]interface{}, path []string) (interface{}, bool) {
+	var current interface{} = details
+	for _, key := range path {
+		object, ok := current.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		current, ok = object[key]
+		if !ok {
+			return nil, false
+		}
+	}
+	return current, true
+}
+
+// setPath writes a value at a key path, creating intermediate objects as needed
+func setPath(target map[string]interface{}, path []string, value interface{}) {
+	for _, key := range path[:len(path)-1] {
+		next, ok := target[key].(map[string]interface{})
+		if !ok {
+			next = make(map[string]interface{})
+			target[key] = next
+		}
+		target = next
+	}
+	target[path[len(path)-1]] = value
+}
@@ -0,0 +1,42 @@
+package api
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/doron-cohen/argus/backend/internal/storage"
+)
+
+// componentsETag derives a weak ETag for a components listing from the time components last
+// changed and how many exist, so it can be checked without loading them. A deletion moves the
+// change time forward, and a permanent one lowers the count. The variant, built from the
+// response format and query, keeps different listings from sharing an ETag.
+func (s *APIServer) componentsETag(ctx context.Context, variant string) (string, error) {
+	updatedAt, err := s.Repo.GetComponentsMaxUpdatedAt(ctx)
+	if err != nil {
+		return "", err
+	}
+	count, err := s.Repo.CountComponents(ctx, storage.ComponentFilters{})
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256(fmt.Appendf(nil, "%d|%d|%s", updatedAt.UnixNano(), count, variant))
+	// Weak, since the bare array is not ordered and may be serialized differently each time
+	return `W/"` + hex.EncodeToString(sum[:16]) + `"`, nil
+}
+
+// etagMatches reports whether an If-None-Match header value matches etag, using the weak
+// comparison that RFC 9110 requires for If-None-Match
+func etagMatches(ifNoneMatch string, etag string) bool {
+	for candidate := range strings.SplitSeq(ifNoneMatch, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" || strings.TrimPrefix(candidate, "W/") == strings.TrimPrefix(etag, "W/") {
+			return true
+		}
+	}
+	return false
+}
@@ -2,64 +2,120 @@ package api
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"net/http"
+	"sort"
+	"strings"
+	"time"
 
+	"github.com/doron-cohen/argus/backend/internal/requestlog"
 	"github.com/doron-cohen/argus/backend/internal/storage"
+	"github.com/doron-cohen/argus/backend/internal/utils"
+	"github.com/google/uuid"
 )
 
 type APIServer struct {
-	Repo *storage.Repository
+	Repo   *storage.Repository
+	Config Config
 }
 
-func NewAPIServer(repo *storage.Repository) ServerInterface {
-	return &APIServer{Repo: repo}
+func NewAPIServer(repo *storage.Repository, cfg Config) ServerInterface {
+	return &APIServer{Repo: repo, Config: cfg}
 }
 
-func (s *APIServer) GetComponents(w http.ResponseWriter, r *http.Request) {
+// componentsV2MediaType selects the paginated components response, see GetComponents
+const componentsV2MediaType = "application/vnd.argus.v2+json"
+
+// GetComponents lists components. Clients accepting componentsV2MediaType get a page with
+// pagination metadata; others get the bare array, which holds every component unless
+// limit or offset is set, so existing clients keep working. Responses carry an ETag, and a
+// matching If-None-Match is answered with 304 without loading the components.
+func (s *APIServer) GetComponents(w http.ResponseWriter, r *http.Request, params GetComponentsParams) {
 	ctx := r.Context()
-	components, err := s.Repo.GetComponents(ctx)
+
+	paginated := strings.Contains(r.Header.Get("Accept"), componentsV2MediaType)
+	paged := paginated || params.Limit != nil || params.Offset != nil
+	limit := s.getLimit(params.Limit)
+	offset, err := s.getOffset(params.Offset)
+	if err != nil {
+		s.writeValidationError(w, err.Error())
+		return
+	}
+
+	etag, err := s.componentsETag(ctx, fmt.Sprintf("%t|%s", paginated, r.URL.RawQuery))
 	if err != nil {
 		http.Error(w, "failed to fetch components", http.StatusInternalServerError)
 		return
 	}
+	w.Header().Set("ETag", etag)
+	if params.IfNoneMatch != nil && etagMatches(*params.IfNoneMatch, etag) {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
 
-	var apiComponents []Component
-	for _, c := range components {
-		component := Component{
-			Name: c.Name,
-		}
+	includeDeleted := params.IncludeDeleted != nil && *params.IncludeDeleted
 
-		// Set ID if available (use ComponentID from storage)
-		if c.ComponentID != "" {
-			id := c.ComponentID
-			component.Id = &id
+	var components []storage.Component
+	var total int64
+	switch {
+	case includeDeleted && params.Maintainer != nil:
+		s.writeValidationError(w, "include_deleted cannot be combined with maintainer")
+		return
+	case includeDeleted:
+		components, err = s.Repo.GetComponentsIncludingDeleted(ctx)
+		total = int64(len(components))
+		if paged {
+			components = pageComponents(components, limit, offset)
 		}
-
-		// Set description if available
-		if c.Description != "" {
-			description := c.Description
-			component.Description = &description
+	case params.Maintainer != nil:
+		maintainer := strings.TrimSpace(*params.Maintainer)
+		if maintainer == "" {
+			s.writeValidationError(w, "maintainer cannot be empty")
+			return
 		}
+		components, err = s.Repo.GetComponentsByMaintainer(ctx, maintainer)
+		total = int64(len(components))
+		if paged {
+			components = pageComponents(components, limit, offset)
+		}
+	case paged:
+		components, total, err = s.Repo.GetComponentsPaginated(ctx, limit, offset)
+	default:
+		components, err = s.Repo.GetComponents(ctx)
+	}
+	if err != nil {
+		http.Error(w, "failed to fetch components", http.StatusInternalServerError)
+		return
+	}
 
-		// Set owners if available
-		if len(c.Maintainers) > 0 || c.Team != "" {
-			owners := &Owners{}
-
-			if len(c.Maintainers) > 0 {
-				maintainers := []string(c.Maintainers)
-				owners.Maintainers = &maintainers
-			}
-
-			if c.Team != "" {
-				team := c.Team
-				owners.Team = &team
-			}
+	if paginated {
+		apiComponents := make([]Component, len(components))
+		for i := range components {
+			apiComponents[i] = s.convertToAPIComponent(&components[i])
+		}
+		response := ComponentsResponse{
+			Components: apiComponents,
+			Pagination: Pagination{
+				Total:   int(total),
+				Limit:   limit,
+				Offset:  offset,
+				HasMore: utils.HasMorePages(offset, limit, len(components), total),
+			},
+		}
 
-			component.Owners = owners
+		w.Header().Set("Content-Type", componentsV2MediaType)
+		w.WriteHeader(http.StatusOK)
+		if err := json.NewEncoder(w).Encode(response); err != nil {
+			http.Error(w, "failed to encode response", http.StatusInternalServerError)
 		}
+		return
+	}
 
-		apiComponents = append(apiComponents, component)
+	var apiComponents []Component
+	for i := range components {
+		apiComponents = append(apiComponents, s.convertToAPIComponent(&components[i]))
 	}
 
 	w.Header().Set("Content-Type", "application/json")
@@ -70,8 +126,104 @@ func (s *APIServer) GetComponents(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-func (s *APIServer) GetComponentById(w http.ResponseWriter, r *http.Request, componentId string) {
+// pageComponents orders already loaded components by identifier and returns one page of them
+func pageComponents(components []storage.Component, limit int, offset int) []storage.Component {
+	sort.Slice(components, func(i, j int) bool {
+		return components[i].ComponentID < components[j].ComponentID
+	})
+	if offset >= len(components) {
+		return nil
+	}
+	return components[offset:min(offset+limit, len(components))]
+}
+
+// SearchComponents returns components whose identifier, name, description or team contains the query
+func (s *APIServer) SearchComponents(w http.ResponseWriter, r *http.Request, params SearchComponentsParams) {
+	query := strings.TrimSpace(params.Q)
+	if query == "" {
+		s.writeValidationError(w, "q cannot be empty")
+		return
+	}
+
+	limit := s.getLimit(params.Limit)
+	offset, err := s.getOffset(params.Offset)
+	if err != nil {
+		s.writeValidationError(w, err.Error())
+		return
+	}
+
+	components, total, err := s.Repo.SearchComponents(r.Context(), query, limit, offset)
+	if err != nil {
+		http.Error(w, "failed to search components", http.StatusInternalServerError)
+		return
+	}
+
+	apiComponents := make([]Component, len(components))
+	for i := range components {
+		apiComponents[i] = s.convertToAPIComponent(&components[i])
+	}
+
+	response := ComponentSearchResponse{
+		Components: apiComponents,
+		Pagination: Pagination{
+			Total:   int(total),
+			Limit:   limit,
+			Offset:  offset,
+			HasMore: utils.HasMorePages(offset, limit, len(components), total),
+		},
+	}
+
+	s.writeJSONResponse(w, response)
+}
+
+// CountComponents returns the number of components, optionally only those of a team or with labels
+func (s *APIServer) CountComponents(w http.ResponseWriter, r *http.Request, params CountComponentsParams) {
+	var filters storage.ComponentFilters
+	if params.Team != nil {
+		filters.Team = strings.TrimSpace(*params.Team)
+		if filters.Team == "" {
+			s.writeValidationError(w, "team cannot be empty")
+			return
+		}
+	}
+	if params.Label != nil {
+		filters.Labels = make(map[string]string, len(*params.Label))
+		for _, label := range *params.Label {
+			key, value, ok := strings.Cut(label, "=")
+			if !ok || key == "" {
+				s.writeValidationError(w, fmt.Sprintf("label %q must be in the form key=value", label))
+				return
+			}
+			if existing, seen := filters.Labels[key]; seen && existing != value {
+				s.writeValidationError(w, fmt.Sprintf("label %q is given more than once with different values", key))
+				return
+			}
+			filters.Labels[key] = value
+		}
+	}
+
+	count, err := s.Repo.CountComponents(r.Context(), filters)
+	if err != nil {
+		http.Error(w, "failed to count components", http.StatusInternalServerError)
+		return
+	}
+
+	s.writeJSONResponse(w, ComponentCount{Count: count})
+}
+
+func (s *APIServer) GetComponentById(w http.ResponseWriter, r *http.Request, componentId string, params GetComponentByIdParams) {
 	ctx := r.Context()
+
+	var includes componentIncludes
+	if params.Include != nil {
+		var err error
+		includes, err = parseComponentIncludes(*params.Include)
+		if err != nil {
+			s.writeValidationError(w, err.Error())
+			return
+		}
+	}
+
 	component, err := s.Repo.GetComponentByID(ctx, componentId)
 	if err != nil {
 		if err == storage.ErrComponentNotFound {
@@ -85,6 +237,16 @@ func (s *APIServer) GetComponentById(w http.ResponseWriter, r *http.Request, com
 	// Convert storage component to API component
 	apiComponent := s.convertToAPIComponent(component)
 
+	if includes.reportSummary {
+		summary, err := s.Repo.GetCheckReportStatusCounts(ctx, componentId)
+		if err != nil {
+			http.Error(w, "failed to fetch reports summary", http.StatusInternalServerError)
+			return
+		}
+		apiSummary := convertToAPIReportsSummary(summary)
+		apiComponent.ReportSummary = &apiSummary
+	}
+
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusOK)
 	if err := json.NewEncoder(w).Encode(apiComponent); err != nil {
@@ -93,6 +255,133 @@ func (s *APIServer) GetComponentById(w http.ResponseWriter, r *http.Request, com
 	}
 }
 
+// componentIncludes holds the optional component fields requested by a client
+type componentIncludes struct {
+	reportSummary bool
+}
+
+// parseComponentIncludes parses a comma-separated list of optional component fields
+func parseComponentIncludes(raw string) (componentIncludes, error) {
+	var includes componentIncludes
+	for _, field := range strings.Split(raw, ",") {
+		switch strings.TrimSpace(field) {
+		case "report_summary":
+			includes.reportSummary = true
+		default:
+			return componentIncludes{}, fmt.Errorf("invalid include value %q, expected report_summary", strings.TrimSpace(field))
+		}
+	}
+	return includes, nil
+}
+
+// DeleteComponent soft-deletes a component, keeping its reports and history
+func (s *APIServer) DeleteComponent(w http.ResponseWriter, r *http.Request, componentId string) {
+	if err := s.Repo.SoftDeleteComponent(r.Context(), componentId); err != nil {
+		if err == storage.ErrComponentNotFound {
+			s.writeNotFoundError(w)
+			return
+		}
+		http.Error(w, "failed to delete component", http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// AddComponentMaintainer adds a single maintainer to a component
+func (s *APIServer) AddComponentMaintainer(w http.ResponseWriter, r *http.Request, componentId string) {
+	ctx := r.Context()
+
+	var input MaintainerInput
+	if !s.decodeBody(w, r, &input, maxMaintainerBodyBytes) {
+		return
+	}
+	if storage.NormalizeMaintainer(input.Identifier) == "" {
+		s.writeValidationError(w, "identifier is required and cannot be empty")
+		return
+	}
+
+	maintainers, err := s.Repo.AddComponentMaintainer(ctx, componentId, input.Identifier)
+	if err != nil {
+		if err == storage.ErrComponentNotFound {
+			s.writeNotFoundError(w)
+			return
+		}
+		http.Error(w, "failed to add maintainer", http.StatusInternalServerError)
+		return
+	}
+
+	s.writeJSONResponse(w, MaintainersResponse{Maintainers: []string(maintainers)})
+}
+
+// RemoveComponentMaintainer removes a single maintainer from a component
+func (s *APIServer) RemoveComponentMaintainer(w http.ResponseWriter, r *http.Request, componentId string, identifier string) {
+	ctx := r.Context()
+
+	if _, err := s.Repo.RemoveComponentMaintainer(ctx, componentId, identifier); err != nil {
+		if err == storage.ErrComponentNotFound {
+			s.writeNotFoundError(w)
+			return
+		}
+		http.Error(w, "failed to remove maintainer", http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// maxMaintainerBodyBytes bounds the body of a maintainer change, which holds a single identifier
+const maxMaintainerBodyBytes = 4 << 10
+
+// decodeBody decodes the request body, read up to maxBytes, into v, rejecting unknown fields and
+// anything after the JSON value. It writes a 413 when the body is too large and a 400 when it is
+// not a single valid JSON value, and returns false in both cases.
+func (s *APIServer) decodeBody(w http.ResponseWriter, r *http.Request, v any, maxBytes int64) bool {
+	decoder := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxBytes))
+	decoder.DisallowUnknownFields()
+	err := decoder.Decode(v)
+	if err == nil {
+		if _, err = decoder.Token(); errors.Is(err, io.EOF) {
+			return true
+		}
+		if err == nil {
+			s.writeValidationError(w, "Invalid JSON format: request body must contain a single JSON value")
+			return false
+		}
+	}
+
+	var tooLarge *http.MaxBytesError
+	if errors.As(err, &tooLarge) {
+		code := "PAYLOAD_TOO_LARGE"
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusRequestEntityTooLarge)
+		_ = json.NewEncoder(w).Encode(Error{Error: fmt.Sprintf("request body cannot exceed %d bytes", tooLarge.Limit), Code: &code})
+		return false
+	}
+	// encoding/json has no typed error for unknown fields
+	if field, ok := strings.CutPrefix(err.Error(), "json: unknown field "); ok {
+		s.writeValidationError(w, "unknown field "+field)
+		return false
+	}
+	s.writeValidationError(w, "Invalid JSON format")
+	return false
+}
+
+// writeValidationError writes a bad request error response
+func (s *APIServer) writeValidationError(w http.ResponseWriter, message string) {
+	code := "VALIDATION_ERROR"
+	errorResponse := Error{
+		Error: message,
+		Code:  &code,
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusBadRequest)
+	if err := json.NewEncoder(w).Encode(errorResponse); err != nil {
+		http.Error(w, "failed to encode error response", http.StatusInternalServerError)
+		return
+	}
+}
+
 // convertToAPIComponent converts a storage component to an API component
 func (s *APIServer) convertToAPIComponent(component *storage.Component) Component {
 	apiComponent := Component{
@@ -128,14 +417,47 @@ func (s *APIServer) convertToAPIComponent(component *storage.Component) Componen
 		apiComponent.Owners = owners
 	}
 
+	if len(component.Labels) > 0 {
+		labels := map[string]string(component.Labels)
+		apiComponent.Labels = &labels
+	}
+
+	if component.Lifecycle != "" {
+		lifecycle := ComponentLifecycle(component.Lifecycle)
+		apiComponent.Lifecycle = &lifecycle
+	}
+
+	if len(component.ExpectedChecks) > 0 {
+		expectedChecks := []string(component.ExpectedChecks)
+		apiComponent.ExpectedChecks = &expectedChecks
+	}
+
+	if len(component.DependsOn) > 0 {
+		dependsOn := []string(component.DependsOn)
+		apiComponent.DependsOn = &dependsOn
+	}
+
+	if component.SourceRevision != "" {
+		apiComponent.SourceRevision = utils.ToPointer(component.SourceRevision)
+	}
+
+	if component.DeletedAt.Valid {
+		apiComponent.DeletedAt = utils.ToPointer(component.DeletedAt.Time)
+	}
+
 	return apiComponent
 }
 
-// writeNotFoundError writes a not found error response
+// writeNotFoundError writes a component not found error response
 func (s *APIServer) writeNotFoundError(w http.ResponseWriter) {
+	s.writeNotFoundMessage(w, "Component not found")
+}
+
+// writeNotFoundMessage writes a not found error response with the given message
+func (s *APIServer) writeNotFoundMessage(w http.ResponseWriter, message string) {
 	code := "NOT_FOUND"
 	errorResponse := Error{
-		Error: "Component not found",
+		Error: message,
 		Code:  &code,
 	}
 	w.Header().Set("Content-Type", "application/json")
@@ -170,28 +492,149 @@ func (s *APIServer) convertAPISStatusToStorageStatus(status GetComponentReportsP
 	return &statusValue, nil
 }
 
+// convertAPIOrderToStorageOrder converts the sort and order query parameters, defaulting to timestamp descending
+func (s *APIServer) convertAPIOrderToStorageOrder(sort *GetComponentReportsParamsSort, order *GetComponentReportsParamsOrder) (storage.ReportOrder, error) {
+	var reportOrder storage.ReportOrder
+	if sort != nil {
+		switch *sort {
+		case Timestamp:
+			reportOrder.Field = storage.ReportSortTimestamp
+		case Status:
+			reportOrder.Field = storage.ReportSortStatus
+		default:
+			return storage.ReportOrder{}, fmt.Errorf("invalid sort %q, expected timestamp or status", *sort)
+		}
+	}
+	if order != nil {
+		switch *order {
+		case Asc:
+			reportOrder.Ascending = true
+		case Desc:
+		default:
+			return storage.ReportOrder{}, fmt.Errorf("invalid order %q, expected asc or desc", *order)
+		}
+	}
+	return reportOrder, nil
+}
+
+// GetComponentReports lists a component's reports as JSON, or as CSV when the client sends
+// "Accept: text/csv" or format=csv. Both formats honor the same filters and pagination.
 func (s *APIServer) GetComponentReports(w http.ResponseWriter, r *http.Request, componentId string, params GetComponentReportsParams) {
 	ctx := r.Context()
 
 	// Convert API parameters to storage types for database filtering
-	var status *storage.CheckStatus
+	var statuses []storage.CheckStatus
 	if params.Status != nil {
+		for _, apiStatus := range *params.Status {
+			status, err := s.convertAPISStatusToStorageStatus(apiStatus)
+			if err != nil {
+				// Invalid status, return 400 Bad Request
+				http.Error(w, fmt.Sprintf("Invalid status parameter: %v", apiStatus), http.StatusBadRequest)
+				return
+			}
+			statuses = append(statuses, *status)
+		}
+	}
+
+	// Empty slugs are ignored, so check_slug= still matches every check
+	var checkSlugs []string
+	if params.CheckSlug != nil {
+		for _, slug := range *params.CheckSlug {
+			if slug != "" {
+				checkSlugs = append(checkSlugs, slug)
+			}
+		}
+	}
+
+	var includes reportIncludes
+	if params.Include != nil {
 		var err error
-		status, err = s.convertAPISStatusToStorageStatus(*params.Status)
+		includes, err = parseReportIncludes(*params.Include)
 		if err != nil {
-			// Invalid status, return 400 Bad Request
-			http.Error(w, fmt.Sprintf("Invalid status parameter: %v", *params.Status), http.StatusBadRequest)
+			s.writeValidationError(w, err.Error())
 			return
 		}
 	}
+	if params.IncludeDetails != nil && *params.IncludeDetails {
+		includes.details = true
+	}
+
+	// An empty window (since equal to before) is valid and matches nothing
+	if params.Since != nil && params.Before != nil && params.Since.After(*params.Before) {
+		s.writeValidationError(w, "since must not be after before")
+		return
+	}
+
+	var detailsFields [][]string
+	if params.DetailsFields != nil {
+		if !includes.details {
+			s.writeValidationError(w, "details_fields requires include=details or include_details=true")
+			return
+		}
+		var err error
+		detailsFields, err = parseDetailsFields(*params.DetailsFields)
+		if err != nil {
+			s.writeValidationError(w, err.Error())
+			return
+		}
+	}
+
+	if params.Format != nil && *params.Format != Json && *params.Format != Csv {
+		s.writeValidationError(w, fmt.Sprintf("unknown format %q, expected json or csv", *params.Format))
+		return
+	}
+	asCSV := wantsReportsCSV(r, params.Format)
 
 	// Get pagination parameters
-	limit := s.getLimit(params)
-	offset := s.getOffset(params)
+	limit := s.getLimit(params.Limit)
+	offset, err := s.getOffset(params.Offset)
+	if err != nil {
+		s.writeValidationError(w, err.Error())
+		return
+	}
 	latestPerCheck := params.LatestPerCheck != nil && *params.LatestPerCheck
 
+	order, err := s.convertAPIOrderToStorageOrder(params.Sort, params.Order)
+	if err != nil {
+		s.writeValidationError(w, err.Error())
+		return
+	}
+
+	var cursor *storage.ReportCursor
+	if params.Cursor != nil {
+		if params.Offset != nil {
+			s.writeValidationError(w, "cursor cannot be combined with offset")
+			return
+		}
+		if latestPerCheck {
+			s.writeValidationError(w, "cursor cannot be combined with latest_per_check")
+			return
+		}
+		if !order.IsDefault() {
+			s.writeValidationError(w, "cursor requires reports sorted by timestamp descending")
+			return
+		}
+		cursor, err = decodeReportCursor(*params.Cursor)
+		if err != nil {
+			s.writeValidationError(w, err.Error())
+			return
+		}
+	}
+
 	// Get reports with database-level filtering, pagination, and latest per check
-	reports, total, err := s.Repo.GetCheckReportsForComponentWithPagination(ctx, componentId, status, params.CheckSlug, params.Since, limit, offset, latestPerCheck)
+	var reports []storage.CheckReport
+	var total int64
+	var nextCursor *storage.ReportCursor
+	if cursor != nil {
+		reports, total, nextCursor, err = s.Repo.GetCheckReportsForComponentByCursor(ctx, componentId, statuses, checkSlugs, params.Since, params.Before, cursor, limit)
+	} else {
+		reports, total, err = s.Repo.GetCheckReportsForComponentWithPagination(ctx, componentId, statuses, checkSlugs, params.Since, params.Before, limit, offset, latestPerCheck, order)
+		// Default-ordered offset pages share the cursor ordering, so clients can switch to cursors from any page
+		if err == nil && !latestPerCheck && order.IsDefault() && utils.HasMorePages(offset, limit, len(reports), total) {
+			next := storage.CursorFor(reports[len(reports)-1])
+			nextCursor = &next
+		}
+	}
 	if err != nil {
 		if err == storage.ErrComponentNotFound {
 			s.writeNotFoundError(w)
@@ -203,15 +646,42 @@ func (s *APIServer) GetComponentReports(w http.ResponseWriter, r *http.Request,
 
 	// Convert storage reports to API reports
 	apiReports := s.convertToAPICheckReports(reports)
+	if asCSV {
+		if err := writeReportsCSV(w, apiReports); err != nil {
+			// The status is already sent, so the client sees a truncated document
+			requestlog.Logger(ctx).Warn("Failed to write reports CSV", "component_id", componentId, "error", err)
+		}
+		return
+	}
+	for i, report := range reports {
+		if includes.details && report.Details != nil {
+			details := map[string]interface{}(report.Details)
+			if detailsFields != nil {
+				details = projectDetails(details, detailsFields)
+			}
+			apiReports[i].Details = &details
+		}
+		if includes.metadata && report.Metadata != nil {
+			metadata := map[string]interface{}(report.Metadata)
+			apiReports[i].Metadata = &metadata
+		}
+	}
 
 	// Create pagination metadata
-	hasMore := offset+limit < int(total)
+	hasMore := utils.HasMorePages(offset, limit, len(reports), total)
+	if cursor != nil {
+		hasMore = nextCursor != nil
+	}
 	pagination := Pagination{
 		Total:   int(total),
 		Limit:   limit,
 		Offset:  offset,
 		HasMore: hasMore,
 	}
+	if nextCursor != nil {
+		token := encodeReportCursor(*nextCursor)
+		pagination.NextCursor = &token
+	}
 
 	// Create response
 	response := ComponentReportsResponse{
@@ -222,6 +692,578 @@ func (s *APIServer) GetComponentReports(w http.ResponseWriter, r *http.Request,
 	s.writeJSONResponse(w, response)
 }
 
+// GetComponentMissingChecks lists the component's expected checks that have not reported within max_age
+func (s *APIServer) GetComponentMissingChecks(w http.ResponseWriter, r *http.Request, componentId string, params GetComponentMissingChecksParams) {
+	maxAge, err := parseMaxAge(params.MaxAge)
+	if err != nil {
+		s.writeValidationError(w, err.Error())
+		return
+	}
+
+	expected, err := s.Repo.GetExpectedCheckReports(r.Context(), componentId)
+	if err != nil {
+		if err == storage.ErrComponentNotFound {
+			s.writeNotFoundError(w)
+			return
+		}
+		http.Error(w, "failed to fetch expected checks", http.StatusInternalServerError)
+		return
+	}
+
+	cutoff := time.Now().Add(-maxAge)
+	missing := make([]MissingCheck, 0)
+	for _, check := range expected {
+		if check.LatestReport == nil {
+			missing = append(missing, MissingCheck{Slug: check.Slug})
+			continue
+		}
+		if check.LatestReport.Timestamp.Before(cutoff) {
+			timestamp := check.LatestReport.Timestamp
+			missing = append(missing, MissingCheck{Slug: check.Slug, LatestReportAt: &timestamp})
+		}
+	}
+
+	s.writeJSONResponse(w, MissingChecksResponse{MaxAge: maxAge.String(), Checks: missing})
+}
+
+// GetComponentDependencies lists the components the component's manifest depends on, flagging
+// dependencies on identifiers no live component has as dangling
+func (s *APIServer) GetComponentDependencies(w http.ResponseWriter, r *http.Request, componentId string) {
+	dependencies, err := s.Repo.GetComponentDependencies(r.Context(), componentId)
+	if err != nil {
+		if err == storage.ErrComponentNotFound {
+			s.writeNotFoundError(w)
+			return
+		}
+		http.Error(w, "failed to fetch component dependencies", http.StatusInternalServerError)
+		return
+	}
+
+	apiDependencies := make([]ComponentDependency, len(dependencies))
+	for i, dependency := range dependencies {
+		apiDependencies[i] = ComponentDependency{ComponentId: dependency.ComponentID, Dangling: dependency.Component == nil}
+		if dependency.Component != nil {
+			component := s.convertToAPIComponent(dependency.Component)
+			apiDependencies[i].Component = &component
+		}
+	}
+
+	s.writeJSONResponse(w, ComponentDependenciesResponse{Dependencies: apiDependencies})
+}
+
+// GetComponentDependents lists the components whose manifest depends on the component
+func (s *APIServer) GetComponentDependents(w http.ResponseWriter, r *http.Request, componentId string) {
+	dependents, err := s.Repo.GetComponentDependents(r.Context(), componentId)
+	if err != nil {
+		if err == storage.ErrComponentNotFound {
+			s.writeNotFoundError(w)
+			return
+		}
+		http.Error(w, "failed to fetch component dependents", http.StatusInternalServerError)
+		return
+	}
+
+	apiDependents := make([]Component, len(dependents))
+	for i := range dependents {
+		apiDependents[i] = s.convertToAPIComponent(&dependents[i])
+	}
+
+	s.writeJSONResponse(w, ComponentDependentsResponse{Dependents: apiDependents})
+}
+
+const (
+	// defaultImpactDepth is how many dependency edges an impact query follows without max_depth
+	defaultImpactDepth = 5
+	// maxImpactDepth bounds how far an impact query traverses the dependency graph
+	maxImpactDepth = 10
+)
+
+// GetComponentImpact lists the components that depend on the component, directly or
+// transitively, each with the status of its checks
+func (s *APIServer) GetComponentImpact(w http.ResponseWriter, r *http.Request, componentId string, params GetComponentImpactParams) {
+	maxDepth := defaultImpactDepth
+	if params.MaxDepth != nil {
+		maxDepth = *params.MaxDepth
+		if maxDepth < 1 || maxDepth > maxImpactDepth {
+			s.writeValidationError(w, fmt.Sprintf("max_depth must be between 1 and %d", maxImpactDepth))
+			return
+		}
+	}
+
+	impacted, err := s.Repo.GetComponentImpact(r.Context(), componentId, maxDepth)
+	if err != nil {
+		if err == storage.ErrComponentNotFound {
+			s.writeNotFoundError(w)
+			return
+		}
+		http.Error(w, "failed to fetch component impact", http.StatusInternalServerError)
+		return
+	}
+
+	apiImpacted := make([]ImpactedComponent, len(impacted))
+	for i := range impacted {
+		component := s.convertToAPIComponent(&impacted[i].Component)
+		summary := convertToAPIReportsSummary(&impacted[i].Summary)
+		component.ReportSummary = &summary
+		apiImpacted[i] = ImpactedComponent{Depth: impacted[i].Depth, Component: component}
+	}
+
+	s.writeJSONResponse(w, ComponentImpactResponse{MaxDepth: maxDepth, Components: apiImpacted})
+}
+
+// GetComponentStaleChecks lists the component's checks whose latest report is older than max_age,
+// and its expected checks that never reported
+func (s *APIServer) GetComponentStaleChecks(w http.ResponseWriter, r *http.Request, componentId string, params GetComponentStaleChecksParams) {
+	maxAge, err := parseMaxAge(params.MaxAge)
+	if err != nil {
+		s.writeValidationError(w, err.Error())
+		return
+	}
+
+	checks, err := s.Repo.GetStaleChecks(r.Context(), componentId, time.Now().Add(-maxAge))
+	if err != nil {
+		if err == storage.ErrComponentNotFound {
+			s.writeNotFoundError(w)
+			return
+		}
+		http.Error(w, "failed to fetch stale checks", http.StatusInternalServerError)
+		return
+	}
+
+	stale := make([]StaleCheck, len(checks))
+	for i, check := range checks {
+		stale[i] = StaleCheck{Slug: check.Slug, Expected: check.Expected}
+		if check.LatestReport != nil {
+			timestamp := check.LatestReport.Timestamp
+			stale[i].LatestReportAt = &timestamp
+		}
+	}
+
+	s.writeJSONResponse(w, StaleChecksResponse{MaxAge: maxAge.String(), Checks: stale})
+}
+
+// parseMaxAge parses a max_age parameter, defaulting to a day
+func parseMaxAge(value *string) (time.Duration, error) {
+	if value == nil {
+		return 24 * time.Hour, nil
+	}
+	maxAge, err := time.ParseDuration(*value)
+	if err != nil || maxAge <= 0 {
+		return 0, fmt.Errorf("invalid max_age %q, expected a positive duration such as 24h", *value)
+	}
+	return maxAge, nil
+}
+
+// GetComponentReportsSummary counts a component's checks by the status of their latest report
+func (s *APIServer) GetComponentReportsSummary(w http.ResponseWriter, r *http.Request, componentId string) {
+	summary, err := s.Repo.GetCheckReportStatusCounts(r.Context(), componentId)
+	if err != nil {
+		if err == storage.ErrComponentNotFound {
+			s.writeNotFoundError(w)
+			return
+		}
+		http.Error(w, "failed to fetch reports summary", http.StatusInternalServerError)
+		return
+	}
+
+	s.writeJSONResponse(w, convertToAPIReportsSummary(summary))
+}
+
+// convertToAPIReportsSummary converts status counts to the API summary, zeroed when there are no reports
+func convertToAPIReportsSummary(summary *storage.CheckReportStatusCounts) ComponentReportsSummary {
+	return ComponentReportsSummary{
+		TotalChecks:    int(summary.TotalChecks),
+		StatusCounts:   convertToAPIStatusCounts(summary.Counts),
+		LatestReportAt: summary.LatestReportAt,
+	}
+}
+
+// convertToAPIStatusCounts converts counts keyed by storage status, filling in zeros for missing statuses
+func convertToAPIStatusCounts(counts map[storage.CheckStatus]int64) CheckStatusCounts {
+	return CheckStatusCounts{
+		Pass:      int(counts[storage.CheckStatusPass]),
+		Fail:      int(counts[storage.CheckStatusFail]),
+		Disabled:  int(counts[storage.CheckStatusDisabled]),
+		Skipped:   int(counts[storage.CheckStatusSkipped]),
+		Unknown:   int(counts[storage.CheckStatusUnknown]),
+		Error:     int(counts[storage.CheckStatusError]),
+		Completed: int(counts[storage.CheckStatusCompleted]),
+	}
+}
+
+const (
+	// defaultTimeSeriesBuckets is how many buckets a time series covers without since
+	defaultTimeSeriesBuckets = 30
+	// maxTimeSeriesBuckets bounds the size of a time series response
+	maxTimeSeriesBuckets = 1000
+)
+
+// GetComponentReportsTimeSeries counts a check's reports by status in hourly or daily buckets
+func (s *APIServer) GetComponentReportsTimeSeries(w http.ResponseWriter, r *http.Request, componentId string, params GetComponentReportsTimeSeriesParams) {
+	checkSlug := strings.TrimSpace(params.CheckSlug)
+	if checkSlug == "" {
+		s.writeValidationError(w, "check_slug is required")
+		return
+	}
+
+	bucketParam := N1d
+	if params.Bucket != nil {
+		bucketParam = *params.Bucket
+	}
+	var bucket storage.TimeBucket
+	switch bucketParam {
+	case N1h:
+		bucket = storage.TimeBucketHour
+	case N1d:
+		bucket = storage.TimeBucketDay
+	default:
+		s.writeValidationError(w, fmt.Sprintf("unknown bucket %q, expected 1h or 1d", bucketParam))
+		return
+	}
+	size := bucket.Duration()
+
+	now := time.Now().UTC()
+	since := now.Add(-(defaultTimeSeriesBuckets - 1) * size)
+	if params.Since != nil {
+		since = *params.Since
+		if since.After(now) {
+			s.writeValidationError(w, "since must not be in the future")
+			return
+		}
+		if now.Truncate(size).Sub(since.UTC().Truncate(size))/size >= maxTimeSeriesBuckets {
+			s.writeValidationError(w, fmt.Sprintf("since spans more than %d buckets", maxTimeSeriesBuckets))
+			return
+		}
+	}
+
+	buckets, err := s.Repo.GetReportStatusTimeSeries(r.Context(), componentId, checkSlug, bucket, since, now)
+	if err != nil {
+		if err == storage.ErrComponentNotFound {
+			s.writeNotFoundError(w)
+			return
+		}
+		http.Error(w, "failed to fetch report time series", http.StatusInternalServerError)
+		return
+	}
+
+	response := ReportTimeSeries{
+		CheckSlug: checkSlug,
+		Bucket:    string(bucketParam),
+		Buckets:   make([]ReportStatusBucket, len(buckets)),
+	}
+	for i, b := range buckets {
+		response.Buckets[i] = ReportStatusBucket{
+			Start:        b.Start,
+			StatusCounts: convertToAPIStatusCounts(b.Counts),
+		}
+	}
+
+	s.writeJSONResponse(w, response)
+}
+
+// GetComponentReportById returns one of a component's reports, including its details and metadata
+func (s *APIServer) GetComponentReportById(w http.ResponseWriter, r *http.Request, componentId string, reportId string) {
+	id, err := uuid.Parse(reportId)
+	if err != nil {
+		s.writeValidationError(w, fmt.Sprintf("invalid report ID %q", reportId))
+		return
+	}
+
+	report, err := s.Repo.GetCheckReportByID(r.Context(), componentId, id)
+	if err != nil {
+		switch err {
+		case storage.ErrComponentNotFound:
+			s.writeNotFoundError(w)
+		case storage.ErrReportNotFound:
+			s.writeNotFoundMessage(w, "Report not found")
+		default:
+			http.Error(w, "failed to fetch report", http.StatusInternalServerError)
+		}
+		return
+	}
+
+	apiReport := s.convertToAPICheckReport(*report)
+	if report.Details != nil {
+		details := map[string]interface{}(report.Details)
+		apiReport.Details = &details
+	}
+	if report.Metadata != nil {
+		metadata := map[string]interface{}(report.Metadata)
+		apiReport.Metadata = &metadata
+	}
+
+	s.writeJSONResponse(w, apiReport)
+}
+
+// GetComponentHistory returns the audit history of field changes for a component
+func (s *APIServer) GetComponentHistory(w http.ResponseWriter, r *http.Request, componentId string, params GetComponentHistoryParams) {
+	ctx := r.Context()
+
+	var field *string
+	if params.Field != nil {
+		switch *params.Field {
+		case Name, Description, Maintainers, Team, Labels, Lifecycle, ExpectedChecks, DependsOn, ComponentId:
+			field = utils.ToPointer(string(*params.Field))
+		default:
+			s.writeValidationError(w, fmt.Sprintf("invalid field: %s", *params.Field))
+			return
+		}
+	}
+
+	limit := s.getLimit(params.Limit)
+	offset, err := s.getOffset(params.Offset)
+	if err != nil {
+		s.writeValidationError(w, err.Error())
+		return
+	}
+
+	entries, total, err := s.Repo.GetComponentHistory(ctx, componentId, field, params.Since, limit, offset)
+	if err != nil {
+		if err == storage.ErrComponentNotFound {
+			s.writeNotFoundError(w)
+			return
+		}
+		http.Error(w, "failed to fetch component history", http.StatusInternalServerError)
+		return
+	}
+
+	apiEntries := make([]ComponentHistoryEntry, len(entries))
+	for i, entry := range entries {
+		changes := make(map[string]FieldChange, len(entry.Changes))
+		for name, value := range entry.Changes {
+			change, _ := value.(map[string]interface{})
+			changes[name] = FieldChange{Old: change["old"], New: change["new"]}
+		}
+		apiEntries[i] = ComponentHistoryEntry{
+			Id:        entry.ID.String(),
+			ChangedAt: entry.ChangedAt,
+			Changes:   changes,
+		}
+		if entry.Source != "" {
+			apiEntries[i].Source = utils.ToPointer(entry.Source)
+		}
+	}
+
+	response := ComponentHistoryResponse{
+		Entries: apiEntries,
+		Pagination: Pagination{
+			Total:   int(total),
+			Limit:   limit,
+			Offset:  offset,
+			HasMore: utils.HasMorePages(offset, limit, len(entries), total),
+		},
+	}
+
+	s.writeJSONResponse(w, response)
+}
+
+// GetLatestReports returns the latest report of every component and check pair across the catalog
+func (s *APIServer) GetLatestReports(w http.ResponseWriter, r *http.Request, params GetLatestReportsParams) {
+	var filters storage.LatestReportFilters
+	if params.Status != nil {
+		for _, apiStatus := range *params.Status {
+			status, err := s.convertAPISStatusToStorageStatus(GetComponentReportsParamsStatus(apiStatus))
+			if err != nil {
+				s.writeValidationError(w, fmt.Sprintf("invalid status parameter: %v", apiStatus))
+				return
+			}
+			filters.Statuses = append(filters.Statuses, *status)
+		}
+	}
+
+	// Empty slugs are ignored, as in GetComponentReports
+	if params.CheckSlug != nil {
+		for _, slug := range *params.CheckSlug {
+			if slug != "" {
+				filters.CheckSlugs = append(filters.CheckSlugs, slug)
+			}
+		}
+	}
+
+	if params.Team != nil {
+		filters.Team = strings.TrimSpace(*params.Team)
+		if filters.Team == "" {
+			s.writeValidationError(w, "team cannot be empty")
+			return
+		}
+	}
+
+	limit := s.getLimit(params.Limit)
+	offset, err := s.getOffset(params.Offset)
+	if err != nil {
+		s.writeValidationError(w, err.Error())
+		return
+	}
+
+	reports, total, err := s.Repo.GetLatestReports(r.Context(), filters, limit, offset)
+	if err != nil {
+		http.Error(w, "failed to fetch latest reports", http.StatusInternalServerError)
+		return
+	}
+
+	s.writeJSONResponse(w, LatestReportsResponse{
+		Reports: s.convertToAPILatestReports(reports),
+		Pagination: Pagination{
+			Total:   int(total),
+			Limit:   limit,
+			Offset:  offset,
+			HasMore: utils.HasMorePages(offset, limit, len(reports), total),
+		},
+	})
+}
+
+// GetTeamReports returns a page of the reports of the components owned by a team: the latest
+// report of every check unless latest_per_check is false
+func (s *APIServer) GetTeamReports(w http.ResponseWriter, r *http.Request, team string, params GetTeamReportsParams) {
+	ctx := r.Context()
+
+	team = strings.TrimSpace(team)
+	if team == "" {
+		s.writeValidationError(w, "team cannot be empty")
+		return
+	}
+	// An empty slug is ignored, as in GetLatestReports
+	checkSlug := params.CheckSlug
+	if checkSlug != nil && *checkSlug == "" {
+		checkSlug = nil
+	}
+
+	components, err := s.Repo.GetComponentsByTeam(ctx, team)
+	if err != nil {
+		http.Error(w, "failed to fetch team reports", http.StatusInternalServerError)
+		return
+	}
+	componentIDs := make([]string, len(components))
+	for i, component := range components {
+		componentIDs[i] = component.ComponentID
+	}
+	sort.Strings(componentIDs)
+
+	limit := s.getLimit(params.Limit)
+	offset, err := s.getOffset(params.Offset)
+	if err != nil {
+		s.writeValidationError(w, err.Error())
+		return
+	}
+
+	var reports []storage.CheckReport
+	var total int64
+	if params.LatestPerCheck == nil || *params.LatestPerCheck {
+		// A team's latest reports are bounded by its components and checks, so they are paged here
+		reports, err = s.Repo.GetLatestReportsForComponents(ctx, componentIDs, checkSlug)
+		total = int64(len(reports))
+		reports = reports[min(offset, len(reports)):min(offset+limit, len(reports))]
+	} else {
+		reports, total, err = s.Repo.GetCheckReportsForComponents(ctx, componentIDs, checkSlug, limit, offset)
+	}
+	if err != nil {
+		http.Error(w, "failed to fetch team reports", http.StatusInternalServerError)
+		return
+	}
+
+	s.writeJSONResponse(w, TeamReportsResponse{
+		Team:       team,
+		Components: componentIDs,
+		Reports:    s.convertToAPILatestReports(reports),
+		Pagination: Pagination{
+			Total:   int(total),
+			Limit:   limit,
+			Offset:  offset,
+			HasMore: utils.HasMorePages(offset, limit, len(reports), total),
+		},
+	})
+}
+
+// convertToAPILatestReports converts reports, which must have Component loaded, to API latest reports
+func (s *APIServer) convertToAPILatestReports(reports []storage.CheckReport) []LatestReport {
+	apiReports := make([]LatestReport, len(reports))
+	for i, report := range reports {
+		apiReport := s.convertToAPICheckReport(report)
+		apiReports[i] = LatestReport{
+			Id:          apiReport.Id,
+			CheckSlug:   apiReport.CheckSlug,
+			ComponentId: report.Component.ComponentID,
+			Status:      LatestReportStatus(apiReport.Status),
+			Timestamp:   apiReport.Timestamp,
+			StartedAt:   apiReport.StartedAt,
+			DurationMs:  apiReport.DurationMs,
+		}
+	}
+	return apiReports
+}
+
+// GetChecks lists checks with their usage, optionally filtered by report count
+func (s *APIServer) GetChecks(w http.ResponseWriter, r *http.Request, params GetChecksParams) {
+	ctx := r.Context()
+
+	var filter storage.CheckUsageFilter
+	if params.MinReports != nil {
+		if *params.MinReports < 0 {
+			s.writeValidationError(w, "min_reports must not be negative")
+			return
+		}
+		filter.MinReports = utils.ToPointer(int64(*params.MinReports))
+	}
+	if params.MaxReports != nil {
+		if *params.MaxReports < 0 {
+			s.writeValidationError(w, "max_reports must not be negative")
+			return
+		}
+		filter.MaxReports = utils.ToPointer(int64(*params.MaxReports))
+	}
+	if filter.MinReports != nil && filter.MaxReports != nil && *filter.MinReports > *filter.MaxReports {
+		s.writeValidationError(w, "min_reports must not be greater than max_reports")
+		return
+	}
+
+	limit := s.getLimit(params.Limit)
+	offset, err := s.getOffset(params.Offset)
+	if err != nil {
+		s.writeValidationError(w, err.Error())
+		return
+	}
+	includeComponentCount := params.IncludeComponentCount != nil && *params.IncludeComponentCount
+
+	filter.ComponentID = params.ComponentId
+
+	checks, total, err := s.Repo.GetChecksWithUsage(ctx, filter, limit, offset)
+	if err != nil {
+		if err == storage.ErrComponentNotFound {
+			s.writeNotFoundError(w)
+			return
+		}
+		http.Error(w, "failed to fetch checks", http.StatusInternalServerError)
+		return
+	}
+
+	apiChecks := make([]CheckUsage, len(checks))
+	for i, check := range checks {
+		apiChecks[i] = CheckUsage{
+			Slug:        check.Slug,
+			Name:        check.Name,
+			ReportCount: int(check.ReportCount),
+		}
+		if check.Description != "" {
+			apiChecks[i].Description = utils.ToPointer(check.Description)
+		}
+		if includeComponentCount {
+			apiChecks[i].ComponentCount = utils.ToPointer(int(check.ComponentCount))
+		}
+	}
+
+	response := ChecksResponse{
+		Checks: apiChecks,
+		Pagination: Pagination{
+			Total:   int(total),
+			Limit:   limit,
+			Offset:  offset,
+			HasMore: utils.HasMorePages(offset, limit, len(checks), total),
+		},
+	}
+
+	s.writeJSONResponse(w, response)
+}
+
 // convertToAPICheckReport converts a storage check report to an API check report
 func (s *APIServer) convertToAPICheckReport(report storage.CheckReport) CheckReport {
 	// Convert status to CheckReportStatus
@@ -246,29 +1288,37 @@ func (s *APIServer) convertToAPICheckReport(report storage.CheckReport) CheckRep
 	}
 
 	apiReport := CheckReport{
-		Id:        report.ID.String(),
-		CheckSlug: report.Check.Slug,
-		Status:    status,
-		Timestamp: report.Timestamp,
+		Id:         report.ID.String(),
+		CheckSlug:  report.Check.Slug,
+		Status:     status,
+		Timestamp:  report.Timestamp,
+		StartedAt:  report.StartedAt,
+		DurationMs: report.DurationMs,
 	}
 
 	return apiReport
 }
 
-// getLimit returns the limit parameter with validation
-func (s *APIServer) getLimit(params GetComponentReportsParams) int {
-	if params.Limit != nil && *params.Limit > 0 && *params.Limit <= 100 {
-		return *params.Limit
+// getLimit returns the limit parameter, or the configured default page limit when it is
+// missing or outside 1 to the configured maximum
+func (s *APIServer) getLimit(limit *int) int {
+	defaultLimit, maxLimit := s.Config.pageLimits()
+	if limit != nil && *limit > 0 && *limit <= maxLimit {
+		return *limit
 	}
-	return 50 // default
+	return defaultLimit
 }
 
-// getOffset returns the offset parameter with validation
-func (s *APIServer) getOffset(params GetComponentReportsParams) int {
-	if params.Offset != nil && *params.Offset >= 0 {
-		return *params.Offset
+// getOffset returns the offset parameter with validation. Offsets past the last item are valid
+// and get an empty page, but offsets beyond the configured maximum are rejected.
+func (s *APIServer) getOffset(offset *int) (int, error) {
+	if offset == nil || *offset < 0 {
+		return 0, nil // default
+	}
+	if maxOffset := s.Config.maxOffset(); *offset > maxOffset {
+		return 0, fmt.Errorf("offset cannot exceed %d", maxOffset)
 	}
-	return 0 // default
+	return *offset, nil
 }
 
 // convertToAPICheckReports converts a slice of storage check reports to API check reports
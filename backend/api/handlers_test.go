@@ -1,15 +1,22 @@
 package api
 
 import (
+	"bufio"
+	"context"
+	"encoding/csv"
 	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
+	"strings"
 	"testing"
 	"time"
 
+	adminapi "github.com/doron-cohen/argus/backend/admin/api"
 	"github.com/doron-cohen/argus/backend/internal/storage"
 	"github.com/glebarez/sqlite"
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"gorm.io/gorm"
@@ -345,6 +352,162 @@ func TestGetComponentReports_Pagination(t *testing.T) {
 	}
 }
 
+func TestGetComponentReports_PagesToBoundary(t *testing.T) {
+	repo, server := setupTestEnvironment(t)
+	defer cleanupTestEnvironment(t, repo)
+	createBoundaryReports(t, repo)
+
+	handler := Handler(server)
+	// pageAll follows has_more from the first page and returns every page's length
+	pageAll := func(t *testing.T, query string) ([]int, int) {
+		var lengths []int
+		offset := 0
+		for {
+			req := httptest.NewRequest("GET", fmt.Sprintf("/components/boundary-component/reports?limit=2&offset=%d%s", offset, query), nil)
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, req)
+			require.Equal(t, http.StatusOK, w.Code)
+
+			var response ComponentReportsResponse
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+			lengths = append(lengths, len(response.Reports))
+			if !response.Pagination.HasMore {
+				// The final page ends exactly at the total
+				assert.Equal(t, response.Pagination.Total, offset+len(response.Reports))
+				return lengths, response.Pagination.Total
+			}
+			require.Less(t, len(lengths), 10, "has_more never became false")
+			offset += response.Pagination.Limit
+		}
+	}
+
+	t.Run("LatestPerCheck", func(t *testing.T) {
+		lengths, total := pageAll(t, "&latest_per_check=true")
+		assert.Equal(t, 4, total)
+		assert.Equal(t, []int{2, 2}, lengths)
+	})
+
+	t.Run("AllReports", func(t *testing.T) {
+		lengths, total := pageAll(t, "")
+		assert.Equal(t, 8, total)
+		assert.Equal(t, []int{2, 2, 2, 2}, lengths)
+	})
+
+	t.Run("LatestPerCheckFiltered", func(t *testing.T) {
+		lengths, total := pageAll(t, "&latest_per_check=true&check_slug=lint&check_slug=security")
+		assert.Equal(t, 2, total)
+		assert.Equal(t, []int{2}, lengths)
+	})
+}
+
+func TestGetComponentReports_OutOfRangeOffsets(t *testing.T) {
+	repo, server := setupTestEnvironment(t)
+	defer cleanupTestEnvironment(t, repo)
+	createBoundaryReports(t, repo)
+	server.Config = Config{MaxOffset: 1000}
+
+	handler := Handler(server)
+	tests := []struct {
+		name          string
+		query         string
+		expectedCount int
+		expectedTotal int
+	}{
+		{"JustBelowTotal", "offset=7", 1, 8},
+		{"AtTotal", "offset=8", 0, 8},
+		{"FarBeyondTotal", "offset=1000", 0, 8},
+		{"LatestPerCheckJustBelowTotal", "offset=3&latest_per_check=true", 1, 4},
+		{"LatestPerCheckAtTotal", "offset=4&latest_per_check=true", 0, 4},
+		{"LatestPerCheckFarBeyondTotal", "offset=1000&latest_per_check=true", 0, 4},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest("GET", "/components/boundary-component/reports?limit=2&"+tt.query, nil)
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, req)
+			require.Equal(t, http.StatusOK, w.Code)
+
+			var response ComponentReportsResponse
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+			// The total stays accurate however far past the end the page is
+			assert.Len(t, response.Reports, tt.expectedCount)
+			assert.Equal(t, tt.expectedTotal, response.Pagination.Total)
+			assert.False(t, response.Pagination.HasMore)
+			assert.Nil(t, response.Pagination.NextCursor)
+		})
+	}
+
+	t.Run("AboveMaxOffset", func(t *testing.T) {
+		for _, query := range []string{"offset=1001", "offset=1001&latest_per_check=true"} {
+			req := httptest.NewRequest("GET", "/components/boundary-component/reports?limit=2&"+query, nil)
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, req)
+			assert.Equal(t, http.StatusBadRequest, w.Code, query)
+			assert.Contains(t, w.Body.String(), "offset cannot exceed 1000")
+		}
+	})
+}
+
+func TestGetOffset(t *testing.T) {
+	server := &APIServer{}
+	offset := func(v int) *int { return &v }
+
+	value, err := server.getOffset(nil)
+	require.NoError(t, err)
+	assert.Equal(t, 0, value)
+
+	value, err = server.getOffset(offset(-5))
+	require.NoError(t, err)
+	assert.Equal(t, 0, value)
+
+	value, err = server.getOffset(offset(DefaultMaxOffset))
+	require.NoError(t, err)
+	assert.Equal(t, DefaultMaxOffset, value)
+
+	_, err = server.getOffset(offset(DefaultMaxOffset + 1))
+	assert.EqualError(t, err, fmt.Sprintf("offset cannot exceed %d", DefaultMaxOffset))
+
+	// Every paginated endpoint applies the maximum
+	server.Repo, _ = setupTestEnvironment(t)
+	defer cleanupTestEnvironment(t, server.Repo)
+	server.Config = Config{MaxOffset: 10}
+	handler := Handler(server)
+	for _, path := range []string{
+		"/components?offset=11",
+		"/components:search?q=auth&offset=11",
+		"/checks?offset=11",
+		"/reports/latest?offset=11",
+		"/teams/platform/reports?offset=11",
+		"/components/auth-service/history?offset=11",
+	} {
+		req := httptest.NewRequest("GET", path, nil)
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, req)
+		assert.Equal(t, http.StatusBadRequest, w.Code, path)
+	}
+}
+
+// createBoundaryReports creates a component with four checks that reported twice each:
+// eight reports, four latest per check
+func createBoundaryReports(t *testing.T, repo *storage.Repository) {
+	component := storage.Component{ComponentID: "boundary-component", Name: "Boundary Component"}
+	require.NoError(t, repo.DB.Create(&component).Error)
+	now := time.Now()
+	for i, slug := range []string{"build", "lint", "security", "unit-tests"} {
+		check := storage.Check{Slug: slug, Name: slug}
+		require.NoError(t, repo.DB.Create(&check).Error)
+		for age := 0; age < 2; age++ {
+			report := storage.CheckReport{
+				CheckID:     check.ID,
+				ComponentID: component.ID,
+				Status:      storage.CheckStatusPass,
+				Timestamp:   now.Add(-time.Duration(i*2+age) * time.Minute),
+			}
+			require.NoError(t, repo.DB.Create(&report).Error)
+		}
+	}
+}
+
 // createMultipleTestReports creates multiple test reports for pagination testing
 func createMultipleTestReports(t *testing.T, repo *storage.Repository) {
 	// Create test component
@@ -467,7 +630,7 @@ func TestGetComponentReports_InvalidStatusParameter(t *testing.T) {
 		// Call handler with invalid status
 		invalidStatus := GetComponentReportsParamsStatus("invalid-status")
 		server.GetComponentReports(w, req, "test-component-status", GetComponentReportsParams{
-			Status: &invalidStatus,
+			Status: &[]GetComponentReportsParamsStatus{invalidStatus},
 		})
 
 		// Should return 400 Bad Request for invalid status
@@ -486,7 +649,7 @@ func TestGetComponentReports_InvalidStatusParameter(t *testing.T) {
 		// Call handler with valid status
 		validStatus := GetComponentReportsParamsStatusPass
 		server.GetComponentReports(w, req, "test-component-status", GetComponentReportsParams{
-			Status: &validStatus,
+			Status: &[]GetComponentReportsParamsStatus{validStatus},
 		})
 
 		// Should return 200 OK
@@ -501,3 +664,2031 @@ func TestGetComponentReports_InvalidStatusParameter(t *testing.T) {
 		assert.Equal(t, 0, response.Pagination.Total)
 	})
 }
+
+func TestGetComponents_MaintainerFilter(t *testing.T) {
+	repo, server := setupTestEnvironment(t)
+	defer cleanupTestEnvironment(t, repo)
+
+	for _, component := range []storage.Component{
+		{ComponentID: "maintainer-filter-auth", Name: "Auth", Maintainers: storage.StringArray{"@alice", "@auth-team"}},
+		{ComponentID: "maintainer-filter-lead", Name: "Lead", Maintainers: storage.StringArray{"@auth-team-lead"}},
+		{ComponentID: "maintainer-filter-none", Name: "None"},
+	} {
+		require.NoError(t, repo.DB.Create(&component).Error)
+	}
+
+	getComponents := func(maintainer string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest("GET", "/components?maintainer="+url.QueryEscape(maintainer), nil)
+		w := httptest.NewRecorder()
+		Handler(server).ServeHTTP(w, req)
+		return w
+	}
+	componentIDs := func(t *testing.T, w *httptest.ResponseRecorder) []string {
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+		var components []Component
+		require.NoError(t, json.NewDecoder(w.Body).Decode(&components))
+		var ids []string
+		for _, component := range components {
+			ids = append(ids, *component.Id)
+		}
+		return ids
+	}
+
+	assert.Equal(t, []string{"maintainer-filter-auth"}, componentIDs(t, getComponents("@alice")))
+	assert.Equal(t, []string{"maintainer-filter-auth"}, componentIDs(t, getComponents("@auth-team")))
+	assert.Equal(t, []string{"maintainer-filter-lead"}, componentIDs(t, getComponents("@auth-team-lead")))
+	assert.Empty(t, componentIDs(t, getComponents("@nobody")))
+	assert.Equal(t, http.StatusBadRequest, getComponents(" ").Code)
+}
+
+func TestGetComponents_IncludeDeleted(t *testing.T) {
+	repo, server := setupTestEnvironment(t)
+	defer cleanupTestEnvironment(t, repo)
+
+	for _, component := range []storage.Component{
+		{ComponentID: "include-deleted-live", Name: "Live"},
+		{ComponentID: "include-deleted-retired", Name: "Retired"},
+	} {
+		require.NoError(t, repo.DB.Create(&component).Error)
+	}
+	require.NoError(t, repo.SoftDeleteComponent(t.Context(), "include-deleted-retired"))
+
+	getComponents := func(query string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest("GET", "/components"+query, nil)
+		w := httptest.NewRecorder()
+		Handler(server).ServeHTTP(w, req)
+		return w
+	}
+	decode := func(t *testing.T, w *httptest.ResponseRecorder) map[string]Component {
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+		var components []Component
+		require.NoError(t, json.NewDecoder(w.Body).Decode(&components))
+		byID := make(map[string]Component)
+		for _, component := range components {
+			byID[*component.Id] = component
+		}
+		return byID
+	}
+
+	components := decode(t, getComponents(""))
+	assert.Contains(t, components, "include-deleted-live")
+	assert.NotContains(t, components, "include-deleted-retired")
+
+	components = decode(t, getComponents("?include_deleted=true"))
+	require.Contains(t, components, "include-deleted-live")
+	require.Contains(t, components, "include-deleted-retired")
+	assert.Nil(t, components["include-deleted-live"].DeletedAt)
+	assert.NotNil(t, components["include-deleted-retired"].DeletedAt)
+
+	// Deleted components stay hidden from lookups and their reports
+	w := httptest.NewRecorder()
+	server.GetComponentById(w, httptest.NewRequest("GET", "/components/include-deleted-retired", nil), "include-deleted-retired", GetComponentByIdParams{})
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	w = getComponents("/include-deleted-retired/reports")
+	assert.Equal(t, http.StatusNotFound, w.Code)
+
+	assert.Equal(t, http.StatusBadRequest, getComponents("?include_deleted=true&maintainer=@alice").Code)
+}
+
+func TestComponentMaintainers(t *testing.T) {
+	repo, server := setupTestEnvironment(t)
+	defer cleanupTestEnvironment(t, repo)
+
+	component := storage.Component{
+		ComponentID: "maintainers-service",
+		Name:        "Maintainers Service",
+		Maintainers: storage.StringArray{"alice@company.com"},
+	}
+	require.NoError(t, repo.DB.Create(&component).Error)
+
+	handler := Handler(server)
+
+	addMaintainer := func(t *testing.T, componentID, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest("POST", "/components/"+componentID+"/maintainers", strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, req)
+		return w
+	}
+	removeMaintainer := func(t *testing.T, componentID, identifier string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest("DELETE", "/components/"+componentID+"/maintainers/"+url.PathEscape(identifier), nil)
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, req)
+		return w
+	}
+	storedMaintainers := func(t *testing.T) (storage.StringArray, bool) {
+		stored, err := repo.GetComponentByID(context.Background(), "maintainers-service")
+		require.NoError(t, err)
+		return stored.Maintainers, stored.MaintainersManaged
+	}
+
+	t.Run("add normalizes and marks maintainers as managed", func(t *testing.T) {
+		w := addMaintainer(t, "maintainers-service", `{"identifier": "  @Bob-Dev "}`)
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var response MaintainersResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.Equal(t, []string{"alice@company.com", "@bob-dev"}, response.Maintainers)
+
+		maintainers, managed := storedMaintainers(t)
+		assert.Equal(t, storage.StringArray{"alice@company.com", "@bob-dev"}, maintainers)
+		assert.True(t, managed)
+	})
+
+	t.Run("add is idempotent", func(t *testing.T) {
+		w := addMaintainer(t, "maintainers-service", `{"identifier": "ALICE@company.com"}`)
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var response MaintainersResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.Equal(t, []string{"alice@company.com", "@bob-dev"}, response.Maintainers)
+	})
+
+	t.Run("add rejects empty identifier", func(t *testing.T) {
+		w := addMaintainer(t, "maintainers-service", `{"identifier": "   "}`)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("add rejects malformed bodies", func(t *testing.T) {
+		tests := []struct {
+			name   string
+			body   string
+			status int
+			error  string
+		}{
+			{"UnknownField", `{"identifier": "@carol", "role": "owner"}`, http.StatusBadRequest, `unknown field "role"`},
+			{"TrailingData", `{"identifier": "@carol"} {"identifier": "@dave"}`, http.StatusBadRequest, "single JSON value"},
+			{"TooLarge", `{"identifier": "` + strings.Repeat("a", maxMaintainerBodyBytes) + `"}`, http.StatusRequestEntityTooLarge, "request body cannot exceed"},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				w := addMaintainer(t, "maintainers-service", tt.body)
+				assert.Equal(t, tt.status, w.Code)
+				var response Error
+				require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+				assert.Contains(t, response.Error, tt.error)
+			})
+		}
+
+		maintainers, _ := storedMaintainers(t)
+		assert.NotContains(t, maintainers, "@carol")
+	})
+
+	t.Run("remove", func(t *testing.T) {
+		w := removeMaintainer(t, "maintainers-service", "Alice@Company.com")
+		assert.Equal(t, http.StatusNoContent, w.Code)
+
+		maintainers, _ := storedMaintainers(t)
+		assert.Equal(t, storage.StringArray{"@bob-dev"}, maintainers)
+	})
+
+	t.Run("remove nonexistent is a no-op", func(t *testing.T) {
+		w := removeMaintainer(t, "maintainers-service", "nobody@company.com")
+		assert.Equal(t, http.StatusNoContent, w.Code)
+
+		maintainers, _ := storedMaintainers(t)
+		assert.Equal(t, storage.StringArray{"@bob-dev"}, maintainers)
+	})
+
+	t.Run("unknown component", func(t *testing.T) {
+		w := addMaintainer(t, "missing-service", `{"identifier": "alice@company.com"}`)
+		assert.Equal(t, http.StatusNotFound, w.Code)
+
+		w = removeMaintainer(t, "missing-service", "alice@company.com")
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestRequireAuthOnSecured(t *testing.T) {
+	repo, server := setupTestEnvironment(t)
+	defer cleanupTestEnvironment(t, repo)
+	require.NoError(t, repo.DB.Create(&storage.Component{ComponentID: "guarded-service", Name: "Guarded"}).Error)
+
+	handler := HandlerWithOptions(server, ChiServerOptions{
+		Middlewares: []MiddlewareFunc{RequireAuthOnSecured(adminapi.RequireToken("admin-secret"))},
+	})
+	serve := func(method, path, token string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, path, nil)
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, req)
+		return w
+	}
+
+	t.Run("rejects deletes without the admin token", func(t *testing.T) {
+		for _, token := range []string{"", "wrong"} {
+			w := serve("DELETE", "/components/guarded-service", token)
+			assert.Equal(t, http.StatusUnauthorized, w.Code)
+			assert.Equal(t, "Bearer", w.Header().Get("WWW-Authenticate"))
+		}
+
+		_, err := repo.GetComponentByID(t.Context(), "guarded-service")
+		assert.NoError(t, err, "the component is kept")
+	})
+
+	t.Run("rejects maintainer changes without the admin token", func(t *testing.T) {
+		req := httptest.NewRequest("POST", "/components/guarded-service/maintainers", strings.NewReader(`{"identifier": "@mallory"}`))
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, req)
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+		assert.Equal(t, http.StatusUnauthorized, serve("DELETE", "/components/guarded-service/maintainers/@alice", "").Code)
+
+		stored, err := repo.GetComponentByID(t.Context(), "guarded-service")
+		require.NoError(t, err)
+		assert.Empty(t, stored.Maintainers)
+		assert.False(t, stored.MaintainersManaged, "sync keeps owning the maintainers")
+	})
+
+	t.Run("reads stay open", func(t *testing.T) {
+		assert.Equal(t, http.StatusOK, serve("GET", "/components/guarded-service", "").Code)
+	})
+
+	t.Run("deletes with the admin token", func(t *testing.T) {
+		assert.Equal(t, http.StatusNoContent, serve("DELETE", "/components/guarded-service", "admin-secret").Code)
+
+		_, err := repo.GetComponentByID(t.Context(), "guarded-service")
+		assert.ErrorIs(t, err, storage.ErrComponentNotFound)
+	})
+}
+
+func TestGetComponentReports_DetailsProjection(t *testing.T) {
+	repo, server := setupTestEnvironment(t)
+	defer cleanupTestEnvironment(t, repo)
+
+	component := storage.Component{ComponentID: "details-projection-service", Name: "Details Projection Service"}
+	require.NoError(t, repo.DB.Create(&component).Error)
+	check := storage.Check{Slug: "details-projection-check", Name: "Details Projection Check"}
+	require.NoError(t, repo.DB.Create(&check).Error)
+	report := storage.CheckReport{
+		CheckID:     check.ID,
+		ComponentID: component.ID,
+		Status:      storage.CheckStatusPass,
+		Timestamp:   time.Now(),
+		Details: storage.JSONB{
+			"coverage": map[string]interface{}{"total": 87.5, "files": []interface{}{"a.go", "b.go"}},
+			"duration": 12.0,
+			"log":      "a very long log",
+		},
+	}
+	require.NoError(t, repo.DB.Create(&report).Error)
+
+	getReports := func(params GetComponentReportsParams) *httptest.ResponseRecorder {
+		req := httptest.NewRequest("GET", "/catalog/v1/components/details-projection-service/reports", nil)
+		w := httptest.NewRecorder()
+		server.GetComponentReports(w, req, "details-projection-service", params)
+		return w
+	}
+	decodeDetails := func(t *testing.T, w *httptest.ResponseRecorder) *map[string]interface{} {
+		require.Equal(t, http.StatusOK, w.Code)
+		var response ComponentReportsResponse
+		require.NoError(t, json.NewDecoder(w.Body).Decode(&response))
+		require.Len(t, response.Reports, 1)
+		return response.Reports[0].Details
+	}
+
+	includeDetails := true
+
+	t.Run("OmittedByDefault", func(t *testing.T) {
+		assert.Nil(t, decodeDetails(t, getReports(GetComponentReportsParams{})))
+	})
+
+	t.Run("FullDetails", func(t *testing.T) {
+		details := decodeDetails(t, getReports(GetComponentReportsParams{IncludeDetails: &includeDetails}))
+		require.NotNil(t, details)
+		assert.Len(t, *details, 3)
+	})
+
+	t.Run("NestedKey", func(t *testing.T) {
+		fields := "coverage.total"
+		details := decodeDetails(t, getReports(GetComponentReportsParams{IncludeDetails: &includeDetails, DetailsFields: &fields}))
+		require.NotNil(t, details)
+		assert.Equal(t, map[string]interface{}{
+			"coverage": map[string]interface{}{"total": 87.5},
+		}, *details)
+	})
+
+	t.Run("TopLevelKeyAndMissingKey", func(t *testing.T) {
+		fields := "duration, missing, coverage.total.nope"
+		details := decodeDetails(t, getReports(GetComponentReportsParams{IncludeDetails: &includeDetails, DetailsFields: &fields}))
+		require.NotNil(t, details)
+		assert.Equal(t, map[string]interface{}{"duration": 12.0}, *details)
+	})
+
+	t.Run("FieldsWithoutIncludeDetails", func(t *testing.T) {
+		fields := "duration"
+		w := getReports(GetComponentReportsParams{DetailsFields: &fields})
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("InvalidFieldPath", func(t *testing.T) {
+		fields := "coverage..total"
+		w := getReports(GetComponentReportsParams{IncludeDetails: &includeDetails, DetailsFields: &fields})
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		var response Error
+		require.NoError(t, json.NewDecoder(w.Body).Decode(&response))
+		require.NotNil(t, response.Code)
+		assert.Equal(t, "VALIDATION_ERROR", *response.Code)
+	})
+}
+
+func TestGetChecks_UsageFilters(t *testing.T) {
+	// Usage counts span all components, so use an isolated database
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	require.NoError(t, err)
+	sqlDB, err := db.DB()
+	require.NoError(t, err)
+	sqlDB.SetMaxOpenConns(1)
+	require.NoError(t, db.AutoMigrate(&storage.Component{}, &storage.Check{}, &storage.CheckReport{}))
+	server := &APIServer{Repo: &storage.Repository{DB: db}}
+
+	component := storage.Component{ComponentID: "checks-usage-service", Name: "Checks Usage Service"}
+	require.NoError(t, db.Create(&component).Error)
+	used := storage.Check{Slug: "unit-tests", Name: "Unit Tests", Description: "Runs unit tests"}
+	unused := storage.Check{Slug: "unit-tset", Name: "Unit Tset"}
+	require.NoError(t, db.Create(&used).Error)
+	require.NoError(t, db.Create(&unused).Error)
+	for i := 0; i < 2; i++ {
+		report := storage.CheckReport{CheckID: used.ID, ComponentID: component.ID, Status: storage.CheckStatusPass, Timestamp: time.Now()}
+		require.NoError(t, db.Create(&report).Error)
+	}
+
+	getChecks := func(params GetChecksParams) *httptest.ResponseRecorder {
+		req := httptest.NewRequest("GET", "/checks", nil)
+		w := httptest.NewRecorder()
+		server.GetChecks(w, req, params)
+		return w
+	}
+	decode := func(t *testing.T, w *httptest.ResponseRecorder) ChecksResponse {
+		require.Equal(t, http.StatusOK, w.Code)
+		var response ChecksResponse
+		require.NoError(t, json.NewDecoder(w.Body).Decode(&response))
+		return response
+	}
+	intPtr := func(v int) *int { return &v }
+
+	t.Run("AllChecks", func(t *testing.T) {
+		response := decode(t, getChecks(GetChecksParams{}))
+		require.Len(t, response.Checks, 2)
+		assert.Equal(t, 2, response.Pagination.Total)
+		assert.Equal(t, "unit-tests", response.Checks[0].Slug)
+		assert.Equal(t, 2, response.Checks[0].ReportCount)
+		require.NotNil(t, response.Checks[0].Description)
+		assert.Equal(t, "Runs unit tests", *response.Checks[0].Description)
+		assert.Nil(t, response.Checks[0].ComponentCount)
+		assert.Equal(t, "unit-tset", response.Checks[1].Slug)
+		assert.Equal(t, 0, response.Checks[1].ReportCount)
+	})
+
+	t.Run("ZeroReportCheckOnlyWithMaxReportsZero", func(t *testing.T) {
+		response := decode(t, getChecks(GetChecksParams{MaxReports: intPtr(0)}))
+		require.Len(t, response.Checks, 1)
+		assert.Equal(t, "unit-tset", response.Checks[0].Slug)
+
+		response = decode(t, getChecks(GetChecksParams{MinReports: intPtr(1)}))
+		require.Len(t, response.Checks, 1)
+		assert.Equal(t, "unit-tests", response.Checks[0].Slug)
+	})
+
+	t.Run("ComponentCount", func(t *testing.T) {
+		include := true
+		response := decode(t, getChecks(GetChecksParams{IncludeComponentCount: &include}))
+		require.Len(t, response.Checks, 2)
+		require.NotNil(t, response.Checks[0].ComponentCount)
+		assert.Equal(t, 1, *response.Checks[0].ComponentCount)
+		require.NotNil(t, response.Checks[1].ComponentCount)
+		assert.Equal(t, 0, *response.Checks[1].ComponentCount)
+	})
+
+	t.Run("Pagination", func(t *testing.T) {
+		response := decode(t, getChecks(GetChecksParams{Limit: intPtr(1)}))
+		require.Len(t, response.Checks, 1)
+		assert.Equal(t, 2, response.Pagination.Total)
+		assert.True(t, response.Pagination.HasMore)
+	})
+
+	t.Run("MinGreaterThanMax", func(t *testing.T) {
+		w := getChecks(GetChecksParams{MinReports: intPtr(5), MaxReports: intPtr(1)})
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("ComponentFilter", func(t *testing.T) {
+		componentID := "checks-usage-service"
+		response := decode(t, getChecks(GetChecksParams{ComponentId: &componentID}))
+		require.Len(t, response.Checks, 1)
+		assert.Equal(t, "unit-tests", response.Checks[0].Slug)
+		assert.Equal(t, 1, response.Pagination.Total)
+
+		unreported := storage.Component{ComponentID: "checks-unreported-service", Name: "Checks Unreported Service"}
+		require.NoError(t, db.Create(&unreported).Error)
+		response = decode(t, getChecks(GetChecksParams{ComponentId: &unreported.ComponentID}))
+		assert.Empty(t, response.Checks)
+		assert.Equal(t, 0, response.Pagination.Total)
+	})
+
+	t.Run("ComponentFilterNotFound", func(t *testing.T) {
+		componentID := "no-such-component"
+		w := getChecks(GetChecksParams{ComponentId: &componentID})
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestGetComponentHistory(t *testing.T) {
+	repo, server := setupTestEnvironment(t)
+	defer cleanupTestEnvironment(t, repo)
+	require.NoError(t, repo.DB.AutoMigrate(&storage.ComponentHistory{}))
+	ctx := context.Background()
+
+	before := storage.Component{ComponentID: "history-api-service", Name: "History API Service", Team: "Platform"}
+	require.NoError(t, repo.CreateComponent(ctx, before))
+	stored, err := repo.GetComponentByID(ctx, before.ComponentID)
+	require.NoError(t, err)
+
+	after := *stored
+	after.Team = "Identity"
+	after.Maintainers = storage.StringArray{"alice@company.com"}
+	require.NoError(t, repo.CreateComponentHistory(ctx, storage.ComponentHistory{
+		ComponentID: stored.ID,
+		Changes:     storage.DiffComponents(*stored, after),
+		Source:      "filesystem:/manifests",
+	}))
+
+	getHistory := func(componentID string, params GetComponentHistoryParams) *httptest.ResponseRecorder {
+		req := httptest.NewRequest("GET", "/catalog/v1/components/"+componentID+"/history", nil)
+		w := httptest.NewRecorder()
+		server.GetComponentHistory(w, req, componentID, params)
+		return w
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		w := getHistory(before.ComponentID, GetComponentHistoryParams{})
+		require.Equal(t, http.StatusOK, w.Code)
+
+		var response ComponentHistoryResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.Equal(t, 1, response.Pagination.Total)
+		require.Len(t, response.Entries, 1)
+
+		entry := response.Entries[0]
+		require.NotNil(t, entry.Source)
+		assert.Equal(t, "filesystem:/manifests", *entry.Source)
+		assert.Len(t, entry.Changes, 2)
+		assert.Equal(t, "Platform", entry.Changes["team"].Old)
+		assert.Equal(t, "Identity", entry.Changes["team"].New)
+		assert.Equal(t, []interface{}{"alice@company.com"}, entry.Changes["maintainers"].New)
+	})
+
+	t.Run("FilterByField", func(t *testing.T) {
+		field := Description
+		w := getHistory(before.ComponentID, GetComponentHistoryParams{Field: &field})
+		require.Equal(t, http.StatusOK, w.Code)
+
+		var response ComponentHistoryResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.Empty(t, response.Entries)
+	})
+
+	t.Run("InvalidField", func(t *testing.T) {
+		field := GetComponentHistoryParamsField("owner")
+		w := getHistory(before.ComponentID, GetComponentHistoryParams{Field: &field})
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("ComponentNotFound", func(t *testing.T) {
+		w := getHistory("non-existent", GetComponentHistoryParams{})
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestGetComponentReports_Include(t *testing.T) {
+	repo, server := setupTestEnvironment(t)
+	defer cleanupTestEnvironment(t, repo)
+
+	component := storage.Component{ComponentID: "include-service", Name: "Include Service"}
+	require.NoError(t, repo.DB.Create(&component).Error)
+	check := storage.Check{Slug: "include-check", Name: "Include Check"}
+	require.NoError(t, repo.DB.Create(&check).Error)
+	report := storage.CheckReport{
+		CheckID:     check.ID,
+		ComponentID: component.ID,
+		Status:      storage.CheckStatusPass,
+		Timestamp:   time.Now(),
+		Details:     storage.JSONB{"coverage": 87.5, "duration": 12.0},
+		Metadata:    storage.JSONB{"ci_job_id": "build-123"},
+	}
+	require.NoError(t, repo.DB.Create(&report).Error)
+
+	getReport := func(t *testing.T, params GetComponentReportsParams) CheckReport {
+		req := httptest.NewRequest("GET", "/catalog/v1/components/include-service/reports", nil)
+		w := httptest.NewRecorder()
+		server.GetComponentReports(w, req, "include-service", params)
+		require.Equal(t, http.StatusOK, w.Code)
+
+		var response ComponentReportsResponse
+		require.NoError(t, json.NewDecoder(w.Body).Decode(&response))
+		require.Len(t, response.Reports, 1)
+		return response.Reports[0]
+	}
+
+	t.Run("OmittedByDefault", func(t *testing.T) {
+		apiReport := getReport(t, GetComponentReportsParams{})
+		assert.Nil(t, apiReport.Details)
+		assert.Nil(t, apiReport.Metadata)
+	})
+
+	t.Run("DetailsAndMetadata", func(t *testing.T) {
+		include := "details, metadata"
+		apiReport := getReport(t, GetComponentReportsParams{Include: &include})
+		require.NotNil(t, apiReport.Details)
+		assert.Equal(t, 87.5, (*apiReport.Details)["coverage"])
+		require.NotNil(t, apiReport.Metadata)
+		assert.Equal(t, "build-123", (*apiReport.Metadata)["ci_job_id"])
+	})
+
+	t.Run("MetadataOnly", func(t *testing.T) {
+		include := "metadata"
+		apiReport := getReport(t, GetComponentReportsParams{Include: &include})
+		assert.Nil(t, apiReport.Details)
+		require.NotNil(t, apiReport.Metadata)
+	})
+
+	t.Run("DetailsFieldsWithInclude", func(t *testing.T) {
+		include := "details"
+		fields := "duration"
+		apiReport := getReport(t, GetComponentReportsParams{Include: &include, DetailsFields: &fields})
+		require.NotNil(t, apiReport.Details)
+		assert.Equal(t, map[string]interface{}{"duration": 12.0}, *apiReport.Details)
+	})
+
+	t.Run("InvalidValue", func(t *testing.T) {
+		include := "details,logs"
+		req := httptest.NewRequest("GET", "/catalog/v1/components/include-service/reports", nil)
+		w := httptest.NewRecorder()
+		server.GetComponentReports(w, req, "include-service", GetComponentReportsParams{Include: &include})
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, w.Body.String(), "logs")
+	})
+}
+
+func TestGetComponentReports_RunTiming(t *testing.T) {
+	repo, server := setupTestEnvironment(t)
+	defer cleanupTestEnvironment(t, repo)
+
+	component := storage.Component{ComponentID: "run-timing-service", Name: "Run Timing Service"}
+	require.NoError(t, repo.DB.Create(&component).Error)
+	check := storage.Check{Slug: "run-timing-check", Name: "Run Timing Check"}
+	require.NoError(t, repo.DB.Create(&check).Error)
+
+	now := time.Now().UTC().Truncate(time.Second)
+	startedAt := now.Add(-2 * time.Minute)
+	duration := int64(120000)
+	timed := storage.CheckReport{CheckID: check.ID, ComponentID: component.ID, Status: storage.CheckStatusPass, Timestamp: now, StartedAt: &startedAt, DurationMs: &duration}
+	require.NoError(t, repo.DB.Create(&timed).Error)
+	untimed := storage.CheckReport{CheckID: check.ID, ComponentID: component.ID, Status: storage.CheckStatusFail, Timestamp: now.Add(-time.Hour)}
+	require.NoError(t, repo.DB.Create(&untimed).Error)
+
+	req := httptest.NewRequest("GET", "/catalog/v1/components/run-timing-service/reports", nil)
+	w := httptest.NewRecorder()
+	server.GetComponentReports(w, req, "run-timing-service", GetComponentReportsParams{})
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var response ComponentReportsResponse
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&response))
+	require.Len(t, response.Reports, 2)
+
+	require.NotNil(t, response.Reports[0].StartedAt)
+	assert.True(t, response.Reports[0].StartedAt.Equal(startedAt))
+	assert.Equal(t, &duration, response.Reports[0].DurationMs)
+	assert.Nil(t, response.Reports[1].StartedAt)
+	assert.Nil(t, response.Reports[1].DurationMs)
+}
+
+func TestGetComponentReports_CSV(t *testing.T) {
+	repo, server := setupTestEnvironment(t)
+	defer cleanupTestEnvironment(t, repo)
+
+	component := storage.Component{ComponentID: "csv-service", Name: "CSV Service"}
+	require.NoError(t, repo.DB.Create(&component).Error)
+	check := storage.Check{Slug: "csv-check", Name: "CSV Check"}
+	require.NoError(t, repo.DB.Create(&check).Error)
+	base := time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC)
+	var reports []storage.CheckReport
+	for i, status := range []storage.CheckStatus{storage.CheckStatusPass, storage.CheckStatusFail, storage.CheckStatusError} {
+		report := storage.CheckReport{CheckID: check.ID, ComponentID: component.ID, Status: status, Timestamp: base.Add(-time.Duration(i) * time.Hour)}
+		require.NoError(t, repo.DB.Create(&report).Error)
+		reports = append(reports, report)
+	}
+
+	handler := Handler(server)
+	get := func(t *testing.T, query string, accept string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest("GET", "/components/csv-service/reports?"+query, nil)
+		if accept != "" {
+			req.Header.Set("Accept", accept)
+		}
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, req)
+		return w
+	}
+	readCSV := func(t *testing.T, w *httptest.ResponseRecorder) [][]string {
+		require.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "text/csv; charset=utf-8", w.Header().Get("Content-Type"))
+		records, err := csv.NewReader(w.Body).ReadAll()
+		require.NoError(t, err)
+		return records
+	}
+
+	t.Run("AcceptHeader", func(t *testing.T) {
+		records := readCSV(t, get(t, "", "text/csv"))
+		require.Len(t, records, 4)
+		assert.Equal(t, []string{"id", "check_slug", "status", "timestamp"}, records[0])
+		assert.Equal(t, []string{reports[0].ID.String(), "csv-check", "pass", "2024-01-15T10:30:00Z"}, records[1])
+		assert.Equal(t, []string{reports[2].ID.String(), "csv-check", "error", "2024-01-15T08:30:00Z"}, records[3])
+	})
+
+	t.Run("FormatParameter", func(t *testing.T) {
+		records := readCSV(t, get(t, "format=csv", ""))
+		assert.Len(t, records, 4)
+	})
+
+	t.Run("FiltersAndPagination", func(t *testing.T) {
+		records := readCSV(t, get(t, "format=csv&status=fail&status=error&limit=1&offset=1", ""))
+		require.Len(t, records, 2)
+		assert.Equal(t, "error", records[1][2])
+	})
+
+	t.Run("FormatOverridesAccept", func(t *testing.T) {
+		w := get(t, "format=json", "text/csv")
+		require.Equal(t, http.StatusOK, w.Code)
+		var response ComponentReportsResponse
+		require.NoError(t, json.NewDecoder(w.Body).Decode(&response))
+		assert.Len(t, response.Reports, 3)
+	})
+
+	t.Run("InvalidFormat", func(t *testing.T) {
+		w := get(t, "format=xml", "")
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, w.Body.String(), "unknown format")
+	})
+
+	t.Run("ComponentNotFound", func(t *testing.T) {
+		req := httptest.NewRequest("GET", "/components/missing-service/reports?format=csv", nil)
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, req)
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestStreamComponentReports(t *testing.T) {
+	repo, server := setupTestEnvironment(t)
+	defer cleanupTestEnvironment(t, repo)
+
+	component := storage.Component{ComponentID: "stream-api-service", Name: "Stream API Service"}
+	require.NoError(t, repo.DB.Create(&component).Error)
+	check := storage.Check{Slug: "stream-api-check", Name: "Stream API Check"}
+	require.NoError(t, repo.DB.Create(&check).Error)
+	base := time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC)
+	for i := 0; i < 250; i++ {
+		status := storage.CheckStatusPass
+		if i%5 == 0 {
+			status = storage.CheckStatusFail
+		}
+		report := storage.CheckReport{CheckID: check.ID, ComponentID: component.ID, Status: status, Timestamp: base.Add(-time.Duration(i) * time.Minute)}
+		require.NoError(t, repo.DB.Create(&report).Error)
+	}
+
+	handler := Handler(server)
+	stream := func(t *testing.T, path string) (*httptest.ResponseRecorder, []CheckReport) {
+		req := httptest.NewRequest("GET", path, nil)
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, req)
+		if w.Code != http.StatusOK {
+			return w, nil
+		}
+		assert.Equal(t, "application/x-ndjson", w.Header().Get("Content-Type"))
+
+		var reports []CheckReport
+		scanner := bufio.NewScanner(w.Body)
+		for scanner.Scan() {
+			var report CheckReport
+			require.NoError(t, json.Unmarshal(scanner.Bytes(), &report))
+			reports = append(reports, report)
+		}
+		require.NoError(t, scanner.Err())
+		return w, reports
+	}
+
+	t.Run("AllReportsWithoutPagination", func(t *testing.T) {
+		_, reports := stream(t, "/components/stream-api-service/reports/stream")
+		require.Len(t, reports, 250)
+		assert.Equal(t, "stream-api-check", reports[0].CheckSlug)
+		assert.Equal(t, CheckReportStatusFail, reports[0].Status)
+		assert.True(t, reports[0].Timestamp.Equal(base))
+	})
+
+	t.Run("Filters", func(t *testing.T) {
+		_, reports := stream(t, "/components/stream-api-service/reports/stream?status=fail&since=2024-01-15T09:00:00Z")
+		require.Len(t, reports, 19)
+		for _, report := range reports {
+			assert.Equal(t, CheckReportStatusFail, report.Status)
+		}
+
+		_, reports = stream(t, "/components/stream-api-service/reports/stream?check_slug=other-check")
+		assert.Empty(t, reports)
+	})
+
+	t.Run("InvalidWindow", func(t *testing.T) {
+		w, _ := stream(t, "/components/stream-api-service/reports/stream?since=2024-01-15T09:00:00Z&before=2024-01-15T08:00:00Z")
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("ComponentNotFound", func(t *testing.T) {
+		w, _ := stream(t, "/components/missing-service/reports/stream")
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestGetComponentReports_MultipleStatuses(t *testing.T) {
+	repo, server := setupTestEnvironment(t)
+	defer cleanupTestEnvironment(t, repo)
+
+	component := storage.Component{ComponentID: "multi-status-service", Name: "Multi Status Service"}
+	require.NoError(t, repo.DB.Create(&component).Error)
+	check := storage.Check{Slug: "multi-status-check", Name: "Multi Status Check"}
+	require.NoError(t, repo.DB.Create(&check).Error)
+	for i, status := range []storage.CheckStatus{storage.CheckStatusPass, storage.CheckStatusFail, storage.CheckStatusError} {
+		report := storage.CheckReport{CheckID: check.ID, ComponentID: component.ID, Status: status, Timestamp: time.Now().Add(-time.Duration(i) * time.Minute)}
+		require.NoError(t, repo.DB.Create(&report).Error)
+	}
+
+	// Go through the generated router so the repeated query parameter is parsed
+	handler := Handler(server)
+	getStatuses := func(t *testing.T, query string) []CheckReportStatus {
+		req := httptest.NewRequest("GET", "/components/multi-status-service/reports?"+query, nil)
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, req)
+		require.Equal(t, http.StatusOK, w.Code)
+
+		var response ComponentReportsResponse
+		require.NoError(t, json.NewDecoder(w.Body).Decode(&response))
+		statuses := make([]CheckReportStatus, len(response.Reports))
+		for i, report := range response.Reports {
+			statuses[i] = report.Status
+		}
+		return statuses
+	}
+
+	assert.Equal(t, []CheckReportStatus{CheckReportStatusFail, CheckReportStatusError}, getStatuses(t, "status=fail&status=error"))
+	assert.Equal(t, []CheckReportStatus{CheckReportStatusPass}, getStatuses(t, "status=pass"))
+	assert.Len(t, getStatuses(t, ""), 3)
+}
+
+func TestGetComponentReports_MultipleCheckSlugs(t *testing.T) {
+	repo, server := setupTestEnvironment(t)
+	defer cleanupTestEnvironment(t, repo)
+
+	component := storage.Component{ComponentID: "multi-slug-service", Name: "Multi Slug Service"}
+	require.NoError(t, repo.DB.Create(&component).Error)
+	for _, slug := range []string{"multi-slug-unit", "multi-slug-integration", "multi-slug-lint"} {
+		check := storage.Check{Slug: slug, Name: slug}
+		require.NoError(t, repo.DB.Create(&check).Error)
+		report := storage.CheckReport{CheckID: check.ID, ComponentID: component.ID, Status: storage.CheckStatusPass, Timestamp: time.Now()}
+		require.NoError(t, repo.DB.Create(&report).Error)
+	}
+
+	// Go through the generated router so the repeated query parameter is parsed
+	handler := Handler(server)
+	getSlugs := func(t *testing.T, query string) []string {
+		req := httptest.NewRequest("GET", "/components/multi-slug-service/reports?"+query, nil)
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, req)
+		require.Equal(t, http.StatusOK, w.Code)
+
+		var response ComponentReportsResponse
+		require.NoError(t, json.NewDecoder(w.Body).Decode(&response))
+		slugs := make([]string, len(response.Reports))
+		for i, report := range response.Reports {
+			slugs[i] = report.CheckSlug
+		}
+		return slugs
+	}
+
+	assert.ElementsMatch(t, []string{"multi-slug-unit", "multi-slug-integration"}, getSlugs(t, "check_slug=multi-slug-unit&check_slug=multi-slug-integration"))
+	assert.Equal(t, []string{"multi-slug-lint"}, getSlugs(t, "check_slug=multi-slug-lint"))
+	assert.Len(t, getSlugs(t, "check_slug="), 3)
+	assert.Len(t, getSlugs(t, ""), 3)
+}
+
+func TestGetComponentReports_TimeWindow(t *testing.T) {
+	repo, server := setupTestEnvironment(t)
+	defer cleanupTestEnvironment(t, repo)
+
+	component := storage.Component{ComponentID: "time-window-service", Name: "Time Window Service"}
+	require.NoError(t, repo.DB.Create(&component).Error)
+	check := storage.Check{Slug: "time-window-check", Name: "Time Window Check"}
+	require.NoError(t, repo.DB.Create(&check).Error)
+
+	now := time.Now().UTC().Truncate(time.Second)
+	for _, age := range []time.Duration{time.Hour, 3 * time.Hour, 5 * time.Hour} {
+		report := storage.CheckReport{CheckID: check.ID, ComponentID: component.ID, Status: storage.CheckStatusPass, Timestamp: now.Add(-age)}
+		require.NoError(t, repo.DB.Create(&report).Error)
+	}
+
+	getReports := func(since, before time.Time) *httptest.ResponseRecorder {
+		req := httptest.NewRequest("GET", "/catalog/v1/components/time-window-service/reports", nil)
+		w := httptest.NewRecorder()
+		server.GetComponentReports(w, req, "time-window-service", GetComponentReportsParams{Since: &since, Before: &before})
+		return w
+	}
+
+	t.Run("ClosedWindow", func(t *testing.T) {
+		w := getReports(now.Add(-4*time.Hour), now.Add(-2*time.Hour))
+		require.Equal(t, http.StatusOK, w.Code)
+		var response ComponentReportsResponse
+		require.NoError(t, json.NewDecoder(w.Body).Decode(&response))
+		require.Len(t, response.Reports, 1)
+		assert.True(t, response.Reports[0].Timestamp.Equal(now.Add(-3*time.Hour)))
+	})
+
+	t.Run("SinceEqualToBeforeReturnsNothing", func(t *testing.T) {
+		w := getReports(now.Add(-3*time.Hour), now.Add(-3*time.Hour))
+		require.Equal(t, http.StatusOK, w.Code)
+		var response ComponentReportsResponse
+		require.NoError(t, json.NewDecoder(w.Body).Decode(&response))
+		assert.Empty(t, response.Reports)
+		assert.Equal(t, 0, response.Pagination.Total)
+	})
+
+	t.Run("SinceAfterBefore", func(t *testing.T) {
+		w := getReports(now, now.Add(-time.Hour))
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestGetComponentReportsSummary(t *testing.T) {
+	repo, server := setupTestEnvironment(t)
+	defer cleanupTestEnvironment(t, repo)
+
+	component := storage.Component{ComponentID: "summary-service", Name: "Summary Service"}
+	require.NoError(t, repo.DB.Create(&component).Error)
+	empty := storage.Component{ComponentID: "summary-empty-service", Name: "Summary Empty Service"}
+	require.NoError(t, repo.DB.Create(&empty).Error)
+
+	now := time.Now().UTC().Truncate(time.Second)
+	// Each check's history, oldest first; only the last report counts
+	histories := map[string][]storage.CheckStatus{
+		"summary-unit":  {storage.CheckStatusFail, storage.CheckStatusPass},
+		"summary-lint":  {storage.CheckStatusPass, storage.CheckStatusPass},
+		"summary-build": {storage.CheckStatusPass, storage.CheckStatusFail},
+		"summary-scan":  {storage.CheckStatusError},
+	}
+	for slug, statuses := range histories {
+		check := storage.Check{Slug: slug, Name: slug}
+		require.NoError(t, repo.DB.Create(&check).Error)
+		for i, status := range statuses {
+			report := storage.CheckReport{
+				CheckID:     check.ID,
+				ComponentID: component.ID,
+				Status:      status,
+				Timestamp:   now.Add(time.Duration(i-len(statuses)) * time.Hour),
+			}
+			require.NoError(t, repo.DB.Create(&report).Error)
+		}
+	}
+
+	getSummary := func(componentID string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest("GET", "/catalog/v1/components/"+componentID+"/reports/summary", nil)
+		w := httptest.NewRecorder()
+		server.GetComponentReportsSummary(w, req, componentID)
+		return w
+	}
+
+	t.Run("CountsLatestStatusPerCheck", func(t *testing.T) {
+		w := getSummary("summary-service")
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+		var summary ComponentReportsSummary
+		require.NoError(t, json.NewDecoder(w.Body).Decode(&summary))
+
+		assert.Equal(t, 4, summary.TotalChecks)
+		assert.Equal(t, CheckStatusCounts{Pass: 2, Fail: 1, Error: 1}, summary.StatusCounts)
+		require.NotNil(t, summary.LatestReportAt)
+		assert.True(t, summary.LatestReportAt.Equal(now.Add(-time.Hour)))
+
+		// The summary matches the latest_per_check listing
+		latest := true
+		req := httptest.NewRequest("GET", "/catalog/v1/components/summary-service/reports", nil)
+		listing := httptest.NewRecorder()
+		server.GetComponentReports(listing, req, "summary-service", GetComponentReportsParams{LatestPerCheck: &latest})
+		require.Equal(t, http.StatusOK, listing.Code)
+		var reports ComponentReportsResponse
+		require.NoError(t, json.NewDecoder(listing.Body).Decode(&reports))
+		assert.Equal(t, summary.TotalChecks, reports.Pagination.Total)
+	})
+
+	t.Run("NoReports", func(t *testing.T) {
+		w := getSummary("summary-empty-service")
+		require.Equal(t, http.StatusOK, w.Code)
+		var summary ComponentReportsSummary
+		require.NoError(t, json.NewDecoder(w.Body).Decode(&summary))
+		assert.Equal(t, 0, summary.TotalChecks)
+		assert.Equal(t, CheckStatusCounts{}, summary.StatusCounts)
+		assert.Nil(t, summary.LatestReportAt)
+	})
+
+	t.Run("ComponentNotFound", func(t *testing.T) {
+		w := getSummary("summary-missing-service")
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestGetComponentReports_Cursor(t *testing.T) {
+	repo, server := setupTestEnvironment(t)
+	defer cleanupTestEnvironment(t, repo)
+
+	component := storage.Component{ComponentID: "cursor-api-service", Name: "Cursor API Service"}
+	require.NoError(t, repo.DB.Create(&component).Error)
+	check := storage.Check{Slug: "cursor-api-check", Name: "Cursor API Check"}
+	require.NoError(t, repo.DB.Create(&check).Error)
+
+	now := time.Now().UTC().Truncate(time.Second)
+	for i := 0; i < 200; i++ {
+		report := storage.CheckReport{CheckID: check.ID, ComponentID: component.ID, Status: storage.CheckStatusPass, Timestamp: now.Add(-time.Duration(i/3) * time.Minute)}
+		require.NoError(t, repo.DB.Create(&report).Error)
+	}
+
+	getReports := func(params GetComponentReportsParams) *httptest.ResponseRecorder {
+		req := httptest.NewRequest("GET", "/catalog/v1/components/cursor-api-service/reports", nil)
+		w := httptest.NewRecorder()
+		server.GetComponentReports(w, req, "cursor-api-service", params)
+		return w
+	}
+
+	t.Run("PaginatesToTheEnd", func(t *testing.T) {
+		limit := 45
+		seen := make(map[string]bool)
+		params := GetComponentReportsParams{Limit: &limit}
+		for page := 0; ; page++ {
+			require.Less(t, page, 10, "pagination did not terminate")
+
+			w := getReports(params)
+			require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+			var response ComponentReportsResponse
+			require.NoError(t, json.NewDecoder(w.Body).Decode(&response))
+			assert.Equal(t, 200, response.Pagination.Total)
+
+			for _, report := range response.Reports {
+				assert.False(t, seen[report.Id], "report %s returned twice", report.Id)
+				seen[report.Id] = true
+			}
+
+			if response.Pagination.NextCursor == nil {
+				assert.False(t, response.Pagination.HasMore)
+				break
+			}
+			assert.True(t, response.Pagination.HasMore)
+			params.Cursor = response.Pagination.NextCursor
+		}
+		assert.Len(t, seen, 200)
+	})
+
+	t.Run("LastOffsetPageHasNoCursor", func(t *testing.T) {
+		limit, offset := 100, 100
+		w := getReports(GetComponentReportsParams{Limit: &limit, Offset: &offset})
+		require.Equal(t, http.StatusOK, w.Code)
+		var response ComponentReportsResponse
+		require.NoError(t, json.NewDecoder(w.Body).Decode(&response))
+		assert.Len(t, response.Reports, 100)
+		assert.Nil(t, response.Pagination.NextCursor)
+	})
+
+	t.Run("InvalidCombinations", func(t *testing.T) {
+		cursor := encodeReportCursor(storage.ReportCursor{Timestamp: now, ID: check.ID})
+		offset := 0
+		latest := true
+		invalid := "not-a-cursor"
+
+		for name, params := range map[string]GetComponentReportsParams{
+			"WithOffset":         {Cursor: &cursor, Offset: &offset},
+			"WithLatestPerCheck": {Cursor: &cursor, LatestPerCheck: &latest},
+			"Malformed":          {Cursor: &invalid},
+		} {
+			t.Run(name, func(t *testing.T) {
+				assert.Equal(t, http.StatusBadRequest, getReports(params).Code)
+			})
+		}
+	})
+}
+
+func TestGetComponentReports_Sort(t *testing.T) {
+	repo, server := setupTestEnvironment(t)
+	defer cleanupTestEnvironment(t, repo)
+
+	component := storage.Component{ComponentID: "sort-service", Name: "Sort Service"}
+	require.NoError(t, repo.DB.Create(&component).Error)
+	check := storage.Check{Slug: "sort-check", Name: "Sort Check"}
+	require.NoError(t, repo.DB.Create(&check).Error)
+
+	now := time.Now().UTC().Truncate(time.Second)
+	for i, status := range []storage.CheckStatus{storage.CheckStatusPass, storage.CheckStatusFail, storage.CheckStatusError} {
+		report := storage.CheckReport{CheckID: check.ID, ComponentID: component.ID, Status: status, Timestamp: now.Add(time.Duration(i-3) * time.Hour)}
+		require.NoError(t, repo.DB.Create(&report).Error)
+	}
+
+	getReports := func(query string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest("GET", "/components/sort-service/reports?"+query, nil)
+		w := httptest.NewRecorder()
+		Handler(server).ServeHTTP(w, req)
+		return w
+	}
+	statuses := func(t *testing.T, w *httptest.ResponseRecorder) []CheckReportStatus {
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+		var response ComponentReportsResponse
+		require.NoError(t, json.NewDecoder(w.Body).Decode(&response))
+		var result []CheckReportStatus
+		for _, report := range response.Reports {
+			result = append(result, report.Status)
+		}
+		return result
+	}
+
+	t.Run("DefaultIsNewestFirst", func(t *testing.T) {
+		assert.Equal(t, []CheckReportStatus{CheckReportStatusError, CheckReportStatusFail, CheckReportStatusPass}, statuses(t, getReports("")))
+	})
+
+	t.Run("OldestFirst", func(t *testing.T) {
+		assert.Equal(t, []CheckReportStatus{CheckReportStatusPass, CheckReportStatusFail, CheckReportStatusError}, statuses(t, getReports("order=asc")))
+	})
+
+	t.Run("ByStatus", func(t *testing.T) {
+		assert.Equal(t, []CheckReportStatus{CheckReportStatusError, CheckReportStatusFail, CheckReportStatusPass}, statuses(t, getReports("sort=status&order=asc")))
+		assert.Equal(t, []CheckReportStatus{CheckReportStatusPass, CheckReportStatusFail, CheckReportStatusError}, statuses(t, getReports("sort=status")))
+	})
+
+	t.Run("InvalidValues", func(t *testing.T) {
+		assert.Equal(t, http.StatusBadRequest, getReports("sort=name").Code)
+		assert.Equal(t, http.StatusBadRequest, getReports("order=up").Code)
+	})
+
+	t.Run("CursorRequiresDefaultOrder", func(t *testing.T) {
+		cursor := encodeReportCursor(storage.ReportCursor{Timestamp: now, ID: check.ID})
+		assert.Equal(t, http.StatusBadRequest, getReports("order=asc&cursor="+cursor).Code)
+	})
+}
+
+func TestGetComponentReportById(t *testing.T) {
+	repo, server := setupTestEnvironment(t)
+	defer cleanupTestEnvironment(t, repo)
+
+	component := storage.Component{ComponentID: "single-report-service", Name: "Single Report Service"}
+	require.NoError(t, repo.DB.Create(&component).Error)
+	other := storage.Component{ComponentID: "single-report-other", Name: "Other Service"}
+	require.NoError(t, repo.DB.Create(&other).Error)
+	check := storage.Check{Slug: "single-report-check", Name: "Single Report Check"}
+	require.NoError(t, repo.DB.Create(&check).Error)
+
+	report := storage.CheckReport{
+		CheckID:     check.ID,
+		ComponentID: component.ID,
+		Status:      storage.CheckStatusFail,
+		Timestamp:   time.Now(),
+		Details:     storage.JSONB{"failures": 3.0},
+		Metadata:    storage.JSONB{"ci_job_id": "build-456"},
+	}
+	require.NoError(t, repo.DB.Create(&report).Error)
+	otherReport := storage.CheckReport{CheckID: check.ID, ComponentID: other.ID, Status: storage.CheckStatusPass, Timestamp: time.Now()}
+	require.NoError(t, repo.DB.Create(&otherReport).Error)
+
+	getReport := func(componentID, reportID string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest("GET", "/catalog/v1/components/"+componentID+"/reports/"+reportID, nil)
+		w := httptest.NewRecorder()
+		server.GetComponentReportById(w, req, componentID, reportID)
+		return w
+	}
+	errorMessage := func(t *testing.T, w *httptest.ResponseRecorder) string {
+		var response Error
+		require.NoError(t, json.NewDecoder(w.Body).Decode(&response))
+		return response.Error
+	}
+
+	t.Run("IncludesDetailsAndMetadata", func(t *testing.T) {
+		w := getReport("single-report-service", report.ID.String())
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+		var apiReport CheckReport
+		require.NoError(t, json.NewDecoder(w.Body).Decode(&apiReport))
+		assert.Equal(t, report.ID.String(), apiReport.Id)
+		assert.Equal(t, "single-report-check", apiReport.CheckSlug)
+		assert.Equal(t, CheckReportStatusFail, apiReport.Status)
+		require.NotNil(t, apiReport.Details)
+		assert.Equal(t, 3.0, (*apiReport.Details)["failures"])
+		require.NotNil(t, apiReport.Metadata)
+		assert.Equal(t, "build-456", (*apiReport.Metadata)["ci_job_id"])
+	})
+
+	t.Run("ReportOfAnotherComponent", func(t *testing.T) {
+		w := getReport("single-report-service", otherReport.ID.String())
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		assert.Equal(t, "Report not found", errorMessage(t, w))
+	})
+
+	t.Run("UnknownReport", func(t *testing.T) {
+		w := getReport("single-report-service", uuid.NewString())
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		assert.Equal(t, "Report not found", errorMessage(t, w))
+	})
+
+	t.Run("UnknownComponent", func(t *testing.T) {
+		w := getReport("no-such-component", report.ID.String())
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		assert.Equal(t, "Component not found", errorMessage(t, w))
+	})
+
+	t.Run("InvalidReportID", func(t *testing.T) {
+		w := getReport("single-report-service", "not-a-uuid")
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestSearchComponents(t *testing.T) {
+	repo, server := setupTestEnvironment(t)
+	defer cleanupTestEnvironment(t, repo)
+
+	for _, component := range []storage.Component{
+		{ComponentID: "search-vault", Name: "Vault", Team: "Zebra Security"},
+		{ComponentID: "search-scanner", Name: "Zebra Scanner"},
+		{ComponentID: "search-billing", Name: "Billing", Description: "Zebra invoices"},
+	} {
+		require.NoError(t, repo.DB.Create(&component).Error)
+	}
+
+	// Go through the generated router to cover the route next to /components/{componentId}
+	handler := Handler(server)
+	search := func(t *testing.T, query string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest("GET", "/components:search?"+query, nil)
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, req)
+		return w
+	}
+
+	t.Run("MatchesNameDescriptionAndTeam", func(t *testing.T) {
+		w := search(t, "q=zebra&limit=2")
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+		var response ComponentSearchResponse
+		require.NoError(t, json.NewDecoder(w.Body).Decode(&response))
+		require.Len(t, response.Components, 2)
+		assert.Equal(t, "search-billing", *response.Components[0].Id)
+		assert.Equal(t, "search-scanner", *response.Components[1].Id)
+		assert.Equal(t, 3, response.Pagination.Total)
+		assert.True(t, response.Pagination.HasMore)
+	})
+
+	t.Run("NoMatches", func(t *testing.T) {
+		w := search(t, "q=no-such-component-anywhere")
+		require.Equal(t, http.StatusOK, w.Code)
+
+		var response ComponentSearchResponse
+		require.NoError(t, json.NewDecoder(w.Body).Decode(&response))
+		assert.Empty(t, response.Components)
+		assert.Equal(t, 0, response.Pagination.Total)
+	})
+
+	t.Run("EmptyQuery", func(t *testing.T) {
+		w := search(t, "q=%20")
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("MissingQuery", func(t *testing.T) {
+		w := search(t, "")
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestGetComponents_Pagination(t *testing.T) {
+	repo, server := setupTestEnvironment(t)
+	defer cleanupTestEnvironment(t, repo)
+
+	for i := 0; i < 5; i++ {
+		component := storage.Component{ComponentID: fmt.Sprintf("paged-component-%d", i), Name: "Paged", Maintainers: storage.StringArray{"@pager"}}
+		require.NoError(t, repo.DB.Create(&component).Error)
+	}
+	var total int64
+	require.NoError(t, repo.DB.Model(&storage.Component{}).Count(&total).Error)
+
+	getComponents := func(t *testing.T, query string, accept string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest("GET", "/components?"+query, nil)
+		if accept != "" {
+			req.Header.Set("Accept", accept)
+		}
+		w := httptest.NewRecorder()
+		Handler(server).ServeHTTP(w, req)
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+		return w
+	}
+	getPage := func(t *testing.T, query string) ComponentsResponse {
+		w := getComponents(t, query, "application/vnd.argus.v2+json")
+		assert.Equal(t, "application/vnd.argus.v2+json", w.Header().Get("Content-Type"))
+		var response ComponentsResponse
+		require.NoError(t, json.NewDecoder(w.Body).Decode(&response))
+		return response
+	}
+
+	t.Run("V2DefaultsToFirstPage", func(t *testing.T) {
+		response := getPage(t, "")
+		assert.Equal(t, int(total), response.Pagination.Total)
+		assert.Equal(t, 50, response.Pagination.Limit)
+		assert.Equal(t, 0, response.Pagination.Offset)
+		assert.Len(t, response.Components, min(int(total), 50))
+	})
+
+	t.Run("V2PagesAreOrderedAndDisjoint", func(t *testing.T) {
+		seen := make(map[string]bool)
+		previous := ""
+		for offset := 0; offset < int(total); offset += 2 {
+			response := getPage(t, fmt.Sprintf("limit=2&offset=%d", offset))
+			assert.Equal(t, offset+2 < int(total), response.Pagination.HasMore)
+			for _, component := range response.Components {
+				assert.False(t, seen[*component.Id], "component %s returned twice", *component.Id)
+				seen[*component.Id] = true
+				assert.Greater(t, *component.Id, previous)
+				previous = *component.Id
+			}
+		}
+		assert.Len(t, seen, int(total))
+	})
+
+	t.Run("V2WithMaintainer", func(t *testing.T) {
+		response := getPage(t, "maintainer=@pager&limit=2&offset=2")
+		assert.Equal(t, 5, response.Pagination.Total)
+		require.Len(t, response.Components, 2)
+		assert.Equal(t, "paged-component-2", *response.Components[0].Id)
+		assert.Equal(t, "paged-component-3", *response.Components[1].Id)
+	})
+
+	t.Run("LegacyArrayIsUnchanged", func(t *testing.T) {
+		w := getComponents(t, "", "")
+		assert.Equal(t, "application/json", w.Header().Get("Content-Type"))
+		var components []Component
+		require.NoError(t, json.NewDecoder(w.Body).Decode(&components))
+		assert.Len(t, components, int(total))
+	})
+
+	t.Run("LegacyArrayWithLimit", func(t *testing.T) {
+		w := getComponents(t, "limit=2", "application/json")
+		var components []Component
+		require.NoError(t, json.NewDecoder(w.Body).Decode(&components))
+		assert.Len(t, components, 2)
+	})
+}
+
+func TestGetComponents_ConfiguredPageLimits(t *testing.T) {
+	repo, server := setupTestEnvironment(t)
+	defer cleanupTestEnvironment(t, repo)
+	server.Config = Config{DefaultPageLimit: 2, MaxPageLimit: 3}
+
+	for i := 0; i < 5; i++ {
+		component := storage.Component{ComponentID: fmt.Sprintf("page-limits-component-%d", i), Name: "Page Limits", Maintainers: storage.StringArray{"@page-limits"}}
+		require.NoError(t, repo.DB.Create(&component).Error)
+	}
+
+	getPage := func(t *testing.T, query string) ComponentsResponse {
+		req := httptest.NewRequest("GET", "/components?maintainer=@page-limits&"+query, nil)
+		req.Header.Set("Accept", componentsV2MediaType)
+		w := httptest.NewRecorder()
+		Handler(server).ServeHTTP(w, req)
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+		var response ComponentsResponse
+		require.NoError(t, json.NewDecoder(w.Body).Decode(&response))
+		return response
+	}
+
+	testCases := []struct {
+		query         string
+		expectedLimit int
+	}{
+		{query: "", expectedLimit: 2},
+		{query: "limit=3", expectedLimit: 3},
+		{query: "limit=4", expectedLimit: 2},
+	}
+	for _, tc := range testCases {
+		response := getPage(t, tc.query)
+		assert.Equal(t, tc.expectedLimit, response.Pagination.Limit, tc.query)
+		assert.Len(t, response.Components, tc.expectedLimit, tc.query)
+	}
+}
+
+func TestConfig_Validate(t *testing.T) {
+	assert.NoError(t, Config{DefaultPageLimit: DefaultPageLimit, MaxPageLimit: DefaultMaxPageLimit, MaxOffset: DefaultMaxOffset}.Validate())
+	assert.NoError(t, Config{DefaultPageLimit: 100, MaxPageLimit: 100, MaxOffset: 1}.Validate())
+	assert.ErrorContains(t, Config{DefaultPageLimit: 0, MaxPageLimit: 100}.Validate(), "default_page_limit must be positive")
+	assert.ErrorContains(t, Config{DefaultPageLimit: 50, MaxPageLimit: 0}.Validate(), "max_page_limit must be positive")
+	assert.ErrorContains(t, Config{DefaultPageLimit: 200, MaxPageLimit: 100}.Validate(), "default_page_limit 200 cannot exceed max_page_limit 100")
+	assert.ErrorContains(t, Config{DefaultPageLimit: 50, MaxPageLimit: 100, MaxOffset: 0}.Validate(), "max_offset must be positive")
+}
+
+func TestGetComponents_ETag(t *testing.T) {
+	repo, server := setupTestEnvironment(t)
+	defer cleanupTestEnvironment(t, repo)
+
+	require.NoError(t, repo.CreateComponent(t.Context(), storage.Component{ComponentID: "etag-service", Name: "ETag Service"}))
+
+	getComponents := func(t *testing.T, query string, ifNoneMatch string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest("GET", "/components"+query, nil)
+		if ifNoneMatch != "" {
+			req.Header.Set("If-None-Match", ifNoneMatch)
+		}
+		w := httptest.NewRecorder()
+		Handler(server).ServeHTTP(w, req)
+		return w
+	}
+
+	w := getComponents(t, "", "")
+	require.Equal(t, http.StatusOK, w.Code)
+	etag := w.Header().Get("ETag")
+	require.NotEmpty(t, etag)
+
+	w = getComponents(t, "", etag)
+	assert.Equal(t, http.StatusNotModified, w.Code)
+	assert.Empty(t, w.Body.String())
+	assert.Equal(t, etag, w.Header().Get("ETag"))
+	assert.Equal(t, http.StatusNotModified, getComponents(t, "", `"stale", `+etag).Code)
+
+	// Listings with different queries have their own ETags
+	w = getComponents(t, "?limit=1", etag)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.NotEqual(t, etag, w.Header().Get("ETag"))
+
+	time.Sleep(5 * time.Millisecond)
+	require.NoError(t, repo.CreateComponent(t.Context(), storage.Component{ComponentID: "etag-other", Name: "Other"}))
+	w = getComponents(t, "", etag)
+	require.Equal(t, http.StatusOK, w.Code)
+	created := w.Header().Get("ETag")
+	assert.NotEqual(t, etag, created)
+
+	time.Sleep(5 * time.Millisecond)
+	require.NoError(t, repo.DB.Delete(&storage.Component{}, "component_id = ?", "etag-other").Error)
+	w = getComponents(t, "", created)
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.NotEqual(t, created, w.Header().Get("ETag"))
+}
+
+func TestGetComponentById_SourceRevision(t *testing.T) {
+	repo, server := setupTestEnvironment(t)
+	defer cleanupTestEnvironment(t, repo)
+
+	revision := "3f5c2a9e8b7d6c5f4e3a2b1c0d9e8f7a6b5c4d3e"
+	require.NoError(t, repo.DB.Create(&storage.Component{ComponentID: "revision-synced", Name: "Synced", SourceRevision: revision}).Error)
+	require.NoError(t, repo.DB.Create(&storage.Component{ComponentID: "revision-unknown", Name: "Unknown"}).Error)
+
+	getComponent := func(t *testing.T, componentID string) Component {
+		req := httptest.NewRequest("GET", "/components/"+componentID, nil)
+		w := httptest.NewRecorder()
+		server.GetComponentById(w, req, componentID, GetComponentByIdParams{})
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+		var component Component
+		require.NoError(t, json.NewDecoder(w.Body).Decode(&component))
+		return component
+	}
+
+	synced := getComponent(t, "revision-synced")
+	require.NotNil(t, synced.SourceRevision)
+	assert.Equal(t, revision, *synced.SourceRevision)
+
+	assert.Nil(t, getComponent(t, "revision-unknown").SourceRevision)
+}
+
+func TestGetComponentById_IncludeReportSummary(t *testing.T) {
+	repo, server := setupTestEnvironment(t)
+	defer cleanupTestEnvironment(t, repo)
+
+	component := storage.Component{ComponentID: "include-summary-service", Name: "Include Summary Service"}
+	require.NoError(t, repo.DB.Create(&component).Error)
+	require.NoError(t, repo.DB.Create(&storage.Component{ComponentID: "include-summary-empty", Name: "Empty"}).Error)
+
+	now := time.Now().UTC().Truncate(time.Second)
+	for slug, statuses := range map[string][]storage.CheckStatus{
+		"include-unit": {storage.CheckStatusFail, storage.CheckStatusPass},
+		"include-lint": {storage.CheckStatusFail},
+	} {
+		check := storage.Check{Slug: slug, Name: slug}
+		require.NoError(t, repo.DB.Create(&check).Error)
+		for i, status := range statuses {
+			report := storage.CheckReport{
+				CheckID:     check.ID,
+				ComponentID: component.ID,
+				Status:      status,
+				Timestamp:   now.Add(time.Duration(i-len(statuses)) * time.Hour),
+			}
+			require.NoError(t, repo.DB.Create(&report).Error)
+		}
+	}
+
+	getComponent := func(t *testing.T, componentID string, include *string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest("GET", "/components/"+componentID, nil)
+		w := httptest.NewRecorder()
+		server.GetComponentById(w, req, componentID, GetComponentByIdParams{Include: include})
+		return w
+	}
+	reportSummary := "report_summary"
+	decode := func(t *testing.T, w *httptest.ResponseRecorder) Component {
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+		var component Component
+		require.NoError(t, json.NewDecoder(w.Body).Decode(&component))
+		return component
+	}
+
+	t.Run("OmittedByDefault", func(t *testing.T) {
+		w := getComponent(t, "include-summary-service", nil)
+		assert.NotContains(t, w.Body.String(), "report_summary")
+		assert.Nil(t, decode(t, w).ReportSummary)
+	})
+
+	t.Run("CountsLatestStatusPerCheck", func(t *testing.T) {
+		summary := decode(t, getComponent(t, "include-summary-service", &reportSummary)).ReportSummary
+		require.NotNil(t, summary)
+		assert.Equal(t, 2, summary.TotalChecks)
+		assert.Equal(t, CheckStatusCounts{Pass: 1, Fail: 1}, summary.StatusCounts)
+		require.NotNil(t, summary.LatestReportAt)
+		assert.True(t, summary.LatestReportAt.Equal(now.Add(-time.Hour)))
+	})
+
+	t.Run("ZeroedWithoutReports", func(t *testing.T) {
+		w := getComponent(t, "include-summary-empty", &reportSummary)
+		assert.Contains(t, w.Body.String(), `"status_counts":{`)
+		summary := decode(t, w).ReportSummary
+		require.NotNil(t, summary)
+		assert.Equal(t, 0, summary.TotalChecks)
+		assert.Equal(t, CheckStatusCounts{}, summary.StatusCounts)
+		assert.Nil(t, summary.LatestReportAt)
+	})
+
+	t.Run("RejectsUnknownInclude", func(t *testing.T) {
+		include := "report_summary,owners"
+		w := getComponent(t, "include-summary-service", &include)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, w.Body.String(), "invalid include value")
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		w := getComponent(t, "include-summary-missing", &reportSummary)
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestValidateManifest(t *testing.T) {
+	server := &APIServer{}
+	handler := Handler(server)
+	validate := func(t *testing.T, query string, body string) (*httptest.ResponseRecorder, ManifestValidation) {
+		req := httptest.NewRequest("POST", "/manifests/validate"+query, strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/yaml")
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, req)
+
+		var result ManifestValidation
+		if w.Code == http.StatusOK || w.Code == http.StatusUnprocessableEntity {
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &result))
+		}
+		return w, result
+	}
+
+	t.Run("Valid", func(t *testing.T) {
+		w, result := validate(t, "", `version: "v1"
+name: "auth-service"
+owners:
+  team: "platform"
+lifecycle: production
+`)
+		require.Equal(t, http.StatusOK, w.Code)
+		assert.True(t, result.Valid)
+		assert.Empty(t, result.Errors)
+		require.NotNil(t, result.Component)
+		assert.Equal(t, "auth-service", *result.Component.Id)
+		assert.Equal(t, "auth-service", result.Component.Name)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		w, result := validate(t, "", `version: "v2"
+description: "missing a name"
+`)
+		require.Equal(t, http.StatusUnprocessableEntity, w.Code)
+		assert.False(t, result.Valid)
+		assert.Nil(t, result.Component)
+		require.Len(t, result.Errors, 2)
+		assert.Equal(t, "version", *result.Errors[0].Field)
+		assert.Equal(t, 1, *result.Errors[0].Line)
+		assert.Equal(t, "name", *result.Errors[1].Field)
+		assert.Nil(t, result.Errors[1].Line)
+	})
+
+	t.Run("SyntaxError", func(t *testing.T) {
+		w, result := validate(t, "", "version: \"v1\"\nname: [unclosed\n")
+		require.Equal(t, http.StatusUnprocessableEntity, w.Code)
+		require.Len(t, result.Errors, 1)
+		assert.NotNil(t, result.Errors[0].Line)
+	})
+
+	t.Run("UnknownFieldOnlyInStrictMode", func(t *testing.T) {
+		manifest := "version: \"v1\"\nname: \"auth-service\"\nowner: \"alice\"\n"
+
+		w, _ := validate(t, "", manifest)
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		w, result := validate(t, "?strict=true", manifest)
+		require.Equal(t, http.StatusUnprocessableEntity, w.Code)
+		require.Len(t, result.Errors, 1)
+		assert.Equal(t, "owner", *result.Errors[0].Field)
+		assert.Equal(t, 3, *result.Errors[0].Line)
+	})
+
+	t.Run("TooLarge", func(t *testing.T) {
+		w, _ := validate(t, "", strings.Repeat("#", maxManifestSize+1))
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestCountComponents(t *testing.T) {
+	repo, server := setupTestEnvironment(t)
+	defer cleanupTestEnvironment(t, repo)
+
+	for _, component := range []storage.Component{
+		{ComponentID: "count-auth", Name: "Auth", Team: "platform", Labels: storage.StringMap{"tier": "critical"}},
+		{ComponentID: "count-docs", Name: "Docs", Team: "platform", Labels: storage.StringMap{"tier": "low"}},
+		{ComponentID: "count-billing", Name: "Billing", Team: "payments", Labels: storage.StringMap{"tier": "critical"}},
+	} {
+		require.NoError(t, repo.CreateComponent(context.Background(), component))
+	}
+
+	handler := Handler(server)
+	count := func(t *testing.T, query string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest("GET", "/components/count?"+query, nil)
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, req)
+		return w
+	}
+
+	tests := []struct {
+		query string
+		want  int64
+	}{
+		{"", 3},
+		{"team=platform", 2},
+		{"label=tier%3Dcritical", 2},
+		{"team=platform&label=tier=critical", 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			w := count(t, tt.query)
+			require.Equal(t, http.StatusOK, w.Code)
+			var response ComponentCount
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+			assert.Equal(t, tt.want, response.Count)
+		})
+	}
+
+	t.Run("InvalidLabel", func(t *testing.T) {
+		w := count(t, "label=tier")
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, w.Body.String(), `label \"tier\" must be in the form key=value`)
+	})
+
+	t.Run("ConflictingLabels", func(t *testing.T) {
+		w := count(t, "label=tier=critical&label=tier=low")
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("EmptyTeam", func(t *testing.T) {
+		w := count(t, "team=%20")
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestGetLatestReports(t *testing.T) {
+	repo, server := setupTestEnvironment(t)
+	defer cleanupTestEnvironment(t, repo)
+
+	component, check, older := createTestData(t, repo)
+	other := storage.Component{ComponentID: "other-component", Name: "Other Component", Team: "payments"}
+	require.NoError(t, repo.DB.Create(&other).Error)
+	newer := storage.CheckReport{CheckID: check.ID, ComponentID: component.ID, Status: storage.CheckStatusFail, Timestamp: older.Timestamp.Add(time.Minute)}
+	require.NoError(t, repo.DB.Create(&newer).Error)
+	otherReport := storage.CheckReport{CheckID: check.ID, ComponentID: other.ID, Status: storage.CheckStatusPass, Timestamp: older.Timestamp}
+	require.NoError(t, repo.DB.Create(&otherReport).Error)
+
+	handler := Handler(server)
+	get := func(t *testing.T, query string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest("GET", "/reports/latest?"+query, nil)
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, req)
+		return w
+	}
+
+	t.Run("AllPairs", func(t *testing.T) {
+		w := get(t, "")
+		require.Equal(t, http.StatusOK, w.Code)
+		var response LatestReportsResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		require.Len(t, response.Reports, 2)
+		assert.Equal(t, 2, response.Pagination.Total)
+		assert.Equal(t, "other-component", response.Reports[0].ComponentId)
+		assert.Equal(t, "test-component", response.Reports[1].ComponentId)
+		assert.Equal(t, newer.ID.String(), response.Reports[1].Id)
+		assert.Equal(t, LatestReportStatusFail, response.Reports[1].Status)
+		assert.Equal(t, "unit-tests", response.Reports[1].CheckSlug)
+	})
+
+	t.Run("Filters", func(t *testing.T) {
+		w := get(t, "status=pass&check_slug=unit-tests&team=payments")
+		require.Equal(t, http.StatusOK, w.Code)
+		var response LatestReportsResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		require.Len(t, response.Reports, 1)
+		assert.Equal(t, otherReport.ID.String(), response.Reports[0].Id)
+	})
+
+	t.Run("InvalidStatus", func(t *testing.T) {
+		w := get(t, "status=broken")
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("EmptyTeam", func(t *testing.T) {
+		w := get(t, "team=%20")
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestGetTeamReports(t *testing.T) {
+	repo, server := setupTestEnvironment(t)
+	defer cleanupTestEnvironment(t, repo)
+
+	component, check, older := createTestData(t, repo)
+	component.Team = "platform"
+	require.NoError(t, repo.DB.Save(component).Error)
+	quiet := storage.Component{ComponentID: "quiet-component", Name: "Quiet Component", Team: "platform"}
+	require.NoError(t, repo.DB.Create(&quiet).Error)
+	other := storage.Component{ComponentID: "other-component", Name: "Other Component", Team: "payments"}
+	require.NoError(t, repo.DB.Create(&other).Error)
+	newer := storage.CheckReport{CheckID: check.ID, ComponentID: component.ID, Status: storage.CheckStatusFail, Timestamp: older.Timestamp.Add(time.Minute)}
+	require.NoError(t, repo.DB.Create(&newer).Error)
+	otherReport := storage.CheckReport{CheckID: check.ID, ComponentID: other.ID, Status: storage.CheckStatusPass, Timestamp: older.Timestamp}
+	require.NoError(t, repo.DB.Create(&otherReport).Error)
+
+	handler := Handler(server)
+	get := func(t *testing.T, path string) (*httptest.ResponseRecorder, TeamReportsResponse) {
+		req := httptest.NewRequest("GET", path, nil)
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, req)
+		var response TeamReportsResponse
+		if w.Code == http.StatusOK {
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		}
+		return w, response
+	}
+
+	t.Run("LatestReportsOfTeamComponents", func(t *testing.T) {
+		w, response := get(t, "/teams/platform/reports")
+		require.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "platform", response.Team)
+		assert.Equal(t, []string{"quiet-component", "test-component"}, response.Components)
+		require.Len(t, response.Reports, 1)
+		assert.Equal(t, newer.ID.String(), response.Reports[0].Id)
+		assert.Equal(t, "test-component", response.Reports[0].ComponentId)
+		assert.Equal(t, LatestReportStatusFail, response.Reports[0].Status)
+	})
+
+	t.Run("CheckSlug", func(t *testing.T) {
+		w, response := get(t, "/teams/payments/reports?check_slug=lint")
+		require.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, []string{"other-component"}, response.Components)
+		assert.Empty(t, response.Reports)
+	})
+
+	t.Run("TeamWithoutComponents", func(t *testing.T) {
+		w, response := get(t, "/teams/unknown/reports")
+		require.Equal(t, http.StatusOK, w.Code)
+		assert.JSONEq(t, `{"team":"unknown","components":[],"reports":[],"pagination":{"total":0,"limit":50,"offset":0,"has_more":false,"next_cursor":null}}`, w.Body.String())
+		assert.Empty(t, response.Reports)
+	})
+
+	t.Run("EveryReport", func(t *testing.T) {
+		w, response := get(t, "/teams/platform/reports?latest_per_check=false")
+		require.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, 2, response.Pagination.Total)
+		require.Len(t, response.Reports, 2)
+		assert.Equal(t, newer.ID.String(), response.Reports[0].Id)
+		assert.Equal(t, older.ID.String(), response.Reports[1].Id)
+
+		w, response = get(t, "/teams/platform/reports?latest_per_check=false&limit=1&offset=1")
+		require.Equal(t, http.StatusOK, w.Code)
+		assert.False(t, response.Pagination.HasMore)
+		require.Len(t, response.Reports, 1)
+		assert.Equal(t, older.ID.String(), response.Reports[0].Id)
+	})
+
+	t.Run("LatestPaginated", func(t *testing.T) {
+		lint := storage.Check{Slug: "lint", Name: "Lint"}
+		require.NoError(t, repo.DB.Create(&lint).Error)
+		lintReport := storage.CheckReport{CheckID: lint.ID, ComponentID: quiet.ID, Status: storage.CheckStatusPass, Timestamp: older.Timestamp}
+		require.NoError(t, repo.DB.Create(&lintReport).Error)
+
+		w, response := get(t, "/teams/platform/reports?latest_per_check=true&limit=1")
+		require.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, 2, response.Pagination.Total)
+		assert.True(t, response.Pagination.HasMore)
+		require.Len(t, response.Reports, 1)
+		assert.Equal(t, "quiet-component", response.Reports[0].ComponentId)
+
+		w, response = get(t, "/teams/platform/reports?limit=1&offset=5")
+		require.Equal(t, http.StatusOK, w.Code)
+		assert.Empty(t, response.Reports)
+	})
+
+	t.Run("EmptyTeam", func(t *testing.T) {
+		w, _ := get(t, "/teams/%20/reports")
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestGetComponentReportsTimeSeries(t *testing.T) {
+	repo, server := setupTestEnvironment(t)
+	defer cleanupTestEnvironment(t, repo)
+
+	component, check, _ := createTestData(t, repo)
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+	failed := storage.CheckReport{CheckID: check.ID, ComponentID: component.ID, Status: storage.CheckStatusFail, Timestamp: today.Add(-24 * time.Hour)}
+	require.NoError(t, repo.DB.Create(&failed).Error)
+
+	handler := Handler(server)
+	get := func(t *testing.T, path string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest("GET", path, nil)
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, req)
+		return w
+	}
+
+	t.Run("DefaultRange", func(t *testing.T) {
+		w := get(t, "/components/test-component/reports/timeseries?check_slug=unit-tests")
+		require.Equal(t, http.StatusOK, w.Code)
+		var response ReportTimeSeries
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.Equal(t, "1d", response.Bucket)
+		assert.Equal(t, "unit-tests", response.CheckSlug)
+		require.Len(t, response.Buckets, 30)
+		last := response.Buckets[29]
+		assert.True(t, today.Equal(last.Start))
+		assert.Equal(t, 1, last.StatusCounts.Pass)
+		assert.Equal(t, 1, response.Buckets[28].StatusCounts.Fail)
+		assert.Equal(t, CheckStatusCounts{}, response.Buckets[0].StatusCounts)
+	})
+
+	t.Run("Since", func(t *testing.T) {
+		since := today.Add(-2 * time.Hour).Format(time.RFC3339)
+		w := get(t, "/components/test-component/reports/timeseries?check_slug=unit-tests&bucket=1h&since="+url.QueryEscape(since))
+		require.Equal(t, http.StatusOK, w.Code)
+		var response ReportTimeSeries
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.Equal(t, "1h", response.Bucket)
+		assert.GreaterOrEqual(t, len(response.Buckets), 3)
+	})
+
+	t.Run("Errors", func(t *testing.T) {
+		tests := []struct {
+			name string
+			path string
+			code int
+		}{
+			{"MissingCheck", "/components/test-component/reports/timeseries", http.StatusBadRequest},
+			{"UnknownBucket", "/components/test-component/reports/timeseries?check_slug=unit-tests&bucket=1w", http.StatusBadRequest},
+			{"FutureSince", "/components/test-component/reports/timeseries?check_slug=unit-tests&since=2999-01-01T00:00:00Z", http.StatusBadRequest},
+			{"TooManyBuckets", "/components/test-component/reports/timeseries?check_slug=unit-tests&bucket=1h&since=2000-01-01T00:00:00Z", http.StatusBadRequest},
+			{"UnknownComponent", "/components/missing/reports/timeseries?check_slug=unit-tests", http.StatusNotFound},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				assert.Equal(t, tt.code, get(t, tt.path).Code)
+			})
+		}
+	})
+}
+
+func TestGetComponentDependencies(t *testing.T) {
+	repo, server := setupTestEnvironment(t)
+	defer cleanupTestEnvironment(t, repo)
+	require.NoError(t, repo.DB.AutoMigrate(&storage.ComponentDependency{}))
+
+	ctx := t.Context()
+	require.NoError(t, repo.CreateComponent(ctx, storage.Component{
+		ComponentID: "auth-service",
+		Name:        "Auth",
+		DependsOn:   storage.StringArray{"user-db", "session-cache"},
+	}))
+	require.NoError(t, repo.CreateComponent(ctx, storage.Component{ComponentID: "user-db", Name: "Users"}))
+
+	handler := Handler(server)
+	get := func(t *testing.T, path string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest("GET", path, nil)
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, req)
+		return w
+	}
+
+	t.Run("Dependencies", func(t *testing.T) {
+		w := get(t, "/components/auth-service/dependencies")
+		require.Equal(t, http.StatusOK, w.Code)
+		var response ComponentDependenciesResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		require.Len(t, response.Dependencies, 2)
+		assert.Equal(t, "session-cache", response.Dependencies[0].ComponentId)
+		assert.True(t, response.Dependencies[0].Dangling)
+		assert.Nil(t, response.Dependencies[0].Component)
+		assert.Equal(t, "user-db", response.Dependencies[1].ComponentId)
+		assert.False(t, response.Dependencies[1].Dangling)
+		require.NotNil(t, response.Dependencies[1].Component)
+		assert.Equal(t, "Users", response.Dependencies[1].Component.Name)
+	})
+
+	t.Run("Dependents", func(t *testing.T) {
+		w := get(t, "/components/user-db/dependents")
+		require.Equal(t, http.StatusOK, w.Code)
+		var response ComponentDependentsResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		require.Len(t, response.Dependents, 1)
+		assert.Equal(t, "Auth", response.Dependents[0].Name)
+		require.NotNil(t, response.Dependents[0].DependsOn)
+		assert.Equal(t, []string{"user-db", "session-cache"}, *response.Dependents[0].DependsOn)
+	})
+
+	t.Run("NoDependencies", func(t *testing.T) {
+		w := get(t, "/components/user-db/dependencies")
+		require.Equal(t, http.StatusOK, w.Code)
+		assert.JSONEq(t, `{"dependencies":[]}`, w.Body.String())
+	})
+
+	t.Run("ComponentNotFound", func(t *testing.T) {
+		assert.Equal(t, http.StatusNotFound, get(t, "/components/missing-service/dependencies").Code)
+		assert.Equal(t, http.StatusNotFound, get(t, "/components/missing-service/dependents").Code)
+	})
+}
+
+func TestGetComponentImpact(t *testing.T) {
+	repo, server := setupTestEnvironment(t)
+	defer cleanupTestEnvironment(t, repo)
+	require.NoError(t, repo.DB.AutoMigrate(&storage.ComponentDependency{}))
+
+	ctx := t.Context()
+	require.NoError(t, repo.CreateComponent(ctx, storage.Component{ComponentID: "user-db", Name: "Users"}))
+	require.NoError(t, repo.CreateComponent(ctx, storage.Component{ComponentID: "auth-service", Name: "Auth", DependsOn: storage.StringArray{"user-db"}}))
+	require.NoError(t, repo.CreateComponent(ctx, storage.Component{ComponentID: "gateway", Name: "Gateway", DependsOn: storage.StringArray{"auth-service"}}))
+	_, err := repo.CreateCheckReportFromSubmission(ctx, storage.CreateCheckReportInput{
+		ComponentID: "auth-service",
+		CheckSlug:   "unit-tests",
+		Status:      storage.CheckStatusPass,
+		Timestamp:   time.Now(),
+	})
+	require.NoError(t, err)
+
+	handler := Handler(server)
+	get := func(t *testing.T, path string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest("GET", path, nil)
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, req)
+		return w
+	}
+
+	t.Run("DefaultMaxDepth", func(t *testing.T) {
+		w := get(t, "/components/user-db/impact")
+		require.Equal(t, http.StatusOK, w.Code)
+		var response ComponentImpactResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.Equal(t, 5, response.MaxDepth)
+		require.Len(t, response.Components, 2)
+		assert.Equal(t, 1, response.Components[0].Depth)
+		assert.Equal(t, "Auth", response.Components[0].Component.Name)
+		require.NotNil(t, response.Components[0].Component.ReportSummary)
+		assert.Equal(t, 1, response.Components[0].Component.ReportSummary.StatusCounts.Pass)
+		assert.Equal(t, 2, response.Components[1].Depth)
+		assert.Equal(t, "Gateway", response.Components[1].Component.Name)
+		require.NotNil(t, response.Components[1].Component.ReportSummary)
+		assert.Equal(t, 0, response.Components[1].Component.ReportSummary.TotalChecks)
+	})
+
+	t.Run("MaxDepth", func(t *testing.T) {
+		w := get(t, "/components/user-db/impact?max_depth=1")
+		require.Equal(t, http.StatusOK, w.Code)
+		var response ComponentImpactResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		require.Len(t, response.Components, 1)
+		assert.Equal(t, "Auth", response.Components[0].Component.Name)
+	})
+
+	t.Run("InvalidMaxDepth", func(t *testing.T) {
+		assert.Equal(t, http.StatusBadRequest, get(t, "/components/user-db/impact?max_depth=0").Code)
+		assert.Equal(t, http.StatusBadRequest, get(t, "/components/user-db/impact?max_depth=11").Code)
+	})
+
+	t.Run("ComponentNotFound", func(t *testing.T) {
+		assert.Equal(t, http.StatusNotFound, get(t, "/components/missing-service/impact").Code)
+	})
+}
+
+func TestGetComponentMissingChecks(t *testing.T) {
+	repo, server := setupTestEnvironment(t)
+	defer cleanupTestEnvironment(t, repo)
+
+	component, check, _ := createTestData(t, repo)
+	require.NoError(t, repo.DB.Model(component).Update("expected_checks", storage.StringArray{"unit-tests", "security-scan"}).Error)
+	stale := storage.CheckReport{CheckID: check.ID, ComponentID: component.ID, Status: storage.CheckStatusPass, Timestamp: time.Now().Add(-72 * time.Hour)}
+	require.NoError(t, repo.DB.Create(&stale).Error)
+
+	handler := Handler(server)
+	get := func(t *testing.T, path string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest("GET", path, nil)
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, req)
+		return w
+	}
+
+	t.Run("DefaultMaxAge", func(t *testing.T) {
+		w := get(t, "/components/test-component/checks/missing")
+		require.Equal(t, http.StatusOK, w.Code)
+		var response MissingChecksResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.Equal(t, "24h0m0s", response.MaxAge)
+		require.Len(t, response.Checks, 1)
+		assert.Equal(t, "security-scan", response.Checks[0].Slug)
+		assert.Nil(t, response.Checks[0].LatestReportAt)
+	})
+
+	t.Run("ShortMaxAge", func(t *testing.T) {
+		// The latest unit-tests report was created by createTestData just now
+		w := get(t, "/components/test-component/checks/missing?max_age=1ns")
+		require.Equal(t, http.StatusOK, w.Code)
+		var response MissingChecksResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		require.Len(t, response.Checks, 2)
+		assert.Equal(t, "unit-tests", response.Checks[0].Slug)
+		assert.NotNil(t, response.Checks[0].LatestReportAt)
+	})
+
+	t.Run("InvalidMaxAge", func(t *testing.T) {
+		assert.Equal(t, http.StatusBadRequest, get(t, "/components/test-component/checks/missing?max_age=soon").Code)
+		assert.Equal(t, http.StatusBadRequest, get(t, "/components/test-component/checks/missing?max_age=-1h").Code)
+	})
+
+	t.Run("UnknownComponent", func(t *testing.T) {
+		assert.Equal(t, http.StatusNotFound, get(t, "/components/missing/checks/missing").Code)
+	})
+}
+
+func TestGetComponentStaleChecks(t *testing.T) {
+	repo, server := setupTestEnvironment(t)
+	defer cleanupTestEnvironment(t, repo)
+
+	component, _, _ := createTestData(t, repo)
+	require.NoError(t, repo.DB.Model(component).Update("expected_checks", storage.StringArray{"security-scan"}).Error)
+	lint := storage.Check{Slug: "lint", Name: "Lint"}
+	require.NoError(t, repo.DB.Create(&lint).Error)
+	old := storage.CheckReport{CheckID: lint.ID, ComponentID: component.ID, Status: storage.CheckStatusPass, Timestamp: time.Now().Add(-72 * time.Hour)}
+	require.NoError(t, repo.DB.Create(&old).Error)
+
+	handler := Handler(server)
+	get := func(t *testing.T, path string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest("GET", path, nil)
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, req)
+		return w
+	}
+
+	t.Run("DefaultMaxAge", func(t *testing.T) {
+		w := get(t, "/components/test-component/reports/stale")
+		require.Equal(t, http.StatusOK, w.Code)
+		var response StaleChecksResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.Equal(t, "24h0m0s", response.MaxAge)
+		require.Len(t, response.Checks, 2)
+		assert.Equal(t, "lint", response.Checks[0].Slug)
+		assert.False(t, response.Checks[0].Expected)
+		assert.NotNil(t, response.Checks[0].LatestReportAt)
+		assert.Equal(t, "security-scan", response.Checks[1].Slug)
+		assert.True(t, response.Checks[1].Expected)
+		assert.Nil(t, response.Checks[1].LatestReportAt)
+	})
+
+	t.Run("AllFresh", func(t *testing.T) {
+		require.NoError(t, repo.DB.Model(component).Update("expected_checks", nil).Error)
+		w := get(t, "/components/test-component/reports/stale?max_age=168h")
+		require.Equal(t, http.StatusOK, w.Code)
+		var response StaleChecksResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.Empty(t, response.Checks)
+		assert.Contains(t, w.Body.String(), `"checks":[]`)
+	})
+
+	t.Run("InvalidMaxAge", func(t *testing.T) {
+		assert.Equal(t, http.StatusBadRequest, get(t, "/components/test-component/reports/stale?max_age=0s").Code)
+	})
+
+	t.Run("UnknownComponent", func(t *testing.T) {
+		assert.Equal(t, http.StatusNotFound, get(t, "/components/missing/reports/stale").Code)
+	})
+}
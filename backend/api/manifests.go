@@ -0,0 +1,72 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/doron-cohen/argus/backend/internal/models"
+	"github.com/doron-cohen/argus/backend/internal/storage"
+)
+
+// maxManifestSize bounds the manifests accepted for validation
+const maxManifestSize = 1 << 20
+
+// ValidateManifest parses and validates a manifest sent as the request body, answering 200 with
+// the component it describes or 422 with every problem found. Nothing is stored.
+func (s *APIServer) ValidateManifest(w http.ResponseWriter, r *http.Request, params ValidateManifestParams) {
+	content, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxManifestSize))
+	if err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			s.writeValidationError(w, "manifest cannot exceed 1 MiB")
+			return
+		}
+		s.writeValidationError(w, "failed to read manifest")
+		return
+	}
+
+	parser := models.NewParser(models.WithStrictFields(params.Strict != nil && *params.Strict))
+	manifest, problems := parser.Check(content)
+
+	result := ManifestValidation{Valid: len(problems) == 0, Errors: make([]ManifestError, 0, len(problems))}
+	for _, problem := range problems {
+		apiError := ManifestError{Message: problem.Message}
+		if problem.Field != "" {
+			field := problem.Field
+			apiError.Field = &field
+		}
+		if problem.Line > 0 {
+			line := problem.Line
+			apiError.Line = &line
+		}
+		result.Errors = append(result.Errors, apiError)
+	}
+
+	if !result.Valid {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		if err := json.NewEncoder(w).Encode(result); err != nil {
+			http.Error(w, "failed to encode response", http.StatusInternalServerError)
+		}
+		return
+	}
+
+	// Shown as sync would store it, identified by its id or, without one, its name
+	component := manifest.ToComponent()
+	apiComponent := s.convertToAPIComponent(&storage.Component{
+		ComponentID: component.GetIdentifier(),
+		Name:        component.Name,
+		Description: component.Description,
+		Maintainers: storage.StringArray(component.Owners.Maintainers),
+		Team:        component.Owners.Team,
+		Labels:      storage.StringMap(component.Labels),
+		Lifecycle:   component.Lifecycle,
+
+		ExpectedChecks: storage.StringArray(component.Checks),
+		DependsOn:      storage.StringArray(component.DependsOn),
+	})
+	result.Component = &apiComponent
+	s.writeJSONResponse(w, result)
+}
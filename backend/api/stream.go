@@ -0,0 +1,100 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/doron-cohen/argus/backend/internal/requestlog"
+	"github.com/doron-cohen/argus/backend/internal/storage"
+)
+
+// ndjsonMediaType is the content type of streamed reports, one JSON object per line
+const ndjsonMediaType = "application/x-ndjson"
+
+// streamFlushInterval is how many reports are written between flushes to the client
+const streamFlushInterval = 100
+
+// StreamComponentReports writes every matching report of a component as newline-delimited JSON.
+// Reports are read from the database one at a time and flushed to the client as they go, so the
+// export is never held in memory. A client that disconnects cancels the query.
+func (s *APIServer) StreamComponentReports(w http.ResponseWriter, r *http.Request, componentId string, params StreamComponentReportsParams) {
+	ctx := r.Context()
+
+	var statuses []storage.CheckStatus
+	if params.Status != nil {
+		for _, apiStatus := range *params.Status {
+			status, err := s.convertAPISStatusToStorageStatus(GetComponentReportsParamsStatus(apiStatus))
+			if err != nil {
+				s.writeValidationError(w, fmt.Sprintf("invalid status parameter: %v", apiStatus))
+				return
+			}
+			statuses = append(statuses, *status)
+		}
+	}
+
+	// Empty slugs are ignored, as in GetComponentReports
+	var checkSlugs []string
+	if params.CheckSlug != nil {
+		for _, slug := range *params.CheckSlug {
+			if slug != "" {
+				checkSlugs = append(checkSlugs, slug)
+			}
+		}
+	}
+
+	if params.Since != nil && params.Before != nil && params.Since.After(*params.Before) {
+		s.writeValidationError(w, "since must not be after before")
+		return
+	}
+
+	// Headers are sent with the first report, so failures before it still get an error response
+	started := false
+	encoder := json.NewEncoder(w)
+	flusher, _ := w.(http.Flusher)
+	count := 0
+	err := s.Repo.StreamCheckReportsForComponent(ctx, componentId, statuses, checkSlugs, params.Since, params.Before, func(report storage.StreamedReport) error {
+		if !started {
+			w.Header().Set("Content-Type", ndjsonMediaType)
+			w.WriteHeader(http.StatusOK)
+			started = true
+		}
+
+		apiReport := s.convertToAPICheckReport(storage.CheckReport{
+			ID:         report.ID,
+			Status:     report.Status,
+			Timestamp:  report.Timestamp,
+			StartedAt:  report.StartedAt,
+			DurationMs: report.DurationMs,
+			Check:      storage.Check{Slug: report.CheckSlug},
+		})
+		if err := encoder.Encode(apiReport); err != nil {
+			return err
+		}
+
+		count++
+		if flusher != nil && count%streamFlushInterval == 0 {
+			flusher.Flush()
+		}
+		return nil
+	})
+
+	switch {
+	case err == nil && !started:
+		// No reports match: an empty stream
+		w.Header().Set("Content-Type", ndjsonMediaType)
+		w.WriteHeader(http.StatusOK)
+	case err == nil:
+	case errors.Is(err, storage.ErrComponentNotFound):
+		s.writeNotFoundError(w)
+	case !started:
+		http.Error(w, "failed to stream component reports", http.StatusInternalServerError)
+	case errors.Is(err, context.Canceled):
+		// The client went away mid-stream, nothing left to tell it
+	default:
+		// The status is already sent, so the client sees a truncated stream
+		requestlog.Logger(ctx).Warn("Failed to stream component reports", "component_id", componentId, "reports_written", count, "error", err)
+	}
+}
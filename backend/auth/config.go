@@ -0,0 +1,58 @@
+package auth
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Config holds API authentication configuration
+type Config struct {
+	// APIKeys are the keys accepted by the reports API. When empty, the reports API
+	// is open to anyone who can reach the server.
+	APIKeys []APIKey `yaml:"api_keys"`
+}
+
+// APIKey is a key accepted as "Authorization: Bearer <key>"
+type APIKey struct {
+	Name              string   `yaml:"name"`                         // Identifies the key in logs
+	Key               string   `yaml:"key"`                          // The secret value
+	ComponentPrefixes []string `yaml:"component_prefixes,omitempty"` // Restrict the key to components with these identifier prefixes; empty allows all
+}
+
+// Enabled reports whether any API keys are configured
+func (c Config) Enabled() bool {
+	return len(c.APIKeys) > 0
+}
+
+// Validate ensures every key has a value and keys are unique
+func (c Config) Validate() error {
+	seen := make(map[string]bool, len(c.APIKeys))
+	for i, apiKey := range c.APIKeys {
+		if strings.TrimSpace(apiKey.Key) == "" {
+			return fmt.Errorf("api key %d (%q) has an empty key", i, apiKey.Name)
+		}
+		if seen[apiKey.Key] {
+			return fmt.Errorf("api key %d (%q) duplicates another key", i, apiKey.Name)
+		}
+		seen[apiKey.Key] = true
+		for _, prefix := range apiKey.ComponentPrefixes {
+			if prefix == "" {
+				return fmt.Errorf("api key %d (%q) has an empty component prefix", i, apiKey.Name)
+			}
+		}
+	}
+	return nil
+}
+
+// Allows reports whether the key may submit reports for the component
+func (k APIKey) Allows(componentID string) bool {
+	if len(k.ComponentPrefixes) == 0 {
+		return true
+	}
+	for _, prefix := range k.ComponentPrefixes {
+		if strings.HasPrefix(componentID, prefix) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,46 @@
+package auth
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name        string
+		config      Config
+		expectError bool
+	}{
+		{name: "no keys", config: Config{}},
+		{name: "valid keys", config: Config{APIKeys: []APIKey{
+			{Name: "ci", Key: "ci-secret"},
+			{Name: "payments", Key: "payments-secret", ComponentPrefixes: []string{"payments-"}},
+		}}},
+		{name: "empty key", config: Config{APIKeys: []APIKey{{Name: "ci", Key: " "}}}, expectError: true},
+		{name: "duplicate key", config: Config{APIKeys: []APIKey{{Name: "a", Key: "secret"}, {Name: "b", Key: "secret"}}}, expectError: true},
+		{name: "empty prefix", config: Config{APIKeys: []APIKey{{Name: "a", Key: "secret", ComponentPrefixes: []string{""}}}}, expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.config.Validate()
+			if tt.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestAPIKey_Allows(t *testing.T) {
+	unscoped := APIKey{Key: "secret"}
+	assert.True(t, unscoped.Allows("anything"))
+
+	scoped := APIKey{Key: "secret", ComponentPrefixes: []string{"payments-", "billing-"}}
+	assert.True(t, scoped.Allows("payments-api"))
+	assert.True(t, scoped.Allows("billing-worker"))
+	assert.False(t, scoped.Allows("search-api"))
+	assert.False(t, scoped.Allows("my-payments-api"))
+}
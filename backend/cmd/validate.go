@@ -0,0 +1,86 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/doron-cohen/argus/backend/internal/config"
+	"github.com/doron-cohen/argus/backend/internal/storage"
+)
+
+// Exit codes of the validate-config subcommand
+const (
+	exitValid   = 0
+	exitInvalid = 1
+	exitUsage   = 2
+)
+
+// validateConfig runs the validate-config subcommand: it loads a config file the way the
+// server does, environment overrides included, and reports every problem found. With
+// --check-storage it also connects to the database. It returns the exit code.
+func validateConfig(args []string, stdout, stderr io.Writer) int {
+	flags := flag.NewFlagSet("validate-config", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	path := flags.String("path", config.ConfigPath(), "config file to validate")
+	checkStorage := flags.Bool("check-storage", false, "also check that the database is reachable")
+	timeout := flags.Duration("timeout", 5*time.Second, "how long to wait for the database with --check-storage")
+	if err := flags.Parse(args); err != nil {
+		return exitUsage
+	}
+	if flags.NArg() > 0 {
+		_, _ = fmt.Fprintf(stderr, "unexpected arguments: %v\n", flags.Args())
+		return exitUsage
+	}
+
+	cfg, err := config.LoadConfigFile(*path)
+	problems := splitErrors(err)
+	if err == nil {
+		problems = splitErrors(cfg.Validate())
+	}
+	if len(problems) == 0 && *checkStorage {
+		ctx, cancel := context.WithTimeout(context.Background(), *timeout)
+		defer cancel()
+		if err := storage.Ping(ctx, cfg.Storage); err != nil {
+			problems = append(problems, fmt.Errorf("cannot connect to storage at %s:%d: %w", cfg.Storage.Host, cfg.Storage.Port, err))
+		}
+	}
+
+	if len(problems) > 0 {
+		_, _ = fmt.Fprintf(stdout, "%s is invalid:\n", *path)
+		for _, problem := range problems {
+			_, _ = fmt.Fprintf(stdout, "  - %v\n", problem)
+		}
+		return exitInvalid
+	}
+
+	_, _ = fmt.Fprintf(stdout, "%s is valid\n", *path)
+	storageState := "not checked"
+	if *checkStorage {
+		storageState = "reachable"
+	}
+	_, _ = fmt.Fprintf(stdout, "  storage: %s:%d/%s (%s)\n", cfg.Storage.Host, cfg.Storage.Port, cfg.Storage.DBName, storageState)
+	_, _ = fmt.Fprintf(stdout, "  sync sources: %d\n", len(cfg.Sync.Sources))
+	for i, source := range cfg.Sync.Sources {
+		sourceConfig := source.GetConfig()
+		state := ""
+		if !source.IsEnabled() {
+			state = ", disabled"
+		}
+		_, _ = fmt.Fprintf(stdout, "    %d: %s every %s%s\n", i, sourceConfig.GetSourceType(), sourceConfig.GetInterval(), state)
+	}
+	return exitValid
+}
+
+// splitErrors lists the problems joined into err, if any
+func splitErrors(err error) []error {
+	if err == nil {
+		return nil
+	}
+	if joined, ok := err.(interface{ Unwrap() []error }); ok {
+		return joined.Unwrap()
+	}
+	return []error{err}
+}
@@ -0,0 +1,95 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func runValidateConfig(t *testing.T, args ...string) (int, string, string) {
+	t.Helper()
+	var stdout, stderr bytes.Buffer
+	code := validateConfig(args, &stdout, &stderr)
+	return code, stdout.String(), stderr.String()
+}
+
+func writeConfig(t *testing.T, name, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+	return path
+}
+
+func TestValidateConfig_Valid(t *testing.T) {
+	path := writeConfig(t, "config.yaml", `
+storage:
+  host: db.internal
+sync:
+  sources:
+    - type: git
+      url: "https://github.com/org/repo"
+      interval: "5m"
+    - type: filesystem
+      path: "/manifests"
+      interval: "30s"
+      enabled: false
+`)
+
+	code, stdout, _ := runValidateConfig(t, "--path", path)
+	assert.Equal(t, exitValid, code)
+	assert.Contains(t, stdout, path+" is valid")
+	assert.Contains(t, stdout, "storage: db.internal:5432/argus (not checked)")
+	assert.Contains(t, stdout, "0: git every 5m0s")
+	assert.Contains(t, stdout, "1: filesystem every 30s, disabled")
+}
+
+func TestValidateConfig_ReportsEveryProblem(t *testing.T) {
+	path := writeConfig(t, "config.yaml", `
+storage:
+  max_open_conns: 5
+  max_idle_conns: 10
+server:
+  cors:
+    allowed_origins: ["*"]
+    allow_credentials: true
+`)
+
+	code, stdout, _ := runValidateConfig(t, "--path", path)
+	assert.Equal(t, exitInvalid, code)
+	assert.Contains(t, stdout, path+" is invalid:")
+	assert.Contains(t, stdout, "  - invalid storage config: max_idle_conns (10) cannot exceed max_open_conns (5)")
+	assert.Contains(t, stdout, "  - invalid server cors config")
+}
+
+func TestValidateConfig_InvalidSource(t *testing.T) {
+	path := writeConfig(t, "config.toml", `
+[[sync.sources]]
+type = "git"
+url = "https://github.com/org/repo"
+interval = "5s"
+`)
+
+	code, stdout, _ := runValidateConfig(t, "--path", path)
+	assert.Equal(t, exitInvalid, code)
+	assert.Contains(t, stdout, "git source interval must be at least 10s")
+}
+
+func TestValidateConfig_MissingFile(t *testing.T) {
+	code, stdout, _ := runValidateConfig(t, "--path", filepath.Join(t.TempDir(), "missing.yaml"))
+	assert.Equal(t, exitInvalid, code)
+	assert.Contains(t, stdout, "failed to read config file")
+}
+
+func TestValidateConfig_Usage(t *testing.T) {
+	code, _, stderr := runValidateConfig(t, "--unknown")
+	assert.Equal(t, exitUsage, code)
+	assert.Contains(t, stderr, "flag provided but not defined")
+
+	code, _, stderr = runValidateConfig(t, "extra")
+	assert.Equal(t, exitUsage, code)
+	assert.Contains(t, stderr, "unexpected arguments")
+}
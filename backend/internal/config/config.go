@@ -1,19 +1,53 @@
 package config
 
 import (
+	"errors"
 	"fmt"
 	"os"
+	"path/filepath"
 	"strconv"
 	"strings"
 
+	"github.com/doron-cohen/argus/backend/admin"
+	"github.com/doron-cohen/argus/backend/api"
+	"github.com/doron-cohen/argus/backend/auth"
+	"github.com/doron-cohen/argus/backend/internal/cors"
+	"github.com/doron-cohen/argus/backend/internal/metrics"
+	"github.com/doron-cohen/argus/backend/internal/requestlog"
 	"github.com/doron-cohen/argus/backend/internal/storage"
+	"github.com/doron-cohen/argus/backend/internal/tracing"
+	"github.com/doron-cohen/argus/backend/internal/utils"
+	"github.com/doron-cohen/argus/backend/notifications"
+	"github.com/doron-cohen/argus/backend/reports"
 	"github.com/doron-cohen/argus/backend/sync"
 	"gopkg.in/yaml.v3"
 )
 
 type Config struct {
+	Server  ServerConfig   `yaml:"server"`
 	Storage storage.Config `yaml:"storage"`
 	Sync    sync.Config    `yaml:"sync"`
+	Reports reports.Config `yaml:"reports"`
+
+	API api.Config `yaml:"api"`
+
+	Notifications notifications.Config `yaml:"notifications"`
+
+	Admin admin.Config `yaml:"admin"`
+
+	Auth auth.Config `yaml:"auth"`
+
+	Metrics metrics.Config `yaml:"metrics"`
+
+	Tracing tracing.Config `yaml:"tracing"`
+}
+
+// ServerConfig holds configuration for the HTTP server
+type ServerConfig struct {
+	// CORS controls which browser origins may call the /api routes; disabled by default
+	CORS cors.Config `yaml:"cors"`
+	// RequestLog controls the log line written for every API request
+	RequestLog requestlog.Config `yaml:"request_log"`
 }
 
 // DefaultConfig returns a Config with sensible defaults
@@ -26,9 +60,46 @@ func DefaultConfig() Config {
 			Password: "postgres",
 			DBName:   "argus",
 			SSLMode:  "disable",
+
+			MaxOpenConns:    storage.DefaultMaxOpenConns,
+			MaxIdleConns:    storage.DefaultMaxIdleConns,
+			ConnMaxLifetime: storage.DefaultConnMaxLifetime,
 		},
 		Sync: sync.Config{
-			Sources: []sync.SourceConfig{},
+			Sources:      []sync.SourceConfig{},
+			Retries:      sync.DefaultRetries,
+			RetryBackoff: sync.DefaultRetryBackoff,
+			History:      true,
+		},
+		Reports: reports.Config{
+			AssumeUTC:       false,
+			MaxJSONBBytes:   utils.DefaultMaxJSONBBytes,
+			MaxNestingDepth: utils.DefaultMaxJSONBDepth,
+			MaxBodyBytes:    reports.DefaultMaxBodyBytes,
+			Async: reports.AsyncConfig{
+				Enabled:   false,
+				QueueSize: reports.DefaultAsyncQueueSize,
+				Workers:   reports.DefaultAsyncWorkers,
+			},
+			RetentionInterval: reports.DefaultRetentionInterval,
+		},
+		API: api.Config{
+			DefaultPageLimit: api.DefaultPageLimit,
+			MaxPageLimit:     api.DefaultMaxPageLimit,
+			MaxOffset:        api.DefaultMaxOffset,
+		},
+		Notifications: notifications.Config{
+			Delivery: notifications.DeliveryConfig{
+				MaxRetries:     notifications.DefaultMaxRetries,
+				InitialBackoff: notifications.DefaultInitialBackoff,
+				MaxBackoff:     notifications.DefaultMaxBackoff,
+				Timeout:        notifications.DefaultTimeout,
+				QueueSize:      notifications.DefaultQueueSize,
+				Workers:        notifications.DefaultWorkers,
+			},
+		},
+		Metrics: metrics.Config{
+			Enabled: true,
 		},
 	}
 }
@@ -38,30 +109,103 @@ func DefaultConfig() Config {
 // 2. Config file values (if file exists)
 // 3. Default values (lowest priority)
 func LoadConfig() (Config, error) {
-	// Start with defaults
-	cfg := DefaultConfig()
+	cfg, err := loadConfig(ConfigPath(), false)
+	if err != nil {
+		return cfg, err
+	}
+	return cfg, cfg.Validate()
+}
+
+// LoadConfigFile loads configuration like LoadConfig, but from the given file, which must
+// exist. The result is not validated, see Config.Validate.
+func LoadConfigFile(path string) (Config, error) {
+	return loadConfig(path, true)
+}
 
-	// Determine config file path
-	configPath := "config.yaml"
+// ConfigPath returns the config file LoadConfig reads: ARGUS_CONFIG_PATH, or config.yaml
+func ConfigPath() string {
 	if envPath := os.Getenv("ARGUS_CONFIG_PATH"); envPath != "" {
-		configPath = envPath
+		return envPath
 	}
+	return "config.yaml"
+}
+
+// loadConfig decodes the config file over the defaults and applies environment overrides.
+// A missing file leaves the defaults in place unless it is required.
+func loadConfig(configPath string, required bool) (Config, error) {
+	// Start with defaults
+	cfg := DefaultConfig()
 
-	// Try to load config file (optional)
 	if data, err := os.ReadFile(configPath); err == nil {
-		// Parse the YAML
-		if err := yaml.Unmarshal(data, &cfg); err != nil {
+		if err := decodeConfigFile(configPath, data, &cfg); err != nil {
 			return cfg, fmt.Errorf("failed to parse config file: %w", err)
 		}
-	} else if !os.IsNotExist(err) {
+	} else if required || !os.IsNotExist(err) {
 		// Only return error if it's not a "file not found" error
 		return cfg, fmt.Errorf("failed to read config file: %w", err)
 	}
 
 	// Override with environment variables
-	cfg = overrideWithEnvironment(cfg)
+	return overrideWithEnvironment(cfg), nil
+}
 
-	return cfg, nil
+// Validate checks every section of the configuration and returns all problems found,
+// joined into one error
+func (c Config) Validate() error {
+	var errs []error
+	if err := c.Storage.Validate(); err != nil {
+		errs = append(errs, fmt.Errorf("invalid storage config: %w", err))
+	}
+	if err := c.Server.RequestLog.Validate(); err != nil {
+		errs = append(errs, fmt.Errorf("invalid server request_log config: %w", err))
+	}
+	if err := c.Server.CORS.Validate(); err != nil {
+		errs = append(errs, fmt.Errorf("invalid server cors config: %w", err))
+	}
+	// Sources are validated as they are decoded; this catches configs built in code
+	for i, source := range c.Sync.Sources {
+		if sourceConfig := source.GetConfig(); sourceConfig != nil {
+			if err := sourceConfig.Validate(); err != nil {
+				errs = append(errs, fmt.Errorf("invalid sync source %d: %w", i, err))
+			}
+		}
+	}
+	if err := c.API.Validate(); err != nil {
+		errs = append(errs, fmt.Errorf("invalid api config: %w", err))
+	}
+	if err := c.Auth.Validate(); err != nil {
+		errs = append(errs, fmt.Errorf("invalid auth config: %w", err))
+	}
+	if err := c.Notifications.Validate(); err != nil {
+		errs = append(errs, fmt.Errorf("invalid notifications config: %w", err))
+	}
+	if err := c.Tracing.Validate(); err != nil {
+		errs = append(errs, fmt.Errorf("invalid tracing config: %w", err))
+	}
+	return errors.Join(errs...)
+}
+
+// decodeConfigFile decodes a config file over cfg, as TOML when the file has a .toml
+// extension and as YAML otherwise, after resolving ${NAME} references to environment variables
+func decodeConfigFile(path string, data []byte, cfg *Config) error {
+	node := &yaml.Node{}
+	if strings.EqualFold(filepath.Ext(path), ".toml") {
+		var err error
+		if node, err = parseTOML(data); err != nil {
+			return err
+		}
+	} else if err := yaml.Unmarshal(data, node); err != nil {
+		return err
+	}
+
+	// An empty file leaves the config as it is
+	if node.Kind == 0 {
+		return nil
+	}
+	if err := expandEnv(node); err != nil {
+		return err
+	}
+	return node.Decode(cfg)
 }
 
 // overrideWithEnvironment overrides config values with environment variables
@@ -88,6 +232,20 @@ func overrideWithEnvironment(cfg Config) Config {
 		cfg.Storage.SSLMode = val
 	}
 
+	// Admin configuration
+	if val := os.Getenv("ARGUS_ADMIN_TOKEN"); val != "" {
+		cfg.Admin.Token = val
+	}
+
+	// Auth configuration: a comma-separated list of unscoped API keys, added to the configured ones
+	if val := os.Getenv("ARGUS_AUTH_API_KEYS"); val != "" {
+		for _, key := range strings.Split(val, ",") {
+			if key = strings.TrimSpace(key); key != "" {
+				cfg.Auth.APIKeys = append(cfg.Auth.APIKeys, auth.APIKey{Name: "ARGUS_AUTH_API_KEYS", Key: key})
+			}
+		}
+	}
+
 	// Note: Sync sources are not overridden by environment variables
 	// as they require complex configuration that's better handled via config files
 
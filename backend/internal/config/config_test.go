@@ -2,9 +2,15 @@ package config
 
 import (
 	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
+	"github.com/doron-cohen/argus/backend/api"
+	"github.com/doron-cohen/argus/backend/internal/storage"
+	"github.com/doron-cohen/argus/backend/internal/utils"
+	"github.com/doron-cohen/argus/backend/notifications"
+	"github.com/doron-cohen/argus/backend/reports"
 	"github.com/doron-cohen/argus/backend/sync"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -21,9 +27,38 @@ func TestDefaultConfig(t *testing.T) {
 	assert.Equal(t, "postgres", cfg.Storage.Password)
 	assert.Equal(t, "argus", cfg.Storage.DBName)
 	assert.Equal(t, "disable", cfg.Storage.SSLMode)
+	assert.Equal(t, storage.DefaultMaxOpenConns, cfg.Storage.MaxOpenConns)
+	assert.Equal(t, storage.DefaultMaxIdleConns, cfg.Storage.MaxIdleConns)
+	assert.Equal(t, storage.DefaultConnMaxLifetime, cfg.Storage.ConnMaxLifetime)
 
 	// Verify sync defaults
 	assert.Len(t, cfg.Sync.Sources, 0)
+	assert.True(t, cfg.Sync.History)
+
+	// Verify reports defaults
+	assert.False(t, cfg.Reports.AssumeUTC)
+	assert.Equal(t, utils.DefaultMaxJSONBBytes, cfg.Reports.MaxJSONBBytes)
+	assert.Zero(t, cfg.Reports.MaxDetailsBytes)
+	assert.Equal(t, utils.DefaultMaxJSONBDepth, cfg.Reports.MaxNestingDepth)
+	assert.Equal(t, reports.DefaultMaxBodyBytes, cfg.Reports.MaxBodyBytes)
+	assert.False(t, cfg.Reports.Async.Enabled)
+	assert.Equal(t, reports.DefaultAsyncQueueSize, cfg.Reports.Async.QueueSize)
+	assert.Equal(t, reports.DefaultAsyncWorkers, cfg.Reports.Async.Workers)
+	assert.Zero(t, cfg.Reports.Retention)
+	assert.Equal(t, reports.DefaultRetentionInterval, cfg.Reports.RetentionInterval)
+
+	// Verify catalog API defaults
+	assert.Equal(t, api.DefaultPageLimit, cfg.API.DefaultPageLimit)
+	assert.Equal(t, api.DefaultMaxPageLimit, cfg.API.MaxPageLimit)
+	assert.Equal(t, api.DefaultMaxOffset, cfg.API.MaxOffset)
+
+	// Verify notification delivery defaults
+	assert.Equal(t, notifications.DefaultMaxRetries, cfg.Notifications.Delivery.MaxRetries)
+	assert.Equal(t, notifications.DefaultInitialBackoff, cfg.Notifications.Delivery.InitialBackoff)
+	assert.Equal(t, notifications.DefaultMaxBackoff, cfg.Notifications.Delivery.MaxBackoff)
+
+	// Verify metrics defaults
+	assert.True(t, cfg.Metrics.Enabled)
 }
 
 func TestLoadConfig_NoConfigFile(t *testing.T) {
@@ -59,6 +94,8 @@ func TestLoadConfig_EnvironmentVariableOverrides(t *testing.T) {
 		"ARGUS_STORAGE_PASSWORD": "env-pass",
 		"ARGUS_STORAGE_DBNAME":   "env-db",
 		"ARGUS_STORAGE_SSLMODE":  "require",
+		"ARGUS_ADMIN_TOKEN":      "env-admin-token",
+		"ARGUS_AUTH_API_KEYS":    "key-one, key-two",
 	}
 
 	for key, value := range envVars {
@@ -82,6 +119,11 @@ func TestLoadConfig_EnvironmentVariableOverrides(t *testing.T) {
 	assert.Equal(t, "env-pass", cfg.Storage.Password)
 	assert.Equal(t, "env-db", cfg.Storage.DBName)
 	assert.Equal(t, "require", cfg.Storage.SSLMode)
+	assert.Equal(t, "env-admin-token", cfg.Admin.Token)
+	require.Len(t, cfg.Auth.APIKeys, 2)
+	assert.Equal(t, "key-one", cfg.Auth.APIKeys[0].Key)
+	assert.Equal(t, "key-two", cfg.Auth.APIKeys[1].Key)
+	assert.Empty(t, cfg.Auth.APIKeys[0].ComponentPrefixes)
 }
 
 func TestLoadConfig_EnvironmentVariableOverridesConfigFile(t *testing.T) {
@@ -558,6 +600,184 @@ storage:
 	assert.Len(t, cfg.Sync.Sources, 0)
 }
 
+func TestLoadConfig_NotificationDelivery(t *testing.T) {
+	srcFile := "testdata/notifications.yaml"
+	dstFile := "test-config-notifications.yaml"
+
+	err := copyFile(srcFile, dstFile)
+	require.NoError(t, err)
+	defer func() {
+		if err := os.Remove(dstFile); err != nil {
+			t.Logf("Failed to remove test file: %v", err)
+		}
+	}()
+
+	err = os.Setenv("ARGUS_CONFIG_PATH", dstFile)
+	require.NoError(t, err)
+	defer func() {
+		if err := os.Unsetenv("ARGUS_CONFIG_PATH"); err != nil {
+			t.Logf("Failed to unset environment variable: %v", err)
+		}
+	}()
+
+	cfg, err := LoadConfig()
+	require.NoError(t, err)
+
+	// Configured values are parsed, including durations
+	assert.Equal(t, 5, cfg.Notifications.Delivery.MaxRetries)
+	assert.Equal(t, 500*time.Millisecond, cfg.Notifications.Delivery.InitialBackoff)
+	assert.Equal(t, time.Minute, cfg.Notifications.Delivery.MaxBackoff)
+
+	// Omitted values keep their defaults
+	assert.Equal(t, notifications.DefaultTimeout, cfg.Notifications.Delivery.Timeout)
+	assert.Equal(t, notifications.DefaultQueueSize, cfg.Notifications.Delivery.QueueSize)
+}
+
+func TestLoadConfig_ServerCORS(t *testing.T) {
+	load := func(t *testing.T, srcFile string) (Config, error) {
+		dstFile := filepath.Join(t.TempDir(), "config.yaml")
+		require.NoError(t, copyFile(srcFile, dstFile))
+		t.Setenv("ARGUS_CONFIG_PATH", dstFile)
+		return LoadConfig()
+	}
+
+	cfg, err := load(t, "testdata/cors.yaml")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"https://dashboard.example.com"}, cfg.Server.CORS.AllowedOrigins)
+	assert.Equal(t, []string{"GET", "POST"}, cfg.Server.CORS.AllowedMethods)
+	assert.True(t, cfg.Server.CORS.AllowCredentials)
+
+	_, err = load(t, "testdata/cors-invalid.yaml")
+	assert.ErrorContains(t, err, "invalid server cors config")
+
+	// CORS stays off unless configured
+	assert.False(t, DefaultConfig().Server.CORS.Enabled())
+}
+
+func TestLoadConfig_StoragePool(t *testing.T) {
+	load := func(t *testing.T, srcFile string) (Config, error) {
+		dstFile := filepath.Join(t.TempDir(), "config.yaml")
+		require.NoError(t, copyFile(srcFile, dstFile))
+		t.Setenv("ARGUS_CONFIG_PATH", dstFile)
+		return LoadConfig()
+	}
+
+	cfg, err := load(t, "testdata/storage-pool.yaml")
+	require.NoError(t, err)
+	assert.Equal(t, 50, cfg.Storage.MaxOpenConns)
+	assert.Equal(t, 10, cfg.Storage.MaxIdleConns)
+	assert.Equal(t, time.Hour, cfg.Storage.ConnMaxLifetime)
+
+	_, err = load(t, "testdata/storage-pool-invalid.yaml")
+	assert.ErrorContains(t, err, "invalid storage config")
+}
+
+func TestLoadConfig_PageLimits(t *testing.T) {
+	load := func(t *testing.T, srcFile string) (Config, error) {
+		dstFile := filepath.Join(t.TempDir(), "config.yaml")
+		require.NoError(t, copyFile(srcFile, dstFile))
+		t.Setenv("ARGUS_CONFIG_PATH", dstFile)
+		return LoadConfig()
+	}
+
+	cfg, err := load(t, "testdata/page-limits.yaml")
+	require.NoError(t, err)
+	assert.Equal(t, 200, cfg.API.DefaultPageLimit)
+	assert.Equal(t, 500, cfg.API.MaxPageLimit)
+	assert.Equal(t, 1000, cfg.API.MaxOffset)
+
+	_, err = load(t, "testdata/page-limits-invalid.yaml")
+	assert.ErrorContains(t, err, "invalid api config: default_page_limit 200 cannot exceed max_page_limit 100")
+}
+
+func TestLoadConfig_DefaultIntervalInheritance(t *testing.T) {
+	srcFile := "testdata/default-interval.yaml"
+	dstFile := "test-config-default-interval.yaml"
+
+	err := copyFile(srcFile, dstFile)
+	require.NoError(t, err)
+	defer func() {
+		if err := os.Remove(dstFile); err != nil {
+			t.Logf("Failed to remove test file: %v", err)
+		}
+	}()
+
+	err = os.Setenv("ARGUS_CONFIG_PATH", dstFile)
+	require.NoError(t, err)
+	defer func() {
+		if err := os.Unsetenv("ARGUS_CONFIG_PATH"); err != nil {
+			t.Logf("Failed to unset environment variable: %v", err)
+		}
+	}()
+
+	cfg, err := LoadConfig()
+	require.NoError(t, err)
+
+	assert.Equal(t, 15*time.Minute, cfg.Sync.DefaultInterval)
+	require.Len(t, cfg.Sync.Sources, 3)
+
+	// Sources without an interval inherit the default
+	assert.Equal(t, 15*time.Minute, cfg.Sync.Sources[0].GetConfig().GetInterval())
+	assert.Equal(t, 15*time.Minute, cfg.Sync.Sources[1].GetConfig().GetInterval())
+
+	// An explicit source interval overrides the default
+	assert.Equal(t, time.Hour, cfg.Sync.Sources[2].GetConfig().GetInterval())
+}
+
+func TestSyncConfig_DefaultInterval(t *testing.T) {
+	t.Run("without default the hardcoded fallback applies", func(t *testing.T) {
+		var cfg sync.Config
+		err := yaml.Unmarshal([]byte(`
+sources:
+  - type: git
+    url: "https://github.com/org/repo"
+`), &cfg)
+		require.NoError(t, err)
+		assert.Equal(t, 5*time.Minute, cfg.Sources[0].GetConfig().GetInterval())
+	})
+
+	t.Run("default below the git minimum is rejected", func(t *testing.T) {
+		var cfg sync.Config
+		err := yaml.Unmarshal([]byte(`
+default_interval: "5s"
+sources:
+  - type: filesystem
+    path: "/tmp/manifests"
+  - type: git
+    url: "https://github.com/org/repo"
+`), &cfg)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "at least 10s for git source 1")
+	})
+
+	t.Run("default below the git minimum is fine when git sources set their own", func(t *testing.T) {
+		var cfg sync.Config
+		err := yaml.Unmarshal([]byte(`
+default_interval: "5s"
+sources:
+  - type: filesystem
+    path: "/tmp/manifests"
+  - type: git
+    url: "https://github.com/org/repo"
+    interval: "1m"
+`), &cfg)
+		require.NoError(t, err)
+		assert.Equal(t, 5*time.Second, cfg.Sources[0].GetConfig().GetInterval())
+		assert.Equal(t, time.Minute, cfg.Sources[1].GetConfig().GetInterval())
+	})
+
+	t.Run("negative default is rejected", func(t *testing.T) {
+		var cfg sync.Config
+		err := yaml.Unmarshal([]byte(`
+default_interval: "-1m"
+sources:
+  - type: filesystem
+    path: "/tmp/manifests"
+`), &cfg)
+		require.Error(t, err)
+	})
+}
+
 // Helper function to copy a file
 func copyFile(src, dst string) error {
 	data, err := os.ReadFile(src)
@@ -566,3 +786,110 @@ func copyFile(src, dst string) error {
 	}
 	return os.WriteFile(dst, data, 0644)
 }
+
+func TestReportsConfig_Retention(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    string
+		expected time.Duration
+	}{
+		{name: "days", value: "90d", expected: 90 * 24 * time.Hour},
+		{name: "go duration", value: "36h", expected: 36 * time.Hour},
+		{name: "zero", value: "0d", expected: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var cfg reports.Config
+			require.NoError(t, yaml.Unmarshal([]byte("retention: "+tt.value+"\nretention_interval: 6h\n"), &cfg))
+			assert.Equal(t, tt.expected, time.Duration(cfg.Retention))
+			assert.Equal(t, 6*time.Hour, cfg.RetentionInterval)
+		})
+	}
+
+	for _, value := range []string{"ninety days", "-5d", "1.5d"} {
+		t.Run("invalid "+value, func(t *testing.T) {
+			var cfg reports.Config
+			assert.Error(t, yaml.Unmarshal([]byte("retention: "+value+"\n"), &cfg))
+		})
+	}
+}
+
+func TestLoadConfig_TOML(t *testing.T) {
+	load := func(t *testing.T, srcFile string) (Config, error) {
+		dstFile := filepath.Join(t.TempDir(), filepath.Base(srcFile))
+		require.NoError(t, copyFile(srcFile, dstFile))
+		t.Setenv("ARGUS_CONFIG_PATH", dstFile)
+		return LoadConfig()
+	}
+
+	// Every TOML fixture loads into the same config as its YAML counterpart
+	for _, name := range []string{"complex-sync", "cors", "default-interval", "notifications", "storage-pool"} {
+		t.Run(name, func(t *testing.T) {
+			fromYAML, err := load(t, "testdata/"+name+".yaml")
+			require.NoError(t, err)
+			fromTOML, err := load(t, "testdata/"+name+".toml")
+			require.NoError(t, err)
+			assert.Equal(t, fromYAML, fromTOML)
+		})
+	}
+
+	t.Run("SourceTypes", func(t *testing.T) {
+		cfg, err := load(t, "testdata/complex-sync.toml")
+		require.NoError(t, err)
+		require.Len(t, cfg.Sync.Sources, 3)
+		assert.IsType(t, &sync.FilesystemSourceConfig{}, cfg.Sync.Sources[0].GetConfig())
+		assert.IsType(t, &sync.GitSourceConfig{}, cfg.Sync.Sources[1].GetConfig())
+		assert.Equal(t, "services/backend", cfg.Sync.Sources[1].GetConfig().(*sync.GitSourceConfig).BasePath)
+	})
+
+	t.Run("UnknownSourceType", func(t *testing.T) {
+		_, err := load(t, "testdata/unknown-source-type.toml")
+		assert.ErrorContains(t, err, "unknown source type: svn")
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		_, err := load(t, "testdata/storage-pool-invalid.toml")
+		assert.ErrorContains(t, err, "invalid storage config")
+	})
+
+	t.Run("EnvironmentOverrides", func(t *testing.T) {
+		t.Setenv("ARGUS_STORAGE_HOST", "env-host")
+		cfg, err := load(t, "testdata/complex-sync.toml")
+		require.NoError(t, err)
+		assert.Equal(t, "env-host", cfg.Storage.Host)
+		assert.Equal(t, "complex-user", cfg.Storage.User)
+	})
+
+	t.Run("SyntaxError", func(t *testing.T) {
+		dstFile := filepath.Join(t.TempDir(), "config.toml")
+		require.NoError(t, os.WriteFile(dstFile, []byte("[storage]\nhost = \n"), 0644))
+		t.Setenv("ARGUS_CONFIG_PATH", dstFile)
+		_, err := LoadConfig()
+		assert.ErrorContains(t, err, "failed to parse config file: toml: line 2")
+	})
+}
+
+func TestConfig_Validate_JoinsProblems(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.Storage.MaxOpenConns = 0
+	cfg.Server.RequestLog.Level = "loud"
+	cfg.Tracing.Endpoint = "otel-collector:4318"
+
+	err := cfg.Validate()
+	require.Error(t, err)
+	assert.ErrorContains(t, err, "invalid storage config")
+	assert.ErrorContains(t, err, "invalid server request_log config")
+	assert.ErrorContains(t, err, "invalid tracing config")
+
+	assert.NoError(t, DefaultConfig().Validate())
+}
+
+func TestLoadConfigFile_RequiresFile(t *testing.T) {
+	_, err := LoadConfigFile(filepath.Join(t.TempDir(), "missing.yaml"))
+	assert.ErrorContains(t, err, "failed to read config file")
+
+	cfg, err := LoadConfigFile("testdata/storage-pool-invalid.yaml")
+	require.NoError(t, err, "LoadConfigFile leaves validation to the caller")
+	assert.Equal(t, 10, cfg.Storage.MaxIdleConns)
+}
@@ -0,0 +1,98 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// expandEnv resolves ${NAME} references to environment variables in the string values of
+// a config document, so secrets can stay out of the file. "$$" stands for a literal "$".
+// Referencing a variable that is not set is an error; every such reference is reported.
+func expandEnv(node *yaml.Node) error {
+	var errs []error
+	var walk func(node *yaml.Node)
+	walk = func(node *yaml.Node) {
+		switch node.Kind {
+		case yaml.DocumentNode, yaml.SequenceNode:
+			for _, child := range node.Content {
+				walk(child)
+			}
+		case yaml.MappingNode:
+			// Only values are expanded, keys name config fields
+			for i := 1; i < len(node.Content); i += 2 {
+				walk(node.Content[i])
+			}
+		case yaml.ScalarNode:
+			if node.Tag != "!!str" || !strings.Contains(node.Value, "$") {
+				return
+			}
+			value, err := expandString(node.Value)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("line %d: %w", node.Line, err))
+				return
+			}
+			node.Value = value
+			if node.Style == 0 {
+				// Resolve the expanded plain value again, so port: ${DB_PORT} is a number
+				node.Tag = ""
+			}
+		}
+	}
+	walk(node)
+	return errors.Join(errs...)
+}
+
+// expandString replaces the ${NAME} references in s with the values of the variables
+func expandString(s string) (string, error) {
+	var b strings.Builder
+	for {
+		i := strings.IndexByte(s, '$')
+		if i < 0 || i == len(s)-1 {
+			b.WriteString(s)
+			return b.String(), nil
+		}
+		b.WriteString(s[:i])
+
+		switch s[i+1] {
+		case '$':
+			b.WriteByte('$')
+			s = s[i+2:]
+		case '{':
+			end := strings.IndexByte(s[i:], '}')
+			if end < 0 {
+				return "", fmt.Errorf("unterminated environment variable reference %q", s[i:])
+			}
+			name := s[i+2 : i+end]
+			if !validEnvName(name) {
+				return "", fmt.Errorf("invalid environment variable name %q", name)
+			}
+			value, ok := os.LookupEnv(name)
+			if !ok {
+				return "", fmt.Errorf("environment variable %s is not set", name)
+			}
+			b.WriteString(value)
+			s = s[i+end+1:]
+		default:
+			// A "$" that starts no reference is kept as is
+			b.WriteByte('$')
+			s = s[i+1:]
+		}
+	}
+}
+
+// validEnvName reports whether name is a portable environment variable name
+func validEnvName(name string) bool {
+	if name == "" || name[0] >= '0' && name[0] <= '9' {
+		return false
+	}
+	for _, c := range name {
+		if !(c == '_' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9') {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,97 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExpandString(t *testing.T) {
+	t.Setenv("ARGUS_TEST_SECRET", "s3cret")
+	t.Setenv("ARGUS_TEST_EMPTY", "")
+
+	tests := []struct {
+		name  string
+		value string
+		want  string
+		err   string
+	}{
+		{name: "Resolved", value: "${ARGUS_TEST_SECRET}", want: "s3cret"},
+		{name: "Embedded", value: "user:${ARGUS_TEST_SECRET}@host", want: "user:s3cret@host"},
+		{name: "SetButEmpty", value: "${ARGUS_TEST_EMPTY}", want: ""},
+		{name: "EscapedDollar", value: "$${ARGUS_TEST_SECRET}", want: "${ARGUS_TEST_SECRET}"},
+		{name: "DoubleEscape", value: "pa$$$$word", want: "pa$$word"},
+		{name: "LoneDollar", value: "cost: $5 or $", want: "cost: $5 or $"},
+		{name: "Missing", value: "${ARGUS_TEST_MISSING}", err: "environment variable ARGUS_TEST_MISSING is not set"},
+		{name: "Unterminated", value: "${ARGUS_TEST_SECRET", err: "unterminated environment variable reference"},
+		{name: "InvalidName", value: "${1ST}", err: `invalid environment variable name "1ST"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := expandString(tt.value)
+			if tt.err != "" {
+				assert.ErrorContains(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestLoadConfig_EnvironmentReferences(t *testing.T) {
+	load := func(t *testing.T, name, content string) (Config, error) {
+		path := filepath.Join(t.TempDir(), name)
+		require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+		t.Setenv("ARGUS_CONFIG_PATH", path)
+		return LoadConfig()
+	}
+
+	t.Run("YAML", func(t *testing.T) {
+		t.Setenv("TEST_DB_PASSWORD", "from-env")
+		t.Setenv("TEST_DB_PORT", "6543")
+		cfg, err := load(t, "config.yaml", `
+storage:
+  password: ${TEST_DB_PASSWORD}
+  port: ${TEST_DB_PORT}
+  user: "literal-$${TEST_DB_PASSWORD}"
+`)
+		require.NoError(t, err)
+		assert.Equal(t, "from-env", cfg.Storage.Password)
+		assert.Equal(t, 6543, cfg.Storage.Port)
+		assert.Equal(t, "literal-${TEST_DB_PASSWORD}", cfg.Storage.User)
+	})
+
+	t.Run("TOML", func(t *testing.T) {
+		t.Setenv("TEST_DB_PASSWORD", "from-env")
+		cfg, err := load(t, "config.toml", `
+[storage]
+password = "${TEST_DB_PASSWORD}"
+`)
+		require.NoError(t, err)
+		assert.Equal(t, "from-env", cfg.Storage.Password)
+	})
+
+	t.Run("Missing", func(t *testing.T) {
+		_, err := load(t, "config.yaml", `
+storage:
+  user: ${TEST_MISSING_USER}
+  password: ${TEST_MISSING_PASSWORD}
+`)
+		assert.ErrorContains(t, err, "failed to parse config file")
+		assert.ErrorContains(t, err, "line 3: environment variable TEST_MISSING_USER is not set")
+		assert.ErrorContains(t, err, "line 4: environment variable TEST_MISSING_PASSWORD is not set")
+	})
+
+	t.Run("EnvironmentOverridesStillWin", func(t *testing.T) {
+		t.Setenv("TEST_DB_HOST", "from-reference")
+		t.Setenv("ARGUS_STORAGE_HOST", "from-override")
+		cfg, err := load(t, "config.yaml", "storage:\n  host: ${TEST_DB_HOST}\n")
+		require.NoError(t, err)
+		assert.Equal(t, "from-override", cfg.Storage.Host)
+	})
+}
@@ -0,0 +1,31 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/pelletier/go-toml/v2"
+	"gopkg.in/yaml.v3"
+)
+
+// parseTOML parses a TOML document into the equivalent YAML node tree, so TOML configs are
+// decoded by the same yaml tags and UnmarshalYAML methods as YAML ones, including the source
+// type dispatch and duration parsing.
+func parseTOML(data []byte) (*yaml.Node, error) {
+	var document map[string]any
+	if err := toml.Unmarshal(data, &document); err != nil {
+		var decodeErr *toml.DecodeError
+		if errors.As(err, &decodeErr) {
+			line, _ := decodeErr.Position()
+			return nil, fmt.Errorf("toml: line %d: %s", line, strings.TrimPrefix(decodeErr.Error(), "toml: "))
+		}
+		return nil, err
+	}
+
+	node := &yaml.Node{}
+	if err := node.Encode(document); err != nil {
+		return nil, fmt.Errorf("toml: %w", err)
+	}
+	return node, nil
+}
@@ -0,0 +1,116 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func decodeTOML(t *testing.T, document string) map[string]any {
+	t.Helper()
+	node, err := parseTOML([]byte(document))
+	require.NoError(t, err)
+	var out map[string]any
+	require.NoError(t, node.Decode(&out))
+	return out
+}
+
+func TestParseTOML_Values(t *testing.T) {
+	out := decodeTOML(t, `
+# comment
+title = "a \"quoted\" \u00e9 string" # trailing comment
+path = 'C:\no\escapes'
+count = 1_000
+hex = 0xff
+negative = -3
+ratio = 0.5
+enabled = true
+"quoted key" = "x"
+multiline = """
+first \
+  second"""
+raw = '''
+line one
+line two'''
+list = [
+  1,
+  2, # comment inside an array
+]
+nested = [[1, 2], ["a"]]
+point = { x = 1, y = { z = "deep" } }
+site.name = "dotted"
+`)
+
+	assert.Equal(t, `a "quoted" é string`, out["title"])
+	assert.Equal(t, `C:\no\escapes`, out["path"])
+	assert.Equal(t, 1000, out["count"])
+	assert.Equal(t, 255, out["hex"])
+	assert.Equal(t, -3, out["negative"])
+	assert.Equal(t, 0.5, out["ratio"])
+	assert.Equal(t, true, out["enabled"])
+	assert.Equal(t, "x", out["quoted key"])
+	assert.Equal(t, "first second", out["multiline"])
+	assert.Equal(t, "line one\nline two", out["raw"])
+	assert.Equal(t, []any{1, 2}, out["list"])
+	assert.Equal(t, []any{[]any{1, 2}, []any{"a"}}, out["nested"])
+	assert.Equal(t, map[string]any{"x": 1, "y": map[string]any{"z": "deep"}}, out["point"])
+	assert.Equal(t, map[string]any{"name": "dotted"}, out["site"])
+}
+
+func TestParseTOML_Tables(t *testing.T) {
+	out := decodeTOML(t, `
+[server.cors]
+allowed_origins = ["https://a.example.com"]
+
+[server]
+port = 8080
+
+[[sync.sources]]
+type = "git"
+
+[sync.sources.auth]
+username = "bot"
+
+[[sync.sources]]
+type = "filesystem"
+`)
+
+	assert.Equal(t, map[string]any{
+		"cors": map[string]any{"allowed_origins": []any{"https://a.example.com"}},
+		"port": 8080,
+	}, out["server"])
+	assert.Equal(t, map[string]any{"sources": []any{
+		map[string]any{"type": "git", "auth": map[string]any{"username": "bot"}},
+		map[string]any{"type": "filesystem"},
+	}}, out["sync"])
+}
+
+func TestParseTOML_Errors(t *testing.T) {
+	tests := []struct {
+		name     string
+		document string
+		err      string
+	}{
+		{"DuplicateKey", "a = 1\na = 2", "toml: line 2: key a is already defined"},
+		{"DuplicateTable", "[a]\n[a]", "toml: line 2: table a already exists"},
+		{"TableOverValue", "a = 1\n[a.b]", "toml: line 2: key a already exists as a value"},
+		{"MissingValue", "a =", "toml: line 1: expected value"},
+		{"TrailingContent", "a = 1 2", "toml: line 1: expected newline"},
+		{"UnterminatedArray", "a = [1, 2", "toml: line 1: array is incomplete"},
+		{"LeadingZero", "a = 007", "toml: line 1: integers cannot have leading zeroes"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := parseTOML([]byte(tt.document))
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.err)
+		})
+	}
+}
+
+func TestParseTOML_DateTime(t *testing.T) {
+	out := decodeTOML(t, "released = 1979-05-27\n")
+	assert.Equal(t, "1979-05-27", out["released"])
+}
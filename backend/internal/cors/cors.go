@@ -0,0 +1,122 @@
+package cors
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+)
+
+// Config holds cross-origin resource sharing configuration for the API
+type Config struct {
+	// AllowedOrigins are the origins, such as "https://dashboard.example.com", allowed to call
+	// the API from a browser. "*" allows any origin. When empty, no CORS headers are sent.
+	AllowedOrigins []string `yaml:"allowed_origins"`
+	// AllowedMethods are answered to preflight requests, defaults to DefaultAllowedMethods
+	AllowedMethods []string `yaml:"allowed_methods"`
+	// AllowCredentials lets browsers send cookies and other credentials with cross-origin requests
+	AllowCredentials bool `yaml:"allow_credentials"`
+}
+
+// DefaultAllowedMethods are allowed when AllowedMethods is empty
+var DefaultAllowedMethods = []string{http.MethodGet, http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete}
+
+// exposedHeaders are response headers browsers let cross-origin callers read
+const exposedHeaders = "ETag"
+
+// Enabled reports whether any origin is allowed
+func (c Config) Enabled() bool {
+	return len(c.AllowedOrigins) > 0
+}
+
+// Validate ensures origins are "*" or a bare scheme and host, and methods are known
+func (c Config) Validate() error {
+	for _, origin := range c.AllowedOrigins {
+		if origin == "*" {
+			if c.AllowCredentials {
+				return fmt.Errorf(`allowed origin "*" cannot be combined with allow_credentials, list the origins instead`)
+			}
+			continue
+		}
+		parsed, err := url.Parse(origin)
+		if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" || strings.TrimSuffix(parsed.Path, "/") != "" {
+			return fmt.Errorf("allowed origin %q must be a scheme and host, e.g. https://dashboard.example.com", origin)
+		}
+	}
+	for _, method := range c.AllowedMethods {
+		if !slices.Contains(knownMethods, strings.ToUpper(method)) {
+			return fmt.Errorf("unknown allowed method %q", method)
+		}
+	}
+	return nil
+}
+
+// knownMethods are the methods AllowedMethods may list
+var knownMethods = []string{
+	http.MethodGet, http.MethodHead, http.MethodPost, http.MethodPut,
+	http.MethodPatch, http.MethodDelete, http.MethodOptions,
+}
+
+// allows reports whether a request from origin may be answered with CORS headers
+func (c Config) allows(origin string) bool {
+	for _, allowed := range c.AllowedOrigins {
+		if allowed == "*" || strings.EqualFold(strings.TrimSuffix(allowed, "/"), origin) {
+			return true
+		}
+	}
+	return false
+}
+
+// Middleware adds CORS headers to responses for allowed origins and answers their preflight
+// requests. Requests from other origins pass through without CORS headers, so browsers block
+// them. The request's origin is echoed back unless "*" is configured, which is never combined
+// with credentials. When no origins are configured the middleware does nothing.
+func Middleware(cfg Config) func(http.Handler) http.Handler {
+	methods := cfg.AllowedMethods
+	if len(methods) == 0 {
+		methods = DefaultAllowedMethods
+	}
+	allowedMethods := strings.ToUpper(strings.Join(methods, ", "))
+	anyOrigin := slices.Contains(cfg.AllowedOrigins, "*")
+
+	return func(next http.Handler) http.Handler {
+		if !cfg.Enabled() {
+			return next
+		}
+
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			origin := r.Header.Get("Origin")
+			// Responses differ by origin, so caches must not share them across origins
+			w.Header().Add("Vary", "Origin")
+			if origin == "" || !cfg.allows(origin) {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			if anyOrigin {
+				w.Header().Set("Access-Control-Allow-Origin", "*")
+			} else {
+				w.Header().Set("Access-Control-Allow-Origin", origin)
+			}
+			if cfg.AllowCredentials {
+				w.Header().Set("Access-Control-Allow-Credentials", "true")
+			}
+
+			// A preflight asks whether the actual request may be sent; it never reaches the API
+			if r.Method == http.MethodOptions && r.Header.Get("Access-Control-Request-Method") != "" {
+				w.Header().Add("Vary", "Access-Control-Request-Method")
+				w.Header().Add("Vary", "Access-Control-Request-Headers")
+				w.Header().Set("Access-Control-Allow-Methods", allowedMethods)
+				if requested := r.Header.Get("Access-Control-Request-Headers"); requested != "" {
+					w.Header().Set("Access-Control-Allow-Headers", requested)
+				}
+				w.WriteHeader(http.StatusNoContent)
+				return
+			}
+
+			w.Header().Set("Access-Control-Expose-Headers", exposedHeaders)
+			next.ServeHTTP(w, r)
+		})
+	}
+}
@@ -0,0 +1,96 @@
+package cors
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name        string
+		config      Config
+		expectError bool
+	}{
+		{name: "disabled", config: Config{}},
+		{name: "origins", config: Config{AllowedOrigins: []string{"https://dashboard.example.com", "http://localhost:3000"}, AllowCredentials: true}},
+		{name: "any origin", config: Config{AllowedOrigins: []string{"*"}, AllowedMethods: []string{"get", "POST"}}},
+		{name: "any origin with credentials", config: Config{AllowedOrigins: []string{"*"}, AllowCredentials: true}, expectError: true},
+		{name: "origin with path", config: Config{AllowedOrigins: []string{"https://dashboard.example.com/app"}}, expectError: true},
+		{name: "origin without scheme", config: Config{AllowedOrigins: []string{"dashboard.example.com"}}, expectError: true},
+		{name: "unknown method", config: Config{AllowedOrigins: []string{"*"}, AllowedMethods: []string{"FETCH"}}, expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.config.Validate()
+			if tt.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMiddleware(t *testing.T) {
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTeapot)
+	})
+	serve := func(cfg Config, method string, headers map[string]string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, "/api/catalog/v1/components", nil)
+		for name, value := range headers {
+			req.Header.Set(name, value)
+		}
+		w := httptest.NewRecorder()
+		Middleware(cfg)(next).ServeHTTP(w, req)
+		return w
+	}
+	preflight := map[string]string{
+		"Origin":                         "https://dashboard.example.com",
+		"Access-Control-Request-Method":  "PATCH",
+		"Access-Control-Request-Headers": "Content-Type, If-None-Match",
+	}
+
+	t.Run("disabled by default", func(t *testing.T) {
+		w := serve(Config{}, http.MethodOptions, preflight)
+		assert.Equal(t, http.StatusTeapot, w.Code)
+		assert.Empty(t, w.Header().Get("Access-Control-Allow-Origin"))
+	})
+
+	credentials := Config{AllowedOrigins: []string{"https://dashboard.example.com"}, AllowCredentials: true}
+
+	t.Run("preflight from allowed origin", func(t *testing.T) {
+		w := serve(credentials, http.MethodOptions, preflight)
+		assert.Equal(t, http.StatusNoContent, w.Code)
+		assert.Equal(t, "https://dashboard.example.com", w.Header().Get("Access-Control-Allow-Origin"))
+		assert.Equal(t, "true", w.Header().Get("Access-Control-Allow-Credentials"))
+		assert.Equal(t, "GET, POST, PUT, PATCH, DELETE", w.Header().Get("Access-Control-Allow-Methods"))
+		assert.Equal(t, "Content-Type, If-None-Match", w.Header().Get("Access-Control-Allow-Headers"))
+		assert.Contains(t, w.Header().Values("Vary"), "Origin")
+	})
+
+	t.Run("request from allowed origin", func(t *testing.T) {
+		w := serve(credentials, http.MethodGet, map[string]string{"Origin": "https://dashboard.example.com"})
+		assert.Equal(t, http.StatusTeapot, w.Code)
+		assert.Equal(t, "https://dashboard.example.com", w.Header().Get("Access-Control-Allow-Origin"))
+		assert.Equal(t, "ETag", w.Header().Get("Access-Control-Expose-Headers"))
+	})
+
+	t.Run("other origin gets no CORS headers", func(t *testing.T) {
+		w := serve(credentials, http.MethodOptions, map[string]string{"Origin": "https://evil.example.com", "Access-Control-Request-Method": "GET"})
+		assert.Equal(t, http.StatusTeapot, w.Code)
+		assert.Empty(t, w.Header().Get("Access-Control-Allow-Origin"))
+		assert.Empty(t, w.Header().Get("Access-Control-Allow-Credentials"))
+	})
+
+	t.Run("any origin", func(t *testing.T) {
+		w := serve(Config{AllowedOrigins: []string{"*"}, AllowedMethods: []string{"get"}}, http.MethodOptions, preflight)
+		assert.Equal(t, http.StatusNoContent, w.Code)
+		assert.Equal(t, "*", w.Header().Get("Access-Control-Allow-Origin"))
+		assert.Empty(t, w.Header().Get("Access-Control-Allow-Credentials"))
+		assert.Equal(t, "GET", w.Header().Get("Access-Control-Allow-Methods"))
+	})
+}
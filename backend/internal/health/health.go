@@ -3,15 +3,23 @@ package health
 import (
 	"context"
 	"encoding/json"
+	"log/slog"
 	"net/http"
+	"sort"
 	"time"
 )
 
 const (
 	statusHealthy   = "healthy"
 	statusUnhealthy = "unhealthy"
+	statusAlive     = "alive"
+	statusReady     = "ready"
+	statusNotReady  = "not_ready"
 )
 
+// checkTimeout bounds how long a probe waits for its checkers
+const checkTimeout = 5 * time.Second
+
 // Checker defines an interface for health checks
 type Checker interface {
 	HealthCheck(ctx context.Context) error
@@ -25,10 +33,24 @@ type HealthResponse struct {
 	Timestamp string            `json:"timestamp"`
 }
 
+// LivenessResponse represents the liveness probe response
+type LivenessResponse struct {
+	Status    string `json:"status"`
+	Timestamp string `json:"timestamp"`
+}
+
+// ReadinessResponse represents the readiness probe response
+type ReadinessResponse struct {
+	Status    string            `json:"status"`
+	Checks    map[string]string `json:"checks"`
+	NotReady  []string          `json:"not_ready,omitempty"` // Names of the checkers that failed, sorted
+	Timestamp string            `json:"timestamp"`
+}
+
 // HealthHandler creates a health check handler that accepts multiple checkers
 func HealthHandler(checkers ...Checker) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+		ctx, cancel := context.WithTimeout(r.Context(), checkTimeout)
 		defer cancel()
 
 		checks := make(map[string]string)
@@ -65,3 +87,53 @@ func HealthHandler(checkers ...Checker) http.HandlerFunc {
 		}
 	}
 }
+
+// LivenessHandler reports that the process is up. It runs no checks, so a slow or
+// unreachable dependency never gets the process restarted.
+func LivenessHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, LivenessResponse{
+			Status:    statusAlive,
+			Timestamp: time.Now().UTC().Format(time.RFC3339),
+		})
+	}
+}
+
+// ReadinessHandler reports whether every checker passes. It responds 503 listing the
+// checkers that failed, so traffic is held back until all subsystems are ready.
+func ReadinessHandler(checkers ...Checker) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx, cancel := context.WithTimeout(r.Context(), checkTimeout)
+		defer cancel()
+
+		response := ReadinessResponse{
+			Status:    statusReady,
+			Checks:    make(map[string]string),
+			Timestamp: time.Now().UTC().Format(time.RFC3339),
+		}
+		for _, checker := range checkers {
+			if err := checker.HealthCheck(ctx); err != nil {
+				slog.Warn("Readiness check failed", "check", checker.Name(), "error", err)
+				response.Checks[checker.Name()] = statusNotReady
+				response.NotReady = append(response.NotReady, checker.Name())
+				continue
+			}
+			response.Checks[checker.Name()] = statusReady
+		}
+
+		statusCode := http.StatusOK
+		if len(response.NotReady) > 0 {
+			sort.Strings(response.NotReady)
+			response.Status = statusNotReady
+			statusCode = http.StatusServiceUnavailable
+		}
+		writeJSON(w, statusCode, response)
+	}
+}
+
+// writeJSON writes body as a JSON response with the given status code
+func writeJSON(w http.ResponseWriter, statusCode int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	_ = json.NewEncoder(w).Encode(body)
+}
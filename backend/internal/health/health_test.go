@@ -0,0 +1,72 @@
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type stubChecker struct {
+	name string
+	err  error
+}
+
+func (c *stubChecker) HealthCheck(ctx context.Context) error {
+	return c.err
+}
+
+func (c *stubChecker) Name() string {
+	return c.name
+}
+
+func TestLivenessHandler(t *testing.T) {
+	w := httptest.NewRecorder()
+	LivenessHandler()(w, httptest.NewRequest("GET", "/livez", nil))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "application/json", w.Header().Get("Content-Type"))
+	var response LivenessResponse
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&response))
+	assert.Equal(t, "alive", response.Status)
+}
+
+func TestReadinessHandler(t *testing.T) {
+	t.Run("all ready", func(t *testing.T) {
+		database := &stubChecker{name: "database"}
+		syncService := &stubChecker{name: "sync"}
+
+		w := httptest.NewRecorder()
+		ReadinessHandler(database, syncService)(w, httptest.NewRequest("GET", "/readyz", nil))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		var response ReadinessResponse
+		require.NoError(t, json.NewDecoder(w.Body).Decode(&response))
+		assert.Equal(t, "ready", response.Status)
+		assert.Equal(t, map[string]string{"database": "ready", "sync": "ready"}, response.Checks)
+		assert.Empty(t, response.NotReady)
+	})
+
+	t.Run("lists subsystems that are not ready", func(t *testing.T) {
+		database := &stubChecker{name: "database", err: errors.New("connection refused")}
+		syncService := &stubChecker{name: "sync", err: errors.New("no sync yet")}
+		cache := &stubChecker{name: "cache"}
+
+		w := httptest.NewRecorder()
+		ReadinessHandler(syncService, database, cache)(w, httptest.NewRequest("GET", "/readyz", nil))
+
+		assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+		assert.Equal(t, "application/json", w.Header().Get("Content-Type"))
+		var response ReadinessResponse
+		require.NoError(t, json.NewDecoder(w.Body).Decode(&response))
+		assert.Equal(t, "not_ready", response.Status)
+		assert.Equal(t, []string{"database", "sync"}, response.NotReady)
+		assert.Equal(t, "ready", response.Checks["cache"])
+		assert.NotContains(t, w.Body.String(), "connection refused")
+	})
+}
@@ -0,0 +1,38 @@
+// Package metrics holds the Prometheus registry the application's metrics are registered with
+// and serves it for scraping.
+package metrics
+
+import (
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// Config holds metrics configuration
+type Config struct {
+	Enabled bool `yaml:"enabled"` // Serve collected metrics at /metrics
+}
+
+// DurationBuckets are histogram upper bounds in seconds suited to operations taking up to minutes
+var DurationBuckets = []float64{0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60, 120, 300}
+
+// Registry is the registry the application's metrics are registered with. It is separate from
+// the client's global registry so only metrics registered here are served, along with the
+// standard Go runtime and process collectors.
+var Registry = newRegistry()
+
+func newRegistry() *prometheus.Registry {
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+	)
+	return registry
+}
+
+// Handler serves the registry's metrics for scraping
+func Handler() http.Handler {
+	return promhttp.HandlerFor(Registry, promhttp.HandlerOpts{Registry: Registry})
+}
@@ -0,0 +1,33 @@
+package metrics
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHandler(t *testing.T) {
+	runs := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "test_handler_runs_total",
+		Help: "Runs by result.",
+	}, []string{"source", "result"})
+	Registry.MustRegister(runs)
+	defer Registry.Unregister(runs)
+
+	runs.WithLabelValues(`path "quoted"`, "success").Inc()
+
+	w := httptest.NewRecorder()
+	Handler().ServeHTTP(w, httptest.NewRequest("GET", "/metrics", nil))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Header().Get("Content-Type"), "text/plain")
+	body := w.Body.String()
+	assert.Contains(t, body, "# TYPE test_handler_runs_total counter\n")
+	assert.Contains(t, body, `test_handler_runs_total{result="success",source="path \"quoted\""} 1`)
+	// The standard runtime and process collectors are served too
+	assert.Contains(t, body, "go_goroutines ")
+	assert.Contains(t, body, "promhttp_metric_handler_errors_total")
+}
@@ -1,5 +1,12 @@
 package models
 
+import (
+	"fmt"
+	"strings"
+
+	"github.com/doron-cohen/argus/backend/internal/utils"
+)
+
 // Component represents a component found in a source.
 // Components are identified by their unique ID, with name serving as a fallback
 // when no ID is provided.
@@ -17,6 +24,119 @@ type Component struct {
 
 	// Owners contains ownership information for the component.
 	Owners Owners `yaml:"owners" json:"owners"`
+
+	// Labels are free-form key/value pairs used to group and filter components.
+	Labels map[string]string `yaml:"labels" json:"labels"`
+
+	// Lifecycle is the stage the component is in (experimental, production or deprecated).
+	Lifecycle string `yaml:"lifecycle" json:"lifecycle"`
+
+	// Checks lists the slugs of the checks expected to report on the component.
+	Checks []string `yaml:"checks" json:"checks"`
+
+	// PreviousIDs lists identifiers the component had before it was renamed.
+	// Sync moves a component stored under one of them to the current identifier.
+	PreviousIDs []string `yaml:"previous_ids" json:"previous_ids"`
+
+	// DependsOn lists the identifiers of the components this component depends on.
+	// They need not exist yet; an edge to a component that does not is reported as dangling.
+	DependsOn []string `yaml:"depends_on" json:"depends_on"`
+
+	// SourceRevision identifies the version of the source the component was read from.
+	// It is set by the sync fetchers and never read from a manifest.
+	SourceRevision string `yaml:"-" json:"-"`
+}
+
+// Lifecycle stages a component can declare.
+const (
+	LifecycleExperimental = "experimental"
+	LifecycleProduction   = "production"
+	LifecycleDeprecated   = "deprecated"
+)
+
+// ValidateLifecycle checks that a lifecycle is empty or one of the known stages.
+func ValidateLifecycle(lifecycle string) error {
+	switch lifecycle {
+	case "", LifecycleExperimental, LifecycleProduction, LifecycleDeprecated:
+		return nil
+	default:
+		return fmt.Errorf("lifecycle must be one of: %s, %s, %s", LifecycleExperimental, LifecycleProduction, LifecycleDeprecated)
+	}
+}
+
+// ValidateLabels checks that label keys are slugs and values are of reasonable length.
+func ValidateLabels(labels map[string]string) error {
+	for key, value := range labels {
+		if !utils.IsValidSlug(key) {
+			return fmt.Errorf("label key %q must contain only alphanumeric characters, hyphens, and underscores", key)
+		}
+		if len(key) > 63 {
+			return fmt.Errorf("label key %q cannot exceed 63 characters", key)
+		}
+		if len(value) > 255 {
+			return fmt.Errorf("label %q value cannot exceed 255 characters", key)
+		}
+	}
+	return nil
+}
+
+// maxCheckSlugLength matches the size of the slug column checks are stored with.
+const maxCheckSlugLength = 100
+
+// ValidateExpectedChecks checks that expected checks are slugs, each listed once.
+func ValidateExpectedChecks(checks []string) error {
+	seen := make(map[string]bool, len(checks))
+	for _, slug := range checks {
+		if !utils.IsValidSlug(slug) {
+			return fmt.Errorf("check %q must contain only alphanumeric characters, hyphens, and underscores", slug)
+		}
+		if len(slug) > maxCheckSlugLength {
+			return fmt.Errorf("check %q cannot exceed %d characters", slug, maxCheckSlugLength)
+		}
+		if seen[slug] {
+			return fmt.Errorf("check %q is listed more than once", slug)
+		}
+		seen[slug] = true
+	}
+	return nil
+}
+
+// ValidatePreviousIDs checks that previous identifiers are set, differ from the current
+// identifier and are each listed once.
+func ValidatePreviousIDs(identifier string, previousIDs []string) error {
+	seen := make(map[string]bool, len(previousIDs))
+	for _, previousID := range previousIDs {
+		if strings.TrimSpace(previousID) == "" {
+			return fmt.Errorf("previous ID cannot be empty")
+		}
+		if previousID == identifier {
+			return fmt.Errorf("previous ID %q is the current identifier", previousID)
+		}
+		if seen[previousID] {
+			return fmt.Errorf("previous ID %q is listed more than once", previousID)
+		}
+		seen[previousID] = true
+	}
+	return nil
+}
+
+// ValidateDependsOn checks that dependencies are slugs, are not the component itself and are
+// each listed once.
+func ValidateDependsOn(identifier string, dependsOn []string) error {
+	seen := make(map[string]bool, len(dependsOn))
+	for _, dependency := range dependsOn {
+		if !utils.IsValidSlug(dependency) {
+			return fmt.Errorf("dependency %q must contain only alphanumeric characters, hyphens, and underscores", dependency)
+		}
+		if dependency == identifier {
+			return fmt.Errorf("component cannot depend on itself")
+		}
+		if seen[dependency] {
+			return fmt.Errorf("dependency %q is listed more than once", dependency)
+		}
+		seen[dependency] = true
+	}
+	return nil
 }
 
 // Owners contains ownership information for a component.
@@ -1,7 +1,13 @@
 package models
 
 import (
+	"bytes"
 	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strconv"
 
 	"gopkg.in/yaml.v3"
 )
@@ -14,10 +20,22 @@ type ManifestV1 struct {
 	Version string `yaml:"version" json:"version"`
 
 	// Component attributes (flattened)
-	ID          string `yaml:"id" json:"id"`
-	Name        string `yaml:"name" json:"name"`
-	Description string `yaml:"description" json:"description"`
-	Owners      Owners `yaml:"owners" json:"owners"`
+	ID          string            `yaml:"id" json:"id"`
+	Name        string            `yaml:"name" json:"name"`
+	Description string            `yaml:"description" json:"description"`
+	Owners      Owners            `yaml:"owners" json:"owners"`
+	Labels      map[string]string `yaml:"labels" json:"labels"`
+	Lifecycle   string            `yaml:"lifecycle" json:"lifecycle"`
+
+	// Checks lists the slugs of the checks expected to report on the component
+	Checks []string `yaml:"checks" json:"checks"`
+
+	// PreviousIDs lists identifiers the component was known by before, so a renamed
+	// component keeps its reports and history
+	PreviousIDs []string `yaml:"previous_ids" json:"previous_ids"`
+
+	// DependsOn lists the identifiers of the components this component depends on
+	DependsOn []string `yaml:"depends_on" json:"depends_on"`
 }
 
 // Manifest represents the current manifest format.
@@ -25,37 +43,178 @@ type ManifestV1 struct {
 type Manifest = ManifestV1
 
 // Parser handles parsing and validation of manifest files.
-type Parser struct{}
+type Parser struct {
+	strict bool
+}
+
+// ParserOption configures a Parser.
+type ParserOption func(*Parser)
+
+// WithStrictFields makes the parser reject manifests containing unknown fields.
+// By default unknown fields are ignored for backward compatibility.
+func WithStrictFields(strict bool) ParserOption {
+	return func(p *Parser) {
+		p.strict = strict
+	}
+}
 
 // NewParser creates a new manifest parser.
-func NewParser() *Parser {
-	return &Parser{}
+func NewParser(opts ...ParserOption) *Parser {
+	p := &Parser{}
+	for _, opt := range opts {
+		opt(p)
+	}
+	return p
 }
 
 // Parse parses YAML content into a Manifest struct.
+// In strict mode, unknown fields (e.g. a misspelled "maintaners") cause an error naming the field.
 func (p *Parser) Parse(content []byte) (*Manifest, error) {
 	var manifest Manifest
-	if err := yaml.Unmarshal(content, &manifest); err != nil {
+	decoder := yaml.NewDecoder(bytes.NewReader(content))
+	decoder.KnownFields(p.strict)
+	if err := decoder.Decode(&manifest); err != nil && !errors.Is(err, io.EOF) {
 		return nil, err
 	}
 	return &manifest, nil
 }
 
 // Validate checks if the manifest has all required fields.
+// It returns the first problem Check would report.
 func (p *Parser) Validate(manifest *Manifest) error {
-	if manifest.Version == "" {
-		return errors.New("manifest version is required")
+	if problems := validateManifest(manifest, nil); len(problems) > 0 {
+		return errors.New(problems[0].Message)
+	}
+	return nil
+}
+
+// ManifestProblem is a problem found in a manifest by Parser.Check.
+// Field and Line locate the problem when the parser can tell.
+type ManifestProblem struct {
+	// Field is the path of the offending field, such as "lifecycle" or "labels.tier"
+	Field string
+	// Line is the 1-based line of the field or syntax error, 0 when unknown
+	Line int
+	// Message describes the problem
+	Message string
+}
+
+// Check parses and validates a manifest like Parse and Validate, but reports every problem
+// found instead of stopping at the first, with the field and line of each where known.
+// The manifest is nil when the content cannot be parsed.
+func (p *Parser) Check(content []byte) (*Manifest, []ManifestProblem) {
+	manifest, err := p.Parse(content)
+	if err != nil {
+		return nil, parseProblems(err)
 	}
 
-	if manifest.Version != "v1" {
-		return errors.New("unsupported manifest version")
+	// The content parsed above, so it is valid YAML
+	var root yaml.Node
+	_ = yaml.Unmarshal(content, &root)
+
+	return manifest, validateManifest(manifest, fieldLines(&root))
+}
+
+// validateManifest returns the manifest's validation problems, located with lines when given
+func validateManifest(manifest *Manifest, lines map[string]int) []ManifestProblem {
+	var problems []ManifestProblem
+	add := func(field string, message string) {
+		problems = append(problems, ManifestProblem{Field: field, Line: lines[field], Message: message})
+	}
+
+	switch {
+	case manifest.Version == "":
+		add("version", "manifest version is required")
+	case manifest.Version != "v1":
+		add("version", "unsupported manifest version")
 	}
 
 	if manifest.Name == "" {
-		return errors.New("component name is required")
+		add("name", "component name is required")
 	}
 
-	return nil
+	if err := ValidateLifecycle(manifest.Lifecycle); err != nil {
+		add("lifecycle", err.Error())
+	}
+
+	// Labels are checked one at a time, in key order, so each problem names its label
+	keys := make([]string, 0, len(manifest.Labels))
+	for key := range manifest.Labels {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		if err := ValidateLabels(map[string]string{key: manifest.Labels[key]}); err != nil {
+			add("labels."+key, err.Error())
+		}
+	}
+
+	if err := ValidateExpectedChecks(manifest.Checks); err != nil {
+		add("checks", err.Error())
+	}
+
+	identifier := manifest.ID
+	if identifier == "" {
+		identifier = manifest.Name
+	}
+	if err := ValidatePreviousIDs(identifier, manifest.PreviousIDs); err != nil {
+		add("previous_ids", err.Error())
+	}
+	if err := ValidateDependsOn(identifier, manifest.DependsOn); err != nil {
+		add("depends_on", err.Error())
+	}
+
+	return problems
+}
+
+// yamlErrorLine matches the line prefix of YAML syntax and type errors
+var yamlErrorLine = regexp.MustCompile(`^(?:yaml: )?line (\d+): (.*)$`)
+
+// unknownField matches the type error reported for unknown fields in strict mode
+var unknownField = regexp.MustCompile(`^field (\S+) not found in type`)
+
+// parseProblems turns a Parse error into problems, one per type error
+func parseProblems(err error) []ManifestProblem {
+	messages := []string{err.Error()}
+	var typeErr *yaml.TypeError
+	if errors.As(err, &typeErr) {
+		messages = typeErr.Errors
+	}
+
+	problems := make([]ManifestProblem, 0, len(messages))
+	for _, message := range messages {
+		problem := ManifestProblem{Message: message}
+		if match := yamlErrorLine.FindStringSubmatch(message); match != nil {
+			problem.Line, _ = strconv.Atoi(match[1])
+			problem.Message = match[2]
+		}
+		if match := unknownField.FindStringSubmatch(problem.Message); match != nil {
+			problem.Field = match[1]
+			problem.Message = fmt.Sprintf("unknown field %q", match[1])
+		}
+		problems = append(problems, problem)
+	}
+	return problems
+}
+
+// fieldLines maps the top-level keys of a manifest, and the keys of its labels, to their lines
+func fieldLines(root *yaml.Node) map[string]int {
+	lines := make(map[string]int)
+	if root.Kind != yaml.DocumentNode || len(root.Content) == 0 || root.Content[0].Kind != yaml.MappingNode {
+		return lines
+	}
+
+	mapping := root.Content[0].Content
+	for i := 0; i+1 < len(mapping); i += 2 {
+		key, value := mapping[i], mapping[i+1]
+		lines[key.Value] = key.Line
+		if key.Value == "labels" && value.Kind == yaml.MappingNode {
+			for j := 0; j+1 < len(value.Content); j += 2 {
+				lines["labels."+value.Content[j].Value] = value.Content[j].Line
+			}
+		}
+	}
+	return lines
 }
 
 // ToComponent converts the manifest to a Component struct.
@@ -65,5 +224,10 @@ func (m *Manifest) ToComponent() Component {
 		Name:        m.Name,
 		Description: m.Description,
 		Owners:      m.Owners,
+		Labels:      m.Labels,
+		Lifecycle:   m.Lifecycle,
+		Checks:      m.Checks,
+		PreviousIDs: m.PreviousIDs,
+		DependsOn:   m.DependsOn,
 	}
 }
@@ -1,6 +1,8 @@
 package models
 
 import (
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -229,3 +231,224 @@ func TestComponent_GetIdentifier(t *testing.T) {
 		})
 	}
 }
+
+func TestParser_Parse_UnknownFieldLenientByDefault(t *testing.T) {
+	parser := NewParser()
+
+	content, err := os.ReadFile(filepath.Join("testdata", "manifest_unknown_field.yaml"))
+	require.NoError(t, err)
+
+	manifest, err := parser.Parse(content)
+
+	require.NoError(t, err)
+	assert.Equal(t, "Billing Service", manifest.Name)
+	assert.Equal(t, "payments", manifest.Owners.Team)
+	assert.Empty(t, manifest.Owners.Maintainers)
+}
+
+func TestParser_Parse_StrictRejectsUnknownField(t *testing.T) {
+	parser := NewParser(WithStrictFields(true))
+
+	content, err := os.ReadFile(filepath.Join("testdata", "manifest_unknown_field.yaml"))
+	require.NoError(t, err)
+
+	manifest, err := parser.Parse(content)
+
+	require.Error(t, err)
+	assert.Nil(t, manifest)
+	assert.Contains(t, err.Error(), "maintaners")
+}
+
+func TestParser_Parse_StrictAcceptsKnownFields(t *testing.T) {
+	parser := NewParser(WithStrictFields(true))
+
+	yamlContent := []byte(`
+version: "v1"
+name: "user-service"
+owners:
+  maintainers: ["bob@example.com"]
+  team: "identity"
+`)
+
+	manifest, err := parser.Parse(yamlContent)
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"bob@example.com"}, manifest.Owners.Maintainers)
+}
+
+func TestParser_Parse_StrictEmptyContent(t *testing.T) {
+	parser := NewParser(WithStrictFields(true))
+
+	manifest, err := parser.Parse([]byte(``))
+
+	require.NoError(t, err)
+	assert.Equal(t, "", manifest.Name)
+}
+
+func TestParser_Validate_LifecycleAndLabels(t *testing.T) {
+	parser := NewParser()
+
+	valid := &Manifest{
+		Version:   "v1",
+		Name:      "user-service",
+		Lifecycle: LifecycleProduction,
+		Labels:    map[string]string{"tier": "critical"},
+	}
+	assert.NoError(t, parser.Validate(valid))
+
+	badLifecycle := &Manifest{Version: "v1", Name: "user-service", Lifecycle: "retired"}
+	err := parser.Validate(badLifecycle)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "lifecycle must be one of")
+
+	badLabel := &Manifest{Version: "v1", Name: "user-service", Labels: map[string]string{"has space": "x"}}
+	err = parser.Validate(badLabel)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "label key")
+}
+
+func TestParser_PreviousIDs(t *testing.T) {
+	parser := NewParser(WithStrictFields(true))
+
+	manifest, err := parser.Parse([]byte("version: v1\nid: payments\nname: Payments\nprevious_ids:\n  - billing\n"))
+	require.NoError(t, err)
+	require.NoError(t, parser.Validate(manifest))
+	assert.Equal(t, []string{"billing"}, manifest.ToComponent().PreviousIDs)
+
+	tests := []struct {
+		name        string
+		previousIDs []string
+		wantErr     string
+	}{
+		{name: "empty", previousIDs: []string{" "}, wantErr: "previous ID cannot be empty"},
+		{name: "current identifier", previousIDs: []string{"payments"}, wantErr: "is the current identifier"},
+		{name: "duplicate", previousIDs: []string{"billing", "billing"}, wantErr: "listed more than once"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := parser.Validate(&Manifest{Version: "v1", ID: "payments", Name: "Payments", PreviousIDs: tt.previousIDs})
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestParser_ExpectedChecks(t *testing.T) {
+	parser := NewParser(WithStrictFields(true))
+
+	manifest, err := parser.Parse([]byte(`
+version: "v1"
+name: "auth-service"
+checks:
+  - unit-tests
+  - security-scan
+`))
+	require.NoError(t, err)
+	require.NoError(t, parser.Validate(manifest))
+	assert.Equal(t, []string{"unit-tests", "security-scan"}, manifest.ToComponent().Checks)
+
+	badSlug := &Manifest{Version: "v1", Name: "auth-service", Checks: []string{"unit tests"}}
+	err = parser.Validate(badSlug)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `check "unit tests" must contain only`)
+
+	duplicate := &Manifest{Version: "v1", Name: "auth-service", Checks: []string{"lint", "lint"}}
+	err = parser.Validate(duplicate)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "listed more than once")
+
+	_, problems := parser.Check([]byte("version: v1\nname: auth-service\nchecks:\n  - \"\"\n"))
+	require.Len(t, problems, 1)
+	assert.Equal(t, "checks", problems[0].Field)
+	assert.Equal(t, 3, problems[0].Line)
+}
+
+func TestParser_DependsOn(t *testing.T) {
+	parser := NewParser(WithStrictFields(true))
+
+	manifest, err := parser.Parse([]byte(`
+version: "v1"
+name: "auth-service"
+depends_on:
+  - user-db
+  - session-cache
+`))
+	require.NoError(t, err)
+	require.NoError(t, parser.Validate(manifest))
+	assert.Equal(t, []string{"user-db", "session-cache"}, manifest.ToComponent().DependsOn)
+
+	tests := []struct {
+		name      string
+		dependsOn []string
+		wantErr   string
+	}{
+		{"InvalidSlug", []string{"user db"}, `dependency "user db" must contain only`},
+		{"Self", []string{"auth-service"}, "component cannot depend on itself"},
+		{"Duplicate", []string{"user-db", "user-db"}, `dependency "user-db" is listed more than once`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := parser.Validate(&Manifest{Version: "v1", Name: "auth-service", DependsOn: tt.dependsOn})
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+
+	_, problems := parser.Check([]byte("version: v1\nname: auth-service\ndepends_on:\n  - auth-service\n"))
+	require.Len(t, problems, 1)
+	assert.Equal(t, "depends_on", problems[0].Field)
+	assert.Equal(t, 3, problems[0].Line)
+}
+
+func TestParser_Check_Valid(t *testing.T) {
+	manifest, problems := NewParser().Check([]byte(`
+version: "v1"
+name: "auth-service"
+lifecycle: production
+labels:
+  tier: critical
+`))
+	require.NotNil(t, manifest)
+	assert.Empty(t, problems)
+	assert.Equal(t, "auth-service", manifest.Name)
+}
+
+func TestParser_Check_ReportsEveryProblem(t *testing.T) {
+	manifest, problems := NewParser().Check([]byte(`version: "v2"
+description: "missing a name"
+lifecycle: retired
+labels:
+  tier: critical
+  "bad key": value
+`))
+	require.NotNil(t, manifest)
+	assert.Equal(t, []ManifestProblem{
+		{Field: "version", Line: 1, Message: "unsupported manifest version"},
+		{Field: "name", Message: "component name is required"},
+		{Field: "lifecycle", Line: 3, Message: "lifecycle must be one of: experimental, production, deprecated"},
+		{Field: "labels.bad key", Line: 6, Message: `label key "bad key" must contain only alphanumeric characters, hyphens, and underscores`},
+	}, problems)
+
+	// Validate stops at the first of them
+	assert.EqualError(t, NewParser().Validate(manifest), "unsupported manifest version")
+}
+
+func TestParser_Check_SyntaxError(t *testing.T) {
+	manifest, problems := NewParser().Check([]byte("version: \"v1\"\nname: [unclosed\n"))
+	assert.Nil(t, manifest)
+	require.Len(t, problems, 1)
+	assert.Positive(t, problems[0].Line)
+	assert.NotContains(t, problems[0].Message, "line")
+}
+
+func TestParser_Check_UnknownFieldsInStrictMode(t *testing.T) {
+	manifest, problems := NewParser(WithStrictFields(true)).Check([]byte(`version: "v1"
+name: "auth-service"
+owners:
+  maintaners: ["alice"]
+`))
+	assert.Nil(t, manifest)
+	assert.Equal(t, []ManifestProblem{
+		{Field: "maintaners", Line: 4, Message: `unknown field "maintaners"`},
+	}, problems)
+}
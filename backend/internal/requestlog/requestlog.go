@@ -0,0 +1,130 @@
+package requestlog
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/google/uuid"
+)
+
+// Header carries the request ID in requests and responses
+const Header = "X-Request-ID"
+
+// maxRequestIDLength bounds request IDs accepted from clients
+const maxRequestIDLength = 128
+
+// levelOff disables request logging
+const levelOff = "off"
+
+// Config holds configuration for request logging
+type Config struct {
+	// Level requests are logged at: debug, info (default), warn or error. "off" disables
+	// request logging; responses still carry a request ID.
+	Level string `yaml:"level"`
+}
+
+// Validate ensures the level is known
+func (c Config) Validate() error {
+	_, _, err := c.level()
+	return err
+}
+
+// level parses the configured level, reporting false when request logging is off
+func (c Config) level() (slog.Level, bool, error) {
+	if strings.EqualFold(c.Level, levelOff) {
+		return 0, false, nil
+	}
+	if c.Level == "" {
+		return slog.LevelInfo, true, nil
+	}
+
+	var level slog.Level
+	if err := level.UnmarshalText([]byte(c.Level)); err != nil {
+		return 0, false, fmt.Errorf("unknown request log level %q", c.Level)
+	}
+	return level, true, nil
+}
+
+type contextKey struct{}
+
+// WithRequestID returns a copy of ctx carrying the request ID
+func WithRequestID(ctx context.Context, requestID string) context.Context {
+	return context.WithValue(ctx, contextKey{}, requestID)
+}
+
+// RequestID returns the ID of the request ctx belongs to, or "" outside a request
+func RequestID(ctx context.Context) string {
+	requestID, _ := ctx.Value(contextKey{}).(string)
+	return requestID
+}
+
+// Logger returns the default logger, with the request ID attached when ctx belongs to a request,
+// so handlers and the layers below them can tie their logs to the request log line
+func Logger(ctx context.Context) *slog.Logger {
+	if requestID := RequestID(ctx); requestID != "" {
+		return slog.Default().With("request_id", requestID)
+	}
+	return slog.Default()
+}
+
+// Middleware assigns every request an ID, returned in the X-Request-ID header and available
+// through RequestID, and logs the request's method, path, status, size and duration once it
+// completes. An X-Request-ID sent by the client, for example by a proxy, is kept.
+// The config must be valid, see Config.Validate.
+func Middleware(cfg Config) func(http.Handler) http.Handler {
+	level, enabled, err := cfg.level()
+	if err != nil {
+		slog.Warn("Invalid request log level, using info", "error", err)
+		level, enabled = slog.LevelInfo, true
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			start := time.Now()
+
+			requestID := r.Header.Get(Header)
+			if !validRequestID(requestID) {
+				requestID = uuid.NewString()
+			}
+			w.Header().Set(Header, requestID)
+			r = r.WithContext(WithRequestID(r.Context(), requestID))
+
+			ww := middleware.NewWrapResponseWriter(w, r.ProtoMajor)
+			next.ServeHTTP(ww, r)
+
+			if !enabled {
+				return
+			}
+			status := ww.Status()
+			if status == 0 {
+				// Nothing was written, which net/http answers with 200
+				status = http.StatusOK
+			}
+			slog.Log(r.Context(), level, "HTTP request",
+				"request_id", requestID,
+				"method", r.Method,
+				"path", r.URL.Path,
+				"status", status,
+				"bytes", ww.BytesWritten(),
+				"duration", time.Since(start))
+		})
+	}
+}
+
+// validRequestID reports whether a client-supplied request ID is safe to log and echo back
+func validRequestID(requestID string) bool {
+	if requestID == "" || len(requestID) > maxRequestIDLength {
+		return false
+	}
+	for _, c := range requestID {
+		if c < '!' || c > '~' {
+			return false
+		}
+	}
+	return true
+}
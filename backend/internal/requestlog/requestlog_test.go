@@ -0,0 +1,98 @@
+package requestlog
+
+import (
+	"bytes"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// captureLogs sends the default logger's output to a buffer for the duration of the test
+func captureLogs(t *testing.T) *bytes.Buffer {
+	var buf bytes.Buffer
+	previous := slog.Default()
+	slog.SetDefault(slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug})))
+	t.Cleanup(func() { slog.SetDefault(previous) })
+	return &buf
+}
+
+func TestMiddleware(t *testing.T) {
+	var seenID string
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		seenID = RequestID(r.Context())
+		Logger(r.Context()).Info("Handling request")
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte("created"))
+	})
+
+	t.Run("generates and logs a request ID", func(t *testing.T) {
+		logs := captureLogs(t)
+		w := httptest.NewRecorder()
+		Middleware(Config{})(handler).ServeHTTP(w, httptest.NewRequest("POST", "/api/reports/v1/reports", nil))
+
+		requestID := w.Header().Get(Header)
+		_, err := uuid.Parse(requestID)
+		require.NoError(t, err)
+		assert.Equal(t, requestID, seenID)
+
+		lines := strings.Split(strings.TrimSpace(logs.String()), "\n")
+		require.Len(t, lines, 2)
+		assert.Contains(t, lines[0], "request_id="+requestID)
+		for _, field := range []string{"level=INFO", "request_id=" + requestID, "method=POST", "path=/api/reports/v1/reports", "status=201", "bytes=7", "duration="} {
+			assert.Contains(t, lines[1], field)
+		}
+	})
+
+	t.Run("keeps the client's request ID", func(t *testing.T) {
+		captureLogs(t)
+		req := httptest.NewRequest("GET", "/api/catalog/v1/components", nil)
+		req.Header.Set(Header, "proxy-1234")
+		w := httptest.NewRecorder()
+		Middleware(Config{})(handler).ServeHTTP(w, req)
+
+		assert.Equal(t, "proxy-1234", w.Header().Get(Header))
+		assert.Equal(t, "proxy-1234", seenID)
+	})
+
+	t.Run("replaces an unsafe request ID", func(t *testing.T) {
+		captureLogs(t)
+		req := httptest.NewRequest("GET", "/api/catalog/v1/components", nil)
+		req.Header.Set(Header, "forged id\nlevel=ERROR")
+		w := httptest.NewRecorder()
+		Middleware(Config{})(handler).ServeHTTP(w, req)
+
+		assert.NotEqual(t, "forged id\nlevel=ERROR", w.Header().Get(Header))
+	})
+
+	t.Run("configured level", func(t *testing.T) {
+		logs := captureLogs(t)
+		w := httptest.NewRecorder()
+		Middleware(Config{Level: "debug"})(handler).ServeHTTP(w, httptest.NewRequest("GET", "/api/sync/v1/sources", nil))
+		assert.Contains(t, logs.String(), `level=DEBUG msg="HTTP request"`)
+	})
+
+	t.Run("off", func(t *testing.T) {
+		logs := captureLogs(t)
+		w := httptest.NewRecorder()
+		Middleware(Config{Level: "off"})(handler).ServeHTTP(w, httptest.NewRequest("GET", "/api/sync/v1/sources", nil))
+		assert.NotEmpty(t, w.Header().Get(Header))
+		assert.NotContains(t, logs.String(), "HTTP request")
+	})
+}
+
+func TestConfig_Validate(t *testing.T) {
+	for _, level := range []string{"", "debug", "INFO", "warn", "error", "off"} {
+		assert.NoError(t, Config{Level: level}.Validate(), level)
+	}
+	assert.Error(t, Config{Level: "verbose"}.Validate())
+}
+
+func TestLogger_OutsideRequest(t *testing.T) {
+	assert.Same(t, slog.Default(), Logger(t.Context()))
+}
@@ -6,10 +6,17 @@ import (
 	"net/http"
 	"time"
 
+	adminapi "github.com/doron-cohen/argus/backend/admin/api"
 	"github.com/doron-cohen/argus/backend/api"
 	"github.com/doron-cohen/argus/backend/internal/config"
+	"github.com/doron-cohen/argus/backend/internal/cors"
 	"github.com/doron-cohen/argus/backend/internal/health"
+	"github.com/doron-cohen/argus/backend/internal/metrics"
+	"github.com/doron-cohen/argus/backend/internal/requestlog"
 	"github.com/doron-cohen/argus/backend/internal/storage"
+	"github.com/doron-cohen/argus/backend/internal/tracing"
+	"github.com/doron-cohen/argus/backend/notifications"
+	"github.com/doron-cohen/argus/backend/reports"
 	reportsapi "github.com/doron-cohen/argus/backend/reports/api"
 	"github.com/doron-cohen/argus/backend/sync"
 	syncapi "github.com/doron-cohen/argus/backend/sync/api"
@@ -17,39 +24,81 @@ import (
 	"github.com/go-chi/chi/v5"
 )
 
+// syncDrainTimeout bounds how long shutdown waits for running syncs to finish
+const syncDrainTimeout = 10 * time.Second
+
+// tracingFlushTimeout bounds how long shutdown waits to export the spans of the last requests and syncs
+const tracingFlushTimeout = 5 * time.Second
+
 func Start(cfg config.Config) (stop func(), err error) {
 	mux := chi.NewRouter()
 
-	// Basic CORS middleware to allow dev frontend (and tests) to call the API from another origin
-	mux.Use(func(next http.Handler) http.Handler {
-		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			w.Header().Set("Access-Control-Allow-Origin", "*")
-			w.Header().Set("Access-Control-Allow-Methods", "GET,POST,PUT,PATCH,DELETE,OPTIONS")
-			w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization")
-			if r.Method == http.MethodOptions {
-				w.WriteHeader(http.StatusNoContent)
-				return
-			}
-			next.ServeHTTP(w, r)
-		})
-	})
-
 	// Connect to PostgreSQL using storage.ConnectAndMigrate
-	dsn := cfg.Storage.DSN()
-	repo, dberr := storage.ConnectAndMigrate(context.Background(), dsn)
+	repo, dberr := storage.ConnectAndMigrate(context.Background(), cfg.Storage)
 	if dberr != nil {
 		slog.Error("Failed to connect or migrate database", "error", dberr)
 		return nil, dberr
 	}
+	repo.CaseInsensitiveIDs = cfg.Storage.CaseInsensitiveIDs
+
+	// Export spans to the configured OTLP endpoint; tracing stays a no-op without one
+	shutdownTracing, err := tracing.Setup(context.Background(), cfg.Tracing)
+	if err != nil {
+		slog.Error("Failed to set up tracing", "error", err)
+		return nil, err
+	}
 
-	// Mount healthz
+	// Mount healthz and the Kubernetes probes; liveness never touches the database
 	mux.Get("/healthz", health.HealthHandler(repo))
+	mux.Get("/livez", health.LivenessHandler())
 
-	// Mount catalog API under /api/catalog/v1
-	mux.Mount("/api/catalog/v1", api.Handler(api.NewAPIServer(repo)))
+	// Mount Prometheus metrics
+	if cfg.Metrics.Enabled {
+		mux.Handle("/metrics", metrics.Handler())
+	}
+
+	// Every API is mounted under /api, where requests are logged and browsers from the
+	// configured origins may call it
+	apiMux := chi.NewRouter()
+	apiMux.Use(tracing.Middleware)
+	apiMux.Use(requestlog.Middleware(cfg.Server.RequestLog))
+	apiMux.Use(cors.Middleware(cfg.Server.CORS))
+	mux.Mount("/api", apiMux)
+
+	// Mount catalog API under /api/catalog/v1; writes such as deleting a component require the admin token
+	apiMux.Mount("/catalog/v1", api.HandlerWithOptions(api.NewAPIServer(repo, cfg.API), api.ChiServerOptions{
+		Middlewares: []api.MiddlewareFunc{api.RequireAuthOnSecured(adminapi.RequireToken(cfg.Admin.Token))},
+	}))
+
+	// Start webhook delivery workers if any webhooks are configured
+	var dispatcher *notifications.WebhookDispatcher
+	var notifier *notifications.TransitionNotifier
+	if len(cfg.Notifications.Webhooks) > 0 {
+		dispatcher = notifications.NewWebhookDispatcher(repo, cfg.Notifications.Delivery)
+		dispatcher.Start()
+		notifier = notifications.NewTransitionNotifier(repo, dispatcher, cfg.Notifications.Webhooks)
+	}
 
-	// Mount reports API under /api/reports/v1
-	mux.Mount("/api/reports/v1", reportsapi.Handler(reportsapi.NewAPIServer(repo)))
+	// Start async report ingestion workers if enabled
+	var ingestQueue *reports.IngestQueue
+	if cfg.Reports.Async.Enabled {
+		ingestQueue = reports.NewIngestQueue(repo, cfg.Reports.Async, notifier)
+		ingestQueue.Start()
+	}
+
+	// Prune reports older than the retention window in the background
+	retentionCtx, retentionCancel := context.WithCancel(context.Background())
+	go reports.NewPruner(repo, cfg.Reports).Start(retentionCtx)
+
+	// Mount reports API under /api/reports/v1, requiring an API key when keys are configured
+	reportsRouter := chi.NewRouter()
+	reportsRouter.Use(reportsapi.RequireAPIKey(cfg.Auth.APIKeys))
+	apiMux.Mount("/reports/v1", reportsapi.HandlerFromMux(reportsapi.NewAPIServer(repo, cfg.Reports, ingestQueue, notifier), reportsRouter))
+
+	// Mount admin API under /api/admin/v1
+	adminRouter := chi.NewRouter()
+	adminRouter.Use(adminapi.RequireToken(cfg.Admin.Token))
+	apiMux.Mount("/admin/v1", adminapi.HandlerFromMux(adminapi.NewAPIServer(repo, cfg.API.MaxOffset), adminRouter))
 
 	// Initialize sync service (always create, but may not start if no sources configured)
 	// Cast to sync.Repository interface since storage.Repository implements it
@@ -59,8 +108,11 @@ func Start(cfg config.Config) (stop func(), err error) {
 	// Start sync service (will log warning and return if no sources configured)
 	go syncService.StartPeriodicSync(syncCtx)
 
+	// Readiness waits for the database and, when sources are configured, a first successful sync
+	mux.Get("/readyz", health.ReadinessHandler(repo, syncService))
+
 	// Mount sync API under /api/sync/v1
-	mux.Mount("/api/sync/v1", syncapi.Handler(syncapi.NewSyncAPIServer(syncService)))
+	apiMux.Mount("/sync/v1", syncapi.Handler(syncapi.NewSyncAPIServer(syncService)))
 
 	// Serve static files and client routes from embedded frontend
 	// This must come after all API routes to ensure proper precedence
@@ -81,12 +133,29 @@ func Start(cfg config.Config) (stop func(), err error) {
 	}()
 
 	stop = func() {
-		syncCancel() // Stop sync goroutines
+		syncCancel()      // Stop sync goroutines
+		retentionCancel() // Stop pruning reports
 		ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
 		defer cancel()
 		if err := srv.Shutdown(ctx); err != nil {
 			slog.Error("Graceful shutdown failed", "error", err)
 		}
+		if ingestQueue != nil {
+			ingestQueue.Stop() // Persist reports that were already accepted
+		}
+		if dispatcher != nil {
+			dispatcher.Stop() // Dead-letter webhooks that could not be delivered in time
+		}
+		drainCtx, drainCancel := context.WithTimeout(context.Background(), syncDrainTimeout)
+		defer drainCancel()
+		if err := syncService.Shutdown(drainCtx); err != nil {
+			slog.Error("Syncs did not finish before shutdown", "error", err)
+		}
+		flushCtx, flushCancel := context.WithTimeout(context.Background(), tracingFlushTimeout)
+		defer flushCancel()
+		if err := shutdownTracing(flushCtx); err != nil {
+			slog.Error("Failed to flush spans", "error", err)
+		}
 	}
 
 	return stop, nil
@@ -0,0 +1,99 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"gorm.io/gorm"
+)
+
+// ErrUnknownDerivedColumn is returned when backfilling a column that is not registered
+var ErrUnknownDerivedColumn = errors.New("unknown derived column")
+
+// derivedColumn describes a column computed from other fields of the same row
+type derivedColumn struct {
+	// missing selects rows that lack the derived value
+	missing func(db *gorm.DB) *gorm.DB
+	// fill computes and stores the value for up to batchSize missing rows
+	fill func(tx *gorm.DB, batchSize int) (int, error)
+}
+
+// derivedColumns lists every column that can be backfilled, keyed by qualified name
+var derivedColumns = map[string]derivedColumn{
+	"check_reports.checksum": {
+		missing: func(db *gorm.DB) *gorm.DB {
+			return db.Model(&CheckReport{}).Where("checksum IS NULL")
+		},
+		fill: fillCheckReportChecksums,
+	},
+}
+
+// DerivedColumns returns the qualified names of all columns that can be backfilled
+func DerivedColumns() []string {
+	names := make([]string, 0, len(derivedColumns))
+	for name := range derivedColumns {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// CountMissingDerived returns how many rows still lack a derived column
+func (r *Repository) CountMissingDerived(ctx context.Context, column string) (int64, error) {
+	derived, ok := derivedColumns[column]
+	if !ok {
+		return 0, fmt.Errorf("%w: %s", ErrUnknownDerivedColumn, column)
+	}
+
+	var count int64
+	if err := derived.missing(r.DB.WithContext(ctx)).Count(&count).Error; err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// BackfillDerivedBatch populates a derived column for up to batchSize rows that lack it
+// and returns the number of rows updated. Rows that already have a value are never
+// touched, so repeated calls resume where the previous one stopped.
+func (r *Repository) BackfillDerivedBatch(ctx context.Context, column string, batchSize int) (int, error) {
+	derived, ok := derivedColumns[column]
+	if !ok {
+		return 0, fmt.Errorf("%w: %s", ErrUnknownDerivedColumn, column)
+	}
+
+	var updated int
+	err := r.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var err error
+		updated, err = derived.fill(tx, batchSize)
+		return err
+	})
+	return updated, err
+}
+
+// fillCheckReportChecksums computes checksums for reports stored before the column existed
+func fillCheckReportChecksums(tx *gorm.DB, batchSize int) (int, error) {
+	var reports []CheckReport
+	err := tx.Model(&CheckReport{}).
+		Where("checksum IS NULL").
+		Order("id").
+		Limit(batchSize).
+		Find(&reports).Error
+	if err != nil {
+		return 0, err
+	}
+
+	for _, report := range reports {
+		checksum, err := report.ComputeChecksum()
+		if err != nil {
+			return 0, fmt.Errorf("failed to compute checksum for report %s: %w", report.ID, err)
+		}
+		// UpdateColumn skips hooks and leaves updated_at alone
+		err = tx.Model(&CheckReport{}).Where("id = ?", report.ID).UpdateColumn("checksum", checksum).Error
+		if err != nil {
+			return 0, err
+		}
+	}
+	return len(reports), nil
+}
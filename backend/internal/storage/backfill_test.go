@@ -0,0 +1,91 @@
+package storage_test
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/doron-cohen/argus/backend/internal/storage"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// seedReportsWithoutChecksum creates reports and clears their checksum, as if they
+// were stored before the column existed
+func seedReportsWithoutChecksum(t *testing.T, repo *storage.Repository, count int) []storage.CheckReport {
+	component := storage.Component{ComponentID: "backfill-service", Name: "Backfill Service"}
+	require.NoError(t, repo.DB.Create(&component).Error)
+	check := storage.Check{Slug: "backfill-check", Name: "Backfill Check"}
+	require.NoError(t, repo.DB.Create(&check).Error)
+
+	now := time.Now()
+	reports := make([]storage.CheckReport, count)
+	for i := range reports {
+		reports[i] = storage.CheckReport{
+			CheckID:     check.ID,
+			ComponentID: component.ID,
+			Status:      storage.CheckStatusPass,
+			Timestamp:   now.Add(-time.Duration(i) * time.Minute),
+			Details:     storage.JSONB{"run": float64(i)},
+		}
+		require.NoError(t, repo.DB.Create(&reports[i]).Error)
+	}
+	require.NoError(t, repo.DB.Model(&storage.CheckReport{}).Where("1 = 1").UpdateColumn("checksum", nil).Error)
+	return reports
+}
+
+func TestCheckReport_ChecksumSetOnCreate(t *testing.T) {
+	repo := setupIsolatedRepo(t)
+
+	component := storage.Component{ComponentID: "checksum-service", Name: "Checksum Service"}
+	require.NoError(t, repo.DB.Create(&component).Error)
+	check := storage.Check{Slug: "checksum-check", Name: "Checksum Check"}
+	require.NoError(t, repo.DB.Create(&check).Error)
+
+	report := storage.CheckReport{CheckID: check.ID, ComponentID: component.ID, Status: storage.CheckStatusFail, Timestamp: time.Now()}
+	require.NoError(t, repo.DB.Create(&report).Error)
+
+	var stored storage.CheckReport
+	require.NoError(t, repo.DB.First(&stored, "id = ?", report.ID).Error)
+	require.NotNil(t, stored.Checksum)
+	assert.Len(t, *stored.Checksum, 64)
+
+	// Recomputing from the stored row gives the same value
+	recomputed, err := stored.ComputeChecksum()
+	require.NoError(t, err)
+	assert.Equal(t, *stored.Checksum, recomputed)
+}
+
+func TestCheckReport_ComputeChecksumRunTiming(t *testing.T) {
+	report := storage.CheckReport{
+		ComponentID: uuid.MustParse("0190f1a2-0000-7000-8000-000000000001"),
+		CheckID:     uuid.MustParse("0190f1a2-0000-7000-8000-000000000002"),
+		Status:      storage.CheckStatusPass,
+		Timestamp:   time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC),
+	}
+
+	// Reports without run timing hash as they did before the fields existed
+	legacy, err := json.Marshal(map[string]interface{}{
+		"component_id": report.ComponentID.String(),
+		"check_id":     report.CheckID.String(),
+		"status":       report.Status,
+		"timestamp":    "2024-01-15T10:30:00Z",
+		"details":      report.Details,
+		"metadata":     report.Metadata,
+	})
+	require.NoError(t, err)
+	legacySum := sha256.Sum256(legacy)
+	base, err := report.ComputeChecksum()
+	require.NoError(t, err)
+	assert.Equal(t, hex.EncodeToString(legacySum[:]), base)
+
+	startedAt := report.Timestamp.Add(-time.Minute)
+	duration := int64(60000)
+	report.StartedAt, report.DurationMs = &startedAt, &duration
+	timed, err := report.ComputeChecksum()
+	require.NoError(t, err)
+	assert.NotEqual(t, base, timed)
+}
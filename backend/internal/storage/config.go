@@ -1,9 +1,21 @@
 package storage
 
 import (
+	"database/sql"
 	"fmt"
+	"time"
 )
 
+// Connection pool defaults, applied when the pool settings are not configured
+const (
+	DefaultMaxOpenConns    = 25
+	DefaultMaxIdleConns    = 5
+	DefaultConnMaxLifetime = 30 * time.Minute
+)
+
+// minConnMaxLifetime keeps connections from being recycled so often that reconnecting dominates
+const minConnMaxLifetime = time.Minute
+
 type Config struct {
 	Host     string `yaml:"host"`
 	Port     int    `yaml:"port"`
@@ -11,6 +23,18 @@ type Config struct {
 	Password string `yaml:"password"`
 	DBName   string `yaml:"dbname"`
 	SSLMode  string `yaml:"sslmode"`
+
+	// CaseInsensitiveIDs lowercases component identifiers when storing and looking
+	// them up. Existing components must already have lowercase identifiers.
+	CaseInsensitiveIDs bool `yaml:"case_insensitive_ids"`
+
+	// MaxOpenConns caps the connections Argus opens to Postgres, in use or idle.
+	// It must stay below the server's max_connections, less what other clients need.
+	MaxOpenConns int `yaml:"max_open_conns"`
+	// MaxIdleConns is how many unused connections are kept open for reuse, at most MaxOpenConns
+	MaxIdleConns int `yaml:"max_idle_conns"`
+	// ConnMaxLifetime is how long a connection is reused before it is closed and replaced
+	ConnMaxLifetime time.Duration `yaml:"conn_max_lifetime"`
 }
 
 func (c Config) DSN() string {
@@ -19,3 +43,27 @@ func (c Config) DSN() string {
 		c.Host, c.Port, c.User, c.Password, c.DBName, c.SSLMode,
 	)
 }
+
+// Validate ensures the connection pool settings are usable
+func (c Config) Validate() error {
+	if c.MaxOpenConns < 1 {
+		return fmt.Errorf("max_open_conns must be at least 1, got %d", c.MaxOpenConns)
+	}
+	if c.MaxIdleConns < 0 {
+		return fmt.Errorf("max_idle_conns cannot be negative, got %d", c.MaxIdleConns)
+	}
+	if c.MaxIdleConns > c.MaxOpenConns {
+		return fmt.Errorf("max_idle_conns (%d) cannot exceed max_open_conns (%d)", c.MaxIdleConns, c.MaxOpenConns)
+	}
+	if c.ConnMaxLifetime < minConnMaxLifetime {
+		return fmt.Errorf("conn_max_lifetime must be at least %s, got %s", minConnMaxLifetime, c.ConnMaxLifetime)
+	}
+	return nil
+}
+
+// applyPool configures the connection pool of db
+func (c Config) applyPool(db *sql.DB) {
+	db.SetMaxOpenConns(c.MaxOpenConns)
+	db.SetMaxIdleConns(c.MaxIdleConns)
+	db.SetConnMaxLifetime(c.ConnMaxLifetime)
+}
@@ -0,0 +1,45 @@
+package storage_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/doron-cohen/argus/backend/internal/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfig_Validate(t *testing.T) {
+	valid := storage.Config{MaxOpenConns: storage.DefaultMaxOpenConns, MaxIdleConns: storage.DefaultMaxIdleConns, ConnMaxLifetime: storage.DefaultConnMaxLifetime}
+	assert.NoError(t, valid.Validate())
+
+	tests := []struct {
+		name    string
+		modify  func(*storage.Config)
+		wantErr string
+	}{
+		{"no open connections", func(c *storage.Config) { c.MaxOpenConns = 0 }, "max_open_conns must be at least 1"},
+		{"negative idle connections", func(c *storage.Config) { c.MaxIdleConns = -1 }, "max_idle_conns cannot be negative"},
+		{"more idle than open connections", func(c *storage.Config) { c.MaxOpenConns, c.MaxIdleConns = 2, 3 }, "cannot exceed max_open_conns"},
+		{"short lifetime", func(c *storage.Config) { c.ConnMaxLifetime = time.Second }, "conn_max_lifetime must be at least 1m0s"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := valid
+			tt.modify(&cfg)
+			assert.ErrorContains(t, cfg.Validate(), tt.wantErr)
+		})
+	}
+}
+
+func TestRepository_HealthCheck(t *testing.T) {
+	repo := setupIsolatedRepo(t)
+	sqlDB, err := repo.DB.DB()
+	require.NoError(t, err)
+
+	assert.NoError(t, repo.HealthCheck(t.Context()))
+	assert.Zero(t, sqlDB.Stats().InUse, "the health check must release its connection")
+
+	require.NoError(t, sqlDB.Close())
+	assert.Error(t, repo.HealthCheck(t.Context()))
+}
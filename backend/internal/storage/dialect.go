@@ -0,0 +1,297 @@
+package storage
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Dialect encapsulates the SQL that differs between PostgreSQL and SQLite,
+// so queries needing database-specific behavior have one place to handle both.
+// PostgreSQL is the production database; SQLite backs the unit tests.
+type Dialect interface {
+	// Name returns the GORM dialector name the dialect applies to
+	Name() string
+
+	// LatestPerCheck returns the latest report (by timestamp, then ID) for each check
+	// among the reports matched by filtered, sorted by latestPerCheckOrder, with the number of
+	// checks. Reports come with their Check and Component loaded.
+	// filtered must return a fresh query on check_reports with all filters applied.
+	LatestPerCheck(ctx context.Context, filtered func() *gorm.DB, order ReportOrder, limit int, offset int) ([]CheckReport, int64, error)
+
+	// LatestPerComponentCheck returns a subquery selecting the ID of the latest report
+	// (by timestamp, then ID) for each component and check among the reports matched by filtered
+	LatestPerComponentCheck(filtered func() *gorm.DB) *gorm.DB
+
+	// TimeBucket returns an expression for the start of the UTC bucket containing the
+	// timestamp column, formatted as RFC 3339
+	TimeBucket(column string, bucket TimeBucket) string
+
+	// JSONArrayContains matches rows whose JSON array column contains value
+	JSONArrayContains(column string, value string) clause.Expression
+
+	// ContainsText matches rows where any of the columns contains term, case-insensitively
+	ContainsText(columns []string, term string) clause.Expression
+
+	// JSONObjectHas matches rows whose JSON object column maps key to value
+	JSONObjectHas(column string, key string, value string) clause.Expression
+
+	// Dependents returns the live components that depend on root, directly or through other
+	// components, following at most maxDepth dependency edges. Each is returned once, at its
+	// shortest distance from root. Cycles are cut, and root itself is never returned.
+	Dependents(ctx context.Context, db *gorm.DB, root Component, maxDepth int) ([]DependentDepth, error)
+}
+
+// DependentDepth is a component reached by following dependency edges backwards, with the
+// number of edges followed
+type DependentDepth struct {
+	ID    uuid.UUID
+	Depth int
+}
+
+// DialectFor returns the dialect for the database behind db
+func DialectFor(db *gorm.DB) Dialect {
+	if db.Name() == "postgres" {
+		return postgresDialect{}
+	}
+	return sqliteDialect{}
+}
+
+// escapeLike escapes LIKE wildcards so term is matched literally
+func escapeLike(term string) string {
+	replacer := strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+	return replacer.Replace(term)
+}
+
+// latestPerCheckOrder sorts the latest report of each check by the requested order, then timestamp
+// descending, then check slug, then report ID, so checks reported at the same time are listed by
+// slug. Both dialects use it, so pages come back in the same order on either database.
+func latestPerCheckOrder(order ReportOrder) string {
+	const tieBreakers = "(SELECT checks.slug FROM checks WHERE checks.id = check_reports.check_id), check_reports.id"
+	direction := "DESC"
+	if order.Ascending {
+		direction = "ASC"
+	}
+	if order.Field == ReportSortStatus {
+		return fmt.Sprintf("check_reports.status %s, check_reports.timestamp DESC, %s", direction, tieBreakers)
+	}
+	return fmt.Sprintf("check_reports.timestamp %s, %s", direction, tieBreakers)
+}
+
+// postgresDialect implements Dialect for PostgreSQL
+type postgresDialect struct{}
+
+func (postgresDialect) Name() string {
+	return "postgres"
+}
+
+func (postgresDialect) LatestPerCheck(ctx context.Context, filtered func() *gorm.DB, order ReportOrder, limit int, offset int) ([]CheckReport, int64, error) {
+	// DISTINCT ON keeps the first row per check; the report ID breaks timestamp ties
+	latestReportSubquery := filtered().
+		Select("DISTINCT ON (check_reports.check_id) check_reports.id").
+		Order("check_reports.check_id, check_reports.timestamp DESC, check_reports.id DESC")
+
+	var reports []CheckReport
+	err := filtered().
+		Where("check_reports.id IN (?)", latestReportSubquery).
+		Preload("Check").
+		Preload("Component").
+		Scopes(WithPagination(limit, offset)).
+		Order(latestPerCheckOrder(order)).
+		Find(&reports).Error
+	if err != nil {
+		return nil, 0, fmt.Errorf("find query failed: %w", err)
+	}
+
+	var total int64
+	err = filtered().Select("COUNT(DISTINCT check_reports.check_id)").Scan(&total).Error
+	if err != nil {
+		return nil, 0, fmt.Errorf("count query failed: %w", err)
+	}
+
+	return reports, total, nil
+}
+
+func (postgresDialect) LatestPerComponentCheck(filtered func() *gorm.DB) *gorm.DB {
+	return filtered().
+		Select("DISTINCT ON (check_reports.component_id, check_reports.check_id) check_reports.id").
+		Order("check_reports.component_id, check_reports.check_id, check_reports.timestamp DESC, check_reports.id DESC")
+}
+
+func (postgresDialect) TimeBucket(column string, bucket TimeBucket) string {
+	// Bucket names are date_trunc units
+	return fmt.Sprintf(`to_char(date_trunc('%s', %s AT TIME ZONE 'UTC'), 'YYYY-MM-DD"T"HH24:MI:SS"Z"')`, bucket, column)
+}
+
+func (postgresDialect) JSONArrayContains(column string, value string) clause.Expression {
+	encoded, _ := json.Marshal([]string{value})
+	return clause.Expr{SQL: fmt.Sprintf("%s @> ?::jsonb", column), Vars: []interface{}{string(encoded)}}
+}
+
+func (postgresDialect) ContainsText(columns []string, term string) clause.Expression {
+	pattern := "%" + escapeLike(term) + "%"
+	conditions := make([]string, len(columns))
+	vars := make([]interface{}, len(columns))
+	for i, column := range columns {
+		conditions[i] = fmt.Sprintf("%s ILIKE ?", column)
+		vars[i] = pattern
+	}
+	return clause.Expr{SQL: "(" + strings.Join(conditions, " OR ") + ")", Vars: vars}
+}
+
+func (postgresDialect) JSONObjectHas(column string, key string, value string) clause.Expression {
+	encoded, _ := json.Marshal(map[string]string{key: value})
+	return clause.Expr{SQL: fmt.Sprintf("%s @> ?::jsonb", column), Vars: []interface{}{string(encoded)}}
+}
+
+// Dependents walks the dependents with a recursive CTE. Each row carries the path it was reached
+// by, and a component already on the path is not followed again, which ends cycles.
+func (postgresDialect) Dependents(ctx context.Context, db *gorm.DB, root Component, maxDepth int) ([]DependentDepth, error) {
+	const query = `
+WITH RECURSIVE impact (id, component_id, depth, path) AS (
+	SELECT components.id, components.component_id, 1, ARRAY[CAST(@root_id AS uuid), components.id]
+	FROM component_dependencies
+	JOIN components ON components.id = component_dependencies.component_id AND components.deleted_at IS NULL
+	WHERE component_dependencies.depends_on = @root_component_id AND components.id <> @root_id
+	UNION ALL
+	SELECT components.id, components.component_id, impact.depth + 1, impact.path || components.id
+	FROM impact
+	JOIN component_dependencies ON component_dependencies.depends_on = impact.component_id
+	JOIN components ON components.id = component_dependencies.component_id AND components.deleted_at IS NULL
+	WHERE impact.depth < @max_depth AND components.id <> ALL (impact.path)
+)
+SELECT id, MIN(depth) AS depth FROM impact GROUP BY id`
+
+	var dependents []DependentDepth
+	err := db.WithContext(ctx).Raw(query, map[string]interface{}{
+		"root_id":           root.ID,
+		"root_component_id": root.ComponentID,
+		"max_depth":         maxDepth,
+	}).Scan(&dependents).Error
+	if err != nil {
+		return nil, err
+	}
+	return dependents, nil
+}
+
+// sqliteDialect implements Dialect for SQLite
+type sqliteDialect struct{}
+
+func (sqliteDialect) Name() string {
+	return "sqlite"
+}
+
+// LatestPerCheck ranks each check's reports with ROW_NUMBER and keeps the first,
+// matching the DISTINCT ON query on PostgreSQL, so sorting and pagination stay in the database.
+func (sqliteDialect) LatestPerCheck(ctx context.Context, filtered func() *gorm.DB, order ReportOrder, limit int, offset int) ([]CheckReport, int64, error) {
+	// The report ID breaks timestamp ties, as on PostgreSQL
+	rankedReports := filtered().
+		Select("check_reports.id, ROW_NUMBER() OVER (PARTITION BY check_reports.check_id ORDER BY check_reports.timestamp DESC, check_reports.id DESC) AS row_number")
+	latestReportSubquery := filtered().Session(&gorm.Session{NewDB: true}).
+		Table("(?) AS ranked_reports", rankedReports).
+		Select("ranked_reports.id").
+		Where("ranked_reports.row_number = 1")
+
+	var reports []CheckReport
+	err := filtered().
+		Where("check_reports.id IN (?)", latestReportSubquery).
+		Preload("Check").
+		Preload("Component").
+		Scopes(WithPagination(limit, offset)).
+		Order(latestPerCheckOrder(order)).
+		Find(&reports).Error
+	if err != nil {
+		return nil, 0, fmt.Errorf("find query failed: %w", err)
+	}
+
+	var total int64
+	err = filtered().Select("COUNT(DISTINCT check_reports.check_id)").Scan(&total).Error
+	if err != nil {
+		return nil, 0, fmt.Errorf("count query failed: %w", err)
+	}
+
+	return reports, total, nil
+}
+
+func (sqliteDialect) LatestPerComponentCheck(filtered func() *gorm.DB) *gorm.DB {
+	rankedReports := filtered().
+		Select("check_reports.id, ROW_NUMBER() OVER (PARTITION BY check_reports.component_id, check_reports.check_id ORDER BY check_reports.timestamp DESC, check_reports.id DESC) AS row_number")
+	return filtered().Session(&gorm.Session{NewDB: true}).
+		Table("(?) AS ranked_reports", rankedReports).
+		Select("ranked_reports.id").
+		Where("ranked_reports.row_number = 1")
+}
+
+func (sqliteDialect) TimeBucket(column string, bucket TimeBucket) string {
+	format := "%Y-%m-%dT00:00:00Z"
+	if bucket == TimeBucketHour {
+		format = "%Y-%m-%dT%H:00:00Z"
+	}
+	return fmt.Sprintf("strftime('%s', %s)", format, column)
+}
+
+func (sqliteDialect) JSONArrayContains(column string, value string) clause.Expression {
+	return clause.Expr{
+		SQL:  fmt.Sprintf("EXISTS (SELECT 1 FROM json_each(%s) WHERE json_each.value = ?)", column),
+		Vars: []interface{}{value},
+	}
+}
+
+func (sqliteDialect) ContainsText(columns []string, term string) clause.Expression {
+	pattern := "%" + strings.ToLower(escapeLike(term)) + "%"
+	conditions := make([]string, len(columns))
+	vars := make([]interface{}, len(columns))
+	for i, column := range columns {
+		conditions[i] = fmt.Sprintf(`LOWER(%s) LIKE ? ESCAPE '\'`, column)
+		vars[i] = pattern
+	}
+	return clause.Expr{SQL: "(" + strings.Join(conditions, " OR ") + ")", Vars: vars}
+}
+
+func (sqliteDialect) JSONObjectHas(column string, key string, value string) clause.Expression {
+	// Quoting the key keeps dots and other path syntax in it literal
+	return clause.Expr{
+		SQL:  fmt.Sprintf("json_extract(%s, '$.' || json_quote(?)) = ?", column),
+		Vars: []interface{}{key, value},
+	}
+}
+
+// Dependents walks the dependents breadth first, one query per level. Visiting each component
+// once yields its shortest distance and ends cycles.
+func (sqliteDialect) Dependents(ctx context.Context, db *gorm.DB, root Component, maxDepth int) ([]DependentDepth, error) {
+	visited := map[uuid.UUID]bool{root.ID: true}
+	frontier := []string{root.ComponentID}
+	dependents := []DependentDepth{}
+
+	for depth := 1; depth <= maxDepth && len(frontier) > 0; depth++ {
+		var level []struct {
+			ID          uuid.UUID
+			ComponentID string
+		}
+		err := db.WithContext(ctx).Table("component_dependencies").
+			Select("components.id, components.component_id").
+			Joins("JOIN components ON components.id = component_dependencies.component_id AND components.deleted_at IS NULL").
+			Where("component_dependencies.depends_on IN ?", frontier).
+			Scan(&level).Error
+		if err != nil {
+			return nil, err
+		}
+
+		frontier = nil
+		for _, component := range level {
+			if visited[component.ID] {
+				continue
+			}
+			visited[component.ID] = true
+			dependents = append(dependents, DependentDepth{ID: component.ID, Depth: depth})
+			frontier = append(frontier, component.ComponentID)
+		}
+	}
+
+	return dependents, nil
+}
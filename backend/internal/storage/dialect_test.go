@@ -0,0 +1,155 @@
+package storage_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/doron-cohen/argus/backend/internal/storage"
+	"github.com/glebarez/sqlite"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func setupIsolatedRepo(t *testing.T) *storage.Repository {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	require.NoError(t, err)
+	sqlDB, err := db.DB()
+	require.NoError(t, err)
+	sqlDB.SetMaxOpenConns(1)
+	repo := &storage.Repository{DB: db}
+	require.NoError(t, repo.Migrate(t.Context()))
+	return repo
+}
+
+func TestDialectFor(t *testing.T) {
+	repo := setupIsolatedRepo(t)
+	assert.Equal(t, "sqlite", storage.DialectFor(repo.DB).Name())
+}
+
+func TestDialect_JSONArrayContains(t *testing.T) {
+	repo := setupIsolatedRepo(t)
+	dialect := storage.DialectFor(repo.DB)
+
+	require.NoError(t, repo.DB.Create(&storage.Component{ComponentID: "a", Name: "A", Maintainers: storage.StringArray{"alice@company.com", "@bob"}}).Error)
+	require.NoError(t, repo.DB.Create(&storage.Component{ComponentID: "b", Name: "B", Maintainers: storage.StringArray{"carol@company.com"}}).Error)
+	require.NoError(t, repo.DB.Create(&storage.Component{ComponentID: "c", Name: "C"}).Error)
+
+	var components []storage.Component
+	require.NoError(t, repo.DB.Where(dialect.JSONArrayContains("maintainers", "@bob")).Find(&components).Error)
+	require.Len(t, components, 1)
+	assert.Equal(t, "a", components[0].ComponentID)
+
+	// Substrings of an element do not match
+	components = nil
+	require.NoError(t, repo.DB.Where(dialect.JSONArrayContains("maintainers", "alice")).Find(&components).Error)
+	assert.Empty(t, components)
+}
+
+func TestDialect_ContainsText(t *testing.T) {
+	repo := setupIsolatedRepo(t)
+	dialect := storage.DialectFor(repo.DB)
+
+	require.NoError(t, repo.DB.Create(&storage.Component{ComponentID: "auth", Name: "Auth Service", Description: "Handles login"}).Error)
+	require.NoError(t, repo.DB.Create(&storage.Component{ComponentID: "billing", Name: "Billing", Description: "100% uptime target"}).Error)
+
+	tests := []struct {
+		name     string
+		term     string
+		expected []string
+	}{
+		{"case insensitive name match", "auth service", []string{"auth"}},
+		{"description match", "LOGIN", []string{"auth"}},
+		{"wildcards are literal", "%", []string{"billing"}},
+		{"underscore is literal", "_", nil},
+		{"no match", "payments", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var components []storage.Component
+			err := repo.DB.Where(dialect.ContainsText([]string{"name", "description"}, tt.term)).
+				Order("component_id").
+				Find(&components).Error
+			require.NoError(t, err)
+
+			var ids []string
+			for _, component := range components {
+				ids = append(ids, component.ComponentID)
+			}
+			assert.Equal(t, tt.expected, ids)
+		})
+	}
+}
+
+func TestDialect_LatestPerCheckAppliesFiltersFirst(t *testing.T) {
+	repo := setupIsolatedRepo(t)
+	ctx := t.Context()
+
+	component := storage.Component{ComponentID: "dialect-service", Name: "Dialect Service"}
+	require.NoError(t, repo.DB.Create(&component).Error)
+	check := storage.Check{Slug: "dialect-check", Name: "Dialect Check"}
+	require.NoError(t, repo.DB.Create(&check).Error)
+
+	now := time.Now().UTC().Truncate(time.Second)
+	older := storage.CheckReport{CheckID: check.ID, ComponentID: component.ID, Status: storage.CheckStatusPass, Timestamp: now.Add(-2 * time.Hour)}
+	newer := storage.CheckReport{CheckID: check.ID, ComponentID: component.ID, Status: storage.CheckStatusFail, Timestamp: now.Add(-1 * time.Hour)}
+	require.NoError(t, repo.DB.Create(&older).Error)
+	require.NoError(t, repo.DB.Create(&newer).Error)
+
+	reports, total, err := repo.GetCheckReportsForComponentWithPagination(ctx, "dialect-service", nil, nil, nil, nil, 10, 0, true, storage.ReportOrder{})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), total)
+	require.Len(t, reports, 1)
+	assert.Equal(t, newer.ID, reports[0].ID)
+
+	// The latest passing report is returned even though a newer failing one exists
+	status := storage.CheckStatusPass
+	reports, total, err = repo.GetCheckReportsForComponentWithPagination(ctx, "dialect-service", []storage.CheckStatus{status}, nil, nil, nil, 10, 0, true, storage.ReportOrder{})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), total)
+	require.Len(t, reports, 1)
+	assert.Equal(t, older.ID, reports[0].ID)
+}
+
+func TestDialect_LatestPerCheckPaginationIsDeterministic(t *testing.T) {
+	repo := setupIsolatedRepo(t)
+	ctx := t.Context()
+
+	component := storage.Component{ComponentID: "paging-service", Name: "Paging Service"}
+	require.NoError(t, repo.DB.Create(&component).Error)
+
+	// Several checks share the same latest timestamp, so only the tie-breaker orders them
+	now := time.Now().UTC().Truncate(time.Second)
+	slugs := []string{"check-e", "check-c", "check-a", "check-d", "check-b", "check-f"}
+	for i, slug := range slugs {
+		check := storage.Check{Slug: slug, Name: slug}
+		require.NoError(t, repo.DB.Create(&check).Error)
+		timestamp := now
+		if i == len(slugs)-1 {
+			timestamp = now.Add(-time.Hour)
+		}
+		report := storage.CheckReport{CheckID: check.ID, ComponentID: component.ID, Status: storage.CheckStatusPass, Timestamp: timestamp}
+		require.NoError(t, repo.DB.Create(&report).Error)
+	}
+
+	pageThrough := func() []string {
+		var seen []string
+		for offset := 0; ; offset += 2 {
+			reports, total, err := repo.GetCheckReportsForComponentWithPagination(ctx, "paging-service", nil, nil, nil, nil, 2, offset, true, storage.ReportOrder{})
+			require.NoError(t, err)
+			require.Equal(t, int64(len(slugs)), total)
+			if len(reports) == 0 {
+				return seen
+			}
+			for _, report := range reports {
+				seen = append(seen, report.Check.Slug)
+			}
+		}
+	}
+
+	expected := []string{"check-a", "check-b", "check-c", "check-d", "check-e", "check-f"}
+	for i := 0; i < 5; i++ {
+		assert.Equal(t, expected, pageThrough())
+	}
+}
@@ -1,8 +1,15 @@
 package storage
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"maps"
+	"slices"
+	"sort"
 	"time"
 
+	"github.com/doron-cohen/argus/backend/internal/utils"
 	"github.com/google/uuid"
 	"gorm.io/gorm"
 )
@@ -28,6 +35,32 @@ type Component struct {
 	Description string
 	Maintainers StringArray `gorm:"type:jsonb"`
 	Team        string
+	Labels      StringMap `gorm:"type:jsonb"`
+	Lifecycle   string
+
+	// TeamKey is the normalized Team that teams are matched on, see utils.NormalizeTeam
+	TeamKey string `gorm:"index"`
+
+	// ExpectedChecks lists the slugs of the checks the manifest expects to report on the component
+	ExpectedChecks StringArray `gorm:"type:jsonb"`
+
+	// DependsOn lists the identifiers of the components the manifest depends on. The repository
+	// mirrors it into ComponentDependency edges, which dependency lookups use.
+	DependsOn StringArray `gorm:"type:jsonb"`
+
+	// MaintainersManaged is set once maintainers are edited through the API,
+	// after which sync must leave them alone
+	MaintainersManaged bool `gorm:"not null;default:false"`
+
+	// Sources lists the sync sources that provide this component, see PruneSourceComponents
+	Sources StringArray `gorm:"type:jsonb"`
+
+	// SourceRevision identifies the source version the current manifest fields were synced from
+	SourceRevision string
+
+	CreatedAt time.Time      `gorm:"autoCreateTime"`
+	UpdatedAt time.Time      `gorm:"autoUpdateTime"`
+	DeletedAt gorm.DeletedAt `gorm:"index"`
 
 	// Relationships
 	CheckReports []CheckReport
@@ -40,6 +73,13 @@ func (c *Component) BeforeCreate(tx *gorm.DB) (err error) {
 	return
 }
 
+// BeforeSave keeps TeamKey in step with Team when a whole component is created or saved.
+// Column updates must set team_key themselves.
+func (c *Component) BeforeSave(tx *gorm.DB) error {
+	c.TeamKey = utils.NormalizeTeam(c.Team)
+	return nil
+}
+
 // Check represents a quality check that can be performed on components
 type Check struct {
 	ID          uuid.UUID `gorm:"type:uuid;primaryKey"`
@@ -57,18 +97,28 @@ func (c *Check) BeforeCreate(tx *gorm.DB) (err error) {
 	return
 }
 
-// CheckReport represents a report of a check execution on a component
+// CheckReport represents a report of a check execution on a component.
+// idx_check_reports_component_timestamp serves listing a component's reports newest first,
+// and idx_check_reports_latest matches the per-check ordering of the latest-per-check query.
 type CheckReport struct {
-	ID          uuid.UUID   `gorm:"type:uuid;primaryKey"`
-	CheckID     uuid.UUID   `gorm:"type:uuid;not null;index:idx_check_timestamp"`
-	ComponentID uuid.UUID   `gorm:"type:uuid;not null;index:idx_component_check"`
+	ID          uuid.UUID   `gorm:"type:uuid;primaryKey;index:idx_check_reports_component_timestamp,priority:3;index:idx_check_reports_latest,priority:4,sort:desc"`
+	CheckID     uuid.UUID   `gorm:"type:uuid;not null;index:idx_check_timestamp,priority:1;index:idx_check_reports_latest,priority:2;uniqueIndex:idx_check_reports_idempotency,priority:2"`
+	ComponentID uuid.UUID   `gorm:"type:uuid;not null;index:idx_check_reports_component_timestamp,priority:1;index:idx_check_reports_latest,priority:1;uniqueIndex:idx_check_reports_idempotency,priority:1"`
 	Status      CheckStatus `gorm:"type:varchar(20);not null;index:idx_check_status"`
-	Timestamp   time.Time   `gorm:"not null;index:idx_check_timestamp"`
+	Timestamp   time.Time   `gorm:"not null;index:idx_check_timestamp,priority:2;index:idx_check_reports_component_timestamp,priority:2;index:idx_check_reports_latest,priority:3,sort:desc"`
+	StartedAt   *time.Time  // When the check run started, if reported
+	DurationMs  *int64      // How long the check run took in milliseconds, if reported
 	Details     JSONB       `gorm:"type:jsonb"`
 	Metadata    JSONB       `gorm:"type:jsonb"`
-	CreatedAt   time.Time   `gorm:"autoCreateTime"`
+	CreatedAt   time.Time   `gorm:"autoCreateTime;index:idx_check_reports_created_at"` // When the server received the report
 	UpdatedAt   time.Time   `gorm:"autoUpdateTime"`
 
+	// Checksum is derived from the report content, see ComputeChecksum
+	Checksum *string `gorm:"size:64"`
+
+	// IdempotencyKey is the key the report was submitted with, unique per component and check
+	IdempotencyKey *string `gorm:"size:255;uniqueIndex:idx_check_reports_idempotency,priority:3"`
+
 	// Relationships
 	Check     Check
 	Component Component
@@ -77,6 +127,139 @@ type CheckReport struct {
 func (cr *CheckReport) BeforeCreate(tx *gorm.DB) (err error) {
 	if cr.ID == uuid.Nil {
 		cr.ID, err = uuid.NewV7()
+		if err != nil {
+			return
+		}
+	}
+	if cr.Checksum == nil {
+		var checksum string
+		checksum, err = cr.ComputeChecksum()
+		cr.Checksum = &checksum
 	}
 	return
 }
+
+// ComputeChecksum returns a SHA-256 over the report content: component, check,
+// status, timestamp, details and metadata. Identical reports share a checksum.
+func (cr *CheckReport) ComputeChecksum() (string, error) {
+	content := map[string]interface{}{
+		"component_id": cr.ComponentID.String(),
+		"check_id":     cr.CheckID.String(),
+		"status":       cr.Status,
+		// Postgres keeps microseconds, so truncate to hash the value as stored
+		"timestamp": cr.Timestamp.UTC().Truncate(time.Microsecond).Format(time.RFC3339Nano),
+		"details":   cr.Details,
+		"metadata":  cr.Metadata,
+	}
+	// Added only when reported, so reports without them keep the checksums they were stored with
+	if cr.StartedAt != nil {
+		content["started_at"] = cr.StartedAt.UTC().Truncate(time.Microsecond).Format(time.RFC3339Nano)
+	}
+	if cr.DurationMs != nil {
+		content["duration_ms"] = *cr.DurationMs
+	}
+	encoded, err := json.Marshal(content)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(encoded)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// ComponentDependency is an edge from a component to a component it depends on. The target is
+// kept as an identifier rather than a reference, since it may not have been synced yet.
+type ComponentDependency struct {
+	ID          uuid.UUID `gorm:"type:uuid;primaryKey"`
+	ComponentID uuid.UUID `gorm:"type:uuid;not null;uniqueIndex:idx_component_dependencies_edge,priority:1"`
+	DependsOn   string    `gorm:"not null;uniqueIndex:idx_component_dependencies_edge,priority:2;index:idx_component_dependencies_depends_on"`
+	CreatedAt   time.Time `gorm:"autoCreateTime"`
+}
+
+func (d *ComponentDependency) BeforeCreate(tx *gorm.DB) (err error) {
+	if d.ID == uuid.Nil {
+		d.ID, err = uuid.NewV7()
+	}
+	return
+}
+
+// WebhookDeadLetter records a webhook payload that could not be delivered
+type WebhookDeadLetter struct {
+	ID        uuid.UUID `gorm:"type:uuid;primaryKey"`
+	URL       string    `gorm:"not null"`
+	Payload   string    `gorm:"type:text;not null"`
+	Attempts  int       `gorm:"not null"`
+	LastError string    `gorm:"type:text"`
+	CreatedAt time.Time `gorm:"autoCreateTime;index"`
+}
+
+func (w *WebhookDeadLetter) BeforeCreate(tx *gorm.DB) (err error) {
+	if w.ID == uuid.Nil {
+		w.ID, err = uuid.NewV7()
+	}
+	return
+}
+
+// ComponentHistory records the fields changed by a single component update
+type ComponentHistory struct {
+	ID            uuid.UUID   `gorm:"type:uuid;primaryKey"`
+	ComponentID   uuid.UUID   `gorm:"type:uuid;not null;index:idx_component_history_changed_at"`
+	ChangedFields StringArray `gorm:"type:jsonb"`
+	Changes       JSONB       `gorm:"type:jsonb"` // Field name to {"old": ..., "new": ...}
+	Source        string
+	ChangedAt     time.Time `gorm:"not null;index:idx_component_history_changed_at"`
+}
+
+func (h *ComponentHistory) BeforeCreate(tx *gorm.DB) (err error) {
+	if h.ID == uuid.Nil {
+		h.ID, err = uuid.NewV7()
+	}
+	if h.ChangedAt.IsZero() {
+		h.ChangedAt = time.Now()
+	}
+	if h.ChangedFields == nil {
+		h.ChangedFields = make(StringArray, 0, len(h.Changes))
+		for field := range h.Changes {
+			h.ChangedFields = append(h.ChangedFields, field)
+		}
+		sort.Strings(h.ChangedFields)
+	}
+	return
+}
+
+// manifestColumns returns the columns of a component that come from its manifest, keyed like
+// the fields reported by DiffComponents
+func (c *Component) manifestColumns() map[string]interface{} {
+	return map[string]interface{}{
+		"name":        c.Name,
+		"description": c.Description,
+		"maintainers": c.Maintainers,
+		"team":        c.Team,
+		"labels":      c.Labels,
+		"lifecycle":   c.Lifecycle,
+
+		"expected_checks": c.ExpectedChecks,
+		"depends_on":      c.DependsOn,
+	}
+}
+
+// DiffComponents returns the changes between two versions of a component, keyed by field
+// name, suitable for ComponentHistory.Changes. It is empty when nothing changed.
+func DiffComponents(before Component, after Component) JSONB {
+	changes := JSONB{}
+	record := func(field string, oldValue interface{}, newValue interface{}, equal bool) {
+		if !equal {
+			changes[field] = map[string]interface{}{"old": oldValue, "new": newValue}
+		}
+	}
+
+	record("name", before.Name, after.Name, before.Name == after.Name)
+	record("description", before.Description, after.Description, before.Description == after.Description)
+	record("maintainers", []string(before.Maintainers), []string(after.Maintainers), slices.Equal(before.Maintainers, after.Maintainers))
+	record("team", before.Team, after.Team, before.Team == after.Team)
+	record("labels", map[string]string(before.Labels), map[string]string(after.Labels), maps.Equal(before.Labels, after.Labels))
+	record("lifecycle", before.Lifecycle, after.Lifecycle, before.Lifecycle == after.Lifecycle)
+	record("expected_checks", []string(before.ExpectedChecks), []string(after.ExpectedChecks), slices.Equal(before.ExpectedChecks, after.ExpectedChecks))
+	record("depends_on", []string(before.DependsOn), []string(after.DependsOn), slices.Equal(before.DependsOn, after.DependsOn))
+
+	return changes
+}
@@ -1,15 +1,23 @@
 package storage
 
 import (
+	"cmp"
 	"context"
+	"database/sql"
 	"errors"
 	"fmt"
-	"sort"
+	"slices"
+	"strings"
 	"time"
 
+	"github.com/doron-cohen/argus/backend/internal/tracing"
+	"github.com/doron-cohen/argus/backend/internal/utils"
 	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/logger"
 )
 
 // ErrComponentNotFound is returned when a component is not found
@@ -18,8 +26,20 @@ var ErrComponentNotFound = errors.New("component not found")
 // ErrCheckNotFound is returned when a check is not found
 var ErrCheckNotFound = errors.New("check not found")
 
+// ErrReportNotFound is returned when a check report is not found
+var ErrReportNotFound = errors.New("report not found")
+
 type Repository struct {
 	DB *gorm.DB
+
+	// CaseInsensitiveIDs folds component identifiers to lowercase, see utils.NormalizeIdentifier
+	CaseInsensitiveIDs bool
+}
+
+// normalizeComponentID applies the identifier normalization rules used for both storing
+// and looking up components
+func (r *Repository) normalizeComponentID(componentID string) string {
+	return utils.NormalizeIdentifier(componentID, r.CaseInsensitiveIDs)
 }
 
 // GORM Scopes for reusable query logic
@@ -31,18 +51,25 @@ func WithComponentID(componentID uuid.UUID) func(db *gorm.DB) *gorm.DB {
 	}
 }
 
-// WithStatus scope filters by check status
-func WithStatus(status CheckStatus) func(db *gorm.DB) *gorm.DB {
+// WithStatus scope filters by check status, matching any of the given statuses
+func WithStatus(statuses ...CheckStatus) func(db *gorm.DB) *gorm.DB {
 	return func(db *gorm.DB) *gorm.DB {
-		return db.Where("status = ?", status)
+		if len(statuses) == 1 {
+			return db.Where("status = ?", statuses[0])
+		}
+		return db.Where("status IN ?", statuses)
 	}
 }
 
-// WithCheckSlug scope filters by check slug
-func WithCheckSlug(checkSlug string) func(db *gorm.DB) *gorm.DB {
+// WithCheckSlug scope filters by check slug, matching any of the given slugs.
+// Each report has one check, so the join never duplicates rows.
+func WithCheckSlug(checkSlugs ...string) func(db *gorm.DB) *gorm.DB {
 	return func(db *gorm.DB) *gorm.DB {
-		return db.Joins("JOIN checks ON check_reports.check_id = checks.id").
-			Where("checks.slug = ?", checkSlug)
+		db = db.Joins("JOIN checks ON check_reports.check_id = checks.id")
+		if len(checkSlugs) == 1 {
+			return db.Where("checks.slug = ?", checkSlugs[0])
+		}
+		return db.Where("checks.slug IN ?", checkSlugs)
 	}
 }
 
@@ -53,6 +80,27 @@ func WithSince(since time.Time) func(db *gorm.DB) *gorm.DB {
 	}
 }
 
+// WithBefore scope filters by timestamp (exclusive upper bound)
+func WithBefore(before time.Time) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Where("timestamp < ?", before)
+	}
+}
+
+// WithReceivedAfter scope filters by server-side receive time (inclusive)
+func WithReceivedAfter(receivedAfter time.Time) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Where("check_reports.created_at >= ?", receivedAfter)
+	}
+}
+
+// WithReceivedBefore scope filters by server-side receive time (exclusive)
+func WithReceivedBefore(receivedBefore time.Time) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Where("check_reports.created_at < ?", receivedBefore)
+	}
+}
+
 // WithPagination scope applies pagination
 func WithPagination(limit, offset int) func(db *gorm.DB) *gorm.DB {
 	return func(db *gorm.DB) *gorm.DB {
@@ -60,33 +108,44 @@ func WithPagination(limit, offset int) func(db *gorm.DB) *gorm.DB {
 	}
 }
 
-// WithOrderByTimestamp scope orders by timestamp descending
-func WithOrderByTimestamp() func(db *gorm.DB) *gorm.DB {
-	return func(db *gorm.DB) *gorm.DB {
-		return db.Order("timestamp DESC")
-	}
+// ReportSortField is a field check reports can be sorted by
+type ReportSortField string
+
+const (
+	ReportSortTimestamp ReportSortField = "timestamp"
+	ReportSortStatus    ReportSortField = "status"
+)
+
+// ReportOrder selects how check reports are sorted. The zero value sorts by timestamp, newest first.
+type ReportOrder struct {
+	Field     ReportSortField
+	Ascending bool
+}
+
+// IsDefault reports whether the order is timestamp descending, the order cursors are built on
+func (o ReportOrder) IsDefault() bool {
+	return (o.Field == "" || o.Field == ReportSortTimestamp) && !o.Ascending
 }
 
-// WithLatestPerCheck scope applies latest per check logic
-// For PostgreSQL, uses DISTINCT ON; for SQLite, uses a subquery approach
-func WithLatestPerCheck() func(db *gorm.DB) *gorm.DB {
+// WithReportOrder scope orders reports by the requested field. Ties are broken by timestamp
+// descending and then by id, so every order is total.
+func WithReportOrder(order ReportOrder) func(db *gorm.DB) *gorm.DB {
 	return func(db *gorm.DB) *gorm.DB {
-		// Check if we're using PostgreSQL by looking at the driver
-		dialectorName := db.Name()
-		if dialectorName == "postgres" {
-			// PostgreSQL-specific DISTINCT ON approach
-			return db.Distinct("check_id, id, component_id, status, timestamp, details, metadata, created_at, updated_at").
-				Order("check_id, timestamp DESC")
+		direction := "DESC"
+		if order.Ascending {
+			direction = "ASC"
 		}
+		if order.Field == ReportSortStatus {
+			return db.Order(fmt.Sprintf("check_reports.status %s, check_reports.timestamp DESC, check_reports.id DESC", direction))
+		}
+		return db.Order(fmt.Sprintf("check_reports.timestamp %s, check_reports.id %s", direction, direction))
+	}
+}
 
-		// For SQLite and other databases, we'll handle this in the main query
-		// by using a subquery to get the latest timestamp for each check_id
-		subQuery := db.Session(&gorm.Session{}).
-			Model(&CheckReport{}).
-			Select("check_id, MAX(timestamp) as max_timestamp").
-			Group("check_id")
-
-		return db.Joins("JOIN (?) as latest ON check_reports.check_id = latest.check_id AND check_reports.timestamp = latest.max_timestamp", subQuery)
+// WithCursorBefore scope keeps reports that sort after the cursor in timestamp and id descending order
+func WithCursorBefore(cursor ReportCursor) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Where("(check_reports.timestamp, check_reports.id) < (?, ?)", cursor.Timestamp, cursor.ID)
 	}
 }
 
@@ -98,42 +157,107 @@ func WithPreloads() func(db *gorm.DB) *gorm.DB {
 }
 
 // applyFilters applies all filters to a query
-func (r *Repository) applyFilters(query *gorm.DB, status *CheckStatus, checkSlug *string, since *time.Time) *gorm.DB {
-	if status != nil {
-		query = query.Scopes(WithStatus(*status))
+func (r *Repository) applyFilters(query *gorm.DB, statuses []CheckStatus, checkSlugs []string, since *time.Time, before *time.Time) *gorm.DB {
+	if len(statuses) > 0 {
+		query = query.Scopes(WithStatus(statuses...))
 	}
-	if checkSlug != nil && *checkSlug != "" {
-		query = query.Scopes(WithCheckSlug(*checkSlug))
+	if len(checkSlugs) > 0 {
+		query = query.Scopes(WithCheckSlug(checkSlugs...))
 	}
 	if since != nil {
 		query = query.Scopes(WithSince(*since))
 	}
+	if before != nil {
+		query = query.Scopes(WithBefore(*before))
+	}
 	return query
 }
 
-func ConnectAndMigrate(ctx context.Context, dsn string) (*Repository, error) {
-	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{})
+// Ping opens a connection to the database described by cfg and closes it again, without
+// touching the schema
+func Ping(ctx context.Context, cfg Config) error {
+	db, err := gorm.Open(postgres.Open(cfg.DSN()), &gorm.Config{
+		DisableAutomaticPing: true,
+		Logger:               logger.Default.LogMode(logger.Silent),
+	})
+	if err != nil {
+		return err
+	}
+	sqlDB, err := db.DB()
+	if err != nil {
+		return err
+	}
+	defer func() { _ = sqlDB.Close() }()
+	return sqlDB.PingContext(ctx)
+}
+
+// ConnectAndMigrate connects to the database described by cfg, sizes its connection pool
+// and migrates the schema
+func ConnectAndMigrate(ctx context.Context, cfg Config) (*Repository, error) {
+	db, err := gorm.Open(postgres.Open(cfg.DSN()), &gorm.Config{})
 	if err != nil {
 		return nil, err
 	}
 
-	// Migrate all tables
-	if err := db.WithContext(ctx).AutoMigrate(&Component{}, &Check{}, &CheckReport{}); err != nil {
+	sqlDB, err := db.DB()
+	if err != nil {
 		return nil, err
 	}
+	cfg.applyPool(sqlDB)
 
-	return &Repository{DB: db}, nil
+	repo := &Repository{DB: db}
+	if err := repo.Migrate(ctx); err != nil {
+		return nil, err
+	}
+	return repo, nil
 }
 
 func (r *Repository) Migrate(ctx context.Context) error {
 	// Migrate all tables
-	if err := r.DB.WithContext(ctx).AutoMigrate(&Component{}, &Check{}, &CheckReport{}); err != nil {
+	if err := r.DB.WithContext(ctx).AutoMigrate(&Component{}, &Check{}, &CheckReport{}, &WebhookDeadLetter{}, &ComponentHistory{}, &ComponentDependency{}); err != nil {
 		return err
 	}
 
+	if err := r.backfillTeamKeys(ctx); err != nil {
+		return fmt.Errorf("failed to backfill team keys: %w", err)
+	}
+
+	// Drop indexes made redundant by the composite check_reports indexes
+	migrator := r.DB.WithContext(ctx).Migrator()
+	for _, name := range supersededIndexes {
+		if migrator.HasIndex(&CheckReport{}, name) {
+			if err := migrator.DropIndex(&CheckReport{}, name); err != nil {
+				return fmt.Errorf("failed to drop index %s: %w", name, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// backfillTeamKeys sets the team_key of components stored before the column existed.
+// Deleted components are included so they still match their team once restored.
+func (r *Repository) backfillTeamKeys(ctx context.Context) error {
+	var components []Component
+	err := r.DB.WithContext(ctx).Unscoped().Select("id", "team").
+		Where("team <> '' AND (team_key IS NULL OR team_key = '')").
+		Find(&components).Error
+	if err != nil {
+		return err
+	}
+	for _, component := range components {
+		err := r.DB.WithContext(ctx).Unscoped().Model(&Component{}).Where("id = ?", component.ID).
+			UpdateColumn("team_key", utils.NormalizeTeam(component.Team)).Error
+		if err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
+// supersededIndexes are check_reports indexes from earlier versions that are prefixes of current ones
+var supersededIndexes = []string{"idx_component_check"}
+
 // Component methods
 func (r *Repository) GetComponents(ctx context.Context) ([]Component, error) {
 	var components []Component
@@ -141,10 +265,64 @@ func (r *Repository) GetComponents(ctx context.Context) ([]Component, error) {
 	return components, err
 }
 
+// GetComponentsIncludingDeleted returns every component ordered by identifier, soft-deleted ones
+// included. Deleted components have DeletedAt set.
+func (r *Repository) GetComponentsIncludingDeleted(ctx context.Context) ([]Component, error) {
+	var components []Component
+	err := r.DB.WithContext(ctx).Unscoped().Order("component_id").Find(&components).Error
+	return components, err
+}
+
+// GetComponentsPaginated returns a page of components ordered by identifier, along with the total number of components
+func (r *Repository) GetComponentsPaginated(ctx context.Context, limit int, offset int) ([]Component, int64, error) {
+	var total int64
+	if err := r.DB.WithContext(ctx).Model(&Component{}).Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("count query failed: %w", err)
+	}
+
+	var components []Component
+	err := r.DB.WithContext(ctx).
+		Order("component_id").
+		Scopes(WithPagination(limit, offset)).
+		Find(&components).Error
+	if err != nil {
+		return nil, 0, fmt.Errorf("find query failed: %w", err)
+	}
+
+	return components, total, nil
+}
+
+// GetComponentsMaxUpdatedAt returns the time components were last changed: the latest updated_at
+// or deleted_at of any component, deleted ones included, so deletions move it forward too.
+// It returns the zero time when there are no components.
+func (r *Repository) GetComponentsMaxUpdatedAt(ctx context.Context) (time.Time, error) {
+	var latest time.Time
+
+	var updated Component
+	err := r.DB.WithContext(ctx).Unscoped().Select("updated_at").Order("updated_at DESC").Limit(1).Find(&updated).Error
+	if err != nil {
+		return time.Time{}, err
+	}
+	latest = updated.UpdatedAt
+
+	var deleted Component
+	err = r.DB.WithContext(ctx).Unscoped().Select("deleted_at").
+		Where("deleted_at IS NOT NULL").
+		Order("deleted_at DESC").Limit(1).Find(&deleted).Error
+	if err != nil {
+		return time.Time{}, err
+	}
+	if deleted.DeletedAt.Valid && deleted.DeletedAt.Time.After(latest) {
+		latest = deleted.DeletedAt.Time
+	}
+
+	return latest, nil
+}
+
 // GetComponentByID returns a component by its unique identifier
 func (r *Repository) GetComponentByID(ctx context.Context, componentID string) (*Component, error) {
 	var component Component
-	err := r.DB.WithContext(ctx).Where("component_id = ?", componentID).First(&component).Error
+	err := r.DB.WithContext(ctx).Where("component_id = ?", r.normalizeComponentID(componentID)).First(&component).Error
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, ErrComponentNotFound
@@ -154,382 +332,1747 @@ func (r *Repository) GetComponentByID(ctx context.Context, componentID string) (
 	return &component, nil
 }
 
-// GetComponentsByTeam returns all components owned by a specific team
+// GetComponentsByTeam returns all components owned by a specific team. Team names are matched
+// ignoring case and surrounding whitespace, see utils.NormalizeTeam.
 func (r *Repository) GetComponentsByTeam(ctx context.Context, team string) ([]Component, error) {
 	var components []Component
-	err := r.DB.WithContext(ctx).Where("team = ?", team).Find(&components).Error
+	err := r.DB.WithContext(ctx).Where("team_key = ?", utils.NormalizeTeam(team)).Find(&components).Error
 	if err != nil {
 		return nil, err
 	}
 	return components, nil
 }
 
-// CreateComponent creates a new component
-func (r *Repository) CreateComponent(ctx context.Context, component Component) error {
-	return r.DB.WithContext(ctx).Create(&component).Error
+// SearchComponents returns components whose identifier, name, description or team contains query,
+// case-insensitively, ordered by identifier, along with the total number of matches
+func (r *Repository) SearchComponents(ctx context.Context, query string, limit int, offset int) ([]Component, int64, error) {
+	match := DialectFor(r.DB).ContainsText([]string{"component_id", "name", "description", "team"}, query)
+
+	var total int64
+	if err := r.DB.WithContext(ctx).Model(&Component{}).Where(match).Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("count query failed: %w", err)
+	}
+
+	var components []Component
+	err := r.DB.WithContext(ctx).
+		Where(match).
+		Order("component_id").
+		Scopes(WithPagination(limit, offset)).
+		Find(&components).Error
+	if err != nil {
+		return nil, 0, fmt.Errorf("find query failed: %w", err)
+	}
+
+	return components, total, nil
 }
 
-// Check methods - only what's needed for handlers
-func (r *Repository) GetCheckBySlug(ctx context.Context, slug string) (*Check, error) {
-	var check Check
-	err := r.DB.WithContext(ctx).Where("slug = ?", slug).First(&check).Error
+// GetComponentsByMaintainer returns all components listing the given maintainer.
+// The identifier must equal one of the maintainers exactly; substrings of a maintainer do not match.
+func (r *Repository) GetComponentsByMaintainer(ctx context.Context, identifier string) ([]Component, error) {
+	var components []Component
+	err := r.DB.WithContext(ctx).Where(DialectFor(r.DB).JSONArrayContains("maintainers", identifier)).Find(&components).Error
 	if err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, ErrCheckNotFound
-		}
 		return nil, err
 	}
-	return &check, nil
+	return components, nil
 }
 
-func (r *Repository) CreateCheck(ctx context.Context, check Check) error {
-	return r.DB.WithContext(ctx).Create(&check).Error
+// ComponentFilters narrows CountComponents. Zero values are ignored.
+type ComponentFilters struct {
+	// Team matches the owning team exactly
+	Team string
+	// Labels must all be set on a component, with the same values
+	Labels map[string]string
 }
 
-// GetOrCreateCheckBySlug auto-creates a check if it doesn't exist, returns CheckID
-func (r *Repository) GetOrCreateCheckBySlug(ctx context.Context, slug string, name *string, description *string) (uuid.UUID, error) {
-	// First try to get existing check
-	check, err := r.GetCheckBySlug(ctx, slug)
-	if err == nil {
-		// Check exists, return its ID
-		return check.ID, nil
+// CountComponents returns the number of components matching filters, not counting deleted ones.
+// Pass zero filters to count the whole catalog.
+func (r *Repository) CountComponents(ctx context.Context, filters ComponentFilters) (int64, error) {
+	query := r.DB.WithContext(ctx).Model(&Component{})
+	if filters.Team != "" {
+		query = query.Where("team_key = ?", utils.NormalizeTeam(filters.Team))
 	}
-	if !errors.Is(err, ErrCheckNotFound) {
-		// Some other error occurred
-		return uuid.Nil, err
+	dialect := DialectFor(r.DB)
+	for key, value := range filters.Labels {
+		query = query.Where(dialect.JSONObjectHas("labels", key, value))
 	}
 
-	// Check doesn't exist, create it with provided values or defaults
-	checkName := slug // Default name is slug
-	if name != nil && *name != "" {
-		checkName = *name
+	var count int64
+	if err := query.Count(&count).Error; err != nil {
+		return 0, err
 	}
+	return count, nil
+}
 
-	checkDescription := "Auto-created check for slug: " + slug // Default description
-	if description != nil && *description != "" {
-		checkDescription = *description
-	}
+// CreateComponent creates a new component. A soft-deleted component with the same identifier
+// is restored with the new fields instead, keeping its UUID and reports.
+func (r *Repository) CreateComponent(ctx context.Context, component Component) error {
+	component.ComponentID = r.normalizeComponentID(component.ComponentID)
+	return r.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var deleted Component
+		err := tx.Unscoped().
+			Where("component_id = ? AND deleted_at IS NOT NULL", component.ComponentID).
+			First(&deleted).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			if err := tx.Create(&component).Error; err != nil {
+				return err
+			}
+			return r.createDependenciesInTransaction(tx, component.ID, component.DependsOn)
+		}
+		if err != nil {
+			return err
+		}
 
-	newCheck := Check{
-		Slug:        slug,
-		Name:        checkName,
-		Description: checkDescription,
-	}
+		component.ID = deleted.ID
+		component.CreatedAt = deleted.CreatedAt
+		if err := tx.Unscoped().Save(&component).Error; err != nil {
+			return err
+		}
+		return r.replaceDependenciesInTransaction(tx, component.ID, component.DependsOn)
+	})
+}
 
-	err = r.CreateCheck(ctx, newCheck)
-	if err != nil {
-		return uuid.Nil, err
+// AddComponentSource records that a sync source provides a component. It is a no-op if the
+// source is already recorded.
+func (r *Repository) AddComponentSource(ctx context.Context, componentID string, sourceID string) error {
+	return r.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		component, err := r.getComponentInTransaction(ctx, tx, componentID)
+		if err != nil {
+			return err
+		}
+		if slices.Contains(component.Sources, sourceID) {
+			return nil
+		}
+
+		sources := append(StringArray{}, component.Sources...)
+		return tx.Model(&Component{}).
+			Where("id = ?", component.ID).
+			Update("sources", append(sources, sourceID)).Error
+	})
+}
+
+// PruneSourceComponents detaches a sync source from the components it provided that are not in
+// seenIDs, and deletes the components that are left without any source. Deletion is soft unless
+// hardDelete is set, in which case the component's reports and history are removed with it.
+// It returns the identifiers of the deleted components.
+func (r *Repository) PruneSourceComponents(ctx context.Context, sourceID string, seenIDs []string, hardDelete bool) ([]string, error) {
+	seen := make(map[string]bool, len(seenIDs))
+	for _, id := range seenIDs {
+		seen[r.normalizeComponentID(id)] = true
 	}
 
-	// Get the created check to return its ID
-	createdCheck, err := r.GetCheckBySlug(ctx, slug)
+	var deleted []string
+	err := r.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var components []Component
+		err := tx.Where(DialectFor(r.DB).JSONArrayContains("sources", sourceID)).Find(&components).Error
+		if err != nil {
+			return err
+		}
+
+		for _, component := range components {
+			if seen[component.ComponentID] {
+				continue
+			}
+
+			remaining := StringArray{}
+			for _, source := range component.Sources {
+				if source != sourceID {
+					remaining = append(remaining, source)
+				}
+			}
+
+			if len(remaining) > 0 {
+				err = tx.Model(&Component{}).Where("id = ?", component.ID).Update("sources", remaining).Error
+			} else {
+				err = r.deleteComponentInTransaction(tx, component, hardDelete)
+				deleted = append(deleted, component.ComponentID)
+			}
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
 	if err != nil {
-		return uuid.Nil, err
+		return nil, err
 	}
+	return deleted, nil
+}
 
-	return createdCheck.ID, nil
+// SoftDeleteComponent soft-deletes a component and detaches it from its sync sources. Its reports
+// and history are kept, but are no longer served since the component cannot be looked up. A source
+// that still provides the component restores it on its next sync.
+func (r *Repository) SoftDeleteComponent(ctx context.Context, componentID string) error {
+	return r.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		component, err := r.getComponentInTransaction(ctx, tx, componentID)
+		if err != nil {
+			return err
+		}
+		return r.deleteComponentInTransaction(tx, *component, false)
+	})
 }
 
-// CreateCheckReportInput represents the input data for creating a check report
-type CreateCheckReportInput struct {
-	ComponentID      string
-	CheckSlug        string
-	CheckName        *string
-	CheckDescription *string
-	Status           CheckStatus
-	Timestamp        time.Time
-	Details          JSONB
-	Metadata         JSONB
+// GetSourceComponents returns the components a sync source provides
+func (r *Repository) GetSourceComponents(ctx context.Context, sourceID string) ([]Component, error) {
+	var components []Component
+	err := r.DB.WithContext(ctx).
+		Where(DialectFor(r.DB).JSONArrayContains("sources", sourceID)).
+		Order("component_id").
+		Find(&components).Error
+	return components, err
 }
 
-// CreateCheckReportFromSubmission creates a check report from API submission data
-func (r *Repository) CreateCheckReportFromSubmission(ctx context.Context, input CreateCheckReportInput) (uuid.UUID, error) {
-	var reportID uuid.UUID
+// ReadOnly runs fn with a repository bound to a read-only transaction, so nothing done through
+// it can write. On PostgreSQL any attempted write fails; SQLite does not enforce this.
+func (r *Repository) ReadOnly(ctx context.Context, fn func(tx *Repository) error) error {
+	return r.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return fn(&Repository{DB: tx, CaseInsensitiveIDs: r.CaseInsensitiveIDs})
+	}, &sql.TxOptions{ReadOnly: true})
+}
 
-	// Use transaction to ensure atomicity
-	err := r.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
-		// Verify component exists and get its UUID using the transaction
-		component, err := r.getComponentInTransaction(ctx, tx, input.ComponentID)
+// deleteComponentInTransaction soft-deletes a component, or removes it along with its reports
+// and history when hardDelete is set
+func (r *Repository) deleteComponentInTransaction(tx *gorm.DB, component Component, hardDelete bool) error {
+	if !hardDelete {
+		return tx.Model(&Component{}).Where("id = ?", component.ID).
+			Updates(map[string]interface{}{"sources": StringArray{}, "deleted_at": time.Now()}).Error
+	}
+
+	if err := tx.Where("component_id = ?", component.ID).Delete(&CheckReport{}).Error; err != nil {
+		return err
+	}
+	if err := tx.Where("component_id = ?", component.ID).Delete(&ComponentHistory{}).Error; err != nil {
+		return err
+	}
+	if err := tx.Where("component_id = ?", component.ID).Delete(&ComponentDependency{}).Error; err != nil {
+		return err
+	}
+	return tx.Unscoped().Delete(&Component{}, "id = ?", component.ID).Error
+}
+
+// UpdateComponent updates the manifest fields of an existing component, looked up by its ComponentID.
+// Only changed columns are written, so updated_at is left alone when nothing changed, and the UUID
+// primary key is preserved. Maintainers are kept as they are once managed through the API.
+func (r *Repository) UpdateComponent(ctx context.Context, component Component) error {
+	return r.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		existing, err := r.getComponentInTransaction(ctx, tx, component.ComponentID)
 		if err != nil {
 			return err
 		}
 
-		// Get or create check by slug with provided name and description using the transaction
-		checkID, err := r.getOrCreateCheckInTransaction(ctx, tx, input)
-		if err != nil {
-			return err
+		if existing.MaintainersManaged {
+			component.Maintainers = existing.Maintainers
 		}
 
-		// Create the report
-		report := CheckReport{
-			CheckID:     checkID,
-			ComponentID: component.ID,
-			Status:      input.Status,
-			Timestamp:   input.Timestamp,
-			Details:     input.Details,
-			Metadata:    input.Metadata,
+		changes := DiffComponents(*existing, component)
+		if len(changes) == 0 {
+			return nil
 		}
 
-		if err := tx.Create(&report).Error; err != nil {
-			return err
+		columns := component.manifestColumns()
+		updates := make(map[string]interface{}, len(changes)+1)
+		for field := range changes {
+			updates[field] = columns[field]
+		}
+		if component.SourceRevision != "" {
+			updates["source_revision"] = component.SourceRevision
+		}
+		if _, ok := changes["team"]; ok {
+			updates["team_key"] = utils.NormalizeTeam(component.Team)
 		}
 
-		reportID = report.ID
+		if err := tx.Model(&Component{}).Where("id = ?", existing.ID).Updates(updates).Error; err != nil {
+			return err
+		}
+		if _, ok := changes["depends_on"]; ok {
+			return r.replaceDependenciesInTransaction(tx, existing.ID, component.DependsOn)
+		}
 		return nil
 	})
+}
 
-	return reportID, err
+// replaceDependenciesInTransaction replaces the dependency edges of a component with edges to
+// the given identifiers
+func (r *Repository) replaceDependenciesInTransaction(tx *gorm.DB, componentID uuid.UUID, dependsOn []string) error {
+	if err := tx.Where("component_id = ?", componentID).Delete(&ComponentDependency{}).Error; err != nil {
+		return err
+	}
+	return r.createDependenciesInTransaction(tx, componentID, dependsOn)
 }
 
-// getComponentInTransaction gets a component within a transaction
-func (r *Repository) getComponentInTransaction(ctx context.Context, tx *gorm.DB, componentID string) (*Component, error) {
-	var component Component
-	err := tx.WithContext(ctx).Where("component_id = ?", componentID).First(&component).Error
-	if err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, ErrComponentNotFound
+// createDependenciesInTransaction adds edges from a component to the given identifiers
+func (r *Repository) createDependenciesInTransaction(tx *gorm.DB, componentID uuid.UUID, dependsOn []string) error {
+	edges := make([]ComponentDependency, 0, len(dependsOn))
+	seen := make(map[string]bool, len(dependsOn))
+	for _, dependency := range dependsOn {
+		dependency = r.normalizeComponentID(dependency)
+		if !seen[dependency] {
+			seen[dependency] = true
+			edges = append(edges, ComponentDependency{ComponentID: componentID, DependsOn: dependency})
 		}
-		return nil, err
 	}
-	return &component, nil
+	if len(edges) == 0 {
+		return nil
+	}
+	return tx.Create(&edges).Error
 }
 
-// getOrCreateCheckInTransaction gets or creates a check within a transaction
-func (r *Repository) getOrCreateCheckInTransaction(ctx context.Context, tx *gorm.DB, input CreateCheckReportInput) (uuid.UUID, error) {
-	var check Check
-	err := tx.WithContext(ctx).Where("slug = ?", input.CheckSlug).First(&check).Error
-	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
-		return uuid.Nil, err
+// RenameComponent moves the component stored under oldID to newID. The component keeps its UUID,
+// and with it its reports and history, and is restored if it was deleted. A component already
+// stored under newID, such as one a sync created before the rename was declared, is merged into
+// it: its reports, history and sources move over and it is removed. It returns
+// ErrComponentNotFound when no component, deleted or not, is stored under oldID.
+func (r *Repository) RenameComponent(ctx context.Context, oldID string, newID string) error {
+	oldID, newID = r.normalizeComponentID(oldID), r.normalizeComponentID(newID)
+	if oldID == newID {
+		return nil
 	}
 
-	if errors.Is(err, gorm.ErrRecordNotFound) {
-		return r.createCheckInTransaction(ctx, tx, input)
-	}
+	return r.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var component Component
+		err := tx.Unscoped().Where("component_id = ?", oldID).First(&component).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ErrComponentNotFound
+		}
+		if err != nil {
+			return err
+		}
 
-	return check.ID, nil
+		sources := append(StringArray{}, component.Sources...)
+		var duplicate Component
+		err = tx.Unscoped().Where("component_id = ?", newID).First(&duplicate).Error
+		switch {
+		case err == nil:
+			if err := r.mergeComponentInTransaction(tx, duplicate, component); err != nil {
+				return err
+			}
+			for _, source := range duplicate.Sources {
+				if !slices.Contains(sources, source) {
+					sources = append(sources, source)
+				}
+			}
+		case !errors.Is(err, gorm.ErrRecordNotFound):
+			return err
+		}
+
+		return tx.Unscoped().Model(&Component{}).Where("id = ?", component.ID).Updates(map[string]interface{}{
+			"component_id": newID,
+			"sources":      sources,
+			"deleted_at":   nil,
+		}).Error
+	})
 }
 
-// createCheckInTransaction creates a new check within a transaction
+// mergeComponentInTransaction moves the reports and history of from onto into and removes from.
+// Reports that would repeat an idempotency key of into lose the key.
+func (r *Repository) mergeComponentInTransaction(tx *gorm.DB, from Component, into Component) error {
+	err := tx.Exec(`UPDATE check_reports SET idempotency_key = NULL
+		WHERE component_id = ? AND idempotency_key IS NOT NULL AND EXISTS (
+			SELECT 1 FROM check_reports kept
+			WHERE kept.component_id = ? AND kept.check_id = check_reports.check_id AND kept.idempotency_key = check_reports.idempotency_key)`,
+		from.ID, into.ID).Error
+	if err != nil {
+		return err
+	}
+	if err := tx.Model(&CheckReport{}).Where("component_id = ?", from.ID).Update("component_id", into.ID).Error; err != nil {
+		return err
+	}
+	if err := tx.Model(&ComponentHistory{}).Where("component_id = ?", from.ID).Update("component_id", into.ID).Error; err != nil {
+		return err
+	}
+	// The dependencies that count are those declared by the manifest of the component kept
+	if err := tx.Where("component_id = ?", from.ID).Delete(&ComponentDependency{}).Error; err != nil {
+		return err
+	}
+	return tx.Unscoped().Delete(&Component{}, "id = ?", from.ID).Error
+}
+
+// NormalizeMaintainer trims and lowercases a maintainer identifier so equivalent spellings dedupe
+func NormalizeMaintainer(identifier string) string {
+	return strings.ToLower(strings.TrimSpace(identifier))
+}
+
+// AddComponentMaintainer adds a maintainer to a component if not already present and returns the updated list.
+// The component's maintainers are marked as manually managed.
+func (r *Repository) AddComponentMaintainer(ctx context.Context, componentID string, identifier string) (StringArray, error) {
+	normalized := NormalizeMaintainer(identifier)
+	return r.updateComponentMaintainers(ctx, componentID, func(maintainers StringArray) StringArray {
+		for _, existing := range maintainers {
+			if NormalizeMaintainer(existing) == normalized {
+				return maintainers
+			}
+		}
+		return append(maintainers, normalized)
+	})
+}
+
+// RemoveComponentMaintainer removes a maintainer from a component. Removing a maintainer that is not present is a no-op.
+// The component's maintainers are marked as manually managed.
+func (r *Repository) RemoveComponentMaintainer(ctx context.Context, componentID string, identifier string) (StringArray, error) {
+	normalized := NormalizeMaintainer(identifier)
+	return r.updateComponentMaintainers(ctx, componentID, func(maintainers StringArray) StringArray {
+		remaining := StringArray{}
+		for _, existing := range maintainers {
+			if NormalizeMaintainer(existing) != normalized {
+				remaining = append(remaining, existing)
+			}
+		}
+		return remaining
+	})
+}
+
+// updateComponentMaintainers applies a change to a component's maintainers within a transaction
+func (r *Repository) updateComponentMaintainers(ctx context.Context, componentID string, change func(StringArray) StringArray) (StringArray, error) {
+	var maintainers StringArray
+	err := r.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		component, err := r.getComponentInTransaction(ctx, tx, componentID)
+		if err != nil {
+			return err
+		}
+
+		maintainers = change(component.Maintainers)
+		return tx.Model(&Component{}).
+			Where("id = ?", component.ID).
+			Updates(map[string]interface{}{
+				"maintainers":         maintainers,
+				"maintainers_managed": true,
+			}).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return maintainers, nil
+}
+
+// Dependency is a component a component depends on. Component is nil when the edge is
+// dangling: no live component has the identifier, for example because it has not been synced yet.
+type Dependency struct {
+	ComponentID string
+	Component   *Component
+}
+
+// GetComponentDependencies returns the components a component depends on, ordered by identifier.
+// It returns ErrComponentNotFound when the component does not exist.
+func (r *Repository) GetComponentDependencies(ctx context.Context, componentID string) ([]Dependency, error) {
+	component, err := r.GetComponentByID(ctx, componentID)
+	if err != nil {
+		return nil, err
+	}
+
+	var edges []ComponentDependency
+	err = r.DB.WithContext(ctx).Where("component_id = ?", component.ID).Order("depends_on").Find(&edges).Error
+	if err != nil {
+		return nil, err
+	}
+	if len(edges) == 0 {
+		return []Dependency{}, nil
+	}
+
+	targetIDs := make([]string, len(edges))
+	for i, edge := range edges {
+		targetIDs[i] = edge.DependsOn
+	}
+	var targets []Component
+	if err := r.DB.WithContext(ctx).Where("component_id IN ?", targetIDs).Find(&targets).Error; err != nil {
+		return nil, err
+	}
+	byID := make(map[string]*Component, len(targets))
+	for i := range targets {
+		byID[targets[i].ComponentID] = &targets[i]
+	}
+
+	dependencies := make([]Dependency, len(edges))
+	for i, edge := range edges {
+		dependencies[i] = Dependency{ComponentID: edge.DependsOn, Component: byID[edge.DependsOn]}
+	}
+	return dependencies, nil
+}
+
+// GetComponentDependents returns the components that depend on a component, ordered by identifier.
+// It returns ErrComponentNotFound when the component does not exist.
+func (r *Repository) GetComponentDependents(ctx context.Context, componentID string) ([]Component, error) {
+	component, err := r.GetComponentByID(ctx, componentID)
+	if err != nil {
+		return nil, err
+	}
+
+	var dependents []Component
+	err = r.DB.WithContext(ctx).
+		Joins("JOIN component_dependencies ON component_dependencies.component_id = components.id").
+		Where("component_dependencies.depends_on = ?", component.ComponentID).
+		Order("components.component_id").
+		Find(&dependents).Error
+	if err != nil {
+		return nil, err
+	}
+	return dependents, nil
+}
+
+// ImpactedComponent is a component at risk when another component fails, with its distance from
+// that component in dependency edges and its checks counted by the status of their latest report
+type ImpactedComponent struct {
+	Component Component
+	Depth     int
+	Summary   CheckReportStatusCounts
+}
+
+// GetComponentImpact returns the components that depend on a component, directly or through
+// other components up to maxDepth edges away, ordered by depth and then identifier.
+// It returns ErrComponentNotFound when the component does not exist.
+func (r *Repository) GetComponentImpact(ctx context.Context, componentID string, maxDepth int) ([]ImpactedComponent, error) {
+	root, err := r.GetComponentByID(ctx, componentID)
+	if err != nil {
+		return nil, err
+	}
+
+	dependents, err := DialectFor(r.DB).Dependents(ctx, r.DB, *root, maxDepth)
+	if err != nil {
+		return nil, err
+	}
+	if len(dependents) == 0 {
+		return []ImpactedComponent{}, nil
+	}
+
+	ids := make([]uuid.UUID, len(dependents))
+	depths := make(map[uuid.UUID]int, len(dependents))
+	for i, dependent := range dependents {
+		ids[i] = dependent.ID
+		depths[dependent.ID] = dependent.Depth
+	}
+	var components []Component
+	if err := r.DB.WithContext(ctx).Where("id IN ?", ids).Find(&components).Error; err != nil {
+		return nil, err
+	}
+
+	identifiers := make([]string, len(components))
+	for i, component := range components {
+		identifiers[i] = component.ComponentID
+	}
+	reports, err := r.GetLatestReportsForComponents(ctx, identifiers, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	impacted := make([]ImpactedComponent, len(components))
+	indexes := make(map[uuid.UUID]int, len(components))
+	for i, component := range components {
+		impacted[i] = ImpactedComponent{
+			Component: component,
+			Depth:     depths[component.ID],
+			Summary:   CheckReportStatusCounts{Counts: make(map[CheckStatus]int64)},
+		}
+		indexes[component.ID] = i
+	}
+	for _, report := range reports {
+		summary := &impacted[indexes[report.ComponentID]].Summary
+		summary.TotalChecks++
+		summary.Counts[report.Status]++
+		if summary.LatestReportAt == nil || report.Timestamp.After(*summary.LatestReportAt) {
+			timestamp := report.Timestamp
+			summary.LatestReportAt = &timestamp
+		}
+	}
+
+	slices.SortFunc(impacted, func(a, b ImpactedComponent) int {
+		if a.Depth != b.Depth {
+			return cmp.Compare(a.Depth, b.Depth)
+		}
+		return strings.Compare(a.Component.ComponentID, b.Component.ComponentID)
+	})
+	return impacted, nil
+}
+
+// Check methods - only what's needed for handlers
+func (r *Repository) GetCheckBySlug(ctx context.Context, slug string) (*Check, error) {
+	var check Check
+	err := r.DB.WithContext(ctx).Where("slug = ?", slug).First(&check).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrCheckNotFound
+		}
+		return nil, err
+	}
+	return &check, nil
+}
+
+func (r *Repository) CreateCheck(ctx context.Context, check Check) error {
+	return r.DB.WithContext(ctx).Create(&check).Error
+}
+
+// CheckUsage is a check along with how much it is used
+type CheckUsage struct {
+	Check
+	ReportCount    int64
+	ComponentCount int64
+}
+
+// CheckUsageFilter narrows GetChecksWithUsage by report count. Nil bounds are ignored.
+// ComponentID restricts the checks to those with at least one report for that component.
+type CheckUsageFilter struct {
+	MinReports  *int64
+	MaxReports  *int64
+	ComponentID *string
+}
+
+// ListChecks returns all checks ordered by slug
+func (r *Repository) ListChecks(ctx context.Context) ([]Check, error) {
+	var checks []Check
+	err := r.DB.WithContext(ctx).Order("slug").Find(&checks).Error
+	return checks, err
+}
+
+// ListChecksForComponent returns the checks with at least one report for a component, ordered by slug
+func (r *Repository) ListChecksForComponent(ctx context.Context, componentID string) ([]Check, error) {
+	component, err := r.GetComponentByID(ctx, componentID)
+	if err != nil {
+		return nil, err
+	}
+
+	var checks []Check
+	err = r.DB.WithContext(ctx).
+		Where(reportedForComponent(component.ID)).
+		Order("slug").
+		Find(&checks).Error
+	return checks, err
+}
+
+// reportedForComponent matches checks with at least one report for the component
+func reportedForComponent(componentID uuid.UUID) clause.Expression {
+	return clause.Expr{
+		SQL:  "EXISTS (SELECT 1 FROM check_reports WHERE check_reports.check_id = checks.id AND check_reports.component_id = ?)",
+		Vars: []interface{}{componentID},
+	}
+}
+
+// GetChecksWithUsage returns checks ordered by slug with their report and distinct
+// component counts, aggregated over check reports. Checks without reports are included
+// with zero counts, so MaxReports of 0 finds unused checks.
+func (r *Repository) GetChecksWithUsage(ctx context.Context, filter CheckUsageFilter, limit int, offset int) ([]CheckUsage, int64, error) {
+	var component *Component
+	if filter.ComponentID != nil {
+		var err error
+		component, err = r.GetComponentByID(ctx, *filter.ComponentID)
+		if err != nil {
+			return nil, 0, err
+		}
+	}
+
+	// usage builds a fresh aggregate query, since GORM statements must not be reused
+	usage := func() *gorm.DB {
+		query := r.DB.WithContext(ctx).
+			Table("checks").
+			Select("checks.*, COUNT(check_reports.id) AS report_count, COUNT(DISTINCT check_reports.component_id) AS component_count").
+			Joins("LEFT JOIN check_reports ON check_reports.check_id = checks.id").
+			Group("checks.id")
+		if component != nil {
+			query = query.Where(reportedForComponent(component.ID))
+		}
+		if filter.MinReports != nil {
+			query = query.Having("COUNT(check_reports.id) >= ?", *filter.MinReports)
+		}
+		if filter.MaxReports != nil {
+			query = query.Having("COUNT(check_reports.id) <= ?", *filter.MaxReports)
+		}
+		return query
+	}
+
+	var total int64
+	if err := r.DB.WithContext(ctx).Table("(?) AS usage", usage()).Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("count query failed: %w", err)
+	}
+
+	var checks []CheckUsage
+	err := usage().Order("checks.slug").Scopes(WithPagination(limit, offset)).Scan(&checks).Error
+	if err != nil {
+		return nil, 0, fmt.Errorf("find query failed: %w", err)
+	}
+	return checks, total, nil
+}
+
+// GetOrCreateCheckBySlug auto-creates a check if it doesn't exist, returns CheckID
+func (r *Repository) GetOrCreateCheckBySlug(ctx context.Context, slug string, name *string, description *string) (uuid.UUID, error) {
+	// First try to get existing check
+	check, err := r.GetCheckBySlug(ctx, slug)
+	if err == nil {
+		// Check exists, return its ID
+		return check.ID, nil
+	}
+	if !errors.Is(err, ErrCheckNotFound) {
+		// Some other error occurred
+		return uuid.Nil, err
+	}
+
+	// Check doesn't exist, create it with provided values or defaults
+	checkName := slug // Default name is slug
+	if name != nil && *name != "" {
+		checkName = *name
+	}
+
+	checkDescription := "Auto-created check for slug: " + slug // Default description
+	if description != nil && *description != "" {
+		checkDescription = *description
+	}
+
+	newCheck := Check{
+		Slug:        slug,
+		Name:        checkName,
+		Description: checkDescription,
+	}
+
+	err = r.CreateCheck(ctx, newCheck)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	// Get the created check to return its ID
+	createdCheck, err := r.GetCheckBySlug(ctx, slug)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	return createdCheck.ID, nil
+}
+
+// CreateCheckReportInput represents the input data for creating a check report
+type CreateCheckReportInput struct {
+	ReportID         uuid.UUID // Optional; generated on create when zero
+	ComponentID      string
+	CheckSlug        string
+	CheckName        *string
+	CheckDescription *string
+	Status           CheckStatus
+	Timestamp        time.Time
+	StartedAt        *time.Time // Optional
+	DurationMs       *int64     // Optional
+	Details          JSONB
+	Metadata         JSONB
+	IdempotencyKey   string // Optional; see CreateCheckReportIdempotent
+}
+
+// CreateCheckReportFromSubmission creates a check report from API submission data
+func (r *Repository) CreateCheckReportFromSubmission(ctx context.Context, input CreateCheckReportInput) (reportID uuid.UUID, err error) {
+	ctx, span := tracing.Start(ctx, "storage.CreateCheckReportFromSubmission",
+		attribute.String("argus.component_id", input.ComponentID),
+		attribute.String("argus.check_slug", input.CheckSlug))
+	defer func() { tracing.End(span, err) }()
+
+	// Use transaction to ensure atomicity
+	err = r.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// Verify component exists and get its UUID using the transaction
+		component, err := r.getComponentInTransaction(ctx, tx, input.ComponentID)
+		if err != nil {
+			return err
+		}
+
+		// Get or create check by slug with provided name and description using the transaction
+		checkID, err := r.getOrCreateCheckInTransaction(ctx, tx, input)
+		if err != nil {
+			return err
+		}
+
+		// Create the report
+		report := CheckReport{
+			ID:          input.ReportID,
+			CheckID:     checkID,
+			ComponentID: component.ID,
+			Status:      input.Status,
+			Timestamp:   input.Timestamp,
+			StartedAt:   input.StartedAt,
+			DurationMs:  input.DurationMs,
+			Details:     input.Details,
+			Metadata:    input.Metadata,
+		}
+
+		if err := tx.Create(&report).Error; err != nil {
+			return err
+		}
+
+		reportID = report.ID
+		return nil
+	})
+
+	return reportID, err
+}
+
+// CreateCheckReportIdempotent stores a report like CreateCheckReportFromSubmission, unless input has an
+// idempotency key the component already has a report of the same check for. That report's ID is then
+// returned with created set to false, so a retried submission does not store a duplicate.
+func (r *Repository) CreateCheckReportIdempotent(ctx context.Context, input CreateCheckReportInput) (reportID uuid.UUID, created bool, err error) {
+	ctx, span := tracing.Start(ctx, "storage.CreateCheckReportIdempotent",
+		attribute.String("argus.component_id", input.ComponentID),
+		attribute.String("argus.check_slug", input.CheckSlug))
+	defer func() { tracing.End(span, err) }()
+
+	err = r.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		component, err := r.getComponentInTransaction(ctx, tx, input.ComponentID)
+		if err != nil {
+			return err
+		}
+		checkID, err := r.getOrCreateCheckInTransaction(ctx, tx, input)
+		if err != nil {
+			return err
+		}
+
+		if input.IdempotencyKey != "" {
+			var existing CheckReport
+			err := tx.WithContext(ctx).
+				Select("id").
+				Where("component_id = ? AND check_id = ? AND idempotency_key = ?", component.ID, checkID, input.IdempotencyKey).
+				Take(&existing).Error
+			if err == nil {
+				reportID = existing.ID
+				return nil
+			}
+			if !errors.Is(err, gorm.ErrRecordNotFound) {
+				return err
+			}
+		}
+
+		report := CheckReport{
+			ID:          input.ReportID,
+			CheckID:     checkID,
+			ComponentID: component.ID,
+			Status:      input.Status,
+			Timestamp:   input.Timestamp,
+			StartedAt:   input.StartedAt,
+			DurationMs:  input.DurationMs,
+			Details:     input.Details,
+			Metadata:    input.Metadata,
+		}
+		if input.IdempotencyKey != "" {
+			report.IdempotencyKey = &input.IdempotencyKey
+		}
+		if err := tx.Create(&report).Error; err != nil {
+			return err
+		}
+
+		reportID, created = report.ID, true
+		return nil
+	})
+	if err != nil && input.IdempotencyKey != "" && !errors.Is(err, ErrComponentNotFound) {
+		// A concurrent submission with the same key may have been stored first
+		if existingID, lookupErr := r.findReportByIdempotencyKey(ctx, input); lookupErr == nil {
+			return existingID, false, nil
+		}
+	}
+	return reportID, created, err
+}
+
+// findReportByIdempotencyKey returns the ID of the report stored for the component and check of
+// input with its idempotency key
+func (r *Repository) findReportByIdempotencyKey(ctx context.Context, input CreateCheckReportInput) (uuid.UUID, error) {
+	var report CheckReport
+	err := r.DB.WithContext(ctx).
+		Select("check_reports.id").
+		Joins("JOIN components ON components.id = check_reports.component_id").
+		Joins("JOIN checks ON checks.id = check_reports.check_id").
+		Where("components.component_id = ? AND checks.slug = ? AND check_reports.idempotency_key = ?",
+			r.normalizeComponentID(input.ComponentID), input.CheckSlug, input.IdempotencyKey).
+		Take(&report).Error
+	return report.ID, err
+}
+
+// MissingComponentsError lists submitted component IDs that do not exist.
+// It matches ErrComponentNotFound with errors.Is.
+type MissingComponentsError struct {
+	ComponentIDs []string
+}
+
+func (e *MissingComponentsError) Error() string {
+	return fmt.Sprintf("components not found: %s", strings.Join(e.ComponentIDs, ", "))
+}
+
+func (e *MissingComponentsError) Is(target error) bool {
+	return target == ErrComponentNotFound
+}
+
+// MultiComponentReportResult holds the outcome of a multi-component submission
+type MultiComponentReportResult struct {
+	// ReportIDs maps each submitted component ID to its stored report
+	ReportIDs map[string]uuid.UUID
+	// Skipped lists component IDs that did not exist when skipMissing was set
+	Skipped []string
+}
+
+// CreateCheckReportsForComponents stores the same report for several components in a single
+// transaction, sharing the check, details and metadata. input.ComponentID and input.ReportID are ignored.
+// Unless skipMissing is set, any missing component fails the whole submission with a *MissingComponentsError.
+// IDs that normalize to the same component produce a single report.
+func (r *Repository) CreateCheckReportsForComponents(ctx context.Context, input CreateCheckReportInput, componentIDs []string, skipMissing bool) (*MultiComponentReportResult, error) {
+	result := &MultiComponentReportResult{ReportIDs: make(map[string]uuid.UUID, len(componentIDs))}
+
+	err := r.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// Resolve every component before writing anything
+		components := make([]*Component, len(componentIDs))
+		var missing []string
+		for i, componentID := range componentIDs {
+			component, err := r.getComponentInTransaction(ctx, tx, componentID)
+			if errors.Is(err, ErrComponentNotFound) {
+				missing = append(missing, componentID)
+				continue
+			}
+			if err != nil {
+				return err
+			}
+			components[i] = component
+		}
+		if len(missing) > 0 && !skipMissing {
+			return &MissingComponentsError{ComponentIDs: missing}
+		}
+
+		checkID, err := r.getOrCreateCheckInTransaction(ctx, tx, input)
+		if err != nil {
+			return err
+		}
+
+		stored := make(map[uuid.UUID]uuid.UUID, len(components))
+		for i, component := range components {
+			if component == nil {
+				continue
+			}
+			if reportID, ok := stored[component.ID]; ok {
+				result.ReportIDs[componentIDs[i]] = reportID
+				continue
+			}
+
+			report := CheckReport{
+				CheckID:     checkID,
+				ComponentID: component.ID,
+				Status:      input.Status,
+				Timestamp:   input.Timestamp,
+				StartedAt:   input.StartedAt,
+				DurationMs:  input.DurationMs,
+				Details:     input.Details,
+				Metadata:    input.Metadata,
+			}
+			if err := tx.Create(&report).Error; err != nil {
+				return err
+			}
+			stored[component.ID] = report.ID
+			result.ReportIDs[componentIDs[i]] = report.ID
+		}
+
+		result.Skipped = missing
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// CreateCheckReportsFromSubmissions stores several independent reports in a single transaction and
+// returns their IDs in input order. Every component is resolved before anything is written, so a
+// missing component fails the whole batch with a *MissingComponentsError.
+func (r *Repository) CreateCheckReportsFromSubmissions(ctx context.Context, inputs []CreateCheckReportInput) ([]uuid.UUID, error) {
+	reportIDs := make([]uuid.UUID, len(inputs))
+
+	err := r.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		components := make(map[string]*Component)
+		var missing []string
+		for _, input := range inputs {
+			if _, ok := components[input.ComponentID]; ok {
+				continue
+			}
+			component, err := r.getComponentInTransaction(ctx, tx, input.ComponentID)
+			if errors.Is(err, ErrComponentNotFound) {
+				missing = append(missing, input.ComponentID)
+			} else if err != nil {
+				return err
+			}
+			components[input.ComponentID] = component
+		}
+		if len(missing) > 0 {
+			return &MissingComponentsError{ComponentIDs: missing}
+		}
+
+		for i, input := range inputs {
+			checkID, err := r.getOrCreateCheckInTransaction(ctx, tx, input)
+			if err != nil {
+				return err
+			}
+
+			report := CheckReport{
+				ID:          input.ReportID,
+				CheckID:     checkID,
+				ComponentID: components[input.ComponentID].ID,
+				Status:      input.Status,
+				Timestamp:   input.Timestamp,
+				StartedAt:   input.StartedAt,
+				DurationMs:  input.DurationMs,
+				Details:     input.Details,
+				Metadata:    input.Metadata,
+			}
+			if err := tx.Create(&report).Error; err != nil {
+				return err
+			}
+			reportIDs[i] = report.ID
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return reportIDs, nil
+}
+
+// getComponentInTransaction gets a component within a transaction
+func (r *Repository) getComponentInTransaction(ctx context.Context, tx *gorm.DB, componentID string) (*Component, error) {
+	var component Component
+	err := tx.WithContext(ctx).Where("component_id = ?", r.normalizeComponentID(componentID)).First(&component).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrComponentNotFound
+		}
+		return nil, err
+	}
+	return &component, nil
+}
+
+// getOrCreateCheckInTransaction gets or creates a check within a transaction
+func (r *Repository) getOrCreateCheckInTransaction(ctx context.Context, tx *gorm.DB, input CreateCheckReportInput) (uuid.UUID, error) {
+	var check Check
+	err := tx.WithContext(ctx).Where("slug = ?", input.CheckSlug).First(&check).Error
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return uuid.Nil, err
+	}
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return r.createCheckInTransaction(ctx, tx, input)
+	}
+
+	return check.ID, nil
+}
+
+// createCheckInTransaction creates a new check within a transaction
 func (r *Repository) createCheckInTransaction(ctx context.Context, tx *gorm.DB, input CreateCheckReportInput) (uuid.UUID, error) {
 	checkName := input.CheckSlug // Default name is slug
 	if input.CheckName != nil && *input.CheckName != "" {
 		checkName = *input.CheckName
 	}
 
-	checkDescription := "Auto-created check for slug: " + input.CheckSlug // Default description
-	if input.CheckDescription != nil && *input.CheckDescription != "" {
-		checkDescription = *input.CheckDescription
+	checkDescription := "Auto-created check for slug: " + input.CheckSlug // Default description
+	if input.CheckDescription != nil && *input.CheckDescription != "" {
+		checkDescription = *input.CheckDescription
+	}
+
+	newCheck := Check{
+		Slug:        input.CheckSlug,
+		Name:        checkName,
+		Description: checkDescription,
+	}
+
+	if err := tx.WithContext(ctx).Create(&newCheck).Error; err != nil {
+		return uuid.Nil, err
+	}
+
+	return newCheck.ID, nil
+}
+
+// applyReceivedFilters applies the received time filters to a query
+func (r *Repository) applyReceivedFilters(query *gorm.DB, receivedAfter *time.Time, receivedBefore *time.Time) *gorm.DB {
+	if receivedAfter != nil {
+		query = query.Scopes(WithReceivedAfter(*receivedAfter))
+	}
+	if receivedBefore != nil {
+		query = query.Scopes(WithReceivedBefore(*receivedBefore))
+	}
+	return query
+}
+
+// GetReportsByReceivedTime retrieves reports across all components by when the server received them, newest first
+func (r *Repository) GetReportsByReceivedTime(ctx context.Context, receivedAfter *time.Time, receivedBefore *time.Time, limit int, offset int) ([]CheckReport, int64, error) {
+	var total int64
+	countQuery := r.applyReceivedFilters(r.DB.WithContext(ctx).Model(&CheckReport{}), receivedAfter, receivedBefore)
+	if err := countQuery.Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("count query failed: %w", err)
+	}
+
+	query := r.applyReceivedFilters(r.DB.WithContext(ctx), receivedAfter, receivedBefore).
+		Preload("Check").
+		Preload("Component").
+		Order("check_reports.created_at DESC, check_reports.id DESC").
+		Scopes(WithPagination(limit, offset))
+
+	var reports []CheckReport
+	if err := query.Find(&reports).Error; err != nil {
+		return nil, 0, fmt.Errorf("find query failed: %w", err)
+	}
+
+	return reports, total, nil
+}
+
+// PruneReportsOlderThan deletes all reports received before the cutoff and returns the number deleted.
+// It filters on the receive time rather than the report timestamp so the delete uses the
+// created_at index instead of scanning the table.
+func (r *Repository) PruneReportsOlderThan(ctx context.Context, cutoff time.Time) (int64, error) {
+	result := r.DB.WithContext(ctx).Scopes(WithReceivedBefore(cutoff)).Delete(&CheckReport{})
+	if result.Error != nil {
+		return 0, fmt.Errorf("delete query failed: %w", result.Error)
 	}
+	return result.RowsAffected, nil
+}
 
-	newCheck := Check{
-		Slug:        input.CheckSlug,
-		Name:        checkName,
-		Description: checkDescription,
+// GetCheckReportByID returns one of a component's reports with its check.
+// A report that belongs to another component is reported as not found.
+func (r *Repository) GetCheckReportByID(ctx context.Context, componentID string, reportID uuid.UUID) (*CheckReport, error) {
+	component, err := r.GetComponentByID(ctx, componentID)
+	if err != nil {
+		return nil, err
 	}
 
-	if err := tx.WithContext(ctx).Create(&newCheck).Error; err != nil {
-		return uuid.Nil, err
+	var report CheckReport
+	err = r.DB.WithContext(ctx).
+		Scopes(WithComponentID(component.ID)).
+		Preload("Check").
+		Where("check_reports.id = ?", reportID).
+		First(&report).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrReportNotFound
+		}
+		return nil, err
 	}
+	return &report, nil
+}
 
-	return newCheck.ID, nil
+// GetLatestCheckReport returns the most recent report of a check for a component, with its check.
+// ErrReportNotFound is returned when the component has no report of the check.
+func (r *Repository) GetLatestCheckReport(ctx context.Context, componentID string, checkSlug string) (*CheckReport, error) {
+	component, err := r.GetComponentByID(ctx, componentID)
+	if err != nil {
+		return nil, err
+	}
+
+	var report CheckReport
+	err = r.DB.WithContext(ctx).
+		Scopes(WithComponentID(component.ID), WithCheckSlug(checkSlug)).
+		Preload("Check").
+		Order("check_reports.timestamp DESC, check_reports.id DESC").
+		First(&report).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrReportNotFound
+		}
+		return nil, err
+	}
+	return &report, nil
+}
+
+// CheckReportDeleteFilters narrows which of a component's reports DeleteCheckReports removes
+type CheckReportDeleteFilters struct {
+	Before    *time.Time // Only reports with a timestamp before this time
+	CheckSlug *string    // Only reports of this check
+}
+
+// DeleteCheckReports deletes a component's reports matching the filters and returns the number deleted.
+// The delete is always scoped to the component, so other components' reports of the same check are kept.
+func (r *Repository) DeleteCheckReports(ctx context.Context, componentID string, filters CheckReportDeleteFilters) (int64, error) {
+	component, err := r.GetComponentByID(ctx, componentID)
+	if err != nil {
+		return 0, err
+	}
+
+	query := r.DB.WithContext(ctx).Scopes(WithComponentID(component.ID))
+	if filters.Before != nil {
+		query = query.Scopes(WithBefore(*filters.Before))
+	}
+	if filters.CheckSlug != nil {
+		// A subquery rather than WithCheckSlug, since DELETE does not support joins
+		query = query.Where("check_id IN (?)", r.DB.Model(&Check{}).Select("id").Where("slug = ?", *filters.CheckSlug))
+	}
+
+	result := query.Delete(&CheckReport{})
+	if result.Error != nil {
+		return 0, fmt.Errorf("delete query failed: %w", result.Error)
+	}
+	return result.RowsAffected, nil
 }
 
-// HealthCheck implements the health.Checker interface
+// HealthCheck implements the health.Checker interface. The ping borrows a pooled connection
+// only for its round trip and gives up when ctx is done.
 func (r *Repository) HealthCheck(ctx context.Context) error {
-	return r.DB.WithContext(ctx).Raw("SELECT 1").Error
+	sqlDB, err := r.DB.DB()
+	if err != nil {
+		return err
+	}
+	return sqlDB.PingContext(ctx)
 }
 
 func (r *Repository) Name() string {
 	return "database"
 }
 
-// GetCheckReportsForComponentWithPagination retrieves check reports for a component with database-level filtering, pagination, and latest per check
-func (r *Repository) GetCheckReportsForComponentWithPagination(ctx context.Context, componentID string, status *CheckStatus, checkSlug *string, since *time.Time, limit int, offset int, latestPerCheck bool) ([]CheckReport, int64, error) {
+// GetCheckReportsForComponentWithPagination retrieves check reports for a component with database-level filtering, pagination, and latest per check.
+// Reports matching any of the given statuses and any of the given check slugs are returned; empty lists match everything.
+// since and before bound the report timestamp to the half-open window [since, before).
+// order sorts the returned page; with latestPerCheck it is applied after the latest report of each check is selected.
+func (r *Repository) GetCheckReportsForComponentWithPagination(ctx context.Context, componentID string, statuses []CheckStatus, checkSlugs []string, since *time.Time, before *time.Time, limit int, offset int, latestPerCheck bool, order ReportOrder) ([]CheckReport, int64, error) {
+	ctx, span := tracing.Start(ctx, "storage.GetCheckReportsForComponentWithPagination",
+		attribute.String("argus.component_id", componentID),
+		attribute.Int("argus.limit", limit),
+		attribute.Int("argus.offset", offset),
+		attribute.Bool("argus.latest_per_check", latestPerCheck))
+	reports, total, err := r.getCheckReportsForComponentWithPagination(ctx, componentID, statuses, checkSlugs, since, before, limit, offset, latestPerCheck, order)
+	tracing.End(span, err)
+	return reports, total, err
+}
+
+// getCheckReportsForComponentWithPagination runs the query for GetCheckReportsForComponentWithPagination inside its span
+func (r *Repository) getCheckReportsForComponentWithPagination(ctx context.Context, componentID string, statuses []CheckStatus, checkSlugs []string, since *time.Time, before *time.Time, limit int, offset int, latestPerCheck bool, order ReportOrder) ([]CheckReport, int64, error) {
 	// First verify the component exists
 	component, err := r.GetComponentByID(ctx, componentID)
 	if err != nil {
 		return nil, 0, err
 	}
 
+	// filtered builds a fresh query on the component's reports with all filters applied
+	filtered := func() *gorm.DB {
+		query := r.DB.WithContext(ctx).Model(&CheckReport{}).
+			Where("check_reports.component_id = ?", component.ID)
+		return r.applyFilters(query, statuses, checkSlugs, since, before)
+	}
+
+	// Handle latest per check logic
+	if latestPerCheck {
+		return DialectFor(r.DB).LatestPerCheck(ctx, filtered, order, limit, offset)
+	}
+
 	// Get total count for pagination
 	var total int64
-	if latestPerCheck {
-		// For latest per check, we need to count the number of unique checks
-		countQuery := r.DB.WithContext(ctx).
-			Model(&CheckReport{}).
-			Select("COUNT(DISTINCT check_id)").
-			Where("component_id = ?", component.ID)
+	err = filtered().Count(&total).Error
+	if err != nil {
+		return nil, 0, fmt.Errorf("count query failed: %w", err)
+	}
 
-		// Apply filters to count query
-		countQuery = r.applyFilters(countQuery, status, checkSlug, since)
+	// Apply pagination and ordering; the default order matches cursor pages
+	query := filtered().Scopes(WithPreloads(), WithPagination(limit, offset), WithReportOrder(order))
 
-		err = countQuery.Scan(&total).Error
-		if err != nil {
-			return nil, 0, fmt.Errorf("count query failed: %w", err)
-		}
-	} else {
-		// Build base query for counting
-		countQuery := r.DB.WithContext(ctx).Model(&CheckReport{}).
-			Scopes(WithComponentID(component.ID))
+	var reports []CheckReport
+	err = query.Find(&reports).Error
+	if err != nil {
+		return nil, 0, fmt.Errorf("find query failed: %w", err)
+	}
 
-		// Apply filters to count query
-		countQuery = r.applyFilters(countQuery, status, checkSlug, since)
+	return reports, total, err
+}
 
-		err = countQuery.Count(&total).Error
-		if err != nil {
-			return nil, 0, fmt.Errorf("count query failed: %w", err)
-		}
-	}
+// LatestReportFilters narrows GetLatestReports. Zero values are ignored.
+type LatestReportFilters struct {
+	// Statuses match the status of the latest report, so a pair whose latest report has
+	// another status is left out rather than falling back to an older report
+	Statuses []CheckStatus
+	// CheckSlugs match any of the given checks
+	CheckSlugs []string
+	// Team matches the owning team of the component exactly
+	Team string
+}
 
-	// Build query for fetching data
-	query := r.DB.WithContext(ctx).
-		Scopes(WithComponentID(component.ID), WithPreloads())
+// GetLatestReports returns the latest report of every component and check pair across the catalog,
+// ordered by component identifier and then check slug, with the number of matching pairs.
+// Reports of deleted components are left out. Returned reports have Check and Component loaded.
+func (r *Repository) GetLatestReports(ctx context.Context, filters LatestReportFilters, limit int, offset int) ([]CheckReport, int64, error) {
+	ctx, span := tracing.Start(ctx, "storage.GetLatestReports",
+		attribute.Int("argus.limit", limit),
+		attribute.Int("argus.offset", offset))
+	reports, total, err := r.getLatestReports(ctx, filters, limit, offset)
+	tracing.End(span, err)
+	return reports, total, err
+}
 
-	// Apply filters
-	query = r.applyFilters(query, status, checkSlug, since)
+// getLatestReports runs the query for GetLatestReports inside its span
+func (r *Repository) getLatestReports(ctx context.Context, filters LatestReportFilters, limit int, offset int) ([]CheckReport, int64, error) {
+	// filtered builds a fresh query on the reports the latest ones are picked from
+	filtered := func() *gorm.DB {
+		query := r.DB.WithContext(ctx).Model(&CheckReport{}).
+			Joins("JOIN components ON components.id = check_reports.component_id AND components.deleted_at IS NULL")
+		if filters.Team != "" {
+			query = query.Where("components.team_key = ?", utils.NormalizeTeam(filters.Team))
+		}
+		if len(filters.CheckSlugs) > 0 {
+			query = query.Scopes(WithCheckSlug(filters.CheckSlugs...))
+		}
+		return query
+	}
+	latestReportSubquery := DialectFor(r.DB).LatestPerComponentCheck(filtered)
 
-	// Handle latest per check logic
-	if latestPerCheck {
-		return r.getLatestPerCheckReports(ctx, query, *component, status, checkSlug, since, limit, offset)
+	latest := func() *gorm.DB {
+		query := r.DB.WithContext(ctx).Model(&CheckReport{}).
+			Where("check_reports.id IN (?)", latestReportSubquery)
+		if len(filters.Statuses) > 0 {
+			query = query.Where("check_reports.status IN ?", filters.Statuses)
+		}
+		return query
 	}
 
-	// Apply pagination and ordering
-	query = query.Scopes(WithPagination(limit, offset), WithOrderByTimestamp())
+	var total int64
+	if err := latest().Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("count query failed: %w", err)
+	}
 
 	var reports []CheckReport
-	err = query.Find(&reports).Error
+	err := latest().
+		Joins("JOIN components ON components.id = check_reports.component_id").
+		Joins("JOIN checks ON checks.id = check_reports.check_id").
+		Preload("Check").
+		Preload("Component").
+		Order("components.component_id, checks.slug").
+		Scopes(WithPagination(limit, offset)).
+		Find(&reports).Error
 	if err != nil {
 		return nil, 0, fmt.Errorf("find query failed: %w", err)
 	}
 
-	return reports, total, err
+	return reports, total, nil
 }
 
-// applyLatestPerCheckFilters applies filters consistently for latest per check logic
+// GetLatestReportsForComponents returns the latest report of every check of the given components,
+// optionally only of one check, ordered by component identifier and then check slug. The reports
+// are picked in one query however many components are given, and an empty list returns no reports.
+// Unknown and deleted components are skipped. Returned reports have Check and Component loaded.
+func (r *Repository) GetLatestReportsForComponents(ctx context.Context, componentIDs []string, checkSlug *string) ([]CheckReport, error) {
+	if len(componentIDs) == 0 {
+		return []CheckReport{}, nil
+	}
+	filtered := func() *gorm.DB {
+		return r.reportsOfComponents(ctx, componentIDs, checkSlug)
+	}
 
-// getLatestPerCheckReportsPostgreSQL handles latest per check logic for PostgreSQL
-func (r *Repository) getLatestPerCheckReportsPostgreSQL(ctx context.Context, query *gorm.DB, component Component, status *CheckStatus, checkSlug *string, since *time.Time, limit int, offset int) ([]CheckReport, int64, error) {
-	// Build a subquery that gets the latest report ID for each check
-	// This handles timestamp ties by using the report ID as a tiebreaker
-	// The subquery only filters by component_id - the main query already has other filters applied
-	latestReportSubquery := r.DB.WithContext(ctx).
-		Model(&CheckReport{}).
-		Select("DISTINCT ON (check_id) id").
-		Where("component_id = ?", component.ID).
-		Order("check_id, timestamp DESC, id DESC")
+	var reports []CheckReport
+	err := r.DB.WithContext(ctx).Model(&CheckReport{}).
+		Where("check_reports.id IN (?)", DialectFor(r.DB).LatestPerComponentCheck(filtered)).
+		Joins("JOIN components ON components.id = check_reports.component_id").
+		Joins("JOIN checks ON checks.id = check_reports.check_id").
+		Preload("Check").
+		Preload("Component").
+		Order("components.component_id, checks.slug").
+		Find(&reports).Error
+	if err != nil {
+		return nil, err
+	}
+	return reports, nil
+}
 
-	// Use the subquery to filter the main query (which already has filters applied)
-	query = query.Where("check_reports.id IN (?)", latestReportSubquery)
+// GetCheckReportsForComponents returns a page of the reports of the given components, optionally
+// only of one check, newest first, along with the number of matching reports. Like
+// GetLatestReportsForComponents it runs one query for all the components and skips unknown and
+// deleted ones. Returned reports have Check and Component loaded.
+func (r *Repository) GetCheckReportsForComponents(ctx context.Context, componentIDs []string, checkSlug *string, limit int, offset int) ([]CheckReport, int64, error) {
+	if len(componentIDs) == 0 {
+		return []CheckReport{}, 0, nil
+	}
 
-	// Apply pagination and ordering
-	query = query.Scopes(WithPagination(limit, offset), WithOrderByTimestamp())
+	var total int64
+	if err := r.reportsOfComponents(ctx, componentIDs, checkSlug).Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("count query failed: %w", err)
+	}
 
 	var reports []CheckReport
-	err := query.Find(&reports).Error
+	err := r.reportsOfComponents(ctx, componentIDs, checkSlug).
+		Preload("Check").
+		Preload("Component").
+		Scopes(WithPagination(limit, offset), WithReportOrder(ReportOrder{})).
+		Find(&reports).Error
 	if err != nil {
 		return nil, 0, fmt.Errorf("find query failed: %w", err)
 	}
+	return reports, total, nil
+}
+
+// reportsOfComponents builds a query on the reports of the given live components, optionally
+// only of one check
+func (r *Repository) reportsOfComponents(ctx context.Context, componentIDs []string, checkSlug *string) *gorm.DB {
+	normalizedIDs := make([]string, len(componentIDs))
+	for i, componentID := range componentIDs {
+		normalizedIDs[i] = r.normalizeComponentID(componentID)
+	}
+	query := r.DB.WithContext(ctx).Model(&CheckReport{}).
+		Joins("JOIN components ON components.id = check_reports.component_id AND components.deleted_at IS NULL").
+		Where("components.component_id IN ?", normalizedIDs)
+	if checkSlug != nil {
+		query = query.Scopes(WithCheckSlug(*checkSlug))
+	}
+	return query
+}
+
+// ReportCursor marks the last report of a page in timestamp and id descending order
+type ReportCursor struct {
+	Timestamp time.Time
+	ID        uuid.UUID
+}
+
+// CursorFor returns the cursor positioned at the given report
+func CursorFor(report CheckReport) ReportCursor {
+	return ReportCursor{Timestamp: report.Timestamp, ID: report.ID}
+}
 
-	// Count total for pagination - count distinct checks that have reports
-	countQuery := r.DB.WithContext(ctx).
-		Model(&CheckReport{}).
-		Select("COUNT(DISTINCT check_id)").
-		Where("component_id = ?", component.ID)
+// GetCheckReportsForComponentByCursor retrieves a page of check reports for a component using keyset pagination.
+// Reports are ordered by timestamp and id descending; a nil cursor starts from the newest report.
+// The returned cursor points at the last report of the page and is nil when there are no more reports.
+// Filters behave as in GetCheckReportsForComponentWithPagination and the total ignores the cursor.
+func (r *Repository) GetCheckReportsForComponentByCursor(ctx context.Context, componentID string, statuses []CheckStatus, checkSlugs []string, since *time.Time, before *time.Time, cursor *ReportCursor, limit int) ([]CheckReport, int64, *ReportCursor, error) {
+	component, err := r.GetComponentByID(ctx, componentID)
+	if err != nil {
+		return nil, 0, nil, err
+	}
 
-	// Apply filters to count query
-	countQuery = r.applyFilters(countQuery, status, checkSlug, since)
+	filtered := func() *gorm.DB {
+		query := r.DB.WithContext(ctx).Model(&CheckReport{}).
+			Where("check_reports.component_id = ?", component.ID)
+		return r.applyFilters(query, statuses, checkSlugs, since, before)
+	}
 
 	var total int64
-	err = countQuery.Scan(&total).Error
+	if err := filtered().Count(&total).Error; err != nil {
+		return nil, 0, nil, fmt.Errorf("count query failed: %w", err)
+	}
+
+	query := filtered().Scopes(WithPreloads(), WithReportOrder(ReportOrder{}))
+	if cursor != nil {
+		query = query.Scopes(WithCursorBefore(*cursor))
+	}
+
+	// Fetch one extra report to learn whether another page follows
+	var reports []CheckReport
+	if err := query.Limit(limit + 1).Find(&reports).Error; err != nil {
+		return nil, 0, nil, fmt.Errorf("find query failed: %w", err)
+	}
+
+	if len(reports) <= limit {
+		return reports, total, nil, nil
+	}
+
+	reports = reports[:limit]
+	next := CursorFor(reports[len(reports)-1])
+	return reports, total, &next, nil
+}
+
+// StreamedReport is a check report as read by StreamCheckReportsForComponent
+type StreamedReport struct {
+	ID         uuid.UUID
+	CheckSlug  string
+	Status     CheckStatus
+	Timestamp  time.Time
+	StartedAt  *time.Time
+	DurationMs *int64
+}
+
+// StreamCheckReportsForComponent calls fn with every report of a component matching the filters,
+// newest first, reading them one row at a time instead of loading them all. Filters behave as in
+// GetCheckReportsForComponentWithPagination. Iteration stops at the first error from fn, and the
+// query is abandoned when ctx is cancelled. fn must not use the repository, since the query holds
+// its connection until iteration ends.
+func (r *Repository) StreamCheckReportsForComponent(ctx context.Context, componentID string, statuses []CheckStatus, checkSlugs []string, since *time.Time, before *time.Time, fn func(StreamedReport) error) error {
+	component, err := r.GetComponentByID(ctx, componentID)
 	if err != nil {
-		return nil, 0, fmt.Errorf("count query failed: %w", err)
+		return err
 	}
 
-	return reports, total, nil
+	query := r.DB.WithContext(ctx).Model(&CheckReport{}).
+		Where("check_reports.component_id = ?", component.ID)
+	// The slug is selected through a subquery since the check_slug filter may already join checks
+	query = r.applyFilters(query, statuses, checkSlugs, since, before).
+		Select("check_reports.id, check_reports.status, check_reports.timestamp, " +
+			"check_reports.started_at, check_reports.duration_ms, " +
+			"(SELECT checks.slug FROM checks WHERE checks.id = check_reports.check_id) AS check_slug").
+		Scopes(WithReportOrder(ReportOrder{}))
+
+	rows, err := query.Rows()
+	if err != nil {
+		return fmt.Errorf("stream query failed: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	for rows.Next() {
+		// Drivers may not notice a cancelled context until their buffered rows run out
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		var report StreamedReport
+		if err := r.DB.ScanRows(rows, &report); err != nil {
+			return fmt.Errorf("failed to scan report: %w", err)
+		}
+		if err := fn(report); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
+
+// CheckReportStatusCounts summarizes the latest report of each check for a component
+type CheckReportStatusCounts struct {
+	TotalChecks    int64
+	Counts         map[CheckStatus]int64
+	LatestReportAt *time.Time // nil when the component has no reports
 }
 
-// getLatestPerCheckReportsSQLite handles latest per check logic for SQLite and other databases
-// Simplified for testing purposes only - prioritizes PostgreSQL correctness
-func (r *Repository) getLatestPerCheckReportsSQLite(ctx context.Context, query *gorm.DB, component Component, status *CheckStatus, checkSlug *string, since *time.Time, limit int, offset int) ([]CheckReport, int64, error) {
-	// For SQLite testing, use the simplest possible approach
-	// Just get all reports and let the application layer handle "latest per check"
-	// This is not efficient but sufficient for basic testing
+// GetCheckReportStatusCounts counts the checks of a component by the status of their latest report.
+// It uses the same latest-per-check selection as GetCheckReportsForComponentWithPagination.
+func (r *Repository) GetCheckReportStatusCounts(ctx context.Context, componentID string) (*CheckReportStatusCounts, error) {
+	component, err := r.GetComponentByID(ctx, componentID)
+	if err != nil {
+		return nil, err
+	}
 
-	// Get all reports for the component with filters applied
-	allReportsQuery := r.DB.WithContext(ctx).
-		Model(&CheckReport{}).
-		Joins("JOIN checks ON check_reports.check_id = checks.id").
-		Where("check_reports.component_id = ?", component.ID).
-		Preload("Check").
-		Preload("Component").
-		Order("check_reports.timestamp DESC")
+	filtered := func() *gorm.DB {
+		return r.DB.WithContext(ctx).Model(&CheckReport{}).
+			Where("check_reports.component_id = ?", component.ID)
+	}
+
+	summary := &CheckReportStatusCounts{Counts: make(map[CheckStatus]int64)}
 
-	// Apply filters
-	allReportsQuery = r.applyFiltersToLatestQuery(allReportsQuery, status, checkSlug, since)
+	var checkCount int64
+	if err := filtered().Distinct("check_reports.check_id").Count(&checkCount).Error; err != nil {
+		return nil, fmt.Errorf("count query failed: %w", err)
+	}
+	if checkCount == 0 {
+		return summary, nil
+	}
 
-	var allReports []CheckReport
-	err := allReportsQuery.Find(&allReports).Error
+	reports, _, err := DialectFor(r.DB).LatestPerCheck(ctx, filtered, ReportOrder{}, int(checkCount), 0)
 	if err != nil {
-		return nil, 0, fmt.Errorf("find query failed: %w", err)
+		return nil, err
 	}
 
-	// In application layer, get the latest report per check
-	latestPerCheck := make(map[uuid.UUID]*CheckReport)
-	for i := range allReports {
-		report := &allReports[i]
-		if existing, exists := latestPerCheck[report.CheckID]; !exists || report.Timestamp.After(existing.Timestamp) {
-			latestPerCheck[report.CheckID] = report
+	// Count what was loaded so the per-status counts always add up to the total
+	summary.TotalChecks = int64(len(reports))
+	for _, report := range reports {
+		summary.Counts[report.Status]++
+		if summary.LatestReportAt == nil || report.Timestamp.After(*summary.LatestReportAt) {
+			timestamp := report.Timestamp
+			summary.LatestReportAt = &timestamp
 		}
 	}
 
-	// Convert back to slice and sort by timestamp
-	var reports []CheckReport
-	for _, report := range latestPerCheck {
-		reports = append(reports, *report)
+	return summary, nil
+}
+
+// ExpectedCheckReport pairs a check a component expects with the check's latest report on it
+type ExpectedCheckReport struct {
+	Slug         string
+	LatestReport *CheckReport // nil when the check never reported on the component
+}
+
+// GetExpectedCheckReports returns the component's expected checks, in manifest order, each with its
+// latest report on the component. It uses the same latest-per-check selection as
+// GetCheckReportsForComponentWithPagination.
+func (r *Repository) GetExpectedCheckReports(ctx context.Context, componentID string) ([]ExpectedCheckReport, error) {
+	component, err := r.GetComponentByID(ctx, componentID)
+	if err != nil {
+		return nil, err
+	}
+	if len(component.ExpectedChecks) == 0 {
+		return nil, nil
 	}
 
-	// Sort by timestamp descending
-	sort.Slice(reports, func(i, j int) bool {
-		return reports[i].Timestamp.After(reports[j].Timestamp)
-	})
+	filtered := func() *gorm.DB {
+		return r.DB.WithContext(ctx).Model(&CheckReport{}).
+			Where("check_reports.component_id = ?", component.ID).
+			Scopes(WithCheckSlug(component.ExpectedChecks...))
+	}
+	reports, _, err := DialectFor(r.DB).LatestPerCheck(ctx, filtered, ReportOrder{}, len(component.ExpectedChecks), 0)
+	if err != nil {
+		return nil, err
+	}
 
-	// Apply pagination manually
-	total := int64(len(reports))
-	start := offset
-	end := offset + limit
-	switch {
-	case start >= len(reports):
-		reports = []CheckReport{}
-	case end > len(reports):
-		reports = reports[start:]
-	default:
-		reports = reports[start:end]
+	latest := make(map[string]*CheckReport, len(reports))
+	for i := range reports {
+		latest[reports[i].Check.Slug] = &reports[i]
 	}
 
-	return reports, total, nil
+	expected := make([]ExpectedCheckReport, len(component.ExpectedChecks))
+	for i, slug := range component.ExpectedChecks {
+		expected[i] = ExpectedCheckReport{Slug: slug, LatestReport: latest[slug]}
+	}
+	return expected, nil
+}
+
+// StaleCheck is a check of a component whose latest report is too old, or that never reported
+type StaleCheck struct {
+	Slug         string
+	Expected     bool         // Listed in the component's expected checks
+	LatestReport *CheckReport // nil when the check never reported on the component
 }
 
-// applyFiltersToLatestQuery applies filters to a query that already has a checks JOIN
-func (r *Repository) applyFiltersToLatestQuery(query *gorm.DB, status *CheckStatus, checkSlug *string, since *time.Time) *gorm.DB {
-	if status != nil {
-		query = query.Where("check_reports.status = ?", *status)
+// GetStaleChecks returns the component's checks whose latest report is older than cutoff, and its
+// expected checks that never reported, ordered by slug. The component's checks are those that
+// reported on it together with its expected checks.
+func (r *Repository) GetStaleChecks(ctx context.Context, componentID string, cutoff time.Time) ([]StaleCheck, error) {
+	component, err := r.GetComponentByID(ctx, componentID)
+	if err != nil {
+		return nil, err
 	}
-	if since != nil {
-		query = query.Where("check_reports.timestamp >= ?", *since)
+
+	filtered := func() *gorm.DB {
+		return r.DB.WithContext(ctx).Model(&CheckReport{}).
+			Where("check_reports.component_id = ?", component.ID)
 	}
-	if checkSlug != nil && *checkSlug != "" {
-		query = query.Where("checks.slug = ?", *checkSlug)
+
+	var checkCount int64
+	if err := filtered().Distinct("check_reports.check_id").Count(&checkCount).Error; err != nil {
+		return nil, fmt.Errorf("count query failed: %w", err)
 	}
-	return query
+
+	var latest []CheckReport
+	if checkCount > 0 {
+		latest, _, err = DialectFor(r.DB).LatestPerCheck(ctx, filtered, ReportOrder{}, int(checkCount), 0)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	expected := make(map[string]bool, len(component.ExpectedChecks))
+	for _, slug := range component.ExpectedChecks {
+		expected[slug] = true
+	}
+
+	var stale []StaleCheck
+	for i := range latest {
+		slug := latest[i].Check.Slug
+		reported := expected[slug]
+		delete(expected, slug)
+		if latest[i].Timestamp.Before(cutoff) {
+			stale = append(stale, StaleCheck{Slug: slug, Expected: reported, LatestReport: &latest[i]})
+		}
+	}
+	// Expected checks left over never reported
+	for slug := range expected {
+		stale = append(stale, StaleCheck{Slug: slug, Expected: true})
+	}
+
+	slices.SortFunc(stale, func(a, b StaleCheck) int { return strings.Compare(a.Slug, b.Slug) })
+	return stale, nil
+}
+
+// TimeBucket is the size of the buckets GetReportStatusTimeSeries counts reports in
+type TimeBucket string
+
+const (
+	TimeBucketHour TimeBucket = "hour"
+	TimeBucketDay  TimeBucket = "day"
+)
+
+// Duration returns the length of the bucket, or zero for an unknown bucket
+func (b TimeBucket) Duration() time.Duration {
+	switch b {
+	case TimeBucketHour:
+		return time.Hour
+	case TimeBucketDay:
+		return 24 * time.Hour
+	}
+	return 0
+}
+
+// StatusBucket counts reports by status within one time bucket
+type StatusBucket struct {
+	Start  time.Time // Start of the bucket in UTC, inclusive
+	Counts map[CheckStatus]int64
+}
+
+// GetReportStatusTimeSeries counts a component's reports of a check by status, in UTC buckets from
+// the one containing since through the one containing until. Every bucket in the range is returned,
+// oldest first, so buckets without reports have empty counts. An unknown check gives all empty buckets.
+func (r *Repository) GetReportStatusTimeSeries(ctx context.Context, componentID string, checkSlug string, bucket TimeBucket, since time.Time, until time.Time) ([]StatusBucket, error) {
+	size := bucket.Duration()
+	if size == 0 {
+		return nil, fmt.Errorf("unknown time bucket %q", bucket)
+	}
+
+	component, err := r.GetComponentByID(ctx, componentID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Day boundaries in UTC are multiples of a day since the zero time, so Truncate aligns both sizes
+	start := since.UTC().Truncate(size)
+	end := until.UTC().Truncate(size).Add(size)
+	if end.Before(start) {
+		return nil, nil
+	}
+
+	buckets := make([]StatusBucket, int(end.Sub(start)/size))
+	for i := range buckets {
+		buckets[i] = StatusBucket{Start: start.Add(time.Duration(i) * size), Counts: make(map[CheckStatus]int64)}
+	}
+
+	var rows []struct {
+		Bucket string
+		Status CheckStatus
+		Count  int64
+	}
+	err = r.DB.WithContext(ctx).Model(&CheckReport{}).
+		Select(DialectFor(r.DB).TimeBucket("check_reports.timestamp", bucket)+" AS bucket, check_reports.status, COUNT(*) AS count").
+		Where("check_reports.component_id = ?", component.ID).
+		Scopes(WithCheckSlug(checkSlug), WithSince(start), WithBefore(end)).
+		Group("bucket, check_reports.status").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, fmt.Errorf("time series query failed: %w", err)
+	}
+
+	for _, row := range rows {
+		bucketStart, err := time.Parse(time.RFC3339, row.Bucket)
+		if err != nil {
+			return nil, fmt.Errorf("invalid bucket %q: %w", row.Bucket, err)
+		}
+		i := int(bucketStart.Sub(start) / size)
+		if i < 0 || i >= len(buckets) {
+			continue
+		}
+		buckets[i].Counts[row.Status] += row.Count
+	}
+
+	return buckets, nil
+}
+
+// Component history methods
+
+// CreateComponentHistory records the changes made by a component update
+func (r *Repository) CreateComponentHistory(ctx context.Context, entry ComponentHistory) error {
+	return r.DB.WithContext(ctx).Create(&entry).Error
 }
 
-// getLatestPerCheckReports handles the latest per check logic for different database types
-func (r *Repository) getLatestPerCheckReports(ctx context.Context, query *gorm.DB, component Component, status *CheckStatus, checkSlug *string, since *time.Time, limit int, offset int) ([]CheckReport, int64, error) {
-	// Check if we're using PostgreSQL
-	dialectorName := r.DB.Name()
-	if dialectorName == "postgres" {
-		return r.getLatestPerCheckReportsPostgreSQL(ctx, query, component, status, checkSlug, since, limit, offset)
-	} else {
-		return r.getLatestPerCheckReportsSQLite(ctx, query, component, status, checkSlug, since, limit, offset)
+// GetComponentHistory returns a component's history entries, newest first. When field is set
+// only entries that changed that field are returned.
+func (r *Repository) GetComponentHistory(ctx context.Context, componentID string, field *string, since *time.Time, limit int, offset int) ([]ComponentHistory, int64, error) {
+	component, err := r.GetComponentByID(ctx, componentID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	filtered := func() *gorm.DB {
+		query := r.DB.WithContext(ctx).Model(&ComponentHistory{}).
+			Where("component_id = ?", component.ID)
+		if field != nil {
+			query = query.Where(DialectFor(r.DB).JSONArrayContains("changed_fields", *field))
+		}
+		if since != nil {
+			query = query.Where("changed_at >= ?", *since)
+		}
+		return query
+	}
+
+	var total int64
+	if err := filtered().Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("count query failed: %w", err)
+	}
+
+	var entries []ComponentHistory
+	err = filtered().
+		Scopes(WithPagination(limit, offset)).
+		Order("changed_at DESC").
+		Order("id DESC").
+		Find(&entries).Error
+	if err != nil {
+		return nil, 0, fmt.Errorf("find query failed: %w", err)
 	}
+
+	return entries, total, nil
+}
+
+// Webhook methods
+
+// CreateWebhookDeadLetter records a webhook payload that exhausted its delivery attempts
+func (r *Repository) CreateWebhookDeadLetter(ctx context.Context, letter WebhookDeadLetter) error {
+	return r.DB.WithContext(ctx).Create(&letter).Error
 }
@@ -1,6 +1,9 @@
 package storage_test
 
 import (
+	"context"
+	"errors"
+	"strings"
 	"testing"
 	"time"
 
@@ -40,6 +43,73 @@ func TestRepository_Migration(t *testing.T) {
 	assert.Equal(t, int64(1), count)
 }
 
+func TestRepository_TeamMatching(t *testing.T) {
+	repo := setupIsolatedRepo(t)
+	ctx := t.Context()
+
+	for _, component := range []storage.Component{
+		{ComponentID: "team-match-auth", Name: "Auth", Team: "Security Team"},
+		{ComponentID: "team-match-vault", Name: "Vault", Team: "security team"},
+		{ComponentID: "team-match-scanner", Name: "Scanner", Team: "  Security  Team "},
+		{ComponentID: "team-match-billing", Name: "Billing", Team: "Payments"},
+	} {
+		require.NoError(t, repo.CreateComponent(ctx, component))
+	}
+
+	componentIDs := func(team string) []string {
+		components, err := repo.GetComponentsByTeam(ctx, team)
+		require.NoError(t, err)
+		var ids []string
+		for _, component := range components {
+			ids = append(ids, component.ComponentID)
+		}
+		return ids
+	}
+	security := []string{"team-match-auth", "team-match-vault", "team-match-scanner"}
+	assert.ElementsMatch(t, security, componentIDs("Security Team"))
+	assert.ElementsMatch(t, security, componentIDs(" SECURITY TEAM "))
+	assert.Equal(t, []string{"team-match-billing"}, componentIDs("payments"))
+
+	count, err := repo.CountComponents(ctx, storage.ComponentFilters{Team: "security team"})
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), count)
+
+	// The stored name keeps its case for display
+	auth, err := repo.GetComponentByID(ctx, "team-match-auth")
+	require.NoError(t, err)
+	assert.Equal(t, "Security Team", auth.Team)
+
+	// Moving a component to another team moves its key too
+	auth.Team = "Payments"
+	require.NoError(t, repo.UpdateComponent(ctx, *auth))
+	assert.ElementsMatch(t, []string{"team-match-auth", "team-match-billing"}, componentIDs("PAYMENTS"))
+}
+
+func TestRepository_Migrate_BackfillsTeamKeys(t *testing.T) {
+	repo := setupIsolatedRepo(t)
+	ctx := t.Context()
+
+	require.NoError(t, repo.CreateComponent(ctx, storage.Component{ComponentID: "legacy-team-service", Name: "Legacy", Team: " Platform "}))
+	require.NoError(t, repo.CreateComponent(ctx, storage.Component{ComponentID: "legacy-deleted-service", Name: "Deleted", Team: "Platform"}))
+	require.NoError(t, repo.SoftDeleteComponent(ctx, "legacy-deleted-service"))
+	// Rows written before the column existed have no key
+	require.NoError(t, repo.DB.Unscoped().Model(&storage.Component{}).Where("1 = 1").UpdateColumn("team_key", "").Error)
+	components, err := repo.GetComponentsByTeam(ctx, "platform")
+	require.NoError(t, err)
+	require.Empty(t, components)
+
+	require.NoError(t, repo.Migrate(ctx))
+
+	components, err = repo.GetComponentsByTeam(ctx, "platform")
+	require.NoError(t, err)
+	require.Len(t, components, 1)
+	assert.Equal(t, "legacy-team-service", components[0].ComponentID)
+
+	var deleted storage.Component
+	require.NoError(t, repo.DB.Unscoped().First(&deleted, "component_id = ?", "legacy-deleted-service").Error)
+	assert.Equal(t, "platform", deleted.TeamKey)
+}
+
 func TestRepository_GetComponents_Empty(t *testing.T) {
 	// Use a completely isolated database to ensure it's truly empty
 	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
@@ -93,6 +163,29 @@ func TestRepository_JSONBStorage(t *testing.T) {
 		assert.Len(t, results, 1)
 		assert.Equal(t, "auth-service", results[0].ComponentID)
 	})
+
+	t.Run("GetComponentsByMaintainer", func(t *testing.T) {
+		lead := storage.Component{
+			ComponentID: "auth-lead-service",
+			Name:        "Auth Lead Service",
+			Maintainers: storage.StringArray{"@auth-team-lead"},
+		}
+		require.NoError(t, repo.CreateComponent(ctx, lead))
+
+		results, err := repo.GetComponentsByMaintainer(ctx, "@auth-team")
+		require.NoError(t, err)
+		require.Len(t, results, 1)
+		assert.Equal(t, "auth-service", results[0].ComponentID)
+
+		results, err = repo.GetComponentsByMaintainer(ctx, "@auth-team-lead")
+		require.NoError(t, err)
+		require.Len(t, results, 1)
+		assert.Equal(t, "auth-lead-service", results[0].ComponentID)
+
+		results, err = repo.GetComponentsByMaintainer(ctx, "alice")
+		require.NoError(t, err)
+		assert.Empty(t, results)
+	})
 }
 
 func TestRepository_CreateCheckReportWithExistingCheck(t *testing.T) {
@@ -212,6 +305,37 @@ func TestRepository_CreateCheckReportWithNonExistentComponent(t *testing.T) {
 	assert.ErrorIs(t, err, storage.ErrComponentNotFound)
 }
 
+func TestRepository_CreateCheckReportsFromSubmissions(t *testing.T) {
+	repo := setupIsolatedRepo(t)
+	ctx := t.Context()
+	require.NoError(t, repo.CreateComponent(ctx, storage.Component{ComponentID: "batch-service", Name: "Batch Service"}))
+
+	inputs := []storage.CreateCheckReportInput{
+		{ComponentID: "batch-service", CheckSlug: "unit-tests", Status: storage.CheckStatusPass, Timestamp: time.Now()},
+		{ComponentID: "batch-service", CheckSlug: "unit-tests", Status: storage.CheckStatusFail, Timestamp: time.Now()},
+	}
+	reportIDs, err := repo.CreateCheckReportsFromSubmissions(ctx, inputs)
+	require.NoError(t, err)
+	require.Len(t, reportIDs, 2)
+	assert.NotEqual(t, reportIDs[0], reportIDs[1])
+
+	// The check is created once and shared by both reports
+	var checkCount int64
+	require.NoError(t, repo.DB.Model(&storage.Check{}).Where("slug = ?", "unit-tests").Count(&checkCount).Error)
+	assert.Equal(t, int64(1), checkCount)
+
+	// A missing component fails the whole batch
+	inputs = append(inputs, storage.CreateCheckReportInput{ComponentID: "missing-service", CheckSlug: "unit-tests", Status: storage.CheckStatusPass, Timestamp: time.Now()})
+	_, err = repo.CreateCheckReportsFromSubmissions(ctx, inputs)
+	var missingErr *storage.MissingComponentsError
+	require.ErrorAs(t, err, &missingErr)
+	assert.Equal(t, []string{"missing-service"}, missingErr.ComponentIDs)
+
+	var reportCount int64
+	require.NoError(t, repo.DB.Model(&storage.CheckReport{}).Count(&reportCount).Error)
+	assert.Equal(t, int64(2), reportCount)
+}
+
 func TestRepository_GetOrCreateCheckBySlug(t *testing.T) {
 	repo := setupTestRepo(t)
 	ctx := t.Context()
@@ -453,14 +577,14 @@ func TestRepository_GetCheckReportsForComponentWithPagination(t *testing.T) {
 	const unitTestsSlug = "unit-tests-pagination"
 
 	t.Run("Basic pagination without filters", func(t *testing.T) {
-		reports, total, err := repo.GetCheckReportsForComponentWithPagination(ctx, "pagination-test-service", nil, nil, nil, 2, 0, false)
+		reports, total, err := repo.GetCheckReportsForComponentWithPagination(ctx, "pagination-test-service", nil, nil, nil, nil, 2, 0, false, storage.ReportOrder{})
 		require.NoError(t, err)
 		assert.Equal(t, int64(4), total)
 		assert.Len(t, reports, 2)
 	})
 
 	t.Run("Pagination with offset", func(t *testing.T) {
-		reports, total, err := repo.GetCheckReportsForComponentWithPagination(ctx, "pagination-test-service", nil, nil, nil, 2, 2, false)
+		reports, total, err := repo.GetCheckReportsForComponentWithPagination(ctx, "pagination-test-service", nil, nil, nil, nil, 2, 2, false, storage.ReportOrder{})
 		require.NoError(t, err)
 		assert.Equal(t, int64(4), total)
 		assert.Len(t, reports, 2)
@@ -468,7 +592,7 @@ func TestRepository_GetCheckReportsForComponentWithPagination(t *testing.T) {
 
 	t.Run("Filter by status", func(t *testing.T) {
 		status := storage.CheckStatusPass
-		reports, total, err := repo.GetCheckReportsForComponentWithPagination(ctx, "pagination-test-service", &status, nil, nil, 10, 0, false)
+		reports, total, err := repo.GetCheckReportsForComponentWithPagination(ctx, "pagination-test-service", []storage.CheckStatus{status}, nil, nil, nil, 10, 0, false, storage.ReportOrder{})
 		require.NoError(t, err)
 		assert.Equal(t, int64(3), total) // 3 pass reports
 		assert.Len(t, reports, 3)
@@ -477,9 +601,31 @@ func TestRepository_GetCheckReportsForComponentWithPagination(t *testing.T) {
 		}
 	})
 
+	t.Run("Filter by multiple statuses", func(t *testing.T) {
+		statuses := []storage.CheckStatus{storage.CheckStatusFail, storage.CheckStatusError}
+		reports, total, err := repo.GetCheckReportsForComponentWithPagination(ctx, "pagination-test-service", statuses, nil, nil, nil, 10, 0, false, storage.ReportOrder{})
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), total)
+		require.Len(t, reports, 1)
+		assert.Equal(t, storage.CheckStatusFail, reports[0].Status)
+
+		statuses = []storage.CheckStatus{storage.CheckStatusPass, storage.CheckStatusFail}
+		_, total, err = repo.GetCheckReportsForComponentWithPagination(ctx, "pagination-test-service", statuses, nil, nil, nil, 10, 0, false, storage.ReportOrder{})
+		require.NoError(t, err)
+		assert.Equal(t, int64(4), total)
+
+		// Latest per check considers only reports matching any of the statuses
+		statuses = []storage.CheckStatus{storage.CheckStatusFail, storage.CheckStatusError}
+		reports, total, err = repo.GetCheckReportsForComponentWithPagination(ctx, "pagination-test-service", statuses, nil, nil, nil, 10, 0, true, storage.ReportOrder{})
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), total)
+		require.Len(t, reports, 1)
+		assert.Equal(t, unitTestsSlug, reports[0].Check.Slug)
+	})
+
 	t.Run("Filter by check slug", func(t *testing.T) {
 		checkSlug := unitTestsSlug
-		reports, total, err := repo.GetCheckReportsForComponentWithPagination(ctx, "pagination-test-service", nil, &checkSlug, nil, 10, 0, false)
+		reports, total, err := repo.GetCheckReportsForComponentWithPagination(ctx, "pagination-test-service", nil, []string{checkSlug}, nil, nil, 10, 0, false, storage.ReportOrder{})
 		require.NoError(t, err)
 		assert.Equal(t, int64(2), total) // 2 unit-tests reports
 		assert.Len(t, reports, 2)
@@ -490,7 +636,7 @@ func TestRepository_GetCheckReportsForComponentWithPagination(t *testing.T) {
 
 	t.Run("Filter by since timestamp", func(t *testing.T) {
 		since := now.Add(-45 * time.Minute)
-		reports, total, err := repo.GetCheckReportsForComponentWithPagination(ctx, "pagination-test-service", nil, nil, &since, 10, 0, false)
+		reports, total, err := repo.GetCheckReportsForComponentWithPagination(ctx, "pagination-test-service", nil, nil, &since, nil, 10, 0, false, storage.ReportOrder{})
 		require.NoError(t, err)
 		assert.Equal(t, int64(2), total) // 2 recent reports
 		assert.Len(t, reports, 2)
@@ -499,8 +645,44 @@ func TestRepository_GetCheckReportsForComponentWithPagination(t *testing.T) {
 		}
 	})
 
+	t.Run("Filter by before timestamp", func(t *testing.T) {
+		before := now.Add(-90 * time.Minute)
+		reports, total, err := repo.GetCheckReportsForComponentWithPagination(ctx, "pagination-test-service", nil, nil, nil, &before, 10, 0, false, storage.ReportOrder{})
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), total)
+		require.Len(t, reports, 1)
+		assert.Equal(t, storage.CheckStatusFail, reports[0].Status)
+	})
+
+	t.Run("Filter by time window", func(t *testing.T) {
+		since := now.Add(-90 * time.Minute)
+		before := now.Add(-20 * time.Minute)
+		reports, total, err := repo.GetCheckReportsForComponentWithPagination(ctx, "pagination-test-service", nil, nil, &since, &before, 10, 0, false, storage.ReportOrder{})
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), total)
+		for _, report := range reports {
+			assert.False(t, report.Timestamp.Before(since))
+			assert.True(t, report.Timestamp.Before(before))
+		}
+
+		reports, total, err = repo.GetCheckReportsForComponentWithPagination(ctx, "pagination-test-service", nil, nil, &since, &before, 10, 0, true, storage.ReportOrder{})
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), total)
+		assert.Len(t, reports, 2)
+	})
+
+	t.Run("Empty time window returns nothing", func(t *testing.T) {
+		bound := now.Add(-time.Hour)
+		for _, latestPerCheck := range []bool{false, true} {
+			reports, total, err := repo.GetCheckReportsForComponentWithPagination(ctx, "pagination-test-service", nil, nil, &bound, &bound, 10, 0, latestPerCheck, storage.ReportOrder{})
+			require.NoError(t, err)
+			assert.Equal(t, int64(0), total)
+			assert.Empty(t, reports)
+		}
+	})
+
 	t.Run("Latest per check without filters", func(t *testing.T) {
-		reports, total, err := repo.GetCheckReportsForComponentWithPagination(ctx, "pagination-test-service", nil, nil, nil, 10, 0, true)
+		reports, total, err := repo.GetCheckReportsForComponentWithPagination(ctx, "pagination-test-service", nil, nil, nil, nil, 10, 0, true, storage.ReportOrder{})
 		require.NoError(t, err)
 		assert.Equal(t, int64(3), total) // 3 unique checks
 		assert.Len(t, reports, 3)
@@ -518,7 +700,7 @@ func TestRepository_GetCheckReportsForComponentWithPagination(t *testing.T) {
 
 	t.Run("Latest per check with status filter", func(t *testing.T) {
 		status := storage.CheckStatusPass
-		reports, total, err := repo.GetCheckReportsForComponentWithPagination(ctx, "pagination-test-service", &status, nil, nil, 10, 0, true)
+		reports, total, err := repo.GetCheckReportsForComponentWithPagination(ctx, "pagination-test-service", []storage.CheckStatus{status}, nil, nil, nil, 10, 0, true, storage.ReportOrder{})
 		require.NoError(t, err)
 		assert.Equal(t, int64(3), total) // 3 unique checks with pass status
 		assert.Len(t, reports, 3)
@@ -529,7 +711,7 @@ func TestRepository_GetCheckReportsForComponentWithPagination(t *testing.T) {
 
 	t.Run("Latest per check with check slug filter", func(t *testing.T) {
 		checkSlug := unitTestsSlug
-		reports, total, err := repo.GetCheckReportsForComponentWithPagination(ctx, "pagination-test-service", nil, &checkSlug, nil, 10, 0, true)
+		reports, total, err := repo.GetCheckReportsForComponentWithPagination(ctx, "pagination-test-service", nil, []string{checkSlug}, nil, nil, 10, 0, true, storage.ReportOrder{})
 		require.NoError(t, err)
 		assert.Equal(t, int64(1), total) // 1 unique check
 		assert.Len(t, reports, 1)
@@ -537,14 +719,14 @@ func TestRepository_GetCheckReportsForComponentWithPagination(t *testing.T) {
 	})
 
 	t.Run("Latest per check with pagination", func(t *testing.T) {
-		reports, total, err := repo.GetCheckReportsForComponentWithPagination(ctx, "pagination-test-service", nil, nil, nil, 2, 0, true)
+		reports, total, err := repo.GetCheckReportsForComponentWithPagination(ctx, "pagination-test-service", nil, nil, nil, nil, 2, 0, true, storage.ReportOrder{})
 		require.NoError(t, err)
 		assert.Equal(t, int64(3), total) // 3 unique checks
 		assert.Len(t, reports, 2)        // limited by pagination
 	})
 
 	t.Run("Component not found", func(t *testing.T) {
-		_, _, err := repo.GetCheckReportsForComponentWithPagination(ctx, "non-existent-service", nil, nil, nil, 10, 0, false)
+		_, _, err := repo.GetCheckReportsForComponentWithPagination(ctx, "non-existent-service", nil, nil, nil, nil, 10, 0, false, storage.ReportOrder{})
 		assert.ErrorIs(t, err, storage.ErrComponentNotFound)
 	})
 
@@ -552,7 +734,7 @@ func TestRepository_GetCheckReportsForComponentWithPagination(t *testing.T) {
 		status := storage.CheckStatusPass
 		checkSlug := unitTestsSlug
 		since := now.Add(-90 * time.Minute)
-		reports, total, err := repo.GetCheckReportsForComponentWithPagination(ctx, "pagination-test-service", &status, &checkSlug, &since, 10, 0, true)
+		reports, total, err := repo.GetCheckReportsForComponentWithPagination(ctx, "pagination-test-service", []storage.CheckStatus{status}, []string{checkSlug}, &since, nil, 10, 0, true, storage.ReportOrder{})
 		require.NoError(t, err)
 		assert.Equal(t, int64(1), total) // 1 report matching all filters
 		assert.Len(t, reports, 1)
@@ -649,7 +831,7 @@ func TestRepository_CheckSlugFilterWithLatestPerCheck(t *testing.T) {
 
 	t.Run("Check slug filter with latest per check - Service A", func(t *testing.T) {
 		checkSlug := unitTestsSlug
-		reports, total, err := repo.GetCheckReportsForComponentWithPagination(ctx, "service-a", nil, &checkSlug, nil, 10, 0, true)
+		reports, total, err := repo.GetCheckReportsForComponentWithPagination(ctx, "service-a", nil, []string{checkSlug}, nil, nil, 10, 0, true, storage.ReportOrder{})
 		require.NoError(t, err)
 		assert.Equal(t, int64(1), total) // 1 latest report for unit-tests-filter in service-a
 		assert.Len(t, reports, 1)
@@ -661,7 +843,7 @@ func TestRepository_CheckSlugFilterWithLatestPerCheck(t *testing.T) {
 
 	t.Run("Check slug filter with latest per check - Service B", func(t *testing.T) {
 		checkSlug := unitTestsSlug
-		reports, total, err := repo.GetCheckReportsForComponentWithPagination(ctx, "service-b", nil, &checkSlug, nil, 10, 0, true)
+		reports, total, err := repo.GetCheckReportsForComponentWithPagination(ctx, "service-b", nil, []string{checkSlug}, nil, nil, 10, 0, true, storage.ReportOrder{})
 		require.NoError(t, err)
 		assert.Equal(t, int64(1), total) // 1 latest report for unit-tests-filter in service-b
 		assert.Len(t, reports, 1)
@@ -673,7 +855,7 @@ func TestRepository_CheckSlugFilterWithLatestPerCheck(t *testing.T) {
 
 	t.Run("Check slug filter with latest per check - Non-existent check", func(t *testing.T) {
 		checkSlug := "non-existent-check"
-		reports, total, err := repo.GetCheckReportsForComponentWithPagination(ctx, "service-a", nil, &checkSlug, nil, 10, 0, true)
+		reports, total, err := repo.GetCheckReportsForComponentWithPagination(ctx, "service-a", nil, []string{checkSlug}, nil, nil, 10, 0, true, storage.ReportOrder{})
 		require.NoError(t, err)
 		assert.Equal(t, int64(0), total) // No reports for non-existent check
 		assert.Len(t, reports, 0)
@@ -682,7 +864,7 @@ func TestRepository_CheckSlugFilterWithLatestPerCheck(t *testing.T) {
 	t.Run("Check slug filter with latest per check and status filter", func(t *testing.T) {
 		checkSlug := integrationSlug
 		status := storage.CheckStatusPass
-		reports, total, err := repo.GetCheckReportsForComponentWithPagination(ctx, "service-a", &status, &checkSlug, nil, 10, 0, true)
+		reports, total, err := repo.GetCheckReportsForComponentWithPagination(ctx, "service-a", []storage.CheckStatus{status}, []string{checkSlug}, nil, nil, 10, 0, true, storage.ReportOrder{})
 		require.NoError(t, err)
 		assert.Equal(t, int64(1), total) // 1 latest pass report for integration-tests-filter in service-a
 		assert.Len(t, reports, 1)
@@ -693,7 +875,7 @@ func TestRepository_CheckSlugFilterWithLatestPerCheck(t *testing.T) {
 	t.Run("Check slug filter with latest per check and since filter", func(t *testing.T) {
 		checkSlug := unitTestsSlug
 		since := now.Add(-90 * time.Minute) // Should include the pass report but not the fail report
-		reports, total, err := repo.GetCheckReportsForComponentWithPagination(ctx, "service-a", nil, &checkSlug, &since, 10, 0, true)
+		reports, total, err := repo.GetCheckReportsForComponentWithPagination(ctx, "service-a", nil, []string{checkSlug}, &since, nil, 10, 0, true, storage.ReportOrder{})
 		require.NoError(t, err)
 		assert.Equal(t, int64(1), total) // 1 latest report within time range
 		assert.Len(t, reports, 1)
@@ -703,14 +885,14 @@ func TestRepository_CheckSlugFilterWithLatestPerCheck(t *testing.T) {
 
 	t.Run("Check slug filter with latest per check and pagination", func(t *testing.T) {
 		// Get all reports for service-a with latest per check
-		reports, total, err := repo.GetCheckReportsForComponentWithPagination(ctx, "service-a", nil, nil, nil, 10, 0, true)
+		reports, total, err := repo.GetCheckReportsForComponentWithPagination(ctx, "service-a", nil, nil, nil, nil, 10, 0, true, storage.ReportOrder{})
 		require.NoError(t, err)
 		assert.Equal(t, int64(2), total) // 2 unique checks in service-a
 		assert.Len(t, reports, 2)
 
 		// Now filter by check slug with pagination
 		checkSlug := unitTestsSlug
-		filteredReports, filteredTotal, err := repo.GetCheckReportsForComponentWithPagination(ctx, "service-a", nil, &checkSlug, nil, 1, 0, true)
+		filteredReports, filteredTotal, err := repo.GetCheckReportsForComponentWithPagination(ctx, "service-a", nil, []string{checkSlug}, nil, nil, 1, 0, true, storage.ReportOrder{})
 		require.NoError(t, err)
 		assert.Equal(t, int64(1), filteredTotal) // 1 unique check
 		assert.Len(t, filteredReports, 1)
@@ -721,14 +903,14 @@ func TestRepository_CheckSlugFilterWithLatestPerCheck(t *testing.T) {
 		checkSlug := unitTestsSlug
 
 		// Get reports for service-a
-		reportsA, totalA, err := repo.GetCheckReportsForComponentWithPagination(ctx, "service-a", nil, &checkSlug, nil, 10, 0, true)
+		reportsA, totalA, err := repo.GetCheckReportsForComponentWithPagination(ctx, "service-a", nil, []string{checkSlug}, nil, nil, 10, 0, true, storage.ReportOrder{})
 		require.NoError(t, err)
 		assert.Equal(t, int64(1), totalA)
 		assert.Len(t, reportsA, 1)
 		assert.Equal(t, "service-a", reportsA[0].Component.ComponentID)
 
 		// Get reports for service-b
-		reportsB, totalB, err := repo.GetCheckReportsForComponentWithPagination(ctx, "service-b", nil, &checkSlug, nil, 10, 0, true)
+		reportsB, totalB, err := repo.GetCheckReportsForComponentWithPagination(ctx, "service-b", nil, []string{checkSlug}, nil, nil, 10, 0, true, storage.ReportOrder{})
 		require.NoError(t, err)
 		assert.Equal(t, int64(1), totalB)
 		assert.Len(t, reportsB, 1)
@@ -772,7 +954,7 @@ func TestRepository_ApplyLatestPerCheckFilters(t *testing.T) {
 
 	// Test filtering through the public interface
 	t.Run("No filters", func(t *testing.T) {
-		reports, total, err := repo.GetCheckReportsForComponentWithPagination(ctx, "filter-test-service", nil, nil, nil, 10, 0, false)
+		reports, total, err := repo.GetCheckReportsForComponentWithPagination(ctx, "filter-test-service", nil, nil, nil, nil, 10, 0, false, storage.ReportOrder{})
 		require.NoError(t, err)
 		assert.Equal(t, int64(1), total)
 		assert.Len(t, reports, 1)
@@ -780,7 +962,7 @@ func TestRepository_ApplyLatestPerCheckFilters(t *testing.T) {
 
 	t.Run("Status filter", func(t *testing.T) {
 		status := storage.CheckStatusPass
-		reports, total, err := repo.GetCheckReportsForComponentWithPagination(ctx, "filter-test-service", &status, nil, nil, 10, 0, false)
+		reports, total, err := repo.GetCheckReportsForComponentWithPagination(ctx, "filter-test-service", []storage.CheckStatus{status}, nil, nil, nil, 10, 0, false, storage.ReportOrder{})
 		require.NoError(t, err)
 		assert.Equal(t, int64(1), total)
 		assert.Len(t, reports, 1)
@@ -789,7 +971,7 @@ func TestRepository_ApplyLatestPerCheckFilters(t *testing.T) {
 
 	t.Run("Status filter no match", func(t *testing.T) {
 		status := storage.CheckStatusFail
-		reports, total, err := repo.GetCheckReportsForComponentWithPagination(ctx, "filter-test-service", &status, nil, nil, 10, 0, false)
+		reports, total, err := repo.GetCheckReportsForComponentWithPagination(ctx, "filter-test-service", []storage.CheckStatus{status}, nil, nil, nil, 10, 0, false, storage.ReportOrder{})
 		require.NoError(t, err)
 		assert.Equal(t, int64(0), total)
 		assert.Len(t, reports, 0)
@@ -797,7 +979,7 @@ func TestRepository_ApplyLatestPerCheckFilters(t *testing.T) {
 
 	t.Run("Check slug filter", func(t *testing.T) {
 		checkSlug := "test-check"
-		reports, total, err := repo.GetCheckReportsForComponentWithPagination(ctx, "filter-test-service", nil, &checkSlug, nil, 10, 0, false)
+		reports, total, err := repo.GetCheckReportsForComponentWithPagination(ctx, "filter-test-service", nil, []string{checkSlug}, nil, nil, 10, 0, false, storage.ReportOrder{})
 		require.NoError(t, err)
 		assert.Equal(t, int64(1), total)
 		assert.Len(t, reports, 1)
@@ -806,7 +988,7 @@ func TestRepository_ApplyLatestPerCheckFilters(t *testing.T) {
 
 	t.Run("Check slug filter no match", func(t *testing.T) {
 		checkSlug := "non-existent-check"
-		reports, total, err := repo.GetCheckReportsForComponentWithPagination(ctx, "filter-test-service", nil, &checkSlug, nil, 10, 0, false)
+		reports, total, err := repo.GetCheckReportsForComponentWithPagination(ctx, "filter-test-service", nil, []string{checkSlug}, nil, nil, 10, 0, false, storage.ReportOrder{})
 		require.NoError(t, err)
 		assert.Equal(t, int64(0), total)
 		assert.Len(t, reports, 0)
@@ -814,7 +996,7 @@ func TestRepository_ApplyLatestPerCheckFilters(t *testing.T) {
 
 	t.Run("Since filter", func(t *testing.T) {
 		since := time.Now().Add(-1 * time.Hour)
-		reports, total, err := repo.GetCheckReportsForComponentWithPagination(ctx, "filter-test-service", nil, nil, &since, 10, 0, false)
+		reports, total, err := repo.GetCheckReportsForComponentWithPagination(ctx, "filter-test-service", nil, nil, &since, nil, 10, 0, false, storage.ReportOrder{})
 		require.NoError(t, err)
 		assert.Equal(t, int64(1), total)
 		assert.Len(t, reports, 1)
@@ -822,7 +1004,7 @@ func TestRepository_ApplyLatestPerCheckFilters(t *testing.T) {
 
 	t.Run("Since filter no match", func(t *testing.T) {
 		since := time.Now().Add(1 * time.Hour) // Future time
-		reports, total, err := repo.GetCheckReportsForComponentWithPagination(ctx, "filter-test-service", nil, nil, &since, 10, 0, false)
+		reports, total, err := repo.GetCheckReportsForComponentWithPagination(ctx, "filter-test-service", nil, nil, &since, nil, 10, 0, false, storage.ReportOrder{})
 		require.NoError(t, err)
 		assert.Equal(t, int64(0), total)
 		assert.Len(t, reports, 0)
@@ -832,7 +1014,7 @@ func TestRepository_ApplyLatestPerCheckFilters(t *testing.T) {
 		status := storage.CheckStatusPass
 		checkSlug := "test-check"
 		since := time.Now().Add(-1 * time.Hour)
-		reports, total, err := repo.GetCheckReportsForComponentWithPagination(ctx, "filter-test-service", &status, &checkSlug, &since, 10, 0, false)
+		reports, total, err := repo.GetCheckReportsForComponentWithPagination(ctx, "filter-test-service", []storage.CheckStatus{status}, []string{checkSlug}, &since, nil, 10, 0, false, storage.ReportOrder{})
 		require.NoError(t, err)
 		assert.Equal(t, int64(1), total)
 		assert.Len(t, reports, 1)
@@ -840,3 +1022,1784 @@ func TestRepository_ApplyLatestPerCheckFilters(t *testing.T) {
 		assert.Equal(t, "test-check", reports[0].Check.Slug)
 	})
 }
+
+func TestRepository_ReceivedTimeFilters(t *testing.T) {
+	// Use an isolated database so reports from other tests don't leak into the cross-component queries
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	require.NoError(t, err)
+	sqlDB, err := db.DB()
+	require.NoError(t, err)
+	sqlDB.SetMaxOpenConns(1)
+	repo := &storage.Repository{DB: db}
+	ctx := t.Context()
+	require.NoError(t, repo.Migrate(ctx))
+
+	component := storage.Component{ComponentID: "received-test-service", Name: "Received Test Service"}
+	require.NoError(t, repo.DB.Create(&component).Error)
+	check := storage.Check{Slug: "received-test-check", Name: "Received Test Check"}
+	require.NoError(t, repo.DB.Create(&check).Error)
+
+	now := time.Now().UTC().Truncate(time.Second)
+	receivedTimes := []time.Time{
+		now.Add(-72 * time.Hour),
+		now.Add(-48 * time.Hour),
+		now.Add(-2 * time.Hour),
+		now.Add(-1 * time.Hour),
+	}
+	for _, receivedAt := range receivedTimes {
+		report := storage.CheckReport{
+			CheckID:     check.ID,
+			ComponentID: component.ID,
+			Status:      storage.CheckStatusPass,
+			Timestamp:   now.Add(-96 * time.Hour), // Execution time is deliberately unrelated to receive time
+			CreatedAt:   receivedAt,
+		}
+		require.NoError(t, repo.DB.Create(&report).Error)
+	}
+
+	t.Run("No filters returns newest first", func(t *testing.T) {
+		reports, total, err := repo.GetReportsByReceivedTime(ctx, nil, nil, 10, 0)
+		require.NoError(t, err)
+		assert.Equal(t, int64(4), total)
+		require.Len(t, reports, 4)
+		assert.True(t, reports[0].CreatedAt.Equal(receivedTimes[3]))
+		assert.True(t, reports[3].CreatedAt.Equal(receivedTimes[0]))
+		assert.Equal(t, "received-test-check", reports[0].Check.Slug)
+		assert.Equal(t, "received-test-service", reports[0].Component.ComponentID)
+	})
+
+	t.Run("Received after is inclusive", func(t *testing.T) {
+		receivedAfter := receivedTimes[2]
+		reports, total, err := repo.GetReportsByReceivedTime(ctx, &receivedAfter, nil, 10, 0)
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), total)
+		assert.Len(t, reports, 2)
+	})
+
+	t.Run("Received before is exclusive", func(t *testing.T) {
+		receivedBefore := receivedTimes[1]
+		reports, total, err := repo.GetReportsByReceivedTime(ctx, nil, &receivedBefore, 10, 0)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), total)
+		require.Len(t, reports, 1)
+		assert.True(t, reports[0].CreatedAt.Equal(receivedTimes[0]))
+	})
+
+	t.Run("Combined window with pagination", func(t *testing.T) {
+		receivedAfter := now.Add(-50 * time.Hour)
+		receivedBefore := now
+		reports, total, err := repo.GetReportsByReceivedTime(ctx, &receivedAfter, &receivedBefore, 2, 1)
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), total)
+		require.Len(t, reports, 2)
+		assert.True(t, reports[0].CreatedAt.Equal(receivedTimes[2]))
+		assert.True(t, reports[1].CreatedAt.Equal(receivedTimes[1]))
+	})
+
+	t.Run("Pruning uses the same received before filter", func(t *testing.T) {
+		cutoff := now.Add(-24 * time.Hour)
+		deleted, err := repo.PruneReportsOlderThan(ctx, cutoff)
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), deleted)
+
+		reports, total, err := repo.GetReportsByReceivedTime(ctx, nil, nil, 10, 0)
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), total)
+		for _, report := range reports {
+			assert.False(t, report.CreatedAt.Before(cutoff))
+		}
+	})
+}
+
+func TestRepository_CaseInsensitiveIDs(t *testing.T) {
+	repo := setupIsolatedRepo(t)
+	ctx := t.Context()
+
+	// Case is preserved by default
+	require.NoError(t, repo.CreateComponent(ctx, storage.Component{ComponentID: "Mixed-Case", Name: "Mixed Case"}))
+	_, err := repo.GetComponentByID(ctx, "mixed-case")
+	assert.ErrorIs(t, err, storage.ErrComponentNotFound)
+	_, err = repo.GetComponentByID(ctx, "Mixed-Case ")
+	assert.NoError(t, err)
+
+	// With case folding, stored and looked-up identifiers are lowercased
+	repo.CaseInsensitiveIDs = true
+	require.NoError(t, repo.CreateComponent(ctx, storage.Component{ComponentID: "Folded-Service/", Name: "Folded Service"}))
+	component, err := repo.GetComponentByID(ctx, "FOLDED-SERVICE")
+	require.NoError(t, err)
+	assert.Equal(t, "folded-service", component.ComponentID)
+}
+
+func TestRepository_GetChecksWithUsage(t *testing.T) {
+	repo := setupIsolatedRepo(t)
+	ctx := t.Context()
+
+	componentA := storage.Component{ComponentID: "usage-a", Name: "Usage A"}
+	componentB := storage.Component{ComponentID: "usage-b", Name: "Usage B"}
+	require.NoError(t, repo.DB.Create(&componentA).Error)
+	require.NoError(t, repo.DB.Create(&componentB).Error)
+
+	busy := storage.Check{Slug: "busy", Name: "Busy"}
+	rare := storage.Check{Slug: "rare", Name: "Rare"}
+	orphan := storage.Check{Slug: "orphan-typo", Name: "Orphan Typo"}
+	for _, check := range []*storage.Check{&busy, &rare, &orphan} {
+		require.NoError(t, repo.DB.Create(check).Error)
+	}
+
+	now := time.Now()
+	for _, r := range []struct {
+		check     storage.Check
+		component storage.Component
+	}{
+		{busy, componentA}, {busy, componentA}, {busy, componentB}, {rare, componentB},
+	} {
+		report := storage.CheckReport{CheckID: r.check.ID, ComponentID: r.component.ID, Status: storage.CheckStatusPass, Timestamp: now}
+		require.NoError(t, repo.DB.Create(&report).Error)
+	}
+
+	int64Ptr := func(v int64) *int64 { return &v }
+
+	t.Run("all checks", func(t *testing.T) {
+		checks, total, err := repo.GetChecksWithUsage(ctx, storage.CheckUsageFilter{}, 50, 0)
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), total)
+		require.Len(t, checks, 3)
+
+		assert.Equal(t, "busy", checks[0].Slug)
+		assert.Equal(t, "Busy", checks[0].Name)
+		assert.Equal(t, busy.ID, checks[0].ID)
+		assert.Equal(t, int64(3), checks[0].ReportCount)
+		assert.Equal(t, int64(2), checks[0].ComponentCount)
+
+		assert.Equal(t, "orphan-typo", checks[1].Slug)
+		assert.Equal(t, int64(0), checks[1].ReportCount)
+		assert.Equal(t, int64(0), checks[1].ComponentCount)
+
+		assert.Equal(t, "rare", checks[2].Slug)
+		assert.Equal(t, int64(1), checks[2].ReportCount)
+	})
+
+	t.Run("unused checks only with max_reports=0", func(t *testing.T) {
+		checks, total, err := repo.GetChecksWithUsage(ctx, storage.CheckUsageFilter{MaxReports: int64Ptr(0)}, 50, 0)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), total)
+		require.Len(t, checks, 1)
+		assert.Equal(t, "orphan-typo", checks[0].Slug)
+	})
+
+	t.Run("min and max together", func(t *testing.T) {
+		checks, total, err := repo.GetChecksWithUsage(ctx, storage.CheckUsageFilter{MinReports: int64Ptr(1), MaxReports: int64Ptr(2)}, 50, 0)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), total)
+		require.Len(t, checks, 1)
+		assert.Equal(t, "rare", checks[0].Slug)
+	})
+
+	t.Run("pagination", func(t *testing.T) {
+		checks, total, err := repo.GetChecksWithUsage(ctx, storage.CheckUsageFilter{}, 1, 1)
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), total)
+		require.Len(t, checks, 1)
+		assert.Equal(t, "orphan-typo", checks[0].Slug)
+	})
+}
+
+func TestRepository_ComponentHistory(t *testing.T) {
+	repo := setupTestRepo(t)
+	ctx := t.Context()
+
+	before := storage.Component{
+		ComponentID: "history-test-service",
+		Name:        "History Test Service",
+		Description: "Original description",
+		Maintainers: storage.StringArray{"alice@company.com"},
+		Team:        "Platform",
+		Labels:      storage.StringMap{"tier": "critical"},
+	}
+	require.NoError(t, repo.CreateComponent(ctx, before))
+	stored, err := repo.GetComponentByID(ctx, before.ComponentID)
+	require.NoError(t, err)
+
+	t.Run("Diff captures changed fields only", func(t *testing.T) {
+		after := *stored
+		after.Description = "Updated description"
+		after.Maintainers = storage.StringArray{"alice@company.com", "bob@company.com"}
+
+		changes := storage.DiffComponents(*stored, after)
+		assert.Equal(t, storage.JSONB{
+			"description": map[string]interface{}{"old": "Original description", "new": "Updated description"},
+			"maintainers": map[string]interface{}{
+				"old": []string{"alice@company.com"},
+				"new": []string{"alice@company.com", "bob@company.com"},
+			},
+		}, changes)
+
+		assert.Empty(t, storage.DiffComponents(*stored, *stored))
+	})
+
+	t.Run("Update produces a history entry with the deltas", func(t *testing.T) {
+		after := *stored
+		after.Team = "Identity"
+		err := repo.CreateComponentHistory(ctx, storage.ComponentHistory{
+			ComponentID: stored.ID,
+			Changes:     storage.DiffComponents(*stored, after),
+			Source:      "git:https://github.com/org/repo",
+			ChangedAt:   time.Now().Add(-time.Hour),
+		})
+		require.NoError(t, err)
+
+		after.Description = "Updated description"
+		err = repo.CreateComponentHistory(ctx, storage.ComponentHistory{
+			ComponentID: stored.ID,
+			Changes:     storage.DiffComponents(*stored, after),
+			Source:      "git:https://github.com/org/repo",
+		})
+		require.NoError(t, err)
+
+		entries, total, err := repo.GetComponentHistory(ctx, before.ComponentID, nil, nil, 10, 0)
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), total)
+		require.Len(t, entries, 2)
+
+		// Newest first
+		assert.Equal(t, storage.StringArray{"description", "team"}, entries[0].ChangedFields)
+		assert.Equal(t, storage.StringArray{"team"}, entries[1].ChangedFields)
+		assert.Equal(t, "git:https://github.com/org/repo", entries[1].Source)
+		assert.Equal(t, map[string]interface{}{"old": "Platform", "new": "Identity"}, entries[1].Changes["team"])
+	})
+
+	t.Run("Filter by field and since", func(t *testing.T) {
+		field := "description"
+		entries, total, err := repo.GetComponentHistory(ctx, before.ComponentID, &field, nil, 10, 0)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), total)
+		require.Len(t, entries, 1)
+		assert.Contains(t, entries[0].Changes, "description")
+
+		since := time.Now().Add(-time.Minute)
+		_, total, err = repo.GetComponentHistory(ctx, before.ComponentID, nil, &since, 10, 0)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), total)
+	})
+
+	t.Run("Pagination", func(t *testing.T) {
+		entries, total, err := repo.GetComponentHistory(ctx, before.ComponentID, nil, nil, 1, 1)
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), total)
+		require.Len(t, entries, 1)
+		assert.Equal(t, storage.StringArray{"team"}, entries[0].ChangedFields)
+	})
+
+	t.Run("Unknown component", func(t *testing.T) {
+		_, _, err := repo.GetComponentHistory(ctx, "no-such-component", nil, nil, 10, 0)
+		assert.ErrorIs(t, err, storage.ErrComponentNotFound)
+	})
+}
+
+func TestRepository_UpdateComponent(t *testing.T) {
+	repo := setupTestRepo(t)
+	ctx := t.Context()
+
+	require.NoError(t, repo.CreateComponent(ctx, storage.Component{
+		ComponentID: "update-test-service",
+		Name:        "Update Test Service",
+		Description: "Original description",
+		Maintainers: storage.StringArray{"alice@company.com"},
+		Team:        "Platform",
+	}))
+	original, err := repo.GetComponentByID(ctx, "update-test-service")
+	require.NoError(t, err)
+
+	t.Run("Unchanged component keeps updated_at", func(t *testing.T) {
+		require.NoError(t, repo.UpdateComponent(ctx, *original))
+
+		stored, err := repo.GetComponentByID(ctx, "update-test-service")
+		require.NoError(t, err)
+		assert.True(t, original.UpdatedAt.Equal(stored.UpdatedAt))
+	})
+
+	t.Run("Changed fields are written and the ID is preserved", func(t *testing.T) {
+		update := storage.Component{
+			ComponentID: "update-test-service",
+			Name:        "Update Test Service",
+			Description: "New description",
+			Maintainers: storage.StringArray{"bob@company.com"},
+			Team:        "Identity",
+		}
+		require.NoError(t, repo.UpdateComponent(ctx, update))
+
+		stored, err := repo.GetComponentByID(ctx, "update-test-service")
+		require.NoError(t, err)
+		assert.Equal(t, original.ID, stored.ID)
+		assert.Equal(t, "New description", stored.Description)
+		assert.Equal(t, "Identity", stored.Team)
+		assert.Equal(t, storage.StringArray{"bob@company.com"}, stored.Maintainers)
+		assert.False(t, stored.UpdatedAt.Before(original.UpdatedAt))
+	})
+
+	t.Run("Managed maintainers are not overwritten", func(t *testing.T) {
+		_, err := repo.AddComponentMaintainer(ctx, "update-test-service", "carol@company.com")
+		require.NoError(t, err)
+
+		require.NoError(t, repo.UpdateComponent(ctx, storage.Component{
+			ComponentID: "update-test-service",
+			Name:        "Update Test Service",
+			Maintainers: storage.StringArray{"dave@company.com"},
+		}))
+
+		stored, err := repo.GetComponentByID(ctx, "update-test-service")
+		require.NoError(t, err)
+		assert.Equal(t, storage.StringArray{"bob@company.com", "carol@company.com"}, stored.Maintainers)
+		assert.Empty(t, stored.Description)
+	})
+
+	t.Run("Unknown component", func(t *testing.T) {
+		err := repo.UpdateComponent(ctx, storage.Component{ComponentID: "no-such-component", Name: "Missing"})
+		assert.ErrorIs(t, err, storage.ErrComponentNotFound)
+	})
+}
+
+func TestRepository_PruneSourceComponents(t *testing.T) {
+	repo := setupIsolatedRepo(t)
+	ctx := t.Context()
+
+	const sourceA = "git:https://github.com/org/a@main"
+	const sourceB = "filesystem:/manifests"
+	for _, component := range []storage.Component{
+		{ComponentID: "kept-service", Name: "Kept", Sources: storage.StringArray{sourceA}},
+		{ComponentID: "shared-service", Name: "Shared", Sources: storage.StringArray{sourceA, sourceB}},
+		{ComponentID: "removed-service", Name: "Removed", Sources: storage.StringArray{sourceA}},
+		{ComponentID: "legacy-service", Name: "Legacy"},
+	} {
+		require.NoError(t, repo.CreateComponent(ctx, component))
+	}
+	removed, err := repo.GetComponentByID(ctx, "removed-service")
+	require.NoError(t, err)
+
+	t.Run("Soft-deletes components no source provides anymore", func(t *testing.T) {
+		deleted, err := repo.PruneSourceComponents(ctx, sourceA, []string{"kept-service"}, false)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"removed-service"}, deleted)
+
+		_, err = repo.GetComponentByID(ctx, "removed-service")
+		assert.ErrorIs(t, err, storage.ErrComponentNotFound)
+
+		// A component still provided by another source is only detached
+		shared, err := repo.GetComponentByID(ctx, "shared-service")
+		require.NoError(t, err)
+		assert.Equal(t, storage.StringArray{sourceB}, shared.Sources)
+
+		// Components without recorded sources are never pruned
+		_, err = repo.GetComponentByID(ctx, "legacy-service")
+		assert.NoError(t, err)
+	})
+
+	t.Run("Recreating a soft-deleted component restores it", func(t *testing.T) {
+		require.NoError(t, repo.CreateComponent(ctx, storage.Component{
+			ComponentID: "removed-service",
+			Name:        "Removed Again",
+			Sources:     storage.StringArray{sourceA},
+		}))
+
+		restored, err := repo.GetComponentByID(ctx, "removed-service")
+		require.NoError(t, err)
+		assert.Equal(t, removed.ID, restored.ID)
+		assert.Equal(t, "Removed Again", restored.Name)
+	})
+
+	t.Run("Hard delete removes reports and history", func(t *testing.T) {
+		_, err := repo.CreateCheckReportFromSubmission(ctx, storage.CreateCheckReportInput{
+			ComponentID: "removed-service",
+			CheckSlug:   "unit-tests",
+			Status:      storage.CheckStatusPass,
+			Timestamp:   time.Now(),
+		})
+		require.NoError(t, err)
+		require.NoError(t, repo.CreateComponentHistory(ctx, storage.ComponentHistory{
+			ComponentID: removed.ID,
+			Changes:     storage.JSONB{"name": map[string]interface{}{"old": "Removed", "new": "Removed Again"}},
+		}))
+
+		deleted, err := repo.PruneSourceComponents(ctx, sourceA, []string{"kept-service"}, true)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"removed-service"}, deleted)
+
+		var count int64
+		require.NoError(t, repo.DB.Unscoped().Model(&storage.Component{}).Where("id = ?", removed.ID).Count(&count).Error)
+		assert.Equal(t, int64(0), count)
+		require.NoError(t, repo.DB.Model(&storage.CheckReport{}).Where("component_id = ?", removed.ID).Count(&count).Error)
+		assert.Equal(t, int64(0), count)
+		require.NoError(t, repo.DB.Model(&storage.ComponentHistory{}).Where("component_id = ?", removed.ID).Count(&count).Error)
+		assert.Equal(t, int64(0), count)
+	})
+
+	t.Run("AddComponentSource is idempotent", func(t *testing.T) {
+		require.NoError(t, repo.AddComponentSource(ctx, "kept-service", sourceB))
+		require.NoError(t, repo.AddComponentSource(ctx, "kept-service", sourceB))
+
+		kept, err := repo.GetComponentByID(ctx, "kept-service")
+		require.NoError(t, err)
+		assert.Equal(t, storage.StringArray{sourceA, sourceB}, kept.Sources)
+	})
+}
+
+func TestRepository_ListChecks(t *testing.T) {
+	repo := setupIsolatedRepo(t)
+	ctx := t.Context()
+
+	require.NoError(t, repo.CreateComponent(ctx, storage.Component{ComponentID: "list-checks-service", Name: "List Checks Service"}))
+	require.NoError(t, repo.CreateComponent(ctx, storage.Component{ComponentID: "other-service", Name: "Other Service"}))
+	require.NoError(t, repo.CreateCheck(ctx, storage.Check{Slug: "lint", Name: "Lint"}))
+	for _, input := range []storage.CreateCheckReportInput{
+		{ComponentID: "list-checks-service", CheckSlug: "unit-tests"},
+		{ComponentID: "list-checks-service", CheckSlug: "unit-tests"},
+		{ComponentID: "other-service", CheckSlug: "security-scan"},
+	} {
+		input.Status = storage.CheckStatusPass
+		input.Timestamp = time.Now()
+		_, err := repo.CreateCheckReportFromSubmission(ctx, input)
+		require.NoError(t, err)
+	}
+
+	slugs := func(checks []storage.Check) []string {
+		result := make([]string, len(checks))
+		for i, check := range checks {
+			result[i] = check.Slug
+		}
+		return result
+	}
+
+	checks, err := repo.ListChecks(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"lint", "security-scan", "unit-tests"}, slugs(checks))
+
+	checks, err = repo.ListChecksForComponent(ctx, "list-checks-service")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"unit-tests"}, slugs(checks))
+
+	_, err = repo.ListChecksForComponent(ctx, "no-such-component")
+	assert.ErrorIs(t, err, storage.ErrComponentNotFound)
+}
+
+func TestRepository_GetCheckReportsForComponentByCursor(t *testing.T) {
+	repo := setupIsolatedRepo(t)
+	ctx := t.Context()
+
+	component := storage.Component{ComponentID: "cursor-service", Name: "Cursor Service"}
+	require.NoError(t, repo.DB.Create(&component).Error)
+	check := storage.Check{Slug: "cursor-check", Name: "Cursor Check"}
+	require.NoError(t, repo.DB.Create(&check).Error)
+
+	// Four reports share each timestamp, so the id tie-breaker decides page boundaries
+	now := time.Now().UTC().Truncate(time.Second)
+	for i := 0; i < 200; i++ {
+		report := storage.CheckReport{CheckID: check.ID, ComponentID: component.ID, Status: storage.CheckStatusPass, Timestamp: now.Add(-time.Duration(i/4) * time.Minute)}
+		require.NoError(t, repo.DB.Create(&report).Error)
+	}
+
+	seen := make(map[uuid.UUID]bool)
+	var previous *storage.CheckReport
+	var cursor *storage.ReportCursor
+	pages := 0
+	for {
+		reports, total, next, err := repo.GetCheckReportsForComponentByCursor(ctx, "cursor-service", nil, nil, nil, nil, cursor, 30)
+		require.NoError(t, err)
+		assert.Equal(t, int64(200), total)
+		pages++
+
+		for i := range reports {
+			report := reports[i]
+			assert.False(t, seen[report.ID], "report %s returned twice", report.ID)
+			seen[report.ID] = true
+			if previous != nil {
+				assert.False(t, report.Timestamp.After(previous.Timestamp), "reports must be newest first")
+			}
+			previous = &report
+		}
+
+		if next == nil {
+			break
+		}
+		assert.Len(t, reports, 30)
+		cursor = next
+	}
+
+	assert.Len(t, seen, 200)
+	assert.Equal(t, 7, pages)
+
+	t.Run("OffsetPagesMatchCursorPages", func(t *testing.T) {
+		byCursor, _, next, err := repo.GetCheckReportsForComponentByCursor(ctx, "cursor-service", nil, nil, nil, nil, nil, 30)
+		require.NoError(t, err)
+		require.NotNil(t, next)
+		byOffset, _, err := repo.GetCheckReportsForComponentWithPagination(ctx, "cursor-service", nil, nil, nil, nil, 30, 0, false, storage.ReportOrder{})
+		require.NoError(t, err)
+		assert.Equal(t, storage.CursorFor(byOffset[len(byOffset)-1]), *next)
+		for i := range byCursor {
+			assert.Equal(t, byOffset[i].ID, byCursor[i].ID)
+		}
+	})
+
+	t.Run("UnknownComponent", func(t *testing.T) {
+		_, _, _, err := repo.GetCheckReportsForComponentByCursor(ctx, "no-such-component", nil, nil, nil, nil, nil, 30)
+		assert.ErrorIs(t, err, storage.ErrComponentNotFound)
+	})
+}
+
+func TestRepository_GetCheckReportsForComponentWithPagination_Order(t *testing.T) {
+	repo := setupIsolatedRepo(t)
+	ctx := t.Context()
+
+	component := storage.Component{ComponentID: "order-service", Name: "Order Service"}
+	require.NoError(t, repo.DB.Create(&component).Error)
+
+	// Each check's history, oldest first, one hour apart across checks
+	now := time.Now().UTC().Truncate(time.Second)
+	histories := []struct {
+		slug     string
+		statuses []storage.CheckStatus
+	}{
+		{"order-unit", []storage.CheckStatus{storage.CheckStatusFail, storage.CheckStatusPass}},
+		{"order-lint", []storage.CheckStatus{storage.CheckStatusPass, storage.CheckStatusError}},
+		{"order-build", []storage.CheckStatus{storage.CheckStatusPass, storage.CheckStatusFail}},
+	}
+	age := 0
+	for _, history := range histories {
+		check := storage.Check{Slug: history.slug, Name: history.slug}
+		require.NoError(t, repo.DB.Create(&check).Error)
+		for _, status := range history.statuses {
+			age++
+			report := storage.CheckReport{CheckID: check.ID, ComponentID: component.ID, Status: status, Timestamp: now.Add(-time.Duration(10-age) * time.Hour)}
+			require.NoError(t, repo.DB.Create(&report).Error)
+		}
+	}
+
+	describe := func(reports []storage.CheckReport) []string {
+		var described []string
+		for _, report := range reports {
+			described = append(described, report.Check.Slug+":"+string(report.Status))
+		}
+		return described
+	}
+	list := func(latestPerCheck bool, order storage.ReportOrder) []string {
+		reports, _, err := repo.GetCheckReportsForComponentWithPagination(ctx, "order-service", nil, nil, nil, nil, 10, 0, latestPerCheck, order)
+		require.NoError(t, err)
+		return describe(reports)
+	}
+
+	t.Run("TimestampDescendingByDefault", func(t *testing.T) {
+		assert.Equal(t, []string{
+			"order-build:fail", "order-build:pass", "order-lint:error", "order-lint:pass", "order-unit:pass", "order-unit:fail",
+		}, list(false, storage.ReportOrder{}))
+	})
+
+	t.Run("TimestampAscending", func(t *testing.T) {
+		assert.Equal(t, []string{
+			"order-unit:fail", "order-unit:pass", "order-lint:pass", "order-lint:error", "order-build:pass", "order-build:fail",
+		}, list(false, storage.ReportOrder{Field: storage.ReportSortTimestamp, Ascending: true}))
+	})
+
+	t.Run("StatusAscendingNewestFirstWithinStatus", func(t *testing.T) {
+		assert.Equal(t, []string{
+			"order-lint:error", "order-build:fail", "order-unit:fail", "order-build:pass", "order-lint:pass", "order-unit:pass",
+		}, list(false, storage.ReportOrder{Field: storage.ReportSortStatus, Ascending: true}))
+	})
+
+	t.Run("LatestPerCheckSortsLatestReports", func(t *testing.T) {
+		assert.Equal(t, []string{"order-unit:pass", "order-lint:error", "order-build:fail"},
+			list(true, storage.ReportOrder{Field: storage.ReportSortTimestamp, Ascending: true}))
+		assert.Equal(t, []string{"order-unit:pass", "order-build:fail", "order-lint:error"},
+			list(true, storage.ReportOrder{Field: storage.ReportSortStatus}))
+	})
+}
+
+func TestRepository_DeleteCheckReports(t *testing.T) {
+	ctx := t.Context()
+
+	// Two components report on the same check so scoping by component is what protects the other's rows
+	seed := func(t *testing.T) (*storage.Repository, storage.Check, storage.Check, time.Time) {
+		repo := setupIsolatedRepo(t)
+		lint := storage.Check{Slug: "lint", Name: "Lint"}
+		require.NoError(t, repo.DB.Create(&lint).Error)
+		tests := storage.Check{Slug: "tests", Name: "Tests"}
+		require.NoError(t, repo.DB.Create(&tests).Error)
+
+		now := time.Now().UTC().Truncate(time.Second)
+		for _, componentID := range []string{"delete-a", "delete-b"} {
+			component := storage.Component{ComponentID: componentID, Name: componentID}
+			require.NoError(t, repo.DB.Create(&component).Error)
+			for _, check := range []storage.Check{lint, tests} {
+				for i := 0; i < 3; i++ {
+					report := storage.CheckReport{CheckID: check.ID, ComponentID: component.ID, Status: storage.CheckStatusPass, Timestamp: now.Add(-time.Duration(i) * time.Hour)}
+					require.NoError(t, repo.DB.Create(&report).Error)
+				}
+			}
+		}
+		return repo, lint, tests, now
+	}
+
+	countReports := func(t *testing.T, repo *storage.Repository, componentID string) int64 {
+		_, total, err := repo.GetCheckReportsForComponentWithPagination(ctx, componentID, nil, nil, nil, nil, 100, 0, false, storage.ReportOrder{})
+		require.NoError(t, err)
+		return total
+	}
+
+	t.Run("AllReports", func(t *testing.T) {
+		repo, _, _, _ := seed(t)
+		deleted, err := repo.DeleteCheckReports(ctx, "delete-a", storage.CheckReportDeleteFilters{})
+		require.NoError(t, err)
+		assert.Equal(t, int64(6), deleted)
+		assert.Equal(t, int64(0), countReports(t, repo, "delete-a"))
+		assert.Equal(t, int64(6), countReports(t, repo, "delete-b"))
+	})
+
+	t.Run("ByCheckSlug", func(t *testing.T) {
+		repo, lint, tests, _ := seed(t)
+		deleted, err := repo.DeleteCheckReports(ctx, "delete-a", storage.CheckReportDeleteFilters{CheckSlug: &lint.Slug})
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), deleted)
+
+		remaining, _, err := repo.GetCheckReportsForComponentWithPagination(ctx, "delete-a", nil, nil, nil, nil, 100, 0, false, storage.ReportOrder{})
+		require.NoError(t, err)
+		require.Len(t, remaining, 3)
+		for _, report := range remaining {
+			assert.Equal(t, tests.ID, report.CheckID)
+		}
+		assert.Equal(t, int64(6), countReports(t, repo, "delete-b"))
+	})
+
+	t.Run("Before", func(t *testing.T) {
+		repo, _, _, now := seed(t)
+		cutoff := now.Add(-30 * time.Minute)
+		deleted, err := repo.DeleteCheckReports(ctx, "delete-a", storage.CheckReportDeleteFilters{Before: &cutoff})
+		require.NoError(t, err)
+		assert.Equal(t, int64(4), deleted)
+		assert.Equal(t, int64(2), countReports(t, repo, "delete-a"))
+		assert.Equal(t, int64(6), countReports(t, repo, "delete-b"))
+	})
+
+	t.Run("UnknownCheckSlug", func(t *testing.T) {
+		repo, _, _, _ := seed(t)
+		slug := "no-such-check"
+		deleted, err := repo.DeleteCheckReports(ctx, "delete-a", storage.CheckReportDeleteFilters{CheckSlug: &slug})
+		require.NoError(t, err)
+		assert.Equal(t, int64(0), deleted)
+	})
+
+	t.Run("UnknownComponent", func(t *testing.T) {
+		repo, _, _, _ := seed(t)
+		_, err := repo.DeleteCheckReports(ctx, "no-such-component", storage.CheckReportDeleteFilters{})
+		assert.ErrorIs(t, err, storage.ErrComponentNotFound)
+	})
+}
+
+func TestRepository_SoftDeleteComponent(t *testing.T) {
+	repo := setupIsolatedRepo(t)
+	ctx := t.Context()
+
+	const source = "filesystem:/manifests"
+	require.NoError(t, repo.CreateComponent(ctx, storage.Component{ComponentID: "retired-service", Name: "Retired", Sources: storage.StringArray{source}}))
+	_, err := repo.CreateCheckReportFromSubmission(ctx, storage.CreateCheckReportInput{
+		ComponentID: "retired-service",
+		CheckSlug:   "unit-tests",
+		Status:      storage.CheckStatusPass,
+		Timestamp:   time.Now(),
+	})
+	require.NoError(t, err)
+	componentUUID := mustGetComponentUUID(t, repo, "retired-service")
+
+	require.NoError(t, repo.SoftDeleteComponent(ctx, "retired-service"))
+
+	_, err = repo.GetComponentByID(ctx, "retired-service")
+	assert.ErrorIs(t, err, storage.ErrComponentNotFound)
+	_, _, err = repo.GetCheckReportsForComponentWithPagination(ctx, "retired-service", nil, nil, nil, nil, 10, 0, false, storage.ReportOrder{})
+	assert.ErrorIs(t, err, storage.ErrComponentNotFound)
+
+	// The row and its reports are kept for audit, detached from the source
+	var deleted storage.Component
+	require.NoError(t, repo.DB.Unscoped().First(&deleted, "id = ?", componentUUID).Error)
+	assert.True(t, deleted.DeletedAt.Valid)
+	assert.Empty(t, deleted.Sources)
+	var reports int64
+	require.NoError(t, repo.DB.Model(&storage.CheckReport{}).Where("component_id = ?", componentUUID).Count(&reports).Error)
+	assert.Equal(t, int64(1), reports)
+
+	assert.ErrorIs(t, repo.SoftDeleteComponent(ctx, "retired-service"), storage.ErrComponentNotFound)
+	assert.ErrorIs(t, repo.SoftDeleteComponent(ctx, "missing-service"), storage.ErrComponentNotFound)
+}
+
+func TestRepository_GetComponentsIncludingDeleted(t *testing.T) {
+	repo := setupIsolatedRepo(t)
+	ctx := t.Context()
+
+	require.NoError(t, repo.CreateComponent(ctx, storage.Component{ComponentID: "live-service", Name: "Live"}))
+	require.NoError(t, repo.CreateComponent(ctx, storage.Component{ComponentID: "deleted-service", Name: "Deleted"}))
+	require.NoError(t, repo.SoftDeleteComponent(ctx, "deleted-service"))
+
+	components, err := repo.GetComponents(ctx)
+	require.NoError(t, err)
+	require.Len(t, components, 1)
+	assert.Equal(t, "live-service", components[0].ComponentID)
+
+	components, err = repo.GetComponentsIncludingDeleted(ctx)
+	require.NoError(t, err)
+	require.Len(t, components, 2)
+	assert.Equal(t, "deleted-service", components[0].ComponentID)
+	assert.True(t, components[0].DeletedAt.Valid)
+	assert.Equal(t, "live-service", components[1].ComponentID)
+	assert.False(t, components[1].DeletedAt.Valid)
+}
+
+func TestRepository_ComponentDependencies(t *testing.T) {
+	repo := setupIsolatedRepo(t)
+	ctx := t.Context()
+
+	const source = "filesystem:/manifests"
+	require.NoError(t, repo.CreateComponent(ctx, storage.Component{
+		ComponentID: "auth-service",
+		Name:        "Auth",
+		DependsOn:   storage.StringArray{"user-db", "session-cache"},
+		Sources:     storage.StringArray{source},
+	}))
+	require.NoError(t, repo.CreateComponent(ctx, storage.Component{ComponentID: "user-db", Name: "Users", Sources: storage.StringArray{source}}))
+
+	t.Run("DependenciesFlagDanglingEdges", func(t *testing.T) {
+		dependencies, err := repo.GetComponentDependencies(ctx, "auth-service")
+		require.NoError(t, err)
+		require.Len(t, dependencies, 2)
+		assert.Equal(t, "session-cache", dependencies[0].ComponentID)
+		assert.Nil(t, dependencies[0].Component)
+		assert.Equal(t, "user-db", dependencies[1].ComponentID)
+		require.NotNil(t, dependencies[1].Component)
+		assert.Equal(t, "Users", dependencies[1].Component.Name)
+	})
+
+	t.Run("Dependents", func(t *testing.T) {
+		dependents, err := repo.GetComponentDependents(ctx, "user-db")
+		require.NoError(t, err)
+		require.Len(t, dependents, 1)
+		assert.Equal(t, "auth-service", dependents[0].ComponentID)
+
+		dependents, err = repo.GetComponentDependents(ctx, "auth-service")
+		require.NoError(t, err)
+		assert.Empty(t, dependents)
+	})
+
+	t.Run("UpdateReplacesEdges", func(t *testing.T) {
+		auth, err := repo.GetComponentByID(ctx, "auth-service")
+		require.NoError(t, err)
+		auth.DependsOn = storage.StringArray{"session-cache"}
+		require.NoError(t, repo.UpdateComponent(ctx, *auth))
+
+		dependencies, err := repo.GetComponentDependencies(ctx, "auth-service")
+		require.NoError(t, err)
+		require.Len(t, dependencies, 1)
+		assert.Equal(t, "session-cache", dependencies[0].ComponentID)
+
+		dependents, err := repo.GetComponentDependents(ctx, "user-db")
+		require.NoError(t, err)
+		assert.Empty(t, dependents)
+	})
+
+	t.Run("HardDeleteRemovesEdges", func(t *testing.T) {
+		authUUID := mustGetComponentUUID(t, repo, "auth-service")
+		_, err := repo.PruneSourceComponents(ctx, source, []string{"user-db"}, true)
+		require.NoError(t, err)
+
+		var edges int64
+		require.NoError(t, repo.DB.Model(&storage.ComponentDependency{}).Where("component_id = ?", authUUID).Count(&edges).Error)
+		assert.Zero(t, edges)
+	})
+
+	t.Run("MissingComponent", func(t *testing.T) {
+		_, err := repo.GetComponentDependencies(ctx, "missing-service")
+		assert.ErrorIs(t, err, storage.ErrComponentNotFound)
+		_, err = repo.GetComponentDependents(ctx, "missing-service")
+		assert.ErrorIs(t, err, storage.ErrComponentNotFound)
+	})
+}
+
+func TestRepository_GetComponentImpact(t *testing.T) {
+	repo := setupIsolatedRepo(t)
+	ctx := t.Context()
+
+	// user-db <- auth-service <- gateway <- web, and gateway <-> admin depend on each other
+	components := []storage.Component{
+		{ComponentID: "user-db", Name: "Users"},
+		{ComponentID: "auth-service", Name: "Auth", DependsOn: storage.StringArray{"user-db"}},
+		{ComponentID: "gateway", Name: "Gateway", DependsOn: storage.StringArray{"auth-service", "admin"}},
+		{ComponentID: "admin", Name: "Admin", DependsOn: storage.StringArray{"gateway"}},
+		{ComponentID: "web", Name: "Web", DependsOn: storage.StringArray{"gateway", "user-db"}},
+		{ComponentID: "retired", Name: "Retired", DependsOn: storage.StringArray{"user-db"}},
+	}
+	for _, component := range components {
+		require.NoError(t, repo.CreateComponent(ctx, component))
+	}
+	require.NoError(t, repo.SoftDeleteComponent(ctx, "retired"))
+
+	_, err := repo.CreateCheckReportFromSubmission(ctx, storage.CreateCheckReportInput{
+		ComponentID: "auth-service",
+		CheckSlug:   "unit-tests",
+		Status:      storage.CheckStatusFail,
+		Timestamp:   time.Now(),
+	})
+	require.NoError(t, err)
+
+	depths := func(impacted []storage.ImpactedComponent) map[string]int {
+		result := make(map[string]int, len(impacted))
+		for _, component := range impacted {
+			result[component.Component.ComponentID] = component.Depth
+		}
+		return result
+	}
+
+	t.Run("TraversesTransitively", func(t *testing.T) {
+		impacted, err := repo.GetComponentImpact(ctx, "user-db", 5)
+		require.NoError(t, err)
+		// web depends on user-db directly, so it is listed once at depth 1
+		require.Len(t, impacted, 4)
+		assert.Equal(t, "auth-service", impacted[0].Component.ComponentID)
+		assert.Equal(t, "web", impacted[1].Component.ComponentID)
+		assert.Equal(t, map[string]int{"auth-service": 1, "web": 1, "gateway": 2, "admin": 3}, depths(impacted))
+
+		assert.Equal(t, int64(1), impacted[0].Summary.TotalChecks)
+		assert.Equal(t, int64(1), impacted[0].Summary.Counts[storage.CheckStatusFail])
+		assert.NotNil(t, impacted[0].Summary.LatestReportAt)
+		assert.Zero(t, impacted[1].Summary.TotalChecks)
+	})
+
+	t.Run("StopsAtMaxDepth", func(t *testing.T) {
+		impacted, err := repo.GetComponentImpact(ctx, "user-db", 2)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]int{"auth-service": 1, "web": 1, "gateway": 2}, depths(impacted))
+	})
+
+	t.Run("CutsCycles", func(t *testing.T) {
+		impacted, err := repo.GetComponentImpact(ctx, "gateway", 10)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]int{"admin": 1, "web": 1}, depths(impacted))
+	})
+
+	t.Run("NoDependents", func(t *testing.T) {
+		impacted, err := repo.GetComponentImpact(ctx, "web", 5)
+		require.NoError(t, err)
+		assert.Empty(t, impacted)
+	})
+
+	t.Run("MissingComponent", func(t *testing.T) {
+		_, err := repo.GetComponentImpact(ctx, "missing-service", 5)
+		assert.ErrorIs(t, err, storage.ErrComponentNotFound)
+	})
+}
+
+func TestRepository_RenameComponent(t *testing.T) {
+	repo := setupIsolatedRepo(t)
+	ctx := t.Context()
+
+	report := func(componentID string, key string) {
+		t.Helper()
+		_, _, err := repo.CreateCheckReportIdempotent(ctx, storage.CreateCheckReportInput{
+			ComponentID:    componentID,
+			CheckSlug:      "unit-tests",
+			Status:         storage.CheckStatusPass,
+			Timestamp:      time.Now().Add(-time.Minute),
+			IdempotencyKey: key,
+		})
+		require.NoError(t, err)
+	}
+	countReports := func(componentUUID uuid.UUID) int64 {
+		t.Helper()
+		var count int64
+		require.NoError(t, repo.DB.Model(&storage.CheckReport{}).Where("component_id = ?", componentUUID).Count(&count).Error)
+		return count
+	}
+
+	t.Run("Keeps the UUID and reports", func(t *testing.T) {
+		require.NoError(t, repo.CreateComponent(ctx, storage.Component{ComponentID: "billing-api", Name: "Billing"}))
+		original := mustGetComponentUUID(t, repo, "billing-api")
+		report("billing-api", "")
+
+		require.NoError(t, repo.RenameComponent(ctx, "billing-api", "payments-api"))
+
+		_, err := repo.GetComponentByID(ctx, "billing-api")
+		assert.ErrorIs(t, err, storage.ErrComponentNotFound)
+		assert.Equal(t, original, mustGetComponentUUID(t, repo, "payments-api"))
+		assert.Equal(t, int64(1), countReports(original))
+	})
+
+	t.Run("Unknown component", func(t *testing.T) {
+		err := repo.RenameComponent(ctx, "never-existed", "something-else")
+		assert.ErrorIs(t, err, storage.ErrComponentNotFound)
+	})
+
+	t.Run("Restores a deleted component", func(t *testing.T) {
+		require.NoError(t, repo.CreateComponent(ctx, storage.Component{ComponentID: "old-search", Name: "Search", Sources: storage.StringArray{"filesystem:/a"}}))
+		original := mustGetComponentUUID(t, repo, "old-search")
+		_, err := repo.PruneSourceComponents(ctx, "filesystem:/a", nil, false)
+		require.NoError(t, err)
+
+		require.NoError(t, repo.RenameComponent(ctx, "old-search", "search"))
+		assert.Equal(t, original, mustGetComponentUUID(t, repo, "search"))
+	})
+
+	t.Run("Merges a component already holding the new identifier", func(t *testing.T) {
+		require.NoError(t, repo.CreateComponent(ctx, storage.Component{ComponentID: "old-auth", Name: "Auth", Sources: storage.StringArray{"filesystem:/a"}}))
+		require.NoError(t, repo.CreateComponent(ctx, storage.Component{ComponentID: "auth", Name: "Auth", Sources: storage.StringArray{"filesystem:/b"}}))
+		original := mustGetComponentUUID(t, repo, "old-auth")
+		duplicate := mustGetComponentUUID(t, repo, "auth")
+		report("old-auth", "run-1")
+		report("auth", "run-1")
+		report("auth", "run-2")
+		require.NoError(t, repo.CreateComponentHistory(ctx, storage.ComponentHistory{
+			ComponentID: duplicate,
+			Changes:     storage.JSONB{"team": map[string]interface{}{"old": "", "new": "identity"}},
+		}))
+
+		require.NoError(t, repo.RenameComponent(ctx, "old-auth", "auth"))
+
+		renamed, err := repo.GetComponentByID(ctx, "auth")
+		require.NoError(t, err)
+		assert.Equal(t, original, renamed.ID)
+		assert.Equal(t, storage.StringArray{"filesystem:/a", "filesystem:/b"}, renamed.Sources)
+		assert.Equal(t, int64(3), countReports(original))
+		assert.Equal(t, int64(0), countReports(duplicate))
+
+		history, _, err := repo.GetComponentHistory(ctx, "auth", nil, nil, 10, 0)
+		require.NoError(t, err)
+		assert.Len(t, history, 1)
+
+		var duplicates int64
+		require.NoError(t, repo.DB.Unscoped().Model(&storage.Component{}).Where("id = ?", duplicate).Count(&duplicates).Error)
+		assert.Equal(t, int64(0), duplicates)
+	})
+}
+
+func TestRepository_PruneReportsOlderThan(t *testing.T) {
+	repo := setupIsolatedRepo(t)
+	ctx := t.Context()
+
+	check := storage.Check{Slug: "prune-check", Name: "Prune Check"}
+	require.NoError(t, repo.DB.Create(&check).Error)
+
+	now := time.Now().UTC().Truncate(time.Second)
+	for _, componentID := range []string{"prune-a", "prune-b"} {
+		component := storage.Component{ComponentID: componentID, Name: componentID}
+		require.NoError(t, repo.DB.Create(&component).Error)
+		// Reports are pruned by when they were received, not by the timestamp they carry
+		for _, age := range []time.Duration{time.Hour, 10 * 24 * time.Hour, 100 * 24 * time.Hour} {
+			report := storage.CheckReport{CheckID: check.ID, ComponentID: component.ID, Status: storage.CheckStatusPass, Timestamp: now, CreatedAt: now.Add(-age)}
+			require.NoError(t, repo.DB.Create(&report).Error)
+		}
+	}
+
+	pruned, err := repo.PruneReportsOlderThan(ctx, now.Add(-90*24*time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), pruned)
+
+	pruned, err = repo.PruneReportsOlderThan(ctx, now.Add(-7*24*time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), pruned)
+
+	var remaining []storage.CheckReport
+	require.NoError(t, repo.DB.Find(&remaining).Error)
+	require.Len(t, remaining, 2)
+	for _, report := range remaining {
+		assert.True(t, report.CreatedAt.Equal(now.Add(-time.Hour)))
+	}
+}
+
+func TestRepository_CheckReportIndexes(t *testing.T) {
+	repo := setupIsolatedRepo(t)
+	ctx := t.Context()
+	migrator := repo.DB.Migrator()
+
+	for _, name := range []string{"idx_check_reports_component_timestamp", "idx_check_reports_latest", "idx_check_timestamp"} {
+		assert.True(t, migrator.HasIndex(&storage.CheckReport{}, name), "missing index %s", name)
+	}
+
+	t.Run("Superseded index is dropped", func(t *testing.T) {
+		require.NoError(t, repo.DB.Exec("CREATE INDEX idx_component_check ON check_reports (component_id)").Error)
+		require.NoError(t, repo.Migrate(ctx))
+		assert.False(t, migrator.HasIndex(&storage.CheckReport{}, "idx_component_check"))
+	})
+
+	queryPlan := func(t *testing.T, query string) string {
+		rows, err := repo.DB.Raw("EXPLAIN QUERY PLAN " + query).Rows()
+		require.NoError(t, err)
+		defer func() { _ = rows.Close() }()
+
+		var plan []string
+		for rows.Next() {
+			var id, parent, unused int
+			var detail string
+			require.NoError(t, rows.Scan(&id, &parent, &unused, &detail))
+			plan = append(plan, detail)
+		}
+		require.NoError(t, rows.Err())
+		return strings.Join(plan, "\n")
+	}
+
+	t.Run("Component listing uses the component index", func(t *testing.T) {
+		plan := queryPlan(t, "SELECT * FROM check_reports WHERE component_id = 'x' ORDER BY timestamp DESC, id DESC LIMIT 10")
+		assert.Contains(t, plan, "idx_check_reports_component_timestamp")
+		assert.NotContains(t, plan, "TEMP B-TREE", "ordering should come from the index")
+	})
+
+	t.Run("Latest per check uses the latest index", func(t *testing.T) {
+		plan := queryPlan(t, "SELECT id FROM check_reports WHERE component_id = 'x' ORDER BY check_id, timestamp DESC, id DESC")
+		assert.Contains(t, plan, "idx_check_reports_latest")
+		assert.NotContains(t, plan, "TEMP B-TREE", "ordering should come from the index")
+	})
+}
+
+func TestRepository_GetCheckReportByID(t *testing.T) {
+	repo := setupIsolatedRepo(t)
+	ctx := t.Context()
+
+	check := storage.Check{Slug: "by-id-check", Name: "By ID Check"}
+	require.NoError(t, repo.DB.Create(&check).Error)
+	var reportIDs []uuid.UUID
+	for _, componentID := range []string{"by-id-a", "by-id-b"} {
+		component := storage.Component{ComponentID: componentID, Name: componentID}
+		require.NoError(t, repo.DB.Create(&component).Error)
+		report := storage.CheckReport{CheckID: check.ID, ComponentID: component.ID, Status: storage.CheckStatusPass, Timestamp: time.Now(), Details: storage.JSONB{"key": "value"}}
+		require.NoError(t, repo.DB.Create(&report).Error)
+		reportIDs = append(reportIDs, report.ID)
+	}
+
+	report, err := repo.GetCheckReportByID(ctx, "by-id-a", reportIDs[0])
+	require.NoError(t, err)
+	assert.Equal(t, "by-id-check", report.Check.Slug)
+	assert.Equal(t, "value", report.Details["key"])
+
+	_, err = repo.GetCheckReportByID(ctx, "by-id-a", reportIDs[1])
+	assert.ErrorIs(t, err, storage.ErrReportNotFound)
+
+	_, err = repo.GetCheckReportByID(ctx, "by-id-a", uuid.New())
+	assert.ErrorIs(t, err, storage.ErrReportNotFound)
+
+	_, err = repo.GetCheckReportByID(ctx, "no-such-component", reportIDs[0])
+	assert.ErrorIs(t, err, storage.ErrComponentNotFound)
+}
+
+func TestRepository_GetLatestCheckReport(t *testing.T) {
+	repo := setupIsolatedRepo(t)
+	ctx := t.Context()
+
+	component := storage.Component{ComponentID: "latest-check-service", Name: "Latest Check Service"}
+	require.NoError(t, repo.DB.Create(&component).Error)
+	check := storage.Check{Slug: "latest-check", Name: "Latest Check"}
+	require.NoError(t, repo.DB.Create(&check).Error)
+	other := storage.Check{Slug: "other-check", Name: "Other Check"}
+	require.NoError(t, repo.DB.Create(&other).Error)
+
+	now := time.Now().UTC().Truncate(time.Second)
+	reports := []storage.CheckReport{
+		{CheckID: check.ID, ComponentID: component.ID, Status: storage.CheckStatusFail, Timestamp: now.Add(-2 * time.Hour)},
+		{CheckID: check.ID, ComponentID: component.ID, Status: storage.CheckStatusPass, Timestamp: now.Add(-time.Hour)},
+		{CheckID: check.ID, ComponentID: component.ID, Status: storage.CheckStatusError, Timestamp: now.Add(-3 * time.Hour)},
+		{CheckID: other.ID, ComponentID: component.ID, Status: storage.CheckStatusSkipped, Timestamp: now},
+	}
+	for i := range reports {
+		require.NoError(t, repo.DB.Create(&reports[i]).Error)
+	}
+
+	report, err := repo.GetLatestCheckReport(ctx, "latest-check-service", "latest-check")
+	require.NoError(t, err)
+	assert.Equal(t, reports[1].ID, report.ID)
+	assert.Equal(t, storage.CheckStatusPass, report.Status)
+	assert.Equal(t, "latest-check", report.Check.Slug)
+
+	_, err = repo.GetLatestCheckReport(ctx, "latest-check-service", "no-such-check")
+	assert.ErrorIs(t, err, storage.ErrReportNotFound)
+
+	_, err = repo.GetLatestCheckReport(ctx, "no-such-component", "latest-check")
+	assert.ErrorIs(t, err, storage.ErrComponentNotFound)
+}
+
+func TestRepository_MultipleCheckSlugs(t *testing.T) {
+	repo := setupIsolatedRepo(t)
+	ctx := t.Context()
+
+	component := storage.Component{ComponentID: "multi-slug-service", Name: "Multi Slug Service"}
+	require.NoError(t, repo.DB.Create(&component).Error)
+	now := time.Now().UTC().Truncate(time.Second)
+	for _, slug := range []string{"unit-tests", "integration-tests", "lint"} {
+		check := storage.Check{Slug: slug, Name: slug}
+		require.NoError(t, repo.DB.Create(&check).Error)
+		for i := 0; i < 3; i++ {
+			report := storage.CheckReport{CheckID: check.ID, ComponentID: component.ID, Status: storage.CheckStatusPass, Timestamp: now.Add(-time.Duration(i) * time.Minute)}
+			require.NoError(t, repo.DB.Create(&report).Error)
+		}
+	}
+
+	slugs := []string{"unit-tests", "integration-tests"}
+
+	t.Run("All reports", func(t *testing.T) {
+		reports, total, err := repo.GetCheckReportsForComponentWithPagination(ctx, "multi-slug-service", nil, slugs, nil, nil, 50, 0, false, storage.ReportOrder{})
+		require.NoError(t, err)
+		assert.Equal(t, int64(6), total)
+		require.Len(t, reports, 6)
+		seen := make(map[uuid.UUID]bool)
+		for _, report := range reports {
+			assert.Contains(t, slugs, report.Check.Slug)
+			assert.False(t, seen[report.ID], "report %s returned twice", report.ID)
+			seen[report.ID] = true
+		}
+	})
+
+	t.Run("Latest per check", func(t *testing.T) {
+		reports, total, err := repo.GetCheckReportsForComponentWithPagination(ctx, "multi-slug-service", nil, slugs, nil, nil, 50, 0, true, storage.ReportOrder{})
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), total)
+		require.Len(t, reports, 2)
+		assert.ElementsMatch(t, slugs, []string{reports[0].Check.Slug, reports[1].Check.Slug})
+	})
+
+	t.Run("Cursor", func(t *testing.T) {
+		reports, total, next, err := repo.GetCheckReportsForComponentByCursor(ctx, "multi-slug-service", nil, slugs, nil, nil, nil, 4)
+		require.NoError(t, err)
+		assert.Equal(t, int64(6), total)
+		assert.Len(t, reports, 4)
+		assert.NotNil(t, next)
+	})
+}
+
+func TestRepository_SearchComponents(t *testing.T) {
+	repo := setupIsolatedRepo(t)
+	ctx := t.Context()
+
+	for _, component := range []storage.Component{
+		{ComponentID: "auth-service", Name: "Auth Service", Description: "Handles login", Team: "Identity"},
+		{ComponentID: "vault-proxy", Name: "Vault Proxy", Description: "Secrets access", Team: "Security Team"},
+		{ComponentID: "billing", Name: "Billing", Description: "Invoices and payments"},
+		{ComponentID: "scanner", Name: "Dependency Scanner", Description: "Finds vulnerable packages", Team: "Security Team"},
+	} {
+		require.NoError(t, repo.DB.Create(&component).Error)
+	}
+
+	componentIDs := func(components []storage.Component) []string {
+		ids := make([]string, len(components))
+		for i, component := range components {
+			ids[i] = component.ComponentID
+		}
+		return ids
+	}
+
+	tests := []struct {
+		name     string
+		query    string
+		expected []string
+	}{
+		{name: "identifier", query: "auth-", expected: []string{"auth-service"}},
+		{name: "name is case-insensitive", query: "VAULT", expected: []string{"vault-proxy"}},
+		{name: "description", query: "invoice", expected: []string{"billing"}},
+		{name: "team", query: "security", expected: []string{"scanner", "vault-proxy"}},
+		{name: "no match", query: "nothing-like-this", expected: []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			components, total, err := repo.SearchComponents(ctx, tt.query, 10, 0)
+			require.NoError(t, err)
+			assert.Equal(t, int64(len(tt.expected)), total)
+			assert.Equal(t, tt.expected, componentIDs(components))
+		})
+	}
+
+	t.Run("pagination", func(t *testing.T) {
+		components, total, err := repo.SearchComponents(ctx, "e", 2, 1)
+		require.NoError(t, err)
+		assert.Equal(t, int64(4), total)
+		assert.Equal(t, []string{"billing", "scanner"}, componentIDs(components))
+	})
+}
+
+func TestRepository_GetComponentsPaginated(t *testing.T) {
+	repo := setupIsolatedRepo(t)
+	ctx := t.Context()
+
+	for _, componentID := range []string{"delta", "alpha", "charlie", "bravo", "echo"} {
+		require.NoError(t, repo.DB.Create(&storage.Component{ComponentID: componentID, Name: componentID}).Error)
+	}
+
+	components, total, err := repo.GetComponentsPaginated(ctx, 2, 1)
+	require.NoError(t, err)
+	assert.Equal(t, int64(5), total)
+	require.Len(t, components, 2)
+	assert.Equal(t, "bravo", components[0].ComponentID)
+	assert.Equal(t, "charlie", components[1].ComponentID)
+
+	components, total, err = repo.GetComponentsPaginated(ctx, 2, 10)
+	require.NoError(t, err)
+	assert.Equal(t, int64(5), total)
+	assert.Empty(t, components)
+}
+
+func TestRepository_GetComponentsMaxUpdatedAt(t *testing.T) {
+	repo := setupIsolatedRepo(t)
+	ctx := t.Context()
+
+	latest, err := repo.GetComponentsMaxUpdatedAt(ctx)
+	require.NoError(t, err)
+	assert.True(t, latest.IsZero())
+
+	require.NoError(t, repo.CreateComponent(ctx, storage.Component{ComponentID: "etag-a", Name: "A"}))
+	require.NoError(t, repo.CreateComponent(ctx, storage.Component{ComponentID: "etag-b", Name: "B"}))
+	created, err := repo.GetComponentsMaxUpdatedAt(ctx)
+	require.NoError(t, err)
+	assert.False(t, created.IsZero())
+
+	time.Sleep(5 * time.Millisecond)
+	require.NoError(t, repo.UpdateComponent(ctx, storage.Component{ComponentID: "etag-a", Name: "A renamed"}))
+	updated, err := repo.GetComponentsMaxUpdatedAt(ctx)
+	require.NoError(t, err)
+	assert.True(t, updated.After(created))
+
+	// A plain soft delete only sets deleted_at, which counts as a change too
+	time.Sleep(5 * time.Millisecond)
+	require.NoError(t, repo.DB.Delete(&storage.Component{}, "component_id = ?", "etag-b").Error)
+	deleted, err := repo.GetComponentsMaxUpdatedAt(ctx)
+	require.NoError(t, err)
+	assert.True(t, deleted.After(updated))
+
+	count, err := repo.CountComponents(ctx, storage.ComponentFilters{})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+}
+
+func TestRepository_StreamCheckReportsForComponent(t *testing.T) {
+	repo := setupIsolatedRepo(t)
+	ctx := t.Context()
+
+	component := storage.Component{ComponentID: "stream-service", Name: "Stream Service"}
+	require.NoError(t, repo.DB.Create(&component).Error)
+	unit := storage.Check{Slug: "stream-unit", Name: "Stream Unit"}
+	require.NoError(t, repo.DB.Create(&unit).Error)
+	lint := storage.Check{Slug: "stream-lint", Name: "Stream Lint"}
+	require.NoError(t, repo.DB.Create(&lint).Error)
+
+	now := time.Now().UTC().Truncate(time.Second)
+	for i := 0; i < 10; i++ {
+		check, status := unit, storage.CheckStatusPass
+		if i%2 == 1 {
+			check, status = lint, storage.CheckStatusFail
+		}
+		report := storage.CheckReport{CheckID: check.ID, ComponentID: component.ID, Status: status, Timestamp: now.Add(-time.Duration(i) * time.Minute)}
+		require.NoError(t, repo.DB.Create(&report).Error)
+	}
+
+	collect := func(t *testing.T, statuses []storage.CheckStatus, checkSlugs []string, since *time.Time) []storage.StreamedReport {
+		var reports []storage.StreamedReport
+		err := repo.StreamCheckReportsForComponent(ctx, "stream-service", statuses, checkSlugs, since, nil, func(report storage.StreamedReport) error {
+			reports = append(reports, report)
+			return nil
+		})
+		require.NoError(t, err)
+		return reports
+	}
+
+	t.Run("AllReportsNewestFirst", func(t *testing.T) {
+		reports := collect(t, nil, nil, nil)
+		require.Len(t, reports, 10)
+		assert.Equal(t, "stream-unit", reports[0].CheckSlug)
+		assert.Equal(t, storage.CheckStatusPass, reports[0].Status)
+		assert.True(t, reports[0].Timestamp.Equal(now))
+		assert.Equal(t, "stream-lint", reports[1].CheckSlug)
+		for i := 1; i < len(reports); i++ {
+			assert.True(t, reports[i].Timestamp.Before(reports[i-1].Timestamp))
+		}
+	})
+
+	t.Run("Filters", func(t *testing.T) {
+		reports := collect(t, nil, []string{"stream-lint"}, nil)
+		require.Len(t, reports, 5)
+		for _, report := range reports {
+			assert.Equal(t, "stream-lint", report.CheckSlug)
+		}
+
+		since := now.Add(-3 * time.Minute)
+		assert.Len(t, collect(t, []storage.CheckStatus{storage.CheckStatusPass}, nil, &since), 2)
+	})
+
+	t.Run("StopsOnCallbackError", func(t *testing.T) {
+		stop := errors.New("stop")
+		calls := 0
+		err := repo.StreamCheckReportsForComponent(ctx, "stream-service", nil, nil, nil, nil, func(storage.StreamedReport) error {
+			calls++
+			if calls == 3 {
+				return stop
+			}
+			return nil
+		})
+		assert.ErrorIs(t, err, stop)
+		assert.Equal(t, 3, calls)
+	})
+
+	t.Run("StopsWhenCancelled", func(t *testing.T) {
+		cancelCtx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		calls := 0
+		err := repo.StreamCheckReportsForComponent(cancelCtx, "stream-service", nil, nil, nil, nil, func(storage.StreamedReport) error {
+			calls++
+			cancel()
+			return nil
+		})
+		assert.ErrorIs(t, err, context.Canceled)
+		assert.Less(t, calls, 10)
+	})
+
+	t.Run("ComponentNotFound", func(t *testing.T) {
+		err := repo.StreamCheckReportsForComponent(ctx, "missing-service", nil, nil, nil, nil, func(storage.StreamedReport) error { return nil })
+		assert.ErrorIs(t, err, storage.ErrComponentNotFound)
+	})
+}
+
+func TestRepository_CreateCheckReportIdempotent(t *testing.T) {
+	repo := setupIsolatedRepo(t)
+	ctx := t.Context()
+
+	require.NoError(t, repo.CreateComponent(ctx, storage.Component{ComponentID: "idempotent-service", Name: "Idempotent Service"}))
+	require.NoError(t, repo.CreateComponent(ctx, storage.Component{ComponentID: "other-service", Name: "Other Service"}))
+
+	input := storage.CreateCheckReportInput{
+		ComponentID:    "idempotent-service",
+		CheckSlug:      "unit-tests",
+		Status:         storage.CheckStatusPass,
+		Timestamp:      time.Now().Add(-time.Minute),
+		IdempotencyKey: "ci-run-1",
+	}
+	countReports := func(t *testing.T) int64 {
+		var count int64
+		require.NoError(t, repo.DB.WithContext(ctx).Model(&storage.CheckReport{}).Count(&count).Error)
+		return count
+	}
+
+	firstID, created, err := repo.CreateCheckReportIdempotent(ctx, input)
+	require.NoError(t, err)
+	assert.True(t, created)
+
+	t.Run("DuplicateKeyReturnsOriginal", func(t *testing.T) {
+		retry := input
+		retry.Status = storage.CheckStatusFail
+		reportID, created, err := repo.CreateCheckReportIdempotent(ctx, retry)
+		require.NoError(t, err)
+		assert.False(t, created)
+		assert.Equal(t, firstID, reportID)
+		assert.Equal(t, int64(1), countReports(t))
+
+		stored, err := repo.GetCheckReportByID(ctx, "idempotent-service", firstID)
+		require.NoError(t, err)
+		assert.Equal(t, storage.CheckStatusPass, stored.Status)
+	})
+
+	t.Run("KeyIsScopedToComponentAndCheck", func(t *testing.T) {
+		otherCheck := input
+		otherCheck.CheckSlug = "lint"
+		_, created, err := repo.CreateCheckReportIdempotent(ctx, otherCheck)
+		require.NoError(t, err)
+		assert.True(t, created)
+
+		otherComponent := input
+		otherComponent.ComponentID = "other-service"
+		_, created, err = repo.CreateCheckReportIdempotent(ctx, otherComponent)
+		require.NoError(t, err)
+		assert.True(t, created)
+	})
+
+	t.Run("NoKeyAlwaysCreates", func(t *testing.T) {
+		withoutKey := input
+		withoutKey.IdempotencyKey = ""
+		before := countReports(t)
+		for i := 0; i < 2; i++ {
+			_, created, err := repo.CreateCheckReportIdempotent(ctx, withoutKey)
+			require.NoError(t, err)
+			assert.True(t, created)
+		}
+		assert.Equal(t, before+2, countReports(t))
+	})
+
+	t.Run("UniqueIndexBacksTheKey", func(t *testing.T) {
+		// A report stored with a used key outside CreateCheckReportIdempotent is rejected
+		duplicate := storage.CheckReport{
+			CheckID:        mustGetCheckID(t, repo, "unit-tests"),
+			ComponentID:    mustGetComponentUUID(t, repo, "idempotent-service"),
+			Status:         storage.CheckStatusPass,
+			Timestamp:      time.Now(),
+			IdempotencyKey: &input.IdempotencyKey,
+		}
+		assert.Error(t, repo.DB.WithContext(ctx).Create(&duplicate).Error)
+	})
+
+	t.Run("MissingComponent", func(t *testing.T) {
+		missing := input
+		missing.ComponentID = "missing-service"
+		_, _, err := repo.CreateCheckReportIdempotent(ctx, missing)
+		assert.ErrorIs(t, err, storage.ErrComponentNotFound)
+	})
+}
+
+func mustGetCheckID(t *testing.T, repo *storage.Repository, slug string) uuid.UUID {
+	t.Helper()
+	check, err := repo.GetCheckBySlug(t.Context(), slug)
+	require.NoError(t, err)
+	return check.ID
+}
+
+func mustGetComponentUUID(t *testing.T, repo *storage.Repository, componentID string) uuid.UUID {
+	t.Helper()
+	component, err := repo.GetComponentByID(t.Context(), componentID)
+	require.NoError(t, err)
+	return component.ID
+}
+
+func TestRepository_CountComponents_Filters(t *testing.T) {
+	repo := setupIsolatedRepo(t)
+	ctx := t.Context()
+
+	components := []storage.Component{
+		{ComponentID: "count-auth", Name: "Auth", Team: "platform", Labels: storage.StringMap{"tier": "critical", "lang": "go"}},
+		{ComponentID: "count-billing", Name: "Billing", Team: "payments", Labels: storage.StringMap{"tier": "critical"}},
+		{ComponentID: "count-docs", Name: "Docs", Team: "platform", Labels: storage.StringMap{"tier": "low", "owner.name": "docs"}},
+		{ComponentID: "count-legacy", Name: "Legacy", Team: "platform", Labels: storage.StringMap{"tier": "critical"}},
+	}
+	for _, component := range components {
+		require.NoError(t, repo.CreateComponent(ctx, component))
+	}
+	// Deleted components are not counted
+	require.NoError(t, repo.DB.Delete(&storage.Component{}, "component_id = ?", "count-legacy").Error)
+
+	tests := []struct {
+		name    string
+		filters storage.ComponentFilters
+		want    int64
+	}{
+		{"All", storage.ComponentFilters{}, 3},
+		{"Team", storage.ComponentFilters{Team: "platform"}, 2},
+		{"Label", storage.ComponentFilters{Labels: map[string]string{"tier": "critical"}}, 2},
+		{"SeveralLabels", storage.ComponentFilters{Labels: map[string]string{"tier": "critical", "lang": "go"}}, 1},
+		{"TeamAndLabel", storage.ComponentFilters{Team: "payments", Labels: map[string]string{"tier": "critical"}}, 1},
+		{"DottedLabelKey", storage.ComponentFilters{Labels: map[string]string{"owner.name": "docs"}}, 1},
+		{"NoMatch", storage.ComponentFilters{Team: "unknown"}, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			count, err := repo.CountComponents(ctx, tt.filters)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, count)
+		})
+	}
+}
+
+func TestRepository_GetLatestReports(t *testing.T) {
+	repo := setupIsolatedRepo(t)
+	ctx := t.Context()
+
+	auth := storage.Component{ComponentID: "latest-auth", Name: "Auth", Team: "platform"}
+	billing := storage.Component{ComponentID: "latest-billing", Name: "Billing", Team: "payments"}
+	legacy := storage.Component{ComponentID: "latest-legacy", Name: "Legacy", Team: "platform"}
+	for _, component := range []*storage.Component{&auth, &billing, &legacy} {
+		require.NoError(t, repo.DB.Create(component).Error)
+	}
+	lint := storage.Check{Slug: "lint", Name: "Lint"}
+	unitTests := storage.Check{Slug: "unit-tests", Name: "Unit Tests"}
+	require.NoError(t, repo.DB.Create(&lint).Error)
+	require.NoError(t, repo.DB.Create(&unitTests).Error)
+
+	now := time.Now().UTC().Truncate(time.Second)
+	reports := []storage.CheckReport{
+		{CheckID: unitTests.ID, ComponentID: auth.ID, Status: storage.CheckStatusFail, Timestamp: now.Add(-2 * time.Hour)},
+		{CheckID: unitTests.ID, ComponentID: auth.ID, Status: storage.CheckStatusPass, Timestamp: now.Add(-time.Hour)},
+		{CheckID: lint.ID, ComponentID: auth.ID, Status: storage.CheckStatusError, Timestamp: now.Add(-3 * time.Hour)},
+		{CheckID: unitTests.ID, ComponentID: billing.ID, Status: storage.CheckStatusPass, Timestamp: now.Add(-2 * time.Hour)},
+		{CheckID: unitTests.ID, ComponentID: billing.ID, Status: storage.CheckStatusFail, Timestamp: now},
+		{CheckID: unitTests.ID, ComponentID: legacy.ID, Status: storage.CheckStatusFail, Timestamp: now},
+	}
+	for i := range reports {
+		require.NoError(t, repo.DB.Create(&reports[i]).Error)
+	}
+	// Reports of deleted components are left out
+	require.NoError(t, repo.DB.Delete(&legacy).Error)
+
+	t.Run("All pairs", func(t *testing.T) {
+		latest, total, err := repo.GetLatestReports(ctx, storage.LatestReportFilters{}, 50, 0)
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), total)
+		require.Len(t, latest, 3)
+		assert.Equal(t, reports[2].ID, latest[0].ID)
+		assert.Equal(t, reports[1].ID, latest[1].ID)
+		assert.Equal(t, reports[4].ID, latest[2].ID)
+		assert.Equal(t, "latest-auth", latest[0].Component.ComponentID)
+		assert.Equal(t, "lint", latest[0].Check.Slug)
+	})
+
+	t.Run("Status matches the latest report only", func(t *testing.T) {
+		latest, total, err := repo.GetLatestReports(ctx, storage.LatestReportFilters{Statuses: []storage.CheckStatus{storage.CheckStatusFail}}, 50, 0)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), total)
+		require.Len(t, latest, 1)
+		assert.Equal(t, reports[4].ID, latest[0].ID)
+	})
+
+	t.Run("Check slug and team", func(t *testing.T) {
+		latest, total, err := repo.GetLatestReports(ctx, storage.LatestReportFilters{CheckSlugs: []string{"unit-tests"}, Team: "platform"}, 50, 0)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), total)
+		require.Len(t, latest, 1)
+		assert.Equal(t, reports[1].ID, latest[0].ID)
+	})
+
+	t.Run("Pagination", func(t *testing.T) {
+		latest, total, err := repo.GetLatestReports(ctx, storage.LatestReportFilters{}, 2, 1)
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), total)
+		require.Len(t, latest, 2)
+		assert.Equal(t, reports[1].ID, latest[0].ID)
+		assert.Equal(t, reports[4].ID, latest[1].ID)
+	})
+}
+
+func TestRepository_GetLatestReportsForComponents(t *testing.T) {
+	repo := setupIsolatedRepo(t)
+	ctx := t.Context()
+
+	auth := storage.Component{ComponentID: "team-auth", Name: "Auth", Team: "identity"}
+	sso := storage.Component{ComponentID: "team-sso", Name: "SSO", Team: "identity"}
+	retired := storage.Component{ComponentID: "team-retired", Name: "Retired", Team: "identity"}
+	other := storage.Component{ComponentID: "team-other", Name: "Other", Team: "payments"}
+	for _, component := range []*storage.Component{&auth, &sso, &retired, &other} {
+		require.NoError(t, repo.DB.Create(component).Error)
+	}
+	lint := storage.Check{Slug: "team-lint", Name: "Lint"}
+	unitTests := storage.Check{Slug: "team-unit-tests", Name: "Unit Tests"}
+	require.NoError(t, repo.DB.Create(&lint).Error)
+	require.NoError(t, repo.DB.Create(&unitTests).Error)
+
+	now := time.Now().UTC().Truncate(time.Second)
+	reports := []storage.CheckReport{
+		{CheckID: unitTests.ID, ComponentID: auth.ID, Status: storage.CheckStatusFail, Timestamp: now.Add(-time.Hour)},
+		{CheckID: unitTests.ID, ComponentID: auth.ID, Status: storage.CheckStatusPass, Timestamp: now},
+		{CheckID: lint.ID, ComponentID: auth.ID, Status: storage.CheckStatusPass, Timestamp: now},
+		{CheckID: unitTests.ID, ComponentID: sso.ID, Status: storage.CheckStatusFail, Timestamp: now},
+		{CheckID: unitTests.ID, ComponentID: retired.ID, Status: storage.CheckStatusFail, Timestamp: now},
+		{CheckID: unitTests.ID, ComponentID: other.ID, Status: storage.CheckStatusFail, Timestamp: now},
+	}
+	for i := range reports {
+		require.NoError(t, repo.DB.Create(&reports[i]).Error)
+	}
+	require.NoError(t, repo.DB.Delete(&retired).Error)
+
+	componentIDs := []string{"team-sso", "team-auth", "team-retired", "team-missing"}
+
+	t.Run("Every check of the given components", func(t *testing.T) {
+		latest, err := repo.GetLatestReportsForComponents(ctx, componentIDs, nil)
+		require.NoError(t, err)
+		require.Len(t, latest, 3)
+		assert.Equal(t, reports[2].ID, latest[0].ID)
+		assert.Equal(t, reports[1].ID, latest[1].ID)
+		assert.Equal(t, reports[3].ID, latest[2].ID)
+		assert.Equal(t, "team-auth", latest[0].Component.ComponentID)
+		assert.Equal(t, "team-lint", latest[0].Check.Slug)
+	})
+
+	t.Run("One check", func(t *testing.T) {
+		slug := "team-unit-tests"
+		latest, err := repo.GetLatestReportsForComponents(ctx, componentIDs, &slug)
+		require.NoError(t, err)
+		require.Len(t, latest, 2)
+		assert.Equal(t, reports[1].ID, latest[0].ID)
+		assert.Equal(t, reports[3].ID, latest[1].ID)
+	})
+
+	t.Run("No components", func(t *testing.T) {
+		latest, err := repo.GetLatestReportsForComponents(ctx, nil, nil)
+		require.NoError(t, err)
+		assert.NotNil(t, latest)
+		assert.Empty(t, latest)
+
+		all, total, err := repo.GetCheckReportsForComponents(ctx, []string{}, nil, 50, 0)
+		require.NoError(t, err)
+		assert.Zero(t, total)
+		assert.Empty(t, all)
+	})
+
+	t.Run("Every report, newest first", func(t *testing.T) {
+		all, total, err := repo.GetCheckReportsForComponents(ctx, componentIDs, nil, 3, 1)
+		require.NoError(t, err)
+		assert.Equal(t, int64(4), total)
+		require.Len(t, all, 3)
+		for _, report := range all {
+			assert.NotEqual(t, "team-retired", report.Component.ComponentID)
+		}
+		assert.Equal(t, reports[0].ID, all[2].ID)
+
+		slug := "team-lint"
+		all, total, err = repo.GetCheckReportsForComponents(ctx, componentIDs, &slug, 50, 0)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), total)
+		require.Len(t, all, 1)
+		assert.Equal(t, reports[2].ID, all[0].ID)
+	})
+}
+
+func TestRepository_GetReportStatusTimeSeries(t *testing.T) {
+	repo := setupIsolatedRepo(t)
+	ctx := t.Context()
+
+	component := storage.Component{ComponentID: "series-service", Name: "Series Service"}
+	require.NoError(t, repo.DB.Create(&component).Error)
+	check := storage.Check{Slug: "series-check", Name: "Series Check"}
+	require.NoError(t, repo.DB.Create(&check).Error)
+	other := storage.Check{Slug: "other-check", Name: "Other Check"}
+	require.NoError(t, repo.DB.Create(&other).Error)
+
+	day := time.Date(2024, 1, 10, 0, 0, 0, 0, time.UTC)
+	reports := []storage.CheckReport{
+		{CheckID: check.ID, Status: storage.CheckStatusPass, Timestamp: day.Add(time.Hour)},
+		{CheckID: check.ID, Status: storage.CheckStatusPass, Timestamp: day.Add(23 * time.Hour)},
+		{CheckID: check.ID, Status: storage.CheckStatusFail, Timestamp: day.Add(2 * time.Hour)},
+		// Reported in another time zone, but on January 12 in UTC
+		{CheckID: check.ID, Status: storage.CheckStatusFail, Timestamp: time.Date(2024, 1, 11, 20, 30, 0, 0, time.FixedZone("EST", -5*3600))},
+		// Outside the range
+		{CheckID: check.ID, Status: storage.CheckStatusFail, Timestamp: day.Add(-time.Minute)},
+		{CheckID: check.ID, Status: storage.CheckStatusFail, Timestamp: day.Add(3 * 24 * time.Hour)},
+		// Another check
+		{CheckID: other.ID, Status: storage.CheckStatusFail, Timestamp: day.Add(time.Hour)},
+	}
+	for i := range reports {
+		reports[i].ComponentID = component.ID
+		require.NoError(t, repo.DB.Create(&reports[i]).Error)
+	}
+
+	t.Run("Daily buckets are zero filled", func(t *testing.T) {
+		buckets, err := repo.GetReportStatusTimeSeries(ctx, "series-service", "series-check", storage.TimeBucketDay, day.Add(5*time.Hour), day.Add(2*24*time.Hour+time.Hour))
+		require.NoError(t, err)
+		require.Len(t, buckets, 3)
+		assert.Equal(t, day, buckets[0].Start)
+		assert.Equal(t, map[storage.CheckStatus]int64{storage.CheckStatusPass: 2, storage.CheckStatusFail: 1}, buckets[0].Counts)
+		assert.Equal(t, day.Add(24*time.Hour), buckets[1].Start)
+		assert.Empty(t, buckets[1].Counts)
+		assert.Equal(t, map[storage.CheckStatus]int64{storage.CheckStatusFail: 1}, buckets[2].Counts)
+	})
+
+	t.Run("Hourly buckets", func(t *testing.T) {
+		buckets, err := repo.GetReportStatusTimeSeries(ctx, "series-service", "series-check", storage.TimeBucketHour, day, day.Add(3*time.Hour-time.Second))
+		require.NoError(t, err)
+		require.Len(t, buckets, 3)
+		assert.Empty(t, buckets[0].Counts)
+		assert.Equal(t, map[storage.CheckStatus]int64{storage.CheckStatusPass: 1}, buckets[1].Counts)
+		assert.Equal(t, map[storage.CheckStatus]int64{storage.CheckStatusFail: 1}, buckets[2].Counts)
+	})
+
+	t.Run("Unknown check", func(t *testing.T) {
+		buckets, err := repo.GetReportStatusTimeSeries(ctx, "series-service", "no-such-check", storage.TimeBucketDay, day, day)
+		require.NoError(t, err)
+		require.Len(t, buckets, 1)
+		assert.Empty(t, buckets[0].Counts)
+	})
+
+	t.Run("Unknown component", func(t *testing.T) {
+		_, err := repo.GetReportStatusTimeSeries(ctx, "no-such-component", "series-check", storage.TimeBucketDay, day, day)
+		assert.ErrorIs(t, err, storage.ErrComponentNotFound)
+	})
+
+	t.Run("Unknown bucket", func(t *testing.T) {
+		_, err := repo.GetReportStatusTimeSeries(ctx, "series-service", "series-check", storage.TimeBucket("week"), day, day)
+		assert.Error(t, err)
+	})
+}
+
+func TestRepository_GetExpectedCheckReports(t *testing.T) {
+	repo := setupIsolatedRepo(t)
+	ctx := t.Context()
+
+	component := storage.Component{ComponentID: "expected-service", Name: "Expected Service", ExpectedChecks: storage.StringArray{"unit-tests", "security-scan", "lint"}}
+	require.NoError(t, repo.CreateComponent(ctx, component))
+	require.NoError(t, repo.CreateComponent(ctx, storage.Component{ComponentID: "no-expectations", Name: "No Expectations"}))
+	componentID := mustGetComponentUUID(t, repo, "expected-service")
+
+	unitTests := storage.Check{Slug: "unit-tests", Name: "Unit Tests"}
+	require.NoError(t, repo.DB.Create(&unitTests).Error)
+	lint := storage.Check{Slug: "lint", Name: "Lint"}
+	require.NoError(t, repo.DB.Create(&lint).Error)
+	unexpected := storage.Check{Slug: "benchmarks", Name: "Benchmarks"}
+	require.NoError(t, repo.DB.Create(&unexpected).Error)
+
+	now := time.Now().UTC().Truncate(time.Second)
+	reports := []storage.CheckReport{
+		{CheckID: unitTests.ID, ComponentID: componentID, Status: storage.CheckStatusFail, Timestamp: now.Add(-2 * time.Hour)},
+		{CheckID: unitTests.ID, ComponentID: componentID, Status: storage.CheckStatusPass, Timestamp: now.Add(-time.Hour)},
+		{CheckID: lint.ID, ComponentID: componentID, Status: storage.CheckStatusPass, Timestamp: now.Add(-48 * time.Hour)},
+		{CheckID: unexpected.ID, ComponentID: componentID, Status: storage.CheckStatusPass, Timestamp: now},
+	}
+	for i := range reports {
+		require.NoError(t, repo.DB.Create(&reports[i]).Error)
+	}
+
+	expected, err := repo.GetExpectedCheckReports(ctx, "expected-service")
+	require.NoError(t, err)
+	require.Len(t, expected, 3)
+	assert.Equal(t, "unit-tests", expected[0].Slug)
+	require.NotNil(t, expected[0].LatestReport)
+	assert.Equal(t, reports[1].ID, expected[0].LatestReport.ID)
+	assert.Equal(t, "security-scan", expected[1].Slug)
+	assert.Nil(t, expected[1].LatestReport)
+	assert.Equal(t, "lint", expected[2].Slug)
+	require.NotNil(t, expected[2].LatestReport)
+	assert.Equal(t, reports[2].ID, expected[2].LatestReport.ID)
+
+	expected, err = repo.GetExpectedCheckReports(ctx, "no-expectations")
+	require.NoError(t, err)
+	assert.Empty(t, expected)
+
+	_, err = repo.GetExpectedCheckReports(ctx, "no-such-component")
+	assert.ErrorIs(t, err, storage.ErrComponentNotFound)
+}
+
+func TestRepository_GetStaleChecks(t *testing.T) {
+	repo := setupIsolatedRepo(t)
+	ctx := t.Context()
+
+	require.NoError(t, repo.CreateComponent(ctx, storage.Component{ComponentID: "stale-service", Name: "Stale Service", ExpectedChecks: storage.StringArray{"unit-tests", "security-scan"}}))
+	require.NoError(t, repo.CreateComponent(ctx, storage.Component{ComponentID: "fresh-service", Name: "Fresh Service"}))
+	staleID := mustGetComponentUUID(t, repo, "stale-service")
+	freshID := mustGetComponentUUID(t, repo, "fresh-service")
+
+	checks := map[string]*storage.Check{}
+	for _, slug := range []string{"unit-tests", "lint", "benchmarks"} {
+		check := storage.Check{Slug: slug, Name: slug}
+		require.NoError(t, repo.DB.Create(&check).Error)
+		checks[slug] = &check
+	}
+
+	now := time.Now().UTC().Truncate(time.Second)
+	reports := []storage.CheckReport{
+		// Fresh again after an old report
+		{CheckID: checks["unit-tests"].ID, ComponentID: staleID, Status: storage.CheckStatusPass, Timestamp: now.Add(-72 * time.Hour)},
+		{CheckID: checks["unit-tests"].ID, ComponentID: staleID, Status: storage.CheckStatusPass, Timestamp: now.Add(-time.Hour)},
+		// Stopped reporting
+		{CheckID: checks["lint"].ID, ComponentID: staleID, Status: storage.CheckStatusFail, Timestamp: now.Add(-48 * time.Hour)},
+		{CheckID: checks["benchmarks"].ID, ComponentID: freshID, Status: storage.CheckStatusPass, Timestamp: now},
+	}
+	for i := range reports {
+		require.NoError(t, repo.DB.Create(&reports[i]).Error)
+	}
+
+	cutoff := now.Add(-24 * time.Hour)
+	stale, err := repo.GetStaleChecks(ctx, "stale-service", cutoff)
+	require.NoError(t, err)
+	require.Len(t, stale, 2)
+	assert.Equal(t, "lint", stale[0].Slug)
+	assert.False(t, stale[0].Expected)
+	require.NotNil(t, stale[0].LatestReport)
+	assert.Equal(t, reports[2].ID, stale[0].LatestReport.ID)
+	assert.Equal(t, "security-scan", stale[1].Slug)
+	assert.True(t, stale[1].Expected)
+	assert.Nil(t, stale[1].LatestReport)
+
+	stale, err = repo.GetStaleChecks(ctx, "fresh-service", cutoff)
+	require.NoError(t, err)
+	assert.Empty(t, stale)
+
+	_, err = repo.GetStaleChecks(ctx, "no-such-component", cutoff)
+	assert.ErrorIs(t, err, storage.ErrComponentNotFound)
+}
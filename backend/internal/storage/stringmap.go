@@ -0,0 +1,55 @@
+package storage
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/schema"
+)
+
+// StringMap is a custom type to handle PostgreSQL JSONB objects with string values
+// Like StringArray, it can be queried with PostgreSQL's JSONB operators:
+//
+//	WHERE labels @> '{"tier": "critical"}'  -- Find components labelled tier=critical
+//	WHERE labels ? 'tier'                   -- Find components with a tier label
+type StringMap map[string]string
+
+// Value implements driver.Valuer interface for database storage
+func (sm StringMap) Value() (driver.Value, error) {
+	if sm == nil {
+		return nil, nil
+	}
+	return json.Marshal(sm)
+}
+
+// Scan implements sql.Scanner interface for database retrieval
+func (sm *StringMap) Scan(value interface{}) error {
+	if value == nil {
+		*sm = nil
+		return nil
+	}
+
+	var bytes []byte
+	switch v := value.(type) {
+	case []byte:
+		bytes = v
+	case string:
+		bytes = []byte(v)
+	default:
+		return errors.New("cannot scan non-json value into StringMap")
+	}
+
+	return json.Unmarshal(bytes, sm)
+}
+
+// GormDataType implements GormDataTypeInterface
+func (sm StringMap) GormDataType() string {
+	return jsonbType
+}
+
+// GormDBDataType implements GormDBDataTypeInterface
+func (sm StringMap) GormDBDataType(db *gorm.DB, field *schema.Field) string {
+	return jsonbType
+}
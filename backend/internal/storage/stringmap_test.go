@@ -0,0 +1,50 @@
+package storage_test
+
+import (
+	"testing"
+
+	"github.com/doron-cohen/argus/backend/internal/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStringMap(t *testing.T) {
+	t.Run("Value and Scan", func(t *testing.T) {
+		original := storage.StringMap{"tier": "critical", "domain": "payments"}
+
+		value, err := original.Value()
+		require.NoError(t, err)
+		assert.IsType(t, []byte{}, value)
+
+		var scanned storage.StringMap
+		err = scanned.Scan(value)
+		require.NoError(t, err)
+		assert.Equal(t, original, scanned)
+	})
+
+	t.Run("Nil map", func(t *testing.T) {
+		var original storage.StringMap
+
+		value, err := original.Value()
+		require.NoError(t, err)
+		assert.Nil(t, value)
+
+		var scanned storage.StringMap
+		err = scanned.Scan(nil)
+		require.NoError(t, err)
+		assert.Nil(t, scanned)
+	})
+
+	t.Run("Scan string", func(t *testing.T) {
+		var scanned storage.StringMap
+		err := scanned.Scan(`{"tier":"critical"}`)
+		require.NoError(t, err)
+		assert.Equal(t, storage.StringMap{"tier": "critical"}, scanned)
+	})
+
+	t.Run("Scan invalid type", func(t *testing.T) {
+		var scanned storage.StringMap
+		err := scanned.Scan(42)
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,68 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+// serviceName identifies Argus as the service spans are exported for
+const serviceName = "argus"
+
+// Config holds tracing configuration
+type Config struct {
+	// Endpoint is the OTLP/HTTP traces URL spans are exported to, such as
+	// "http://otel-collector:4318/v1/traces". When empty, tracing stays a no-op.
+	Endpoint string `yaml:"endpoint"`
+}
+
+// Validate ensures the endpoint, when set, is an http or https URL
+func (c Config) Validate() error {
+	if c.Endpoint == "" {
+		return nil
+	}
+	parsed, err := url.Parse(c.Endpoint)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return fmt.Errorf("endpoint %q must be an http or https URL, e.g. http://otel-collector:4318/v1/traces", c.Endpoint)
+	}
+	return nil
+}
+
+// NewProvider returns a tracer provider that exports spans in batches to the configured
+// endpoint, or nil when no endpoint is configured. Register it with otel.SetTracerProvider and
+// shut it down on exit to flush pending spans.
+func NewProvider(ctx context.Context, cfg Config) (*sdktrace.TracerProvider, error) {
+	if cfg.Endpoint == "" {
+		return nil, nil
+	}
+	exporter, err := otlptracehttp.New(ctx, otlptracehttp.WithEndpointURL(cfg.Endpoint))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create OTLP exporter: %w", err)
+	}
+	res, err := resource.Merge(resource.Default(), resource.NewSchemaless(attribute.String("service.name", serviceName)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to build tracing resource: %w", err)
+	}
+	return sdktrace.NewTracerProvider(sdktrace.WithBatcher(exporter), sdktrace.WithResource(res)), nil
+}
+
+// Setup registers a provider built by NewProvider as the global tracer provider and returns a
+// function that flushes and shuts it down. Without an endpoint, the no-op provider is kept
+// and the returned function does nothing.
+func Setup(ctx context.Context, cfg Config) (shutdown func(context.Context) error, err error) {
+	provider, err := NewProvider(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+	if provider == nil {
+		return func(context.Context) error { return nil }, nil
+	}
+	otel.SetTracerProvider(provider)
+	return provider.Shutdown, nil
+}
@@ -0,0 +1,80 @@
+package tracing
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace/noop"
+	collectortrace "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestSetup(t *testing.T) {
+	t.Cleanup(func() { otel.SetTracerProvider(noop.NewTracerProvider()) })
+
+	t.Run("exports spans to the configured endpoint", func(t *testing.T) {
+		var exported []*collectortrace.ExportTraceServiceRequest
+		collector := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/v1/traces", r.URL.Path)
+			body, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+			request := &collectortrace.ExportTraceServiceRequest{}
+			require.NoError(t, proto.Unmarshal(body, request))
+			exported = append(exported, request)
+			w.Header().Set("Content-Type", "application/x-protobuf")
+		}))
+		defer collector.Close()
+
+		shutdown, err := Setup(context.Background(), Config{Endpoint: collector.URL + "/v1/traces"})
+		require.NoError(t, err)
+
+		spanCtx, span := Start(context.Background(), "sync.SyncSource", attribute.String("source", "git"))
+		assert.NotEqual(t, context.Background(), spanCtx, "spans are recorded once a provider is set up")
+		End(span, nil)
+
+		// Shutting down flushes the batched span
+		require.NoError(t, shutdown(context.Background()))
+
+		require.Len(t, exported, 1)
+		resourceSpans := exported[0].GetResourceSpans()
+		require.Len(t, resourceSpans, 1)
+		var service string
+		for _, attr := range resourceSpans[0].GetResource().GetAttributes() {
+			if attr.GetKey() == "service.name" {
+				service = attr.GetValue().GetStringValue()
+			}
+		}
+		assert.Equal(t, "argus", service)
+		spans := resourceSpans[0].GetScopeSpans()[0].GetSpans()
+		require.Len(t, spans, 1)
+		assert.Equal(t, "sync.SyncSource", spans[0].GetName())
+	})
+
+	t.Run("keeps the no-op provider without an endpoint", func(t *testing.T) {
+		otel.SetTracerProvider(noop.NewTracerProvider())
+
+		shutdown, err := Setup(context.Background(), Config{})
+		require.NoError(t, err)
+		assert.NoError(t, shutdown(context.Background()))
+
+		ctx := context.Background()
+		spanCtx, span := Start(ctx, "test")
+		defer End(span, nil)
+		assert.Equal(t, ctx, spanCtx)
+	})
+}
+
+func TestConfig_Validate(t *testing.T) {
+	assert.NoError(t, Config{}.Validate())
+	assert.NoError(t, Config{Endpoint: "http://otel-collector:4318/v1/traces"}.Validate())
+	assert.NoError(t, Config{Endpoint: "https://otel.example.com/v1/traces"}.Validate())
+	assert.Error(t, Config{Endpoint: "otel-collector:4318"}.Validate())
+	assert.Error(t, Config{Endpoint: "grpc://otel-collector:4317"}.Validate())
+}
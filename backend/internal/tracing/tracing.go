@@ -0,0 +1,55 @@
+// Package tracing instruments Argus with OpenTelemetry spans.
+//
+// Spans are created through the global tracer provider, which is a no-op until Setup
+// registers one exporting to the configured OTLP endpoint, so instrumentation costs next to
+// nothing when tracing is not set up.
+package tracing
+
+import (
+	"context"
+	"net/http"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// instrumentationName identifies Argus spans to the tracer provider
+const instrumentationName = "github.com/doron-cohen/argus/backend"
+
+// propagator reads W3C trace context headers from incoming requests
+var propagator = propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{})
+
+// Start starts a span as a child of any span in ctx. A span that is not recorded, as with
+// the default no-op provider, is not added to the context, which is returned unchanged.
+func Start(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	spanCtx, span := otel.Tracer(instrumentationName).Start(ctx, name, trace.WithAttributes(attrs...))
+	if !span.IsRecording() {
+		return ctx, span
+	}
+	return spanCtx, span
+}
+
+// End records err, if any, on the span and ends it
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// Middleware continues the trace of an incoming request: spans started while handling it,
+// down to repository queries, become children of the caller's span. Requests without
+// trace context headers are left as they are.
+func Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := propagator.Extract(r.Context(), propagation.HeaderCarrier(r.Header))
+		if trace.SpanContextFromContext(ctx).IsValid() {
+			r = r.WithContext(ctx)
+		}
+		next.ServeHTTP(w, r)
+	})
+}
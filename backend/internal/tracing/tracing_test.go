@@ -0,0 +1,58 @@
+package tracing
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestMiddleware(t *testing.T) {
+	var seen trace.SpanContext
+	handler := Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		seen = trace.SpanContextFromContext(r.Context())
+	}))
+
+	t.Run("continues the caller's trace", func(t *testing.T) {
+		req := httptest.NewRequest("GET", "/api/catalog/v1/components", nil)
+		req.Header.Set("traceparent", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+		handler.ServeHTTP(httptest.NewRecorder(), req)
+
+		assert.True(t, seen.IsRemote())
+		assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", seen.TraceID().String())
+		assert.Equal(t, "00f067aa0ba902b7", seen.SpanID().String())
+	})
+
+	t.Run("ignores requests without trace context", func(t *testing.T) {
+		handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest("GET", "/api/catalog/v1/components", nil))
+
+		assert.False(t, seen.IsValid())
+	})
+}
+
+func TestStart(t *testing.T) {
+	t.Run("leaves the context unchanged without a tracer provider", func(t *testing.T) {
+		ctx := context.Background()
+		spanCtx, span := Start(ctx, "test")
+		defer End(span, nil)
+
+		assert.Equal(t, ctx, spanCtx)
+	})
+
+	t.Run("keeps the parent span context", func(t *testing.T) {
+		parent := trace.NewSpanContext(trace.SpanContextConfig{
+			TraceID:    trace.TraceID{1},
+			SpanID:     trace.SpanID{2},
+			TraceFlags: trace.FlagsSampled,
+			Remote:     true,
+		})
+		ctx := trace.ContextWithRemoteSpanContext(context.Background(), parent)
+		spanCtx, span := Start(ctx, "test")
+		defer End(span, nil)
+
+		assert.Equal(t, parent.TraceID(), trace.SpanContextFromContext(spanCtx).TraceID())
+	})
+}
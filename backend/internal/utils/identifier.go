@@ -0,0 +1,27 @@
+package utils
+
+import "strings"
+
+// NormalizeIdentifier canonicalizes a component identifier. The repository applies it
+// both when sync stores a component and when a component is looked up (catalog API,
+// report submission), so an identifier always resolves to the same component.
+//
+// Rules, applied in order:
+//   - leading and trailing whitespace is trimmed
+//   - trailing slashes are removed
+//   - when foldCase is set, the identifier is lowercased
+func NormalizeIdentifier(id string, foldCase bool) string {
+	id = strings.TrimSpace(id)
+	id = strings.TrimRight(id, "/")
+	if foldCase {
+		id = strings.ToLower(id)
+	}
+	return id
+}
+
+// NormalizeTeam canonicalizes a team name for matching: whitespace is trimmed, runs of
+// whitespace inside the name collapse to one space and the name is lowercased, so
+// "Security Team", "security team" and " Security  Team " name the same team.
+func NormalizeTeam(team string) string {
+	return strings.ToLower(strings.Join(strings.Fields(team), " "))
+}
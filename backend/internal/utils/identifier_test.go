@@ -0,0 +1,52 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeIdentifier(t *testing.T) {
+	tests := []struct {
+		name     string
+		id       string
+		foldCase bool
+		expected string
+	}{
+		{"clean identifier", "auth-service", false, "auth-service"},
+		{"surrounding whitespace", "  auth-service \t\n", false, "auth-service"},
+		{"trailing slash", "auth-service/", false, "auth-service"},
+		{"multiple trailing slashes", "auth-service//", false, "auth-service"},
+		{"whitespace around trailing slash", " auth-service/ ", false, "auth-service"},
+		{"inner slash kept", "team/auth-service", false, "team/auth-service"},
+		{"case kept by default", "Auth-Service", false, "Auth-Service"},
+		{"case folded", " Auth-Service/", true, "auth-service"},
+		{"only whitespace", "   ", false, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, NormalizeIdentifier(tt.id, tt.foldCase))
+		})
+	}
+}
+
+func TestNormalizeTeam(t *testing.T) {
+	tests := []struct {
+		name     string
+		team     string
+		expected string
+	}{
+		{"lowercase", "security team", "security team"},
+		{"mixed case", "Security Team", "security team"},
+		{"surrounding whitespace", "  Security Team \t", "security team"},
+		{"inner whitespace collapsed", "Security \t Team", "security team"},
+		{"empty", "   ", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, NormalizeTeam(tt.team))
+		})
+	}
+}
@@ -0,0 +1,8 @@
+package utils
+
+// HasMorePages reports whether an offset page of returned items is followed by another. It takes
+// what the page holds rather than its limit, so a short page is always the last one, even if
+// total counted rows the page query did not return.
+func HasMorePages(offset int, limit int, returned int, total int64) bool {
+	return returned == limit && offset+returned < int(total)
+}
@@ -0,0 +1,30 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHasMorePages(t *testing.T) {
+	tests := []struct {
+		name     string
+		offset   int
+		limit    int
+		returned int
+		total    int64
+		expected bool
+	}{
+		{"FullPageBeforeEnd", 0, 2, 2, 4, true},
+		{"FullPageAtEnd", 2, 2, 2, 4, false},
+		{"ShortLastPage", 2, 2, 1, 3, false},
+		{"ShortPageBelowTotal", 0, 2, 1, 4, false},
+		{"PastEnd", 10, 2, 0, 4, false},
+		{"Empty", 0, 2, 0, 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, HasMorePages(tt.offset, tt.limit, tt.returned, tt.total))
+		})
+	}
+}
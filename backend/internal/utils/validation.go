@@ -3,6 +3,8 @@ package utils
 import (
 	"encoding/json"
 	"fmt"
+	"strings"
+	"unicode"
 )
 
 // IsValidSlug checks if a string is a valid slug (alphanumeric, hyphens, underscores only)
@@ -29,26 +31,45 @@ func isValidSlugChar(char rune) bool {
 		char == '-' || char == '_'
 }
 
-// ValidateJSONBField validates a JSONB field for size and depth limits
-func ValidateJSONBField(data map[string]interface{}, fieldName string) error {
+// ContainsControlCharacters reports whether s contains control characters such as newlines or tabs.
+// Identifiers from manifests never do, while any printable character may appear in them.
+func ContainsControlCharacters(s string) bool {
+	return strings.IndexFunc(s, unicode.IsControl) >= 0
+}
+
+// DefaultMaxJSONBBytes is the serialized size limit applied when no explicit limit is configured
+const DefaultMaxJSONBBytes = 64 * 1024
+
+// DefaultMaxJSONBDepth is the nesting depth limit applied when no explicit limit is configured
+const DefaultMaxJSONBDepth = 10
+
+// ValidateJSONBField validates a JSONB field for size and depth limits.
+// maxBytes bounds the serialized size and maxDepth the levels of nesting; non-positive values
+// use DefaultMaxJSONBBytes and DefaultMaxJSONBDepth.
+func ValidateJSONBField(data map[string]interface{}, fieldName string, maxBytes int, maxDepth int) error {
 	// Handle nil data
 	if data == nil {
 		return fmt.Errorf("%s must be a valid JSON object", fieldName)
 	}
 
-	// Check for reasonable size limit (1MB)
+	if maxBytes <= 0 {
+		maxBytes = DefaultMaxJSONBBytes
+	}
+	if maxDepth <= 0 {
+		maxDepth = DefaultMaxJSONBDepth
+	}
+
 	jsonData, err := json.Marshal(data)
 	if err != nil {
 		return fmt.Errorf("%s must be a valid JSON object", fieldName)
 	}
 
-	if len(jsonData) > 1024*1024 {
-		return fmt.Errorf("%s cannot exceed 1MB", fieldName)
+	if len(jsonData) > maxBytes {
+		return fmt.Errorf("%s cannot exceed %d bytes, got %d", fieldName, maxBytes, len(jsonData))
 	}
 
-	// Check for reasonable depth (max 10 levels)
-	if getMaxDepth(data) > 10 {
-		return fmt.Errorf("%s cannot exceed 10 levels of nesting", fieldName)
+	if depth := getMaxDepth(data); depth > maxDepth {
+		return fmt.Errorf("%s cannot exceed %d levels of nesting, got %d", fieldName, maxDepth, depth)
 	}
 
 	return nil
@@ -1,6 +1,7 @@
 package utils
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -63,6 +64,31 @@ func TestIsValidSlug(t *testing.T) {
 	}
 }
 
+func TestContainsControlCharacters(t *testing.T) {
+	testCases := []struct {
+		name     string
+		value    string
+		expected bool
+	}{
+		{"slug", "auth-service", false},
+		{"path_like", "github.com/org/repo:service", false},
+		{"with_spaces", "Auth Service", false},
+		{"with_unicode", "服务-α", false},
+		{"empty_string", "", false},
+		{"with_newline", "auth\nservice", true},
+		{"with_tab", "auth\tservice", true},
+		{"with_carriage_return", "auth-service\r", true},
+		{"with_null", "auth\x00service", true},
+		{"with_delete", "auth\x7fservice", true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, ContainsControlCharacters(tc.value), "value: %q", tc.value)
+		})
+	}
+}
+
 func TestValidateJSONBField(t *testing.T) {
 	t.Run("ValidJSONB", func(t *testing.T) {
 		validData := map[string]interface{}{
@@ -72,7 +98,7 @@ func TestValidateJSONBField(t *testing.T) {
 			"duration_seconds":    45,
 		}
 
-		err := ValidateJSONBField(validData, "details")
+		err := ValidateJSONBField(validData, "details", 0, 0)
 		assert.NoError(t, err)
 	})
 
@@ -97,7 +123,7 @@ func TestValidateJSONBField(t *testing.T) {
 			},
 		}
 
-		err := ValidateJSONBField(validData, "details")
+		err := ValidateJSONBField(validData, "details", 0, 0)
 		assert.NoError(t, err)
 	})
 
@@ -115,7 +141,7 @@ func TestValidateJSONBField(t *testing.T) {
 			},
 		}
 
-		err := ValidateJSONBField(validData, "details")
+		err := ValidateJSONBField(validData, "details", 0, 0)
 		assert.NoError(t, err)
 	})
 
@@ -143,7 +169,7 @@ func TestValidateJSONBField(t *testing.T) {
 			},
 		}
 
-		err := ValidateJSONBField(deepData, "details")
+		err := ValidateJSONBField(deepData, "details", 0, 0)
 		assert.NoError(t, err)
 	})
 
@@ -173,13 +199,38 @@ func TestValidateJSONBField(t *testing.T) {
 			},
 		}
 
-		err := ValidateJSONBField(tooDeepData, "details")
+		err := ValidateJSONBField(tooDeepData, "details", 0, 0)
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "cannot exceed 10 levels of nesting")
 	})
 
-	// Note: Size validation test removed due to memory constraints in test environment
-	// The size validation is tested in the actual API integration tests
+	t.Run("InvalidJSONBTooLarge", func(t *testing.T) {
+		largeData := map[string]interface{}{
+			"log": strings.Repeat("x", DefaultMaxJSONBBytes),
+		}
+
+		err := ValidateJSONBField(largeData, "details", 0, 0)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "cannot exceed 65536 bytes")
+	})
+
+	t.Run("CustomSizeLimit", func(t *testing.T) {
+		data := map[string]interface{}{"log": strings.Repeat("x", 100)}
+
+		assert.NoError(t, ValidateJSONBField(data, "details", 1024, 0))
+		err := ValidateJSONBField(data, "details", 64, 0)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "details cannot exceed 64 bytes")
+	})
+
+	t.Run("CustomDepthLimit", func(t *testing.T) {
+		data := map[string]interface{}{"a": map[string]interface{}{"b": []interface{}{1}}}
+
+		assert.NoError(t, ValidateJSONBField(data, "details", 0, 3))
+		err := ValidateJSONBField(data, "details", 0, 2)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "details cannot exceed 2 levels of nesting, got 3")
+	})
 
 	t.Run("InvalidJSONBWithCircularReference", func(t *testing.T) {
 		// This test ensures the function handles circular references gracefully
@@ -187,7 +238,7 @@ func TestValidateJSONBField(t *testing.T) {
 		circularData := make(map[string]interface{})
 		circularData["self"] = circularData // Circular reference
 
-		err := ValidateJSONBField(circularData, "details")
+		err := ValidateJSONBField(circularData, "details", 0, 0)
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "must be a valid JSON object")
 	})
@@ -195,12 +246,12 @@ func TestValidateJSONBField(t *testing.T) {
 	t.Run("EmptyJSONB", func(t *testing.T) {
 		emptyData := map[string]interface{}{}
 
-		err := ValidateJSONBField(emptyData, "details")
+		err := ValidateJSONBField(emptyData, "details", 0, 0)
 		assert.NoError(t, err)
 	})
 
 	t.Run("NilJSONB", func(t *testing.T) {
-		err := ValidateJSONBField(nil, "details")
+		err := ValidateJSONBField(nil, "details", 0, 0)
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "must be a valid JSON object")
 	})
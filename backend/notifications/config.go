@@ -0,0 +1,92 @@
+package notifications
+
+import (
+	"fmt"
+	"net/url"
+	"slices"
+	"time"
+)
+
+// Config holds configuration for outbound notifications
+type Config struct {
+	// Webhooks receive a POST for every report status transition matching their events
+	Webhooks []WebhookConfig `yaml:"webhooks"`
+	// Delivery controls how webhook payloads are sent and retried
+	Delivery DeliveryConfig `yaml:"delivery"`
+}
+
+// WebhookConfig is a receiver of report status transitions
+type WebhookConfig struct {
+	URL string `yaml:"url"`
+	// Events limits the transitions sent to the receiver; empty means every transition
+	Events []string `yaml:"events"`
+}
+
+// Validate checks that every webhook has an HTTP(S) URL and only known events
+func (c Config) Validate() error {
+	for i, webhook := range c.Webhooks {
+		parsed, err := url.Parse(webhook.URL)
+		if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+			return fmt.Errorf("webhook %d has an invalid url %q", i, webhook.URL)
+		}
+		for _, event := range webhook.Events {
+			if !slices.Contains(Events, event) {
+				return fmt.Errorf("webhook %d has an unknown event %q", i, event)
+			}
+		}
+	}
+	return nil
+}
+
+// DeliveryConfig holds configuration for asynchronous webhook delivery
+type DeliveryConfig struct {
+	// MaxRetries is the number of retries after the first failed attempt.
+	// Once exhausted, the payload is recorded in the dead-letter table.
+	MaxRetries int `yaml:"max_retries"`
+	// InitialBackoff is the wait before the first retry; it doubles on every retry
+	InitialBackoff time.Duration `yaml:"initial_backoff"`
+	// MaxBackoff caps the wait between retries
+	MaxBackoff time.Duration `yaml:"max_backoff"`
+	// Timeout bounds a single delivery attempt
+	Timeout time.Duration `yaml:"timeout"`
+	// QueueSize bounds the number of deliveries waiting to be sent
+	QueueSize int `yaml:"queue_size"`
+	// Workers is the number of goroutines sending deliveries
+	Workers int `yaml:"workers"`
+}
+
+// Default delivery settings, used when the configured values are not positive
+const (
+	DefaultMaxRetries     = 3
+	DefaultInitialBackoff = time.Second
+	DefaultMaxBackoff     = 30 * time.Second
+	DefaultTimeout        = 10 * time.Second
+	DefaultQueueSize      = 100
+	DefaultWorkers        = 2
+)
+
+// withDefaults fills in non-positive values. MaxRetries may be zero to disable retries.
+func (c DeliveryConfig) withDefaults() DeliveryConfig {
+	if c.MaxRetries < 0 {
+		c.MaxRetries = 0
+	}
+	if c.InitialBackoff <= 0 {
+		c.InitialBackoff = DefaultInitialBackoff
+	}
+	if c.MaxBackoff <= 0 {
+		c.MaxBackoff = DefaultMaxBackoff
+	}
+	if c.MaxBackoff < c.InitialBackoff {
+		c.MaxBackoff = c.InitialBackoff
+	}
+	if c.Timeout <= 0 {
+		c.Timeout = DefaultTimeout
+	}
+	if c.QueueSize <= 0 {
+		c.QueueSize = DefaultQueueSize
+	}
+	if c.Workers <= 0 {
+		c.Workers = DefaultWorkers
+	}
+	return c
+}
@@ -0,0 +1,250 @@
+package notifications
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/doron-cohen/argus/backend/internal/requestlog"
+	"github.com/doron-cohen/argus/backend/internal/storage"
+	"github.com/google/uuid"
+)
+
+// Events a webhook can subscribe to
+const (
+	// EventStatusChanged matches every status transition
+	EventStatusChanged = "status_changed"
+	// EventCheckFailed is a transition into fail or error from any other status
+	EventCheckFailed = "check_failed"
+	// EventCheckRecovered is a transition from fail or error into pass
+	EventCheckRecovered = "check_recovered"
+)
+
+// Events lists every event a webhook can subscribe to
+var Events = []string{EventStatusChanged, EventCheckFailed, EventCheckRecovered}
+
+// TransitionPayload is the JSON body sent to webhooks when a check's status changes
+type TransitionPayload struct {
+	Event            string    `json:"event"`
+	ComponentID      string    `json:"component_id"`
+	CheckSlug        string    `json:"check_slug"`
+	PreviousStatus   string    `json:"previous_status"`
+	Status           string    `json:"status"`
+	ReportID         string    `json:"report_id"`
+	PreviousReportID string    `json:"previous_report_id"`
+	Timestamp        time.Time `json:"timestamp"`
+}
+
+// transitionEvent returns the most specific event describing a status change
+func transitionEvent(previous, current storage.CheckStatus) string {
+	switch {
+	case failing(current) && !failing(previous):
+		return EventCheckFailed
+	case current == storage.CheckStatusPass && failing(previous):
+		return EventCheckRecovered
+	default:
+		return EventStatusChanged
+	}
+}
+
+func failing(status storage.CheckStatus) bool {
+	return status == storage.CheckStatusFail || status == storage.CheckStatusError
+}
+
+// subscribed reports whether the webhook wants to receive the event
+func (c WebhookConfig) subscribed(event string) bool {
+	return len(c.Events) == 0 || slices.Contains(c.Events, EventStatusChanged) || slices.Contains(c.Events, event)
+}
+
+// checkKey identifies a check of a component
+type checkKey struct {
+	componentID string
+	checkSlug   string
+}
+
+// latestReport is the part of a check's latest report that transitions are computed from
+type latestReport struct {
+	id        uuid.UUID
+	status    storage.CheckStatus
+	timestamp time.Time
+}
+
+func compareCheckKeys(a, b checkKey) int {
+	return cmp.Or(cmp.Compare(a.componentID, b.componentID), cmp.Compare(a.checkSlug, b.checkSlug))
+}
+
+// checkLocks serializes submissions per check, so that no other report for the check is
+// stored between reading its latest report and persisting the ones that replace it
+type checkLocks struct {
+	mu    sync.Mutex
+	locks map[checkKey]*checkLock
+}
+
+type checkLock struct {
+	mu   sync.Mutex
+	refs int
+}
+
+// lock locks every key, in a fixed order so overlapping submissions cannot deadlock,
+// and returns the function that unlocks them
+func (l *checkLocks) lock(keys []checkKey) func() {
+	keys = slices.Clone(keys)
+	slices.SortFunc(keys, compareCheckKeys)
+
+	held := make([]*checkLock, len(keys))
+	for i, key := range keys {
+		l.mu.Lock()
+		if l.locks == nil {
+			l.locks = make(map[checkKey]*checkLock)
+		}
+		lock, ok := l.locks[key]
+		if !ok {
+			lock = &checkLock{}
+			l.locks[key] = lock
+		}
+		lock.refs++
+		l.mu.Unlock()
+
+		lock.mu.Lock()
+		held[i] = lock
+	}
+
+	return func() {
+		for i, lock := range held {
+			lock.mu.Unlock()
+			l.mu.Lock()
+			lock.refs--
+			if lock.refs == 0 {
+				delete(l.locks, keys[i])
+			}
+			l.mu.Unlock()
+		}
+	}
+}
+
+// Snapshot holds the latest stored report of each check about to receive new reports.
+// It is taken before the reports are persisted and consumed by a single Notify call.
+// The checks stay locked until Release, which must be called once the reports are
+// persisted and notified, or persisting them failed.
+type Snapshot struct {
+	latest  map[checkKey]latestReport
+	release func()
+}
+
+// Release unlocks the snapshot's checks for other submissions. Calling it again does nothing.
+func (s Snapshot) Release() {
+	if s.release != nil {
+		s.release()
+	}
+}
+
+// TransitionNotifier sends webhooks when a new report changes the status of a component's check.
+// A nil notifier does nothing, so callers need not check whether webhooks are configured.
+type TransitionNotifier struct {
+	repo       *storage.Repository
+	dispatcher *WebhookDispatcher
+	webhooks   []WebhookConfig
+	locks      checkLocks
+}
+
+// NewTransitionNotifier creates a notifier sending to the webhooks through the dispatcher
+func NewTransitionNotifier(repo *storage.Repository, dispatcher *WebhookDispatcher, webhooks []WebhookConfig) *TransitionNotifier {
+	return &TransitionNotifier{repo: repo, dispatcher: dispatcher, webhooks: webhooks}
+}
+
+// Snapshot locks every check in inputs and loads its latest stored report.
+// Call it before persisting the inputs so Notify can compare against the reports they replace.
+// Concurrent submissions for the same check wait for Release, so each compares against the
+// report actually stored before it. The lock is held by this process only.
+func (n *TransitionNotifier) Snapshot(ctx context.Context, inputs []storage.CreateCheckReportInput) Snapshot {
+	snapshot := Snapshot{latest: make(map[checkKey]latestReport)}
+	if n == nil {
+		return snapshot
+	}
+
+	var keys []checkKey
+	for _, input := range inputs {
+		key := checkKey{componentID: input.ComponentID, checkSlug: input.CheckSlug}
+		if !slices.Contains(keys, key) {
+			keys = append(keys, key)
+		}
+	}
+	snapshot.release = sync.OnceFunc(n.locks.lock(keys))
+
+	for _, key := range keys {
+		report, err := n.repo.GetLatestCheckReport(ctx, key.componentID, key.checkSlug)
+		if err != nil {
+			if !errors.Is(err, storage.ErrReportNotFound) && !errors.Is(err, storage.ErrComponentNotFound) {
+				requestlog.Logger(ctx).Warn("Failed to load latest report for webhooks",
+					"component_id", key.componentID,
+					"check_slug", key.checkSlug,
+					"error", err)
+			}
+			continue
+		}
+		snapshot.latest[key] = latestReport{id: report.ID, status: report.Status, timestamp: report.Timestamp}
+	}
+	return snapshot
+}
+
+// Notify compares each persisted report, in order, with the check's latest report before it
+// and queues a webhook for every status change. reportIDs[i] is the stored ID of inputs[i].
+// Delivery happens in the background, so Notify never waits on a receiver.
+func (n *TransitionNotifier) Notify(snapshot Snapshot, inputs []storage.CreateCheckReportInput, reportIDs []uuid.UUID) {
+	if n == nil {
+		return
+	}
+
+	for i, input := range inputs {
+		key := checkKey{componentID: input.ComponentID, checkSlug: input.CheckSlug}
+		current := latestReport{id: reportIDs[i], status: input.Status, timestamp: input.Timestamp}
+
+		previous, ok := snapshot.latest[key]
+		if ok && current.timestamp.Before(previous.timestamp) {
+			// A backfilled report does not replace the latest one
+			continue
+		}
+		snapshot.latest[key] = current
+
+		if ok && previous.status != current.status {
+			n.send(TransitionPayload{
+				Event:            transitionEvent(previous.status, current.status),
+				ComponentID:      input.ComponentID,
+				CheckSlug:        input.CheckSlug,
+				PreviousStatus:   string(previous.status),
+				Status:           string(current.status),
+				ReportID:         current.id.String(),
+				PreviousReportID: previous.id.String(),
+				Timestamp:        current.timestamp,
+			})
+		}
+	}
+}
+
+// send queues the payload for every webhook subscribed to its event
+func (n *TransitionNotifier) send(payload TransitionPayload) {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		slog.Error("Failed to encode webhook payload", "error", err)
+		return
+	}
+
+	for _, webhook := range n.webhooks {
+		if !webhook.subscribed(payload.Event) {
+			continue
+		}
+		// A payload the dispatcher rejects is already dead-lettered, so it can be replayed
+		if err := n.dispatcher.Send(webhook.URL, body); err != nil {
+			slog.Warn("Status transition webhook not queued",
+				"url", webhook.URL,
+				"component_id", payload.ComponentID,
+				"check_slug", payload.CheckSlug,
+				"error", err)
+		}
+	}
+}
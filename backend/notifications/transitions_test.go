@@ -0,0 +1,240 @@
+package notifications
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/doron-cohen/argus/backend/internal/storage"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// transitionReceiver records the payloads POSTed to it
+type transitionReceiver struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	payloads []TransitionPayload
+}
+
+func newTransitionReceiver(t *testing.T) *transitionReceiver {
+	receiver := &transitionReceiver{}
+	receiver.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var payload TransitionPayload
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&payload))
+		receiver.mu.Lock()
+		receiver.payloads = append(receiver.payloads, payload)
+		receiver.mu.Unlock()
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(receiver.Close)
+	return receiver
+}
+
+func (r *transitionReceiver) received() []TransitionPayload {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]TransitionPayload(nil), r.payloads...)
+}
+
+// storeReports persists inputs around a snapshot and notifies, as the report submission path does
+func storeReports(t *testing.T, repo *storage.Repository, notifier *TransitionNotifier, inputs ...storage.CreateCheckReportInput) {
+	snapshot := notifier.Snapshot(t.Context(), inputs)
+	defer snapshot.Release()
+	reportIDs, err := repo.CreateCheckReportsFromSubmissions(t.Context(), inputs)
+	require.NoError(t, err)
+	notifier.Notify(snapshot, inputs, reportIDs)
+}
+
+func TestTransitionNotifier(t *testing.T) {
+	repo := setupTestRepo(t)
+	require.NoError(t, repo.CreateComponent(t.Context(), storage.Component{ComponentID: "transition-service", Name: "Transition Service"}))
+
+	all := newTransitionReceiver(t)
+	failures := newTransitionReceiver(t)
+
+	dispatcher := NewWebhookDispatcher(repo, testDeliveryConfig(0))
+	dispatcher.Start()
+	defer dispatcher.Stop()
+	notifier := NewTransitionNotifier(repo, dispatcher, []WebhookConfig{
+		{URL: all.URL},
+		{URL: failures.URL, Events: []string{EventCheckFailed}},
+	})
+
+	now := time.Now().UTC().Truncate(time.Second)
+	report := func(status storage.CheckStatus, age time.Duration) storage.CreateCheckReportInput {
+		return storage.CreateCheckReportInput{ComponentID: "transition-service", CheckSlug: "unit-tests", Status: status, Timestamp: now.Add(-age)}
+	}
+
+	// The first report of a check has nothing to transition from
+	storeReports(t, repo, notifier, report(storage.CheckStatusPass, 5*time.Hour))
+	// An unchanged status and a backfilled report are not transitions
+	storeReports(t, repo, notifier, report(storage.CheckStatusPass, 4*time.Hour))
+	storeReports(t, repo, notifier, report(storage.CheckStatusFail, 10*time.Hour))
+	// Reports in one submission are compared in order
+	storeReports(t, repo, notifier, report(storage.CheckStatusFail, 3*time.Hour), report(storage.CheckStatusPass, 2*time.Hour))
+	storeReports(t, repo, notifier, report(storage.CheckStatusSkipped, time.Hour))
+
+	require.Eventually(t, func() bool { return len(all.received()) == 3 }, 2*time.Second, 5*time.Millisecond)
+	payloads := all.received()
+	byEvent := make(map[string]TransitionPayload)
+	for _, payload := range payloads {
+		byEvent[payload.Event] = payload
+	}
+	require.Len(t, byEvent, 3)
+
+	failed := byEvent[EventCheckFailed]
+	assert.Equal(t, "transition-service", failed.ComponentID)
+	assert.Equal(t, "unit-tests", failed.CheckSlug)
+	assert.Equal(t, "pass", failed.PreviousStatus)
+	assert.Equal(t, "fail", failed.Status)
+	assert.True(t, now.Add(-3*time.Hour).Equal(failed.Timestamp))
+	_, err := uuid.Parse(failed.ReportID)
+	assert.NoError(t, err)
+	assert.NotEqual(t, failed.ReportID, failed.PreviousReportID)
+
+	recovered := byEvent[EventCheckRecovered]
+	assert.Equal(t, "fail", recovered.PreviousStatus)
+	assert.Equal(t, "pass", recovered.Status)
+	assert.Equal(t, failed.ReportID, recovered.PreviousReportID)
+
+	changed := byEvent[EventStatusChanged]
+	assert.Equal(t, "pass", changed.PreviousStatus)
+	assert.Equal(t, "skipped", changed.Status)
+
+	require.Eventually(t, func() bool { return len(failures.received()) == 1 }, 2*time.Second, 5*time.Millisecond)
+	assert.Equal(t, EventCheckFailed, failures.received()[0].Event)
+	assert.Empty(t, deadLetters(t, repo))
+}
+
+func TestTransitionNotifier_Nil(t *testing.T) {
+	var notifier *TransitionNotifier
+	inputs := []storage.CreateCheckReportInput{{ComponentID: "any", CheckSlug: "any", Status: storage.CheckStatusFail}}
+
+	snapshot := notifier.Snapshot(t.Context(), inputs)
+	assert.NotPanics(t, func() { notifier.Notify(snapshot, inputs, []uuid.UUID{uuid.New()}) })
+}
+
+func TestConfig_Validate(t *testing.T) {
+	testCases := []struct {
+		name     string
+		webhooks []WebhookConfig
+		wantErr  string
+	}{
+		{name: "no webhooks"},
+		{name: "valid", webhooks: []WebhookConfig{{URL: "https://hooks.example.com/argus", Events: []string{EventCheckFailed, EventCheckRecovered}}}},
+		{name: "missing url", webhooks: []WebhookConfig{{}}, wantErr: "invalid url"},
+		{name: "unsupported scheme", webhooks: []WebhookConfig{{URL: "ftp://hooks.example.com"}}, wantErr: "invalid url"},
+		{name: "unknown event", webhooks: []WebhookConfig{{URL: "http://localhost:9000", Events: []string{"deleted"}}}, wantErr: `unknown event "deleted"`},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := Config{Webhooks: tc.webhooks}.Validate()
+			if tc.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorContains(t, err, tc.wantErr)
+		})
+	}
+}
+
+func TestTransitionNotifier_SnapshotWaitsForRelease(t *testing.T) {
+	repo := setupTestRepo(t)
+	require.NoError(t, repo.CreateComponent(t.Context(), storage.Component{ComponentID: "locked-service", Name: "Locked Service"}))
+	notifier := NewTransitionNotifier(repo, NewWebhookDispatcher(repo, testDeliveryConfig(0)), nil)
+
+	now := time.Now().UTC().Truncate(time.Second)
+	first := []storage.CreateCheckReportInput{{ComponentID: "locked-service", CheckSlug: "lint", Status: storage.CheckStatusPass, Timestamp: now}}
+	snapshot := notifier.Snapshot(t.Context(), first)
+
+	// A second submission for the same check waits until the first is stored
+	taken := make(chan Snapshot)
+	go func() {
+		second := []storage.CreateCheckReportInput{{ComponentID: "locked-service", CheckSlug: "lint", Status: storage.CheckStatusFail, Timestamp: now.Add(time.Second)}}
+		taken <- notifier.Snapshot(t.Context(), second)
+	}()
+
+	// Other checks are not held up
+	other := notifier.Snapshot(t.Context(), []storage.CreateCheckReportInput{{ComponentID: "locked-service", CheckSlug: "build"}})
+	other.Release()
+
+	select {
+	case <-taken:
+		t.Fatal("snapshot of a locked check did not wait for release")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	reportIDs, err := repo.CreateCheckReportsFromSubmissions(t.Context(), first)
+	require.NoError(t, err)
+	notifier.Notify(snapshot, first, reportIDs)
+	snapshot.Release()
+	snapshot.Release()
+
+	select {
+	case second := <-taken:
+		defer second.Release()
+		previous, ok := second.latest[checkKey{componentID: "locked-service", checkSlug: "lint"}]
+		require.True(t, ok, "the second snapshot sees the report stored before it")
+		assert.Equal(t, reportIDs[0], previous.id)
+	case <-time.After(2 * time.Second):
+		t.Fatal("snapshot was not taken after release")
+	}
+}
+
+func TestTransitionNotifier_ConcurrentSubmissions(t *testing.T) {
+	repo := setupTestRepo(t)
+	require.NoError(t, repo.CreateComponent(t.Context(), storage.Component{ComponentID: "busy-service", Name: "Busy Service"}))
+
+	const submissions = 20
+	cfg := testDeliveryConfig(0)
+	cfg.QueueSize = submissions
+	// Workers are not started so every queued payload can be inspected
+	dispatcher := NewWebhookDispatcher(repo, cfg)
+	notifier := NewTransitionNotifier(repo, dispatcher, []WebhookConfig{{URL: "http://127.0.0.1:0"}})
+
+	now := time.Now().UTC().Truncate(time.Second)
+	storeReports(t, repo, notifier, storage.CreateCheckReportInput{ComponentID: "busy-service", CheckSlug: "e2e", Status: storage.CheckStatusPass, Timestamp: now})
+
+	// Submissions alternate between fail and pass and are mostly stored in timestamp order,
+	// so many of them are transitions
+	var sequence atomic.Int64
+	var wg sync.WaitGroup
+	for range submissions {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			n := sequence.Add(1)
+			status := storage.CheckStatusFail
+			if n%2 == 0 {
+				status = storage.CheckStatusPass
+			}
+			storeReports(t, repo, notifier, storage.CreateCheckReportInput{
+				ComponentID: "busy-service",
+				CheckSlug:   "e2e",
+				Status:      status,
+				Timestamp:   now.Add(time.Duration(n) * time.Second),
+			})
+		}()
+	}
+	wg.Wait()
+	close(dispatcher.deliveries)
+
+	// Each stored report is replaced at most once, so no two transitions share a previous report
+	previous := make(map[string]bool)
+	for delivery := range dispatcher.deliveries {
+		var payload TransitionPayload
+		require.NoError(t, json.Unmarshal(delivery.Payload, &payload))
+		assert.False(t, previous[payload.PreviousReportID], "report %s replaced twice", payload.PreviousReportID)
+		previous[payload.PreviousReportID] = true
+		assert.NotEqual(t, payload.PreviousStatus, payload.Status)
+	}
+	assert.NotEmpty(t, previous)
+}
@@ -0,0 +1,253 @@
+package notifications
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/doron-cohen/argus/backend/internal/storage"
+)
+
+// ErrDispatcherFull is returned when the delivery queue has no room for another webhook
+var ErrDispatcherFull = errors.New("webhook delivery queue is full")
+
+// ErrDispatcherClosed is returned when sending after the dispatcher has been stopped
+var ErrDispatcherClosed = errors.New("webhook dispatcher is closed")
+
+// errDispatcherStopped is recorded for deliveries abandoned during shutdown
+var errDispatcherStopped = errors.New("dispatcher stopped before delivery completed")
+
+// Delivery is a single webhook payload addressed to a receiver
+type Delivery struct {
+	URL     string
+	Payload []byte
+}
+
+// statusError is returned when the receiver answers with a non-2xx status
+type statusError struct {
+	StatusCode int
+}
+
+func (e *statusError) Error() string {
+	return fmt.Sprintf("webhook receiver responded with status %d", e.StatusCode)
+}
+
+// retryable reports whether a failed attempt may succeed if repeated.
+// Client errors other than timeouts and rate limiting are permanent.
+func retryable(err error) bool {
+	var se *statusError
+	if errors.As(err, &se) && se.StatusCode >= 400 && se.StatusCode < 500 {
+		return se.StatusCode == http.StatusRequestTimeout || se.StatusCode == http.StatusTooManyRequests
+	}
+	return true
+}
+
+// WebhookDispatcher delivers webhook payloads in the background, retrying failed
+// attempts with exponential backoff. Payloads that cannot be delivered are recorded
+// in the dead-letter table so nothing is silently lost.
+type WebhookDispatcher struct {
+	repo       *storage.Repository
+	client     *http.Client
+	cfg        DeliveryConfig
+	deliveries chan Delivery
+
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	mu      sync.RWMutex
+	closed  bool
+	started bool
+	wg      sync.WaitGroup
+}
+
+// NewWebhookDispatcher creates a new dispatcher. Call Start to begin delivering.
+func NewWebhookDispatcher(repo *storage.Repository, cfg DeliveryConfig) *WebhookDispatcher {
+	cfg = cfg.withDefaults()
+	ctx, cancel := context.WithCancel(context.Background())
+
+	return &WebhookDispatcher{
+		repo:       repo,
+		client:     &http.Client{Timeout: cfg.Timeout},
+		cfg:        cfg,
+		deliveries: make(chan Delivery, cfg.QueueSize),
+		ctx:        ctx,
+		cancel:     cancel,
+	}
+}
+
+// Start launches the delivery workers
+func (d *WebhookDispatcher) Start() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.started {
+		return
+	}
+	d.started = true
+
+	slog.Info("Starting webhook delivery workers", "workers", d.cfg.Workers, "queue_size", cap(d.deliveries))
+	for i := 0; i < d.cfg.Workers; i++ {
+		d.wg.Add(1)
+		go d.work()
+	}
+}
+
+// Send queues a payload for delivery without blocking. A payload the dispatcher cannot take,
+// because the queue is full or the dispatcher is stopped, is dead-lettered with no attempts
+// and the reason is returned.
+func (d *WebhookDispatcher) Send(url string, payload []byte) error {
+	delivery := Delivery{URL: url, Payload: payload}
+	err := d.enqueue(delivery)
+	if err != nil {
+		d.deadLetter(delivery, 0, err)
+	}
+	return err
+}
+
+// enqueue queues a delivery unless the dispatcher is stopped or its queue is full
+func (d *WebhookDispatcher) enqueue(delivery Delivery) error {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	if d.closed {
+		return ErrDispatcherClosed
+	}
+
+	select {
+	case d.deliveries <- delivery:
+		return nil
+	default:
+		return ErrDispatcherFull
+	}
+}
+
+// Stop stops accepting deliveries, abandons pending retries and waits for the
+// workers to finish. Anything not delivered by then is dead-lettered.
+func (d *WebhookDispatcher) Stop() {
+	d.mu.Lock()
+	if d.closed {
+		d.mu.Unlock()
+		return
+	}
+	d.closed = true
+	close(d.deliveries)
+	d.mu.Unlock()
+
+	d.cancel()
+	d.wg.Wait()
+}
+
+// work delivers queued payloads until the queue is closed and drained
+func (d *WebhookDispatcher) work() {
+	defer d.wg.Done()
+	for delivery := range d.deliveries {
+		d.deliver(delivery)
+	}
+}
+
+// deliver attempts a delivery until it succeeds, fails permanently or runs out of retries
+func (d *WebhookDispatcher) deliver(delivery Delivery) {
+	backoff := d.cfg.InitialBackoff
+	attempts := 0
+	var lastErr error
+
+	for attempts <= d.cfg.MaxRetries {
+		if attempts > 0 {
+			if !d.sleep(backoff) {
+				lastErr = errors.Join(errDispatcherStopped, lastErr)
+				break
+			}
+			backoff = min(backoff*2, d.cfg.MaxBackoff)
+		}
+		if d.ctx.Err() != nil {
+			lastErr = errors.Join(errDispatcherStopped, lastErr)
+			break
+		}
+
+		attempts++
+		err := d.send(delivery)
+		if err == nil {
+			if attempts > 1 {
+				slog.Info("Webhook delivered after retry", "url", delivery.URL, "attempts", attempts)
+			}
+			return
+		}
+		lastErr = err
+
+		slog.Warn("Webhook delivery attempt failed",
+			"url", delivery.URL,
+			"attempt", attempts,
+			"max_attempts", d.cfg.MaxRetries+1,
+			"error", err)
+
+		if !retryable(err) {
+			break
+		}
+	}
+
+	d.deadLetter(delivery, attempts, lastErr)
+}
+
+// send performs a single delivery attempt
+func (d *WebhookDispatcher) send(delivery Delivery) error {
+	req, err := http.NewRequestWithContext(d.ctx, http.MethodPost, delivery.URL, bytes.NewReader(delivery.Payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	// Drain the body so the connection can be reused
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return &statusError{StatusCode: resp.StatusCode}
+	}
+	return nil
+}
+
+// sleep waits for the backoff and reports false if the dispatcher stopped meanwhile
+func (d *WebhookDispatcher) sleep(backoff time.Duration) bool {
+	timer := time.NewTimer(backoff)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return true
+	case <-d.ctx.Done():
+		return false
+	}
+}
+
+// deadLetter records an undelivered payload
+func (d *WebhookDispatcher) deadLetter(delivery Delivery, attempts int, lastErr error) {
+	letter := storage.WebhookDeadLetter{
+		URL:      delivery.URL,
+		Payload:  string(delivery.Payload),
+		Attempts: attempts,
+	}
+	if lastErr != nil {
+		letter.LastError = lastErr.Error()
+	}
+
+	slog.Error("Webhook delivery failed, recording dead letter",
+		"url", delivery.URL,
+		"attempts", attempts,
+		"error", lastErr)
+
+	// The dispatcher context may already be cancelled during shutdown
+	if err := d.repo.CreateWebhookDeadLetter(context.Background(), letter); err != nil {
+		slog.Error("Failed to record webhook dead letter",
+			"url", delivery.URL,
+			"payload", string(delivery.Payload),
+			"error", err)
+	}
+}
@@ -0,0 +1,203 @@
+package notifications
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/doron-cohen/argus/backend/internal/storage"
+	"github.com/glebarez/sqlite"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func setupTestRepo(t *testing.T) *storage.Repository {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	require.NoError(t, err)
+	sqlDB, err := db.DB()
+	require.NoError(t, err)
+	sqlDB.SetMaxOpenConns(1)
+
+	repo := &storage.Repository{DB: db}
+	require.NoError(t, repo.Migrate(t.Context()))
+	return repo
+}
+
+func testDeliveryConfig(maxRetries int) DeliveryConfig {
+	return DeliveryConfig{
+		MaxRetries:     maxRetries,
+		InitialBackoff: 5 * time.Millisecond,
+		MaxBackoff:     20 * time.Millisecond,
+		Timeout:        time.Second,
+		QueueSize:      10,
+		Workers:        1,
+	}
+}
+
+func deadLetters(t *testing.T, repo *storage.Repository) []storage.WebhookDeadLetter {
+	var letters []storage.WebhookDeadLetter
+	require.NoError(t, repo.DB.Find(&letters).Error)
+	return letters
+}
+
+func TestWebhookDispatcher_SucceedsAfterRetry(t *testing.T) {
+	repo := setupTestRepo(t)
+
+	var attempts atomic.Int32
+	var received atomic.Value
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if attempts.Add(1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		body, _ := io.ReadAll(r.Body)
+		received.Store(string(body))
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer receiver.Close()
+
+	dispatcher := NewWebhookDispatcher(repo, testDeliveryConfig(3))
+	dispatcher.Start()
+	defer dispatcher.Stop()
+
+	require.NoError(t, dispatcher.Send(receiver.URL, []byte(`{"event":"test"}`)))
+
+	require.Eventually(t, func() bool { return received.Load() != nil }, 2*time.Second, 5*time.Millisecond)
+	assert.Equal(t, `{"event":"test"}`, received.Load())
+	assert.Equal(t, int32(3), attempts.Load())
+	assert.Empty(t, deadLetters(t, repo))
+}
+
+func TestWebhookDispatcher_DeadLetterOnExhaustion(t *testing.T) {
+	repo := setupTestRepo(t)
+
+	var attempts atomic.Int32
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts.Add(1)
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer receiver.Close()
+
+	dispatcher := NewWebhookDispatcher(repo, testDeliveryConfig(2))
+	dispatcher.Start()
+	defer dispatcher.Stop()
+
+	require.NoError(t, dispatcher.Send(receiver.URL, []byte(`{"event":"lost"}`)))
+
+	require.Eventually(t, func() bool { return len(deadLetters(t, repo)) == 1 }, 2*time.Second, 5*time.Millisecond)
+	letter := deadLetters(t, repo)[0]
+	assert.Equal(t, receiver.URL, letter.URL)
+	assert.Equal(t, `{"event":"lost"}`, letter.Payload)
+	assert.Equal(t, 3, letter.Attempts)
+	assert.Contains(t, letter.LastError, "500")
+	assert.Equal(t, int32(3), attempts.Load())
+}
+
+func TestWebhookDispatcher_PermanentFailureSkipsRetries(t *testing.T) {
+	repo := setupTestRepo(t)
+
+	var attempts atomic.Int32
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts.Add(1)
+		w.WriteHeader(http.StatusBadRequest)
+	}))
+	defer receiver.Close()
+
+	dispatcher := NewWebhookDispatcher(repo, testDeliveryConfig(3))
+	dispatcher.Start()
+	defer dispatcher.Stop()
+
+	require.NoError(t, dispatcher.Send(receiver.URL, []byte(`{}`)))
+
+	require.Eventually(t, func() bool { return len(deadLetters(t, repo)) == 1 }, 2*time.Second, 5*time.Millisecond)
+	assert.Equal(t, 1, deadLetters(t, repo)[0].Attempts)
+	assert.Equal(t, int32(1), attempts.Load())
+}
+
+func TestWebhookDispatcher_StopDeadLettersPendingDeliveries(t *testing.T) {
+	repo := setupTestRepo(t)
+
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer receiver.Close()
+
+	cfg := testDeliveryConfig(5)
+	cfg.InitialBackoff = time.Hour
+	cfg.MaxBackoff = time.Hour
+	dispatcher := NewWebhookDispatcher(repo, cfg)
+	dispatcher.Start()
+
+	require.NoError(t, dispatcher.Send(receiver.URL, []byte(`{"event":"shutdown"}`)))
+	time.Sleep(50 * time.Millisecond)
+	dispatcher.Stop()
+
+	letters := deadLetters(t, repo)
+	require.Len(t, letters, 1)
+	assert.Equal(t, `{"event":"shutdown"}`, letters[0].Payload)
+	assert.Contains(t, letters[0].LastError, errDispatcherStopped.Error())
+
+	assert.ErrorIs(t, dispatcher.Send(receiver.URL, []byte(`{}`)), ErrDispatcherClosed)
+}
+
+func TestWebhookDispatcher_SendDeadLettersWhenFull(t *testing.T) {
+	repo := setupTestRepo(t)
+
+	cfg := testDeliveryConfig(0)
+	cfg.QueueSize = 2
+	// Workers are not started so the deliveries stay queued
+	dispatcher := NewWebhookDispatcher(repo, cfg)
+
+	require.NoError(t, dispatcher.Send("http://127.0.0.1:0", []byte(`{"n":1}`)))
+	require.NoError(t, dispatcher.Send("http://127.0.0.1:0", []byte(`{"n":2}`)))
+	assert.Empty(t, deadLetters(t, repo))
+
+	// Sends to a full queue return at once, and the payloads are kept
+	assert.ErrorIs(t, dispatcher.Send("http://127.0.0.1:0", []byte(`{"n":3}`)), ErrDispatcherFull)
+	assert.ErrorIs(t, dispatcher.Send("http://127.0.0.1:0", []byte(`{"n":4}`)), ErrDispatcherFull)
+
+	letters := deadLetters(t, repo)
+	require.Len(t, letters, 2)
+	for i, letter := range letters {
+		assert.Equal(t, "http://127.0.0.1:0", letter.URL)
+		assert.Equal(t, fmt.Sprintf(`{"n":%d}`, i+3), letter.Payload)
+		assert.Equal(t, 0, letter.Attempts)
+		assert.Equal(t, ErrDispatcherFull.Error(), letter.LastError)
+	}
+}
+
+func TestWebhookDispatcher_SendDeadLettersWhenStopped(t *testing.T) {
+	repo := setupTestRepo(t)
+	dispatcher := NewWebhookDispatcher(repo, testDeliveryConfig(0))
+	dispatcher.Start()
+	dispatcher.Stop()
+
+	assert.ErrorIs(t, dispatcher.Send("http://127.0.0.1:0", []byte(`{"event":"late"}`)), ErrDispatcherClosed)
+
+	letters := deadLetters(t, repo)
+	require.Len(t, letters, 1)
+	assert.Equal(t, `{"event":"late"}`, letters[0].Payload)
+	assert.Equal(t, 0, letters[0].Attempts)
+}
+
+func TestDeliveryConfig_WithDefaults(t *testing.T) {
+	cfg := DeliveryConfig{MaxRetries: -1, InitialBackoff: 10 * time.Millisecond}.withDefaults()
+
+	assert.Equal(t, 0, cfg.MaxRetries)
+	assert.Equal(t, 10*time.Millisecond, cfg.InitialBackoff)
+	assert.Equal(t, DefaultMaxBackoff, cfg.MaxBackoff)
+	assert.Equal(t, DefaultTimeout, cfg.Timeout)
+	assert.Equal(t, DefaultQueueSize, cfg.QueueSize)
+	assert.Equal(t, DefaultWorkers, cfg.Workers)
+}
+
+func TestDeliveryConfig_MaxBackoffNotBelowInitial(t *testing.T) {
+	cfg := DeliveryConfig{InitialBackoff: time.Minute, MaxBackoff: time.Second}.withDefaults()
+	assert.Equal(t, time.Minute, cfg.MaxBackoff)
+}
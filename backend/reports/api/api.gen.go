@@ -6,6 +6,7 @@ package api
 import (
 	"bytes"
 	"compress/gzip"
+	"context"
 	"encoding/base64"
 	"fmt"
 	"net/http"
@@ -16,6 +17,23 @@ import (
 
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/go-chi/chi/v5"
+	"github.com/oapi-codegen/runtime"
+)
+
+const (
+	BearerAuthScopes = "bearerAuth.Scopes"
+)
+
+// Defines values for ReportBatchItemResultStatus.
+const (
+	Created ReportBatchItemResultStatus = "created"
+	Failed  ReportBatchItemResultStatus = "failed"
+)
+
+// Defines values for ReportSubmissionMissingComponents.
+const (
+	Reject ReportSubmissionMissingComponents = "reject"
+	Skip   ReportSubmissionMissingComponents = "skip"
 )
 
 // Defines values for ReportSubmissionStatus.
@@ -41,6 +59,12 @@ type Check struct {
 	Slug string `json:"slug"`
 }
 
+// DeleteReportsResponse Result of deleting reports
+type DeleteReportsResponse struct {
+	// Deleted Number of reports deleted
+	Deleted int64 `json:"deleted"`
+}
+
 // Error Error response
 type Error struct {
 	// Code Error code
@@ -51,6 +75,52 @@ type Error struct {
 
 	// Error Error message
 	Error *string `json:"error,omitempty"`
+
+	// FieldErrors Every problem found in the submitted fields, when validation failed. `error` joins
+	// their messages.
+	FieldErrors *[]FieldError `json:"field_errors,omitempty"`
+}
+
+// FieldError A problem with one field of a request
+type FieldError struct {
+	// Field Path of the field, with nested fields separated by dots and list items indexed
+	Field string `json:"field"`
+
+	// Message What is wrong with the field
+	Message string `json:"message"`
+}
+
+// ReportBatchItemResult Outcome of a single item in a batch submission
+type ReportBatchItemResult struct {
+	// Code Error code, when failed. One of VALIDATION_ERROR, NOT_FOUND and FORBIDDEN.
+	Code *string `json:"code,omitempty"`
+
+	// Error Why the item was rejected, when failed
+	Error *string `json:"error,omitempty"`
+
+	// Index Position of the item in the submitted array
+	Index int `json:"index"`
+
+	// ReportId Identifier of the stored report, when created
+	ReportId *string `json:"report_id,omitempty"`
+
+	// Status Whether the item was stored
+	Status ReportBatchItemResultStatus `json:"status"`
+}
+
+// ReportBatchItemResultStatus Whether the item was stored
+type ReportBatchItemResultStatus string
+
+// ReportBatchResponse Per-item outcome of a batch report submission
+type ReportBatchResponse struct {
+	// Failed Number of reports that were rejected
+	Failed int `json:"failed"`
+
+	// Results One result per submitted item, in request order
+	Results []ReportBatchItemResult `json:"results"`
+
+	// Succeeded Number of reports that were stored
+	Succeeded int `json:"succeeded"`
 }
 
 // ReportSubmission A quality check report submission
@@ -58,15 +128,36 @@ type ReportSubmission struct {
 	// Check Information about the check being reported
 	Check Check `json:"check"`
 
-	// ComponentId Unique identifier of the component being reported on
-	ComponentId string `json:"component_id"`
+	// ComponentId Unique identifier of the component being reported on. Exactly one of component_id and component_ids is required.
+	ComponentId string `json:"component_id,omitempty"`
+
+	// ComponentIds Report the same result for several components at once, e.g. a monorepo-wide lint run.
+	// One report is stored per component in a single transaction. Mutually exclusive with component_id.
+	// Multi-component submissions are always stored before responding, even with async ingestion enabled.
+	ComponentIds *[]string `json:"component_ids,omitempty"`
 
 	// Details Check-specific data (coverage %, warnings, etc.)
 	Details *map[string]interface{} `json:"details,omitempty"`
 
+	// DurationMs How long the check run took, in milliseconds
+	DurationMs *int64 `json:"duration_ms,omitempty"`
+
+	// IdempotencyKey Makes retrying a submission safe. When the component already has a report of the same check with
+	// this key, nothing new is stored and the response carries the original report_id with duplicate set.
+	// Submissions with a key are stored before responding, even with async ingestion enabled.
+	// Not supported with component_ids or in batch submissions.
+	IdempotencyKey *string `json:"idempotency_key,omitempty"`
+
 	// Metadata Execution context (CI job, environment, duration)
 	Metadata *map[string]interface{} `json:"metadata,omitempty"`
 
+	// MissingComponents What to do when some of component_ids do not exist. "reject" fails the whole submission with 404,
+	// "skip" stores reports for the components that exist and lists the rest in skipped_components.
+	MissingComponents *ReportSubmissionMissingComponents `json:"missing_components,omitempty"`
+
+	// StartedAt When the check run started. Must not be after timestamp.
+	StartedAt *time.Time `json:"started_at,omitempty"`
+
 	// Status Status of the check execution
 	Status ReportSubmissionStatus `json:"status"`
 
@@ -74,41 +165,114 @@ type ReportSubmission struct {
 	Timestamp time.Time `json:"timestamp"`
 }
 
+// ReportSubmissionMissingComponents What to do when some of component_ids do not exist. "reject" fails the whole submission with 404,
+// "skip" stores reports for the components that exist and lists the rest in skipped_components.
+type ReportSubmissionMissingComponents string
+
 // ReportSubmissionStatus Status of the check execution
 type ReportSubmissionStatus string
 
 // ReportSubmissionResponse Response to a successful report submission
 type ReportSubmissionResponse struct {
+	// Duplicate Set when the idempotency key was already used; report_id is the original report and nothing was stored
+	Duplicate *bool `json:"duplicate,omitempty"`
+
 	// Message Success message
 	Message *string `json:"message,omitempty"`
 
 	// ReportId Unique identifier for the submitted report
 	ReportId *string `json:"report_id,omitempty"`
 
+	// ReportIds Identifiers of the stored reports keyed by component ID, for multi-component submissions
+	ReportIds *map[string]string `json:"report_ids,omitempty"`
+
+	// SkippedComponents Component IDs that did not exist and were skipped (missing_components=skip)
+	SkippedComponents *[]string `json:"skipped_components,omitempty"`
+
 	// Timestamp When the report was received
 	Timestamp *time.Time `json:"timestamp,omitempty"`
 }
 
+// ReportValidationResponse Result of validating a report submission without storing it
+type ReportValidationResponse struct {
+	// CheckSlug Check the report would be recorded against
+	CheckSlug *string `json:"check_slug,omitempty"`
+
+	// ComponentId Component the report would be recorded against
+	ComponentId *string `json:"component_id,omitempty"`
+
+	// Message Human-readable validation summary
+	Message *string `json:"message,omitempty"`
+
+	// Valid Whether the submission passed validation
+	Valid bool `json:"valid"`
+}
+
+// DeleteComponentReportsParams defines parameters for DeleteComponentReports.
+type DeleteComponentReportsParams struct {
+	// Before Only delete reports with a timestamp before this time (ISO 8601, exclusive)
+	Before *time.Time `form:"before,omitempty" json:"before,omitempty"`
+
+	// CheckSlug Only delete reports of this check
+	CheckSlug *string `form:"check_slug,omitempty" json:"check_slug,omitempty"`
+}
+
+// SubmitReportsBatchJSONBody defines parameters for SubmitReportsBatch.
+type SubmitReportsBatchJSONBody = []ReportSubmission
+
 // SubmitReportJSONRequestBody defines body for SubmitReport for application/json ContentType.
 type SubmitReportJSONRequestBody = ReportSubmission
 
+// SubmitReportsBatchJSONRequestBody defines body for SubmitReportsBatch for application/json ContentType.
+type SubmitReportsBatchJSONRequestBody = SubmitReportsBatchJSONBody
+
+// ValidateReportJSONRequestBody defines body for ValidateReport for application/json ContentType.
+type ValidateReportJSONRequestBody = ReportSubmission
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
+	// Delete a component's reports
+	// (DELETE /components/{componentId}/reports)
+	DeleteComponentReports(w http.ResponseWriter, r *http.Request, componentId string, params DeleteComponentReportsParams)
 	// Submit a quality check report
 	// (POST /reports)
 	SubmitReport(w http.ResponseWriter, r *http.Request)
+	// Submit several quality check reports at once
+	// (POST /reports:batch)
+	SubmitReportsBatch(w http.ResponseWriter, r *http.Request)
+	// Validate a quality check report without storing it
+	// (POST /reports:validate)
+	ValidateReport(w http.ResponseWriter, r *http.Request)
 }
 
 // Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
 
 type Unimplemented struct{}
 
+// Delete a component's reports
+// (DELETE /components/{componentId}/reports)
+func (_ Unimplemented) DeleteComponentReports(w http.ResponseWriter, r *http.Request, componentId string, params DeleteComponentReportsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Submit a quality check report
 // (POST /reports)
 func (_ Unimplemented) SubmitReport(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Submit several quality check reports at once
+// (POST /reports:batch)
+func (_ Unimplemented) SubmitReportsBatch(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Validate a quality check report without storing it
+// (POST /reports:validate)
+func (_ Unimplemented) ValidateReport(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // ServerInterfaceWrapper converts contexts to parameters.
 type ServerInterfaceWrapper struct {
 	Handler            ServerInterface
@@ -118,9 +282,65 @@ type ServerInterfaceWrapper struct {
 
 type MiddlewareFunc func(http.Handler) http.Handler
 
+// DeleteComponentReports operation middleware
+func (siw *ServerInterfaceWrapper) DeleteComponentReports(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "componentId" -------------
+	var componentId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "componentId", chi.URLParam(r, "componentId"), &componentId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "componentId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteComponentReportsParams
+
+	// ------------- Optional query parameter "before" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "before", r.URL.Query(), &params.Before)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "before", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "check_slug" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "check_slug", r.URL.Query(), &params.CheckSlug)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "check_slug", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteComponentReports(w, r, componentId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
 // SubmitReport operation middleware
 func (siw *ServerInterfaceWrapper) SubmitReport(w http.ResponseWriter, r *http.Request) {
 
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.SubmitReport(w, r)
 	}))
@@ -132,6 +352,46 @@ func (siw *ServerInterfaceWrapper) SubmitReport(w http.ResponseWriter, r *http.R
 	handler.ServeHTTP(w, r)
 }
 
+// SubmitReportsBatch operation middleware
+func (siw *ServerInterfaceWrapper) SubmitReportsBatch(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SubmitReportsBatch(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ValidateReport operation middleware
+func (siw *ServerInterfaceWrapper) ValidateReport(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ValidateReport(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
 type UnescapedCookieParamError struct {
 	ParamName string
 	Err       error
@@ -245,9 +505,18 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 		ErrorHandlerFunc:   options.ErrorHandlerFunc,
 	}
 
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/components/{componentId}/reports", wrapper.DeleteComponentReports)
+	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/reports", wrapper.SubmitReport)
 	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/reports:batch", wrapper.SubmitReportsBatch)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/reports:validate", wrapper.ValidateReport)
+	})
 
 	return r
 }
@@ -255,29 +524,67 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/7xXa2/buBL9K8Tce4EWkB3asXO7+pY+gDVQbAs33QV2WwgUNbaZSqRKUk6yQf77YqiH",
-	"H9I2TtHdbxZNzpx5nUPegzRFaTRq7yC+Byc3WIjw89UG5Rf6kaGTVpVeGQ0xLPTK2ELQFxOpqTzzG2SS",
-	"NrMUlV4zi6WxHjOIoLSmROsVup6hY7uvd1/MrNjNRuxbzgw6iABvRVHmCDEsK+1YpZVnHp13bGVsvb0N",
-	"ByIoxO1b1Gu/gXjCOY/A35V01nmr9BoeItCiwD6Un6tC6JFFkYk0R0abdvZDVvaRfCQQVwTi0OV0Ph/w",
-	"6PJq3ff4UauvFTKVofZqpdAe+mNkhj3D8XocsU9AYY9C2J+AvtNK5Vn9M1fao/0Ezw8g7g70shJBoXT3",
-	"3QP8EIHFr5WymEH8R43+c7fLpNcoPYX1xlpj+3GFZWbRlUY77DWENBn+3aHw334Qv16+Xby+vFq8+yV5",
-	"s1y+W8JAdjP0QuXBtsgyRQZF/n7Pp7cVRkf+LrudDIPr1sqe93uwKFzYHiqSUCaYcqzNDhM6Y1JobTxL",
-	"kWFR+jtC1MsUfitTBTon1odxP8HfUPl6AJZhPD9UaaGcGxzFS/a1Ernyd0331QPN3O5Ir5AtV/zX4gpi",
-	"+M/ZjlbOGk45qwnlIdpRTqKyU2bBrA5H+4hnWAC0S5io/Gbk0G6VxIGR/Ga/f2cLhdBGrkSpVkqyTHjB",
-	"nkmzRSvWyP4XsRthtdJrFzH0cvz8sLXajUmJVqL21AHxi/l4HkFW2UC1iUNpdOYgnhGr0CgnK6FyGkve",
-	"LpTCOVqYzPlQ4Qv0gpA9LbQ3tyirwMrSaI+3nj17tWDXJo0Y6q2yRheofcRapEexpVZouYEYCqGoTFIl",
-	"1yYNhYfJ9Hw2h9APhfKJ2wiqXion03MysrMeCiTWVCEy3gBKuuQUIS+cD4btvPBVX3/gQ1jvmit0emc7",
-	"+K8K4jzKKkRA2YYIMuVIFEjc3BdVluFXpb9ocxMOhemuWzxHEsHP+63Z2Oo1nVcFOi+Ksg/ztw3qPYQ3",
-	"wjUog+ed6SmfzkZ8MprMryY8Pucx578T7CDWEEMmPI7IDzxG8q3EHYxpl8d9sJ9PoJdlS/69yNp/mDdM",
-	"MFdJic6tqvwEvml5sl/U2sogkS73zHrijZ3L/G6oKjWOE0mqFeyd+fr4AYT5nOOLGecjnP6UjmaTbDYS",
-	"/59cjGazi4v5fDbjnPPv7Y8ma9QgFiWq7Q9tkKM605LSKzMgHu8XIRdNHoioh9SkbiS/VxjHLt8vIIIt",
-	"2lqWYDLmY07hmxK1KBXEcD7mYyKHUvhN6ISz1lx8D6VxfqglCAgTbYIInDjC1M39mF3tpVLlOYnrVuSK",
-	"spOxtPKMFNd5YzEbQ4BWk9Ai63wt28LTVKHzL012V/O89oHN7kGUZa5kOHh27WoRbkoVQglXuqTpoP5l",
-	"od5J3CVY2Do4M64qCmHvIIaXYU+HayvyCg90+9B+2O6ORFdYr1ZC0vn62lxvg/ZOW2Pu6/uxHu8LbGMy",
-	"cepPTIqUpGs8jZro+9o3mXI6X6LOUEuFLpGmooTO5kf69pjsXJwoO6U1WSVbRRhWnsmU19LTKk1H87up",
-	"PZ6+eZg+miK6myek36dVu3vyPFLx7lVyetVPeVI1lT948zTl33tkPKUH/pnLz1N64YdfQZ7QB7zpAzoV",
-	"rsmPXaJ7t/eHQ/mmK1xYqLU1pHjK+Qn0833+O3kPOI4F/luC+xDB7AcCq1+gAygWOvA3a8g4XM7J+fzf",
-	"ce7R0suSeh9t/cAMc78b1U6ghnSSjD78FQAA//8husLDphEAAA==",
+	"H4sIAAAAAAAC/+xae4/bNpD/KgTvDpcAsiNv7LTd4P7YNClqoMkGm20DXBy4tDS2mZVIhaTW6wb+7och",
+	"9Rbttds8rkD+syWKnPf8ZoafaCTTTAoQRtPzT1RHa0iZ/fnzGqIb/BGDjhTPDJeCntOpWEqVMvxH2ELm",
+	"hpg1kAgXkwVwsSIKMqkMxDSgmZIZKMNB9zbq/KXP639ELslmzZo7xxI0DSjcsTRLgJ7Tq1xokgtuiAFt",
+	"NFlK5ZaX7NCApuzuNxArs6bnozAMA2q2GX6rjeJiRXcBFSyFPim/5ikTAwUsZosECC6q97dSaVLyOxJx",
+	"jUS0jzybTDwn6iRf9U/8XfCPORAegzB8yUG1zyO4DXkAw9UwIDOKbA8s2zOK/xc5T2L3M+HCgJrRhy0S",
+	"6w96UgloykX1v0fwLqAKPuZcQUzP3znq31er5OIDRAbZeg4JGLiymtdXoDMptEeyV6DzxKB+Y/ygthbt",
+	"MRbcMe7v8SpPF6Bwj+JTUi5tsDw+C6izU3pOuTBPxrSiGkW0AtVjrtzGx98LpaTq02IfE1Xy2+UhkjHs",
+	"+8i+ayrpj4vfps8vrqeXr+Yvrq4ur6jHemIwjCd2bxbHHDdkyevGmUblEHTOu6hWErBHl7s0Tv9EFTBt",
+	"l1uLm6OmCdekFBBhIiYRE0IasgACaWa2dFeRWEsKDkkqBa3Zqs33Ced5JLLkkMRze6j2nHoLaksyJRcJ",
+	"pGQpcxETLqxj6XyRcmMgJnYLHZDNGgS5ZQmPXXRbMp5APCR/2t3/JB8kF3omzBp4xYkezgQNKDeQ2uP/",
+	"U8GSntP/eFSH1UdFTH30C57jDKkWHFOKbemuflBLsrG+x9hFxdSGmzWRAhwb6BbMChG06dmjXdLf7DXD",
+	"LZZWLHZJ4HYVoGv5EA0ZUwwfLLYklkZbFSVcG2LZJ1zEcAdxX7lDGzY8yivNoUfRW4z+XJONkmLliKmI",
+	"6+9PusZD74tj5UYlAT6Xd8HsGTPRemogdaGrT+plbiKZghO85mKVgJUH2hkjC/zc2ZrW+MGpIaIwy9IW",
+	"L4U9qRsrAvLq8nr+y+Xvr55brfxyefVs+vz5i1fDk0PMHv99u95aFVjWNgxljYKCuEXg39JNQK3leOxS",
+	"al7igersnvs6D2ocHPYjPWoftTnnHvOf1lm3OEgbiSHIfVMwGClgnSRDJ5MQfhyH4QDOfloMxqN4PGA/",
+	"jJ4MxuMnTyaT8TgMw9DHrzbM5NonZDBrUG1BO2LwXJGnaLw1JYXQ37ekXr097AJO5hUt93jA/pT+GtTA",
+	"0iqbjuDs3gnwkPkXHByR5A2GhA0oqCyvqYqRX+fosh45oxe5lyQD1bAlZCRAEysiKJEqBnVsgPdHjF6s",
+	"D6jOowggPpXx2hJKts/uBTWlDJqHNg1nj9Lf1Crz5J6POUu42Rbg9H4tR2UpcUh6rt7YBXVF4vXWPlQu",
+	"nLb6rFOGECmG5MUdi0yytYlSLknzCIc0Gg90M1y1AyjLzXqgQd3yCDxo/xCUDujdYCUH+HCgb3g2kJkD",
+	"ZYNMWtDuwFuHf+3D0FbcNk5hZVIYMhYMGm5BsaTmRhNmiBQRBASLB8JIKoVEyQw2PAaC5QJRuRjOhPMJ",
+	"uzUvg471jlquXNQpzigmNIuQpiF5mZucJcmWwF2U5JrfgsvZTU6GM/EyTwwf1PvVFqMJU0BYsmHb6uwF",
+	"LKX1dow8MRergMAtCLcz01sRES5WoG2GAIGlWuzQWKWvd12FLXiScLGqnrxvePYpytxZ3U/dp2UVVf7t",
+	"+/vfgu3WHwY6g4gveURiZhh5EEnU8ArIfwVkw5TgYqUDAiYaPmzD+XLhPAMVgTAWZv04GU4CGufKAty5",
+	"hkgKNLExVqqgjZ6X8TgsH2RMa3wwmoQ+jFrtlXos9Ve5IQkCuLqQVbkgRsobG2RT1EZJRLN4m9hqvVe/",
+	"pVzwNE8ddb1oz2NIM2lARNv5DWz75LxkN4CObdQW4wNr2B/RbAlD8hYTfTuWsAT7AFuyZpqw0kHksnY/",
+	"xxcaJZYGXJMb2AZESLPGQwRsGu6EkcasS5vWQCKmFAdtH0rFVxyLtAqqOFOP8yzhETNANJjhTLxpeI3z",
+	"BTzSOtA/85xXEl0yK6Jmz4E1kQq11oW0uuN0NOIDlYvB6OzxeDJgxmDtNhjR4GQPA8PQ6k9zmxd3EOX4",
+	"m0RSGLgz5MHPU/JBLgIC4pYrKVIQJiCl5Xb8ZqGYiNb0nKaMI1sRn3+QC5uJqOWI2gCdcjPXa4ZOvohG",
+	"Z49xk3p3ywZbubAPJUHzlrNYI/e5lBWrWM3bjbkYlszWH9TBHxr4SiYjSSwdXtUFFmurMJZomgTuuDZD",
+	"Mis2m1GL350dbtYygaZvWEsYh+NgJmYUU9eMOkvTFUbpdd8K2GLPqepEXRq/TSW4UwZxg8/CkAqYW/GJ",
+	"C9sY1z7x42q03TkzXmwtOoGoWI75SxtSdBrY0oAihqegDUuzdv4/C8/Gg3A0GE2uR+H52U/no8n/0kak",
+	"ipmBAX57Cup/Y59XMMaSVxlNQyAYigvshtrn2jpuIaDM/srFjZAbQcsyzoGJqq1VM1Ls1aOx4vteAWJp",
+	"4qiE+ICMHofnYXisjDrYtey1FoJrUncMcj3YhrRv0F8wDUQRaL3MkyOgbBWNPXoEQzalkBrZyIZnFFeZ",
+	"S3IN8dNGmOfeBGDdpswj7UKwlLWLf4UgFlImwMTBvsobx6q3D3fV4N0WQ7VcEm/r7UBJvb+fXW/vPv8s",
+	"9XRFyQGI9cnXTfW3AbS3D2Azu+t+1fBg+jywrKX7oW07v7QA6dEcd3HrUR+OvP3Zftjtq+/nBn9FJI95",
+	"XGcOa5quJHW7kQf9rPU/+O5hG44nsGLR1gu/+6GoA6KPCU2F57j+VAT89rPGpj0R54+qZXzM4KNsMFsE",
+	"2os3NtnK3FjTwzXc+KvpuX+QZIuGlihkniAiRHlIFSMIXTEutNk/IOpp4nBBXhvLycd2qrPj+8OdCV2j",
+	"aa/zNGVq64tsXLuFvpPci4MNuYaSXFHUOPb+oNxJbe68fhZDB4UoV9xs32BfxGl8AUyBusjNuk/ihSAX",
+	"r6c2xyyVTAnKdMgyjjWQxoZxsq2HKjY9FctdzR1JseSrXEH8dCZcJZGyLWou4SnHOG1kE9ZZMLjiWE40",
+	"onumYMnvymmI7ehY7i3dtbzXxmR0t7Md36X08PJ66voYLkmg/ftaTQ4KmIZuNTJFA3oLyvWs6GgYDkPU",
+	"rMxAsIzTc/p4GA4RqGfMrK1Ym12oT9Xvabx7VJ5TzSF982p8XuWGZvu/2srlhggtIM9s0FRgQLimyVX9",
+	"pUQjm4lm20YBEdjNIUbm0RrisoQrIt2W6DWuYU4wQ/K2iBtLnhhQ2i5X25loV6yNXk41NnU6wwBjTXka",
+	"V8xVnn1Vz2iZYingCfT83emtuUPez4XFpWZNy7k8beiENv3HuZhrHHpyxy7oN3yTLYnbGitq5yqtlGWz",
+	"LeLxKXkwfXNJfnwSjoK6sfXQm0/C0XWIyaTIJ5aVjzmobc2L2502yT4u7RzDi5Uz157bCa3I7qOrkU0O",
+	"ifQ9yt9lN+sWZ2FIbZtJGFvwfqIsc8iYS/Hog3aN43q/Q51f/+UBGyd8rc/KctG9x5+RjmI22z93Kmy8",
+	"JlZ0pOEDloDRlyfgpUNXrgPjaCmD/oNDMf2ho/Dxl6fweg0VSVxbqMiSRG5cAkmZYKuOuTbDgqVy/OWp",
+	"rLEKEmjvA+DZk69jRAYUlncY8kC5uxitjG9DajPXv3uPfldCmirnsFpy/131YOxOzcSVSW18FSCm1hp6",
+	"YoJinSxbNR+G5LoB6XiSIC4oUA9WQrmToyuThr0s4s66Kuu8Yqb2TMbbI6RdhDCHf/CC09zR4RmWu5XF",
+	"4BGXeuv4WozP7JqKrluW5NAaU7X3t8t1ty+sDF+yyNSB1C6j5Q0vR3MfPXezXnM0UGw51/wvmKcLbLoP",
+	"z4KC+37XfnQW4vcZiBhExEHPI5mjQMeTTvf0vqbmkyObmpmScR5VgNfb1xydha6xWba7ql5TXb9167CJ",
+	"zZtowOV4ap5wYY7RuBT1FKse+fmHYS0j6A6jDphDoeDfuDC1fvFQ2p/WHTNyaui8nOHQ83BP67dsdh4v",
+	"znFYiROz/9yANsc5T3Wf8h4Hqq48Hu9Ex9zXLOTculBZSLsBY05xqS8zBTvFtT77vOAEtyrtYBccmbt6",
+	"s//dbtfF3bsvCAT3dnD3YsF97cpdgML4loTVaRLrvo855GVcMtJOcB90J3GpjOHhV8e05U0Xa9Df8exx",
+	"eNZZ3f7xlyVz9BXIvCq0t5CxvX0BUCGVUmYE4YTr5liyzn76GsZVGrU1fJQg+mXgpu8kYQbUAQf4lngc",
+	"T3/8TSSk17nrecVyI/ZK55R6oQL7vi5aq2A4t1P9e8uGPEPrH4VhZfpcWAhWRJEhcTeu3U1N3QmC3OgG",
+	"gk6kvIEYt3StkZlgYuumXdWNiafWlu0uxSVjZjs0IIoF++8kXTfvWbjpM8N2WHXrz3/Xb0js/T3QZLHF",
+	"DGsJ6KrCqszXN2tWPNpudHLdU9vVCXcOmyn7lKtJ3yK9t++TelzjIknqJl3j3qPN6T98bWreyBTa5JT3",
+	"T5/W1xk6ZH6TBG5TgFTl/Rz+F3xP558znX+N/tRFfWYxxEzlrZ3b8MTGvUK7+M7dci3jpOeiwP9fDPJv",
+	"6bcVWa/sJHhnUeUN23Y6LRPf/ox6lYv6EmFjgNm8Z1iX35iooiTHy3ydRpQdyIOIins5QTVBzkBprt2g",
+	"uUis/RZdMbuGv9mk+zeVlZ4x/f7qrT/h/V6XfQ/k/3jQ8D0e/6N4XEarPRWN7/LMbrfb/d8AerbhiOU+",
+	"AAA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -0,0 +1,70 @@
+package api
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/doron-cohen/argus/backend/auth"
+	"github.com/doron-cohen/argus/backend/internal/utils"
+	"github.com/doron-cohen/argus/backend/reports/api/client"
+)
+
+// apiKeyContextKey stores the API key that authenticated a request
+type apiKeyContextKey struct{}
+
+// RequireAPIKey rejects requests without a valid "Authorization: Bearer <key>" header.
+// With no keys configured, authentication is disabled and every request passes.
+func RequireAPIKey(keys []auth.APIKey) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		if len(keys) == 0 {
+			return next
+		}
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			provided, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+			var matched *auth.APIKey
+			if ok {
+				matched = matchAPIKey(keys, provided)
+			}
+			if matched == nil {
+				w.Header().Set("WWW-Authenticate", "Bearer")
+				writeAuthError(w, "Missing or invalid API key", "UNAUTHORIZED", http.StatusUnauthorized)
+				return
+			}
+			next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), apiKeyContextKey{}, matched)))
+		})
+	}
+}
+
+// matchAPIKey returns the key equal to provided, comparing against every key in constant time
+func matchAPIKey(keys []auth.APIKey, provided string) *auth.APIKey {
+	var matched *auth.APIKey
+	for i := range keys {
+		if subtle.ConstantTimeCompare([]byte(provided), []byte(keys[i].Key)) == 1 {
+			matched = &keys[i]
+		}
+	}
+	return matched
+}
+
+// componentForbidden returns an error message when the request's API key may not report on the component
+func componentForbidden(ctx context.Context, componentID string) (string, bool) {
+	key, ok := ctx.Value(apiKeyContextKey{}).(*auth.APIKey)
+	if !ok || key.Allows(componentID) {
+		return "", false
+	}
+	return fmt.Sprintf("API key is not allowed to submit reports for component %s", componentID), true
+}
+
+// writeAuthError sends a JSON error response from the auth middleware
+func writeAuthError(w http.ResponseWriter, message, code string, statusCode int) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	_ = json.NewEncoder(w).Encode(client.Error{
+		Error: utils.ToPointer(message),
+		Code:  utils.ToPointer(code),
+	})
+}
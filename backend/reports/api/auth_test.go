@@ -0,0 +1,125 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/doron-cohen/argus/backend/auth"
+	"github.com/doron-cohen/argus/backend/internal/storage"
+	"github.com/doron-cohen/argus/backend/reports"
+	reportsclient "github.com/doron-cohen/argus/backend/reports/api/client"
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newAuthenticatedHandler(t *testing.T, keys []auth.APIKey) http.Handler {
+	mockRepo := NewMockRepository(t)
+	for _, id := range []string{"payments-api", "payments-worker", "search-api"} {
+		if _, err := mockRepo.GetComponentByID(context.Background(), id); err == storage.ErrComponentNotFound {
+			require.NoError(t, mockRepo.CreateComponent(context.Background(), storage.Component{ComponentID: id, Name: id}))
+		}
+	}
+
+	router := chi.NewRouter()
+	router.Use(RequireAPIKey(keys))
+	return HandlerFromMux(NewAPIServer(mockRepo.Repository, reports.Config{}, nil, nil), router)
+}
+
+func postReport(handler http.Handler, path, key, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest("POST", path, strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	if key != "" {
+		req.Header.Set("Authorization", "Bearer "+key)
+	}
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, req)
+	return w
+}
+
+func reportFor(componentID string) string {
+	return `{"check":{"slug":"auth-unit"},"component_id":"` + componentID + `","status":"pass","timestamp":"2024-01-15T10:30:00Z"}`
+}
+
+func TestRequireAPIKey(t *testing.T) {
+	handler := newAuthenticatedHandler(t, []auth.APIKey{
+		{Name: "ci", Key: "ci-secret"},
+		{Name: "payments", Key: "payments-secret", ComponentPrefixes: []string{"payments-"}},
+	})
+
+	t.Run("missing key", func(t *testing.T) {
+		w := postReport(handler, "/reports", "", reportFor("search-api"))
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+		assert.Equal(t, "Bearer", w.Header().Get("WWW-Authenticate"))
+
+		var response reportsclient.Error
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.Equal(t, "UNAUTHORIZED", *response.Code)
+	})
+
+	t.Run("invalid key", func(t *testing.T) {
+		w := postReport(handler, "/reports", "wrong-secret", reportFor("search-api"))
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+
+	t.Run("unscoped key", func(t *testing.T) {
+		w := postReport(handler, "/reports", "ci-secret", reportFor("search-api"))
+		assert.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	})
+
+	t.Run("scoped key within scope", func(t *testing.T) {
+		w := postReport(handler, "/reports", "payments-secret", reportFor("payments-api"))
+		assert.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	})
+
+	t.Run("scoped key outside scope", func(t *testing.T) {
+		w := postReport(handler, "/reports", "payments-secret", reportFor("search-api"))
+		assert.Equal(t, http.StatusForbidden, w.Code)
+
+		var response reportsclient.Error
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.Equal(t, "FORBIDDEN", *response.Code)
+	})
+
+	t.Run("scoped key with a component outside scope in component_ids", func(t *testing.T) {
+		body := `{"check":{"slug":"auth-unit"},"component_ids":["payments-api","search-api"],"status":"pass","timestamp":"2024-01-15T10:30:00Z"}`
+		w := postReport(handler, "/reports", "payments-secret", body)
+		assert.Equal(t, http.StatusForbidden, w.Code)
+	})
+
+	t.Run("scoped key validation", func(t *testing.T) {
+		w := postReport(handler, "/reports:validate", "payments-secret", reportFor("search-api"))
+		assert.Equal(t, http.StatusForbidden, w.Code)
+	})
+
+	t.Run("scoped key batch", func(t *testing.T) {
+		body := "[" + reportFor("payments-worker") + "," + reportFor("search-api") + "]"
+		w := postReport(handler, "/reports:batch", "payments-secret", body)
+		require.Equal(t, http.StatusMultiStatus, w.Code, w.Body.String())
+
+		var response reportsclient.ReportBatchResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.Equal(t, reportsclient.Created, response.Results[0].Status)
+		assert.Equal(t, reportsclient.Failed, response.Results[1].Status)
+		assert.Equal(t, "FORBIDDEN", *response.Results[1].Code)
+	})
+
+	t.Run("scoped key delete", func(t *testing.T) {
+		req := httptest.NewRequest("DELETE", "/components/search-api/reports", nil)
+		req.Header.Set("Authorization", "Bearer payments-secret")
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, req)
+		assert.Equal(t, http.StatusForbidden, w.Code)
+	})
+}
+
+func TestRequireAPIKey_DisabledWithoutKeys(t *testing.T) {
+	handler := newAuthenticatedHandler(t, nil)
+
+	w := postReport(handler, "/reports", "", reportFor("search-api"))
+	assert.Equal(t, http.StatusOK, w.Code, w.Body.String())
+}
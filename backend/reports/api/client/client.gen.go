@@ -13,6 +13,24 @@ import (
 	"net/url"
 	"strings"
 	"time"
+
+	"github.com/oapi-codegen/runtime"
+)
+
+const (
+	BearerAuthScopes = "bearerAuth.Scopes"
+)
+
+// Defines values for ReportBatchItemResultStatus.
+const (
+	Created ReportBatchItemResultStatus = "created"
+	Failed  ReportBatchItemResultStatus = "failed"
+)
+
+// Defines values for ReportSubmissionMissingComponents.
+const (
+	Reject ReportSubmissionMissingComponents = "reject"
+	Skip   ReportSubmissionMissingComponents = "skip"
 )
 
 // Defines values for ReportSubmissionStatus.
@@ -38,6 +56,12 @@ type Check struct {
 	Slug string `json:"slug"`
 }
 
+// DeleteReportsResponse Result of deleting reports
+type DeleteReportsResponse struct {
+	// Deleted Number of reports deleted
+	Deleted int64 `json:"deleted"`
+}
+
 // Error Error response
 type Error struct {
 	// Code Error code
@@ -48,6 +72,52 @@ type Error struct {
 
 	// Error Error message
 	Error *string `json:"error,omitempty"`
+
+	// FieldErrors Every problem found in the submitted fields, when validation failed. `error` joins
+	// their messages.
+	FieldErrors *[]FieldError `json:"field_errors,omitempty"`
+}
+
+// FieldError A problem with one field of a request
+type FieldError struct {
+	// Field Path of the field, with nested fields separated by dots and list items indexed
+	Field string `json:"field"`
+
+	// Message What is wrong with the field
+	Message string `json:"message"`
+}
+
+// ReportBatchItemResult Outcome of a single item in a batch submission
+type ReportBatchItemResult struct {
+	// Code Error code, when failed. One of VALIDATION_ERROR, NOT_FOUND and FORBIDDEN.
+	Code *string `json:"code,omitempty"`
+
+	// Error Why the item was rejected, when failed
+	Error *string `json:"error,omitempty"`
+
+	// Index Position of the item in the submitted array
+	Index int `json:"index"`
+
+	// ReportId Identifier of the stored report, when created
+	ReportId *string `json:"report_id,omitempty"`
+
+	// Status Whether the item was stored
+	Status ReportBatchItemResultStatus `json:"status"`
+}
+
+// ReportBatchItemResultStatus Whether the item was stored
+type ReportBatchItemResultStatus string
+
+// ReportBatchResponse Per-item outcome of a batch report submission
+type ReportBatchResponse struct {
+	// Failed Number of reports that were rejected
+	Failed int `json:"failed"`
+
+	// Results One result per submitted item, in request order
+	Results []ReportBatchItemResult `json:"results"`
+
+	// Succeeded Number of reports that were stored
+	Succeeded int `json:"succeeded"`
 }
 
 // ReportSubmission A quality check report submission
@@ -55,15 +125,36 @@ type ReportSubmission struct {
 	// Check Information about the check being reported
 	Check Check `json:"check"`
 
-	// ComponentId Unique identifier of the component being reported on
-	ComponentId string `json:"component_id"`
+	// ComponentId Unique identifier of the component being reported on. Exactly one of component_id and component_ids is required.
+	ComponentId string `json:"component_id,omitempty"`
+
+	// ComponentIds Report the same result for several components at once, e.g. a monorepo-wide lint run.
+	// One report is stored per component in a single transaction. Mutually exclusive with component_id.
+	// Multi-component submissions are always stored before responding, even with async ingestion enabled.
+	ComponentIds *[]string `json:"component_ids,omitempty"`
 
 	// Details Check-specific data (coverage %, warnings, etc.)
 	Details *map[string]interface{} `json:"details,omitempty"`
 
+	// DurationMs How long the check run took, in milliseconds
+	DurationMs *int64 `json:"duration_ms,omitempty"`
+
+	// IdempotencyKey Makes retrying a submission safe. When the component already has a report of the same check with
+	// this key, nothing new is stored and the response carries the original report_id with duplicate set.
+	// Submissions with a key are stored before responding, even with async ingestion enabled.
+	// Not supported with component_ids or in batch submissions.
+	IdempotencyKey *string `json:"idempotency_key,omitempty"`
+
 	// Metadata Execution context (CI job, environment, duration)
 	Metadata *map[string]interface{} `json:"metadata,omitempty"`
 
+	// MissingComponents What to do when some of component_ids do not exist. "reject" fails the whole submission with 404,
+	// "skip" stores reports for the components that exist and lists the rest in skipped_components.
+	MissingComponents *ReportSubmissionMissingComponents `json:"missing_components,omitempty"`
+
+	// StartedAt When the check run started. Must not be after timestamp.
+	StartedAt *time.Time `json:"started_at,omitempty"`
+
 	// Status Status of the check execution
 	Status ReportSubmissionStatus `json:"status"`
 
@@ -71,24 +162,70 @@ type ReportSubmission struct {
 	Timestamp time.Time `json:"timestamp"`
 }
 
+// ReportSubmissionMissingComponents What to do when some of component_ids do not exist. "reject" fails the whole submission with 404,
+// "skip" stores reports for the components that exist and lists the rest in skipped_components.
+type ReportSubmissionMissingComponents string
+
 // ReportSubmissionStatus Status of the check execution
 type ReportSubmissionStatus string
 
 // ReportSubmissionResponse Response to a successful report submission
 type ReportSubmissionResponse struct {
+	// Duplicate Set when the idempotency key was already used; report_id is the original report and nothing was stored
+	Duplicate *bool `json:"duplicate,omitempty"`
+
 	// Message Success message
 	Message *string `json:"message,omitempty"`
 
 	// ReportId Unique identifier for the submitted report
 	ReportId *string `json:"report_id,omitempty"`
 
+	// ReportIds Identifiers of the stored reports keyed by component ID, for multi-component submissions
+	ReportIds *map[string]string `json:"report_ids,omitempty"`
+
+	// SkippedComponents Component IDs that did not exist and were skipped (missing_components=skip)
+	SkippedComponents *[]string `json:"skipped_components,omitempty"`
+
 	// Timestamp When the report was received
 	Timestamp *time.Time `json:"timestamp,omitempty"`
 }
 
+// ReportValidationResponse Result of validating a report submission without storing it
+type ReportValidationResponse struct {
+	// CheckSlug Check the report would be recorded against
+	CheckSlug *string `json:"check_slug,omitempty"`
+
+	// ComponentId Component the report would be recorded against
+	ComponentId *string `json:"component_id,omitempty"`
+
+	// Message Human-readable validation summary
+	Message *string `json:"message,omitempty"`
+
+	// Valid Whether the submission passed validation
+	Valid bool `json:"valid"`
+}
+
+// DeleteComponentReportsParams defines parameters for DeleteComponentReports.
+type DeleteComponentReportsParams struct {
+	// Before Only delete reports with a timestamp before this time (ISO 8601, exclusive)
+	Before *time.Time `form:"before,omitempty" json:"before,omitempty"`
+
+	// CheckSlug Only delete reports of this check
+	CheckSlug *string `form:"check_slug,omitempty" json:"check_slug,omitempty"`
+}
+
+// SubmitReportsBatchJSONBody defines parameters for SubmitReportsBatch.
+type SubmitReportsBatchJSONBody = []ReportSubmission
+
 // SubmitReportJSONRequestBody defines body for SubmitReport for application/json ContentType.
 type SubmitReportJSONRequestBody = ReportSubmission
 
+// SubmitReportsBatchJSONRequestBody defines body for SubmitReportsBatch for application/json ContentType.
+type SubmitReportsBatchJSONRequestBody = SubmitReportsBatchJSONBody
+
+// ValidateReportJSONRequestBody defines body for ValidateReport for application/json ContentType.
+type ValidateReportJSONRequestBody = ReportSubmission
+
 // RequestEditorFn  is the function signature for the RequestEditor callback function
 type RequestEditorFn func(ctx context.Context, req *http.Request) error
 
@@ -162,10 +299,35 @@ func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
 
 // The interface specification for the client above.
 type ClientInterface interface {
+	// DeleteComponentReports request
+	DeleteComponentReports(ctx context.Context, componentId string, params *DeleteComponentReportsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// SubmitReportWithBody request with any body
 	SubmitReportWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	SubmitReport(ctx context.Context, body SubmitReportJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SubmitReportsBatchWithBody request with any body
+	SubmitReportsBatchWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SubmitReportsBatch(ctx context.Context, body SubmitReportsBatchJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ValidateReportWithBody request with any body
+	ValidateReportWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ValidateReport(ctx context.Context, body ValidateReportJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+}
+
+func (c *Client) DeleteComponentReports(ctx context.Context, componentId string, params *DeleteComponentReportsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteComponentReportsRequest(c.Server, componentId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
 func (c *Client) SubmitReportWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
@@ -192,6 +354,126 @@ func (c *Client) SubmitReport(ctx context.Context, body SubmitReportJSONRequestB
 	return c.Client.Do(req)
 }
 
+func (c *Client) SubmitReportsBatchWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSubmitReportsBatchRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SubmitReportsBatch(ctx context.Context, body SubmitReportsBatchJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSubmitReportsBatchRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ValidateReportWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewValidateReportRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ValidateReport(ctx context.Context, body ValidateReportJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewValidateReportRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewDeleteComponentReportsRequest generates requests for DeleteComponentReports
+func NewDeleteComponentReportsRequest(server string, componentId string, params *DeleteComponentReportsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "componentId", runtime.ParamLocationPath, componentId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/components/%s/reports", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Before != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "before", runtime.ParamLocationQuery, *params.Before); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.CheckSlug != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "check_slug", runtime.ParamLocationQuery, *params.CheckSlug); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
 // NewSubmitReportRequest calls the generic SubmitReport builder with application/json body
 func NewSubmitReportRequest(server string, body SubmitReportJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
@@ -232,6 +514,86 @@ func NewSubmitReportRequestWithBody(server string, contentType string, body io.R
 	return req, nil
 }
 
+// NewSubmitReportsBatchRequest calls the generic SubmitReportsBatch builder with application/json body
+func NewSubmitReportsBatchRequest(server string, body SubmitReportsBatchJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSubmitReportsBatchRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewSubmitReportsBatchRequestWithBody generates requests for SubmitReportsBatch with any type of body
+func NewSubmitReportsBatchRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/reports:batch")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewValidateReportRequest calls the generic ValidateReport builder with application/json body
+func NewValidateReportRequest(server string, body ValidateReportJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewValidateReportRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewValidateReportRequestWithBody generates requests for ValidateReport with any type of body
+func NewValidateReportRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/reports:validate")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
 func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
 	for _, r := range c.RequestEditors {
 		if err := r(ctx, req); err != nil {
@@ -275,18 +637,64 @@ func WithBaseURL(baseURL string) ClientOption {
 
 // ClientWithResponsesInterface is the interface specification for the client with responses above.
 type ClientWithResponsesInterface interface {
+	// DeleteComponentReportsWithResponse request
+	DeleteComponentReportsWithResponse(ctx context.Context, componentId string, params *DeleteComponentReportsParams, reqEditors ...RequestEditorFn) (*DeleteComponentReportsResponse, error)
+
 	// SubmitReportWithBodyWithResponse request with any body
 	SubmitReportWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SubmitReportResponse, error)
 
 	SubmitReportWithResponse(ctx context.Context, body SubmitReportJSONRequestBody, reqEditors ...RequestEditorFn) (*SubmitReportResponse, error)
+
+	// SubmitReportsBatchWithBodyWithResponse request with any body
+	SubmitReportsBatchWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SubmitReportsBatchResponse, error)
+
+	SubmitReportsBatchWithResponse(ctx context.Context, body SubmitReportsBatchJSONRequestBody, reqEditors ...RequestEditorFn) (*SubmitReportsBatchResponse, error)
+
+	// ValidateReportWithBodyWithResponse request with any body
+	ValidateReportWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ValidateReportResponse, error)
+
+	ValidateReportWithResponse(ctx context.Context, body ValidateReportJSONRequestBody, reqEditors ...RequestEditorFn) (*ValidateReportResponse, error)
+}
+
+type DeleteComponentReportsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DeleteReportsResponse
+	JSON400      *Error
+	JSON401      *Error
+	JSON403      *Error
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteComponentReportsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteComponentReportsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
 }
 
 type SubmitReportResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 	JSON200      *ReportSubmissionResponse
+	JSON202      *ReportSubmissionResponse
 	JSON400      *Error
+	JSON401      *Error
+	JSON403      *Error
+	JSON413      *Error
+	JSON429      *Error
 	JSON500      *Error
+	JSON503      *Error
 }
 
 // Status returns HTTPResponse.Status
@@ -305,6 +713,72 @@ func (r SubmitReportResponse) StatusCode() int {
 	return 0
 }
 
+type SubmitReportsBatchResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ReportBatchResponse
+	JSON207      *ReportBatchResponse
+	JSON400      *Error
+	JSON401      *Error
+	JSON403      *Error
+	JSON404      *Error
+	JSON413      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r SubmitReportsBatchResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SubmitReportsBatchResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ValidateReportResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ReportValidationResponse
+	JSON400      *Error
+	JSON401      *Error
+	JSON403      *Error
+	JSON404      *Error
+	JSON413      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ValidateReportResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ValidateReportResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// DeleteComponentReportsWithResponse request returning *DeleteComponentReportsResponse
+func (c *ClientWithResponses) DeleteComponentReportsWithResponse(ctx context.Context, componentId string, params *DeleteComponentReportsParams, reqEditors ...RequestEditorFn) (*DeleteComponentReportsResponse, error) {
+	rsp, err := c.DeleteComponentReports(ctx, componentId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteComponentReportsResponse(rsp)
+}
+
 // SubmitReportWithBodyWithResponse request with arbitrary body returning *SubmitReportResponse
 func (c *ClientWithResponses) SubmitReportWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SubmitReportResponse, error) {
 	rsp, err := c.SubmitReportWithBody(ctx, contentType, body, reqEditors...)
@@ -322,6 +796,101 @@ func (c *ClientWithResponses) SubmitReportWithResponse(ctx context.Context, body
 	return ParseSubmitReportResponse(rsp)
 }
 
+// SubmitReportsBatchWithBodyWithResponse request with arbitrary body returning *SubmitReportsBatchResponse
+func (c *ClientWithResponses) SubmitReportsBatchWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SubmitReportsBatchResponse, error) {
+	rsp, err := c.SubmitReportsBatchWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSubmitReportsBatchResponse(rsp)
+}
+
+func (c *ClientWithResponses) SubmitReportsBatchWithResponse(ctx context.Context, body SubmitReportsBatchJSONRequestBody, reqEditors ...RequestEditorFn) (*SubmitReportsBatchResponse, error) {
+	rsp, err := c.SubmitReportsBatch(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSubmitReportsBatchResponse(rsp)
+}
+
+// ValidateReportWithBodyWithResponse request with arbitrary body returning *ValidateReportResponse
+func (c *ClientWithResponses) ValidateReportWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ValidateReportResponse, error) {
+	rsp, err := c.ValidateReportWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseValidateReportResponse(rsp)
+}
+
+func (c *ClientWithResponses) ValidateReportWithResponse(ctx context.Context, body ValidateReportJSONRequestBody, reqEditors ...RequestEditorFn) (*ValidateReportResponse, error) {
+	rsp, err := c.ValidateReport(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseValidateReportResponse(rsp)
+}
+
+// ParseDeleteComponentReportsResponse parses an HTTP response from a DeleteComponentReportsWithResponse call
+func ParseDeleteComponentReportsResponse(rsp *http.Response) (*DeleteComponentReportsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteComponentReportsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DeleteReportsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
 // ParseSubmitReportResponse parses an HTTP response from a SubmitReportWithResponse call
 func ParseSubmitReportResponse(rsp *http.Response) (*SubmitReportResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
@@ -343,6 +912,95 @@ func ParseSubmitReportResponse(rsp *http.Response) (*SubmitReportResponse, error
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
+		var dest ReportSubmissionResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON202 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 413:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON413 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSubmitReportsBatchResponse parses an HTTP response from a SubmitReportsBatchWithResponse call
+func ParseSubmitReportsBatchResponse(rsp *http.Response) (*SubmitReportsBatchResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SubmitReportsBatchResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ReportBatchResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 207:
+		var dest ReportBatchResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON207 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -350,6 +1008,102 @@ func ParseSubmitReportResponse(rsp *http.Response) (*SubmitReportResponse, error
 		}
 		response.JSON400 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 413:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON413 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseValidateReportResponse parses an HTTP response from a ValidateReportWithResponse call
+func ParseValidateReportResponse(rsp *http.Response) (*ValidateReportResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ValidateReportResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ReportValidationResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 413:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON413 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -1,25 +1,50 @@
 package api
 
 import (
+	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"net/http"
+	"slices"
 	"strings"
 	"time"
 
 	"github.com/doron-cohen/argus/backend/internal/storage"
 	"github.com/doron-cohen/argus/backend/internal/utils"
+	"github.com/doron-cohen/argus/backend/notifications"
+	"github.com/doron-cohen/argus/backend/reports"
 	"github.com/doron-cohen/argus/backend/reports/api/client"
+	"github.com/google/uuid"
 )
 
+// naiveTimestampLayouts are accepted timestamp layouts that carry no timezone offset
+var naiveTimestampLayouts = []string{
+	"2006-01-02T15:04:05.999999999",
+	"2006-01-02 15:04:05.999999999",
+}
+
 // APIServer implements the ReportsAPI interface
 type APIServer struct {
-	Repo *storage.Repository
+	Repo     *storage.Repository
+	Config   reports.Config
+	Queue    *reports.IngestQueue              // Set to ingest asynchronously; nil persists synchronously
+	Notifier *notifications.TransitionNotifier // Sends status transition webhooks; nil disables them
 }
 
 // NewAPIServer creates a new API server
-func NewAPIServer(repo *storage.Repository) ServerInterface {
-	return &APIServer{Repo: repo}
+// A non-nil queue switches submissions to async ingestion
+func NewAPIServer(repo *storage.Repository, cfg reports.Config, queue *reports.IngestQueue, notifier *notifications.TransitionNotifier) ServerInterface {
+	return &APIServer{Repo: repo, Config: cfg, Queue: queue, Notifier: notifier}
+}
+
+// submissionPayload mirrors client.ReportSubmission but keeps the timestamps raw
+// so they can be parsed explicitly instead of relying on time.Time's JSON decoding
+type submissionPayload struct {
+	client.ReportSubmission
+	Timestamp json.RawMessage `json:"timestamp"`
+	StartedAt json.RawMessage `json:"started_at"`
 }
 
 // convertToStorageStatus converts API status to storage status
@@ -44,23 +69,8 @@ func convertToStorageStatus(status client.ReportSubmissionStatus) storage.CheckS
 	}
 }
 
-// SubmitReport handles report submission
-func (s *APIServer) SubmitReport(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
-
-	var submission client.ReportSubmission
-	if err := json.NewDecoder(r.Body).Decode(&submission); err != nil {
-		s.sendErrorResponse(w, "Invalid JSON format", "VALIDATION_ERROR", http.StatusBadRequest)
-		return
-	}
-
-	// Validate using OpenAPI spec constraints
-	if err := validateReportSubmission(submission); err != nil {
-		s.sendErrorResponse(w, err.Error(), "VALIDATION_ERROR", http.StatusBadRequest)
-		return
-	}
-
-	// Convert API submission to storage input
+// toStorageInput converts an API submission to storage input
+func toStorageInput(submission client.ReportSubmission) storage.CreateCheckReportInput {
 	var details storage.JSONB
 	if submission.Details != nil {
 		details = storage.JSONB(*submission.Details)
@@ -71,19 +81,54 @@ func (s *APIServer) SubmitReport(w http.ResponseWriter, r *http.Request) {
 		metadata = storage.JSONB(*submission.Metadata)
 	}
 
-	input := storage.CreateCheckReportInput{
+	var idempotencyKey string
+	if submission.IdempotencyKey != nil {
+		idempotencyKey = *submission.IdempotencyKey
+	}
+
+	return storage.CreateCheckReportInput{
 		ComponentID:      submission.ComponentId,
 		CheckSlug:        submission.Check.Slug,
 		CheckName:        submission.Check.Name,
 		CheckDescription: submission.Check.Description,
 		Status:           convertToStorageStatus(submission.Status),
 		Timestamp:        submission.Timestamp,
+		StartedAt:        submission.StartedAt,
+		DurationMs:       submission.DurationMs,
 		Details:          details,
 		Metadata:         metadata,
+		IdempotencyKey:   idempotencyKey,
 	}
+}
 
-	// Create the report
-	reportID, err := s.Repo.CreateCheckReportFromSubmission(ctx, input)
+// SubmitReport handles report submission
+func (s *APIServer) SubmitReport(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	submission, ok := s.decodeSubmission(w, r)
+	if !ok {
+		return
+	}
+
+	input := toStorageInput(submission)
+
+	if submission.ComponentIds != nil {
+		s.submitMultiComponentReport(w, r, submission, input)
+		return
+	}
+
+	// Reports with an idempotency key are stored right away, so a retry can be answered
+	// with the original report
+	if s.Queue != nil && input.IdempotencyKey == "" {
+		s.enqueueReport(w, r, input)
+		return
+	}
+
+	// Create the report, remembering the one it replaces for transition webhooks
+	inputs := []storage.CreateCheckReportInput{input}
+	snapshot := s.Notifier.Snapshot(ctx, inputs)
+	defer snapshot.Release()
+	reportID, created, err := s.Repo.CreateCheckReportIdempotent(ctx, input)
 	if err != nil {
 		if err == storage.ErrComponentNotFound {
 			s.sendErrorResponse(w, "Component not found", "NOT_FOUND", http.StatusNotFound)
@@ -99,6 +144,13 @@ func (s *APIServer) SubmitReport(w http.ResponseWriter, r *http.Request) {
 		ReportId:  utils.ToPointer(reportID.String()),
 		Timestamp: utils.ToPointer(time.Now()),
 	}
+	if created {
+		recordSubmissions(input.Status, 1)
+		s.Notifier.Notify(snapshot, inputs, []uuid.UUID{reportID})
+	} else {
+		response.Message = utils.ToPointer("Report already submitted")
+		response.Duplicate = utils.ToPointer(true)
+	}
 
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusOK)
@@ -107,6 +159,452 @@ func (s *APIServer) SubmitReport(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// submitMultiComponentReport stores one report per listed component in a single transaction.
+// These submissions bypass the async queue so the whole set is stored atomically.
+func (s *APIServer) submitMultiComponentReport(w http.ResponseWriter, r *http.Request, submission client.ReportSubmission, input storage.CreateCheckReportInput) {
+	skipMissing := submission.MissingComponents != nil && *submission.MissingComponents == client.Skip
+
+	componentInputs := make([]storage.CreateCheckReportInput, len(*submission.ComponentIds))
+	for i, componentID := range *submission.ComponentIds {
+		componentInputs[i] = input
+		componentInputs[i].ComponentID = componentID
+	}
+	snapshot := s.Notifier.Snapshot(r.Context(), componentInputs)
+	defer snapshot.Release()
+
+	result, err := s.Repo.CreateCheckReportsForComponents(r.Context(), input, *submission.ComponentIds, skipMissing)
+	if err != nil {
+		var missingErr *storage.MissingComponentsError
+		if errors.As(err, &missingErr) {
+			s.sendMissingComponentsResponse(w, missingErr.ComponentIDs)
+			return
+		}
+		http.Error(w, fmt.Sprintf("failed to create reports: %v", err), http.StatusInternalServerError)
+		return
+	}
+
+	recordSubmissions(input.Status, len(result.ReportIDs))
+
+	var storedInputs []storage.CreateCheckReportInput
+	var storedIDs []uuid.UUID
+	for _, componentInput := range componentInputs {
+		if reportID, ok := result.ReportIDs[componentInput.ComponentID]; ok {
+			storedInputs = append(storedInputs, componentInput)
+			storedIDs = append(storedIDs, reportID)
+		}
+	}
+	s.Notifier.Notify(snapshot, storedInputs, storedIDs)
+
+	reportIDs := make(map[string]string, len(result.ReportIDs))
+	for componentID, reportID := range result.ReportIDs {
+		reportIDs[componentID] = reportID.String()
+	}
+
+	response := client.ReportSubmissionResponse{
+		Message:   utils.ToPointer(fmt.Sprintf("%d reports submitted successfully", len(reportIDs))),
+		ReportIds: &reportIDs,
+		Timestamp: utils.ToPointer(time.Now()),
+	}
+	if len(result.Skipped) > 0 {
+		response.SkippedComponents = &result.Skipped
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		http.Error(w, "Failed to encode response", http.StatusInternalServerError)
+	}
+}
+
+// maxBatchSize bounds the number of reports a single batch submission may contain
+const maxBatchSize = 100
+
+// SubmitReportsBatch validates every item of a batch, then stores the valid ones in a single transaction.
+// Invalid items and items for unknown components are reported per item and the response is a 207.
+func (s *APIServer) SubmitReportsBatch(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var items []json.RawMessage
+	if !s.decodeBody(w, r, &items, s.maxBodyBytes()*maxBatchSize) {
+		return
+	}
+	if len(items) == 0 {
+		s.sendErrorResponse(w, "batch must contain at least one report", "VALIDATION_ERROR", http.StatusBadRequest)
+		return
+	}
+	if len(items) > maxBatchSize {
+		s.sendErrorResponse(w, fmt.Sprintf("batch cannot contain more than %d reports", maxBatchSize), "VALIDATION_ERROR", http.StatusBadRequest)
+		return
+	}
+
+	results := make([]client.ReportBatchItemResult, len(items))
+	failItem := func(index int, message, code string) {
+		results[index] = client.ReportBatchItemResult{
+			Index:  index,
+			Status: client.Failed,
+			Error:  utils.ToPointer(message),
+			Code:   utils.ToPointer(code),
+		}
+	}
+
+	// Validate everything and resolve components before storing anything
+	componentExists := make(map[string]bool)
+	var inputs []storage.CreateCheckReportInput
+	var inputIndexes []int
+	for i, item := range items {
+		var payload submissionPayload
+		if err := decodeJSON(bytes.NewReader(item), &payload, s.Config.StrictFields); err != nil {
+			failItem(i, jsonErrorMessage(err), "VALIDATION_ERROR")
+			continue
+		}
+
+		submission, err := s.parseSubmission(payload)
+		if err != nil {
+			failItem(i, err.Error(), "VALIDATION_ERROR")
+			continue
+		}
+		if submission.ComponentIds != nil {
+			failItem(i, "component_ids is not supported in batch submissions", "VALIDATION_ERROR")
+			continue
+		}
+		if submission.IdempotencyKey != nil {
+			failItem(i, "idempotency_key is not supported in batch submissions", "VALIDATION_ERROR")
+			continue
+		}
+		if message, forbidden := componentForbidden(ctx, submission.ComponentId); forbidden {
+			failItem(i, message, "FORBIDDEN")
+			continue
+		}
+
+		exists, checked := componentExists[submission.ComponentId]
+		if !checked {
+			_, err := s.Repo.GetComponentByID(ctx, submission.ComponentId)
+			if err != nil && err != storage.ErrComponentNotFound {
+				http.Error(w, fmt.Sprintf("failed to create reports: %v", err), http.StatusInternalServerError)
+				return
+			}
+			exists = err == nil
+			componentExists[submission.ComponentId] = exists
+		}
+		if !exists {
+			failItem(i, "Component not found", "NOT_FOUND")
+			continue
+		}
+
+		inputs = append(inputs, toStorageInput(submission))
+		inputIndexes = append(inputIndexes, i)
+	}
+
+	if len(inputs) > 0 {
+		snapshot := s.Notifier.Snapshot(ctx, inputs)
+		defer snapshot.Release()
+		reportIDs, err := s.Repo.CreateCheckReportsFromSubmissions(ctx, inputs)
+		if err != nil {
+			var missingErr *storage.MissingComponentsError
+			if errors.As(err, &missingErr) {
+				s.sendMissingComponentsResponse(w, missingErr.ComponentIDs)
+				return
+			}
+			http.Error(w, fmt.Sprintf("failed to create reports: %v", err), http.StatusInternalServerError)
+			return
+		}
+		for i, index := range inputIndexes {
+			results[index] = client.ReportBatchItemResult{
+				Index:    index,
+				Status:   client.Created,
+				ReportId: utils.ToPointer(reportIDs[i].String()),
+			}
+			recordSubmissions(inputs[i].Status, 1)
+		}
+		s.Notifier.Notify(snapshot, inputs, reportIDs)
+	}
+
+	response := client.ReportBatchResponse{
+		Results:   results,
+		Succeeded: len(inputs),
+		Failed:    len(items) - len(inputs),
+	}
+
+	statusCode := http.StatusOK
+	if response.Failed > 0 {
+		statusCode = http.StatusMultiStatus
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		http.Error(w, "Failed to encode response", http.StatusInternalServerError)
+	}
+}
+
+// enqueueReport verifies the component exists and queues the report for background persistence
+func (s *APIServer) enqueueReport(w http.ResponseWriter, r *http.Request, input storage.CreateCheckReportInput) {
+	if _, err := s.Repo.GetComponentByID(r.Context(), input.ComponentID); err != nil {
+		if err == storage.ErrComponentNotFound {
+			s.sendErrorResponse(w, "Component not found", "NOT_FOUND", http.StatusNotFound)
+			return
+		}
+		http.Error(w, fmt.Sprintf("failed to create report: %v", err), http.StatusInternalServerError)
+		return
+	}
+
+	// Assign the ID up front so it can be returned before the report is stored
+	reportID, err := uuid.NewV7()
+	if err != nil {
+		http.Error(w, fmt.Sprintf("failed to create report: %v", err), http.StatusInternalServerError)
+		return
+	}
+	input.ReportID = reportID
+
+	if err := s.Queue.Enqueue(input); err != nil {
+		switch {
+		case errors.Is(err, reports.ErrQueueFull):
+			w.Header().Set("Retry-After", "1")
+			s.sendErrorResponse(w, "Report queue is full, retry later", "QUEUE_FULL", http.StatusTooManyRequests)
+		case errors.Is(err, reports.ErrQueueClosed):
+			s.sendErrorResponse(w, "Report queue is shutting down", "UNAVAILABLE", http.StatusServiceUnavailable)
+		default:
+			http.Error(w, fmt.Sprintf("failed to create report: %v", err), http.StatusInternalServerError)
+		}
+		return
+	}
+	recordSubmissions(input.Status, 1)
+
+	response := client.ReportSubmissionResponse{
+		Message:   utils.ToPointer("Report accepted for processing"),
+		ReportId:  utils.ToPointer(reportID.String()),
+		Timestamp: utils.ToPointer(time.Now()),
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusAccepted)
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		http.Error(w, "Failed to encode response", http.StatusInternalServerError)
+	}
+}
+
+// ValidateReport runs the submission validation and component lookup without storing the report
+func (s *APIServer) ValidateReport(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	submission, ok := s.decodeSubmission(w, r)
+	if !ok {
+		return
+	}
+
+	response := client.ReportValidationResponse{
+		Valid:     true,
+		Message:   utils.ToPointer("Report is valid"),
+		CheckSlug: utils.ToPointer(submission.Check.Slug),
+	}
+
+	// Verify the components exist, as a real submission would
+	if submission.ComponentIds != nil {
+		var missing []string
+		for _, componentID := range *submission.ComponentIds {
+			if _, err := s.Repo.GetComponentByID(ctx, componentID); err != nil {
+				if err == storage.ErrComponentNotFound {
+					missing = append(missing, componentID)
+					continue
+				}
+				http.Error(w, fmt.Sprintf("failed to validate report: %v", err), http.StatusInternalServerError)
+				return
+			}
+		}
+		skipMissing := submission.MissingComponents != nil && *submission.MissingComponents == client.Skip
+		if len(missing) > 0 && !skipMissing {
+			s.sendMissingComponentsResponse(w, missing)
+			return
+		}
+	} else {
+		if _, err := s.Repo.GetComponentByID(ctx, submission.ComponentId); err != nil {
+			if err == storage.ErrComponentNotFound {
+				s.sendErrorResponse(w, "Component not found", "NOT_FOUND", http.StatusNotFound)
+				return
+			}
+			http.Error(w, fmt.Sprintf("failed to validate report: %v", err), http.StatusInternalServerError)
+			return
+		}
+		response.ComponentId = utils.ToPointer(submission.ComponentId)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		http.Error(w, "Failed to encode response", http.StatusInternalServerError)
+	}
+}
+
+// DeleteComponentReports deletes a component's reports, optionally only those before a time or of one check
+func (s *APIServer) DeleteComponentReports(w http.ResponseWriter, r *http.Request, componentId string, params DeleteComponentReportsParams) {
+	ctx := r.Context()
+
+	if message, forbidden := componentForbidden(ctx, componentId); forbidden {
+		s.sendErrorResponse(w, message, "FORBIDDEN", http.StatusForbidden)
+		return
+	}
+	if params.CheckSlug != nil && strings.TrimSpace(*params.CheckSlug) == "" {
+		s.sendErrorResponse(w, "check_slug cannot be empty", "VALIDATION_ERROR", http.StatusBadRequest)
+		return
+	}
+
+	deleted, err := s.Repo.DeleteCheckReports(ctx, componentId, storage.CheckReportDeleteFilters{
+		Before:    params.Before,
+		CheckSlug: params.CheckSlug,
+	})
+	if err != nil {
+		if err == storage.ErrComponentNotFound {
+			s.sendErrorResponse(w, "Component not found", "NOT_FOUND", http.StatusNotFound)
+			return
+		}
+		http.Error(w, fmt.Sprintf("failed to delete reports: %v", err), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(client.DeleteReportsResponse{Deleted: deleted}); err != nil {
+		http.Error(w, "Failed to encode response", http.StatusInternalServerError)
+	}
+}
+
+// decodeSubmission decodes and validates a report submission from the request body, and checks
+// the request's API key may report on its components. It writes the error response itself and
+// returns false when the submission is invalid or forbidden.
+func (s *APIServer) decodeSubmission(w http.ResponseWriter, r *http.Request) (client.ReportSubmission, bool) {
+	var payload submissionPayload
+	if !s.decodeBody(w, r, &payload, s.maxBodyBytes()) {
+		return payload.ReportSubmission, false
+	}
+
+	submission, err := s.parseSubmission(payload)
+	if err != nil {
+		s.sendValidationErrorResponse(w, err)
+		return submission, false
+	}
+
+	componentIDs := []string{submission.ComponentId}
+	if submission.ComponentIds != nil {
+		componentIDs = *submission.ComponentIds
+	}
+	for _, componentID := range componentIDs {
+		if message, forbidden := componentForbidden(r.Context(), componentID); forbidden {
+			s.sendErrorResponse(w, message, "FORBIDDEN", http.StatusForbidden)
+			return submission, false
+		}
+	}
+
+	return submission, true
+}
+
+// errTrailingData is returned by decodeJSON when the body holds more than one JSON value
+var errTrailingData = errors.New("request body must contain a single JSON value")
+
+// maxBodyBytes returns the configured bound on a single submission's request body
+func (s *APIServer) maxBodyBytes() int64 {
+	if s.Config.MaxBodyBytes <= 0 {
+		return reports.DefaultMaxBodyBytes
+	}
+	return int64(s.Config.MaxBodyBytes)
+}
+
+// decodeBody decodes the request body, read up to maxBytes, into v. It writes a 413 when the body
+// is too large and a 400 when it is not a single valid JSON value, and returns false in both cases.
+func (s *APIServer) decodeBody(w http.ResponseWriter, r *http.Request, v any, maxBytes int64) bool {
+	err := decodeJSON(http.MaxBytesReader(w, r.Body, maxBytes), v, s.Config.StrictFields)
+	if err == nil {
+		return true
+	}
+	var tooLarge *http.MaxBytesError
+	if errors.As(err, &tooLarge) {
+		s.sendErrorResponse(w, fmt.Sprintf("request body cannot exceed %d bytes", tooLarge.Limit), "PAYLOAD_TOO_LARGE", http.StatusRequestEntityTooLarge)
+		return false
+	}
+	s.sendErrorResponse(w, jsonErrorMessage(err), "VALIDATION_ERROR", http.StatusBadRequest)
+	return false
+}
+
+// decodeJSON decodes a single JSON value from reader into v, rejecting anything but whitespace after
+// it. With strict set, object fields v has no place for are rejected too.
+func decodeJSON(reader io.Reader, v any, strict bool) error {
+	decoder := json.NewDecoder(reader)
+	if strict {
+		decoder.DisallowUnknownFields()
+	}
+	if err := decoder.Decode(v); err != nil {
+		return err
+	}
+	_, err := decoder.Token()
+	if errors.Is(err, io.EOF) {
+		return nil
+	}
+	var tooLarge *http.MaxBytesError
+	if errors.As(err, &tooLarge) {
+		return err
+	}
+	return errTrailingData
+}
+
+// jsonErrorMessage describes a decodeJSON error to the client. Syntax and type errors keep the
+// generic message, since they point into the body rather than at a field.
+func jsonErrorMessage(err error) string {
+	if errors.Is(err, errTrailingData) {
+		return "Invalid JSON format: " + err.Error()
+	}
+	// encoding/json has no typed error for unknown fields
+	if field, ok := strings.CutPrefix(err.Error(), "json: unknown field "); ok {
+		return "unknown field " + field
+	}
+	return "Invalid JSON format"
+}
+
+// parseSubmission parses the timestamp of a decoded payload and validates the resulting submission.
+// Validation problems are returned together as fieldErrors.
+func (s *APIServer) parseSubmission(payload submissionPayload) (client.ReportSubmission, error) {
+	submission := payload.ReportSubmission
+	timestamp, timestampErr := parseTimestamp(payload.Timestamp, "timestamp", s.Config.AssumeUTC)
+	submission.Timestamp = timestamp
+	startedAt, startedAtErr := parseTimestamp(payload.StartedAt, "started_at", s.Config.AssumeUTC)
+	submission.StartedAt = nil
+	if !startedAt.IsZero() {
+		submission.StartedAt = &startedAt
+	}
+
+	// Validate using OpenAPI spec constraints
+	errs := validateReportSubmission(submission)
+	if timestampErr != nil {
+		// A timestamp that could not be parsed reads as missing, so replace that complaint
+		errs = slices.DeleteFunc(errs, func(fieldErr client.FieldError) bool {
+			return fieldErr.Field == "timestamp"
+		})
+		errs.add("timestamp", "%s", timestampErr)
+	}
+	if startedAtErr != nil {
+		errs.add("started_at", "%s", startedAtErr)
+	}
+
+	// Bound the size and nesting of the free-form fields before they reach storage
+	if submission.Details != nil {
+		maxDetailsBytes := s.Config.MaxDetailsBytes
+		if maxDetailsBytes <= 0 {
+			maxDetailsBytes = s.Config.MaxJSONBBytes
+		}
+		if err := utils.ValidateJSONBField(*submission.Details, "details", maxDetailsBytes, s.Config.MaxNestingDepth); err != nil {
+			errs.add("details", "%s", err)
+		}
+	}
+	if submission.Metadata != nil {
+		if err := utils.ValidateJSONBField(*submission.Metadata, "metadata", s.Config.MaxJSONBBytes, s.Config.MaxNestingDepth); err != nil {
+			errs.add("metadata", "%s", err)
+		}
+	}
+
+	if len(errs) > 0 {
+		return submission, errs
+	}
+	return submission, nil
+}
+
 // sendErrorResponse sends a JSON error response
 func (s *APIServer) sendErrorResponse(w http.ResponseWriter, message, code string, statusCode int) {
 	errorResponse := client.Error{
@@ -120,32 +618,141 @@ func (s *APIServer) sendErrorResponse(w http.ResponseWriter, message, code strin
 	}
 }
 
+// sendValidationErrorResponse sends a 400 for an invalid submission, listing the problems by field
+func (s *APIServer) sendValidationErrorResponse(w http.ResponseWriter, err error) {
+	errorResponse := client.Error{
+		Error: utils.ToPointer(err.Error()),
+		Code:  utils.ToPointer("VALIDATION_ERROR"),
+	}
+	var errs fieldErrors
+	if errors.As(err, &errs) {
+		errorResponse.FieldErrors = utils.ToPointer([]client.FieldError(errs))
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusBadRequest)
+	if err := json.NewEncoder(w).Encode(errorResponse); err != nil {
+		http.Error(w, "Failed to encode error response", http.StatusInternalServerError)
+	}
+}
+
+// sendMissingComponentsResponse sends a 404 listing the components that do not exist
+func (s *APIServer) sendMissingComponentsResponse(w http.ResponseWriter, componentIDs []string) {
+	errorResponse := client.Error{
+		Error: utils.ToPointer(fmt.Sprintf("Components not found: %s", strings.Join(componentIDs, ", "))),
+		Code:  utils.ToPointer("NOT_FOUND"),
+		Details: &map[string]interface{}{
+			"missing_components": componentIDs,
+		},
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusNotFound)
+	if err := json.NewEncoder(w).Encode(errorResponse); err != nil {
+		http.Error(w, "Failed to encode error response", http.StatusInternalServerError)
+	}
+}
+
+// parseTimestamp parses a submitted timestamp field, requiring RFC3339 with an explicit offset.
+// Timestamps without a timezone are rejected unless assumeUTC is set, in which case they are read as UTC.
+// A missing timestamp yields the zero time so the required-field validation can report it.
+func parseTimestamp(raw json.RawMessage, field string, assumeUTC bool) (time.Time, error) {
+	if len(raw) == 0 || string(raw) == "null" {
+		return time.Time{}, nil
+	}
+
+	var value string
+	if err := json.Unmarshal(raw, &value); err != nil {
+		return time.Time{}, fmt.Errorf("%s must be an RFC3339 string", field)
+	}
+
+	if timestamp, err := time.Parse(time.RFC3339Nano, value); err == nil {
+		return timestamp, nil
+	}
+
+	for _, layout := range naiveTimestampLayouts {
+		timestamp, err := time.ParseInLocation(layout, value, time.UTC)
+		if err != nil {
+			continue
+		}
+		if !assumeUTC {
+			return time.Time{}, fmt.Errorf("%s must include a timezone offset (e.g. 'Z' or '+02:00')", field)
+		}
+		return timestamp, nil
+	}
+
+	return time.Time{}, fmt.Errorf("%s must be in RFC3339 format (e.g. '2024-01-15T10:30:00Z')", field)
+}
+
+// fieldErrors collects the problems found in a submission, so all of them are reported at once
+type fieldErrors []client.FieldError
+
+// add records a problem with a field
+func (e *fieldErrors) add(field, format string, args ...any) {
+	*e = append(*e, client.FieldError{Field: field, Message: fmt.Sprintf(format, args...)})
+}
+
+// Error joins the messages of all problems
+func (e fieldErrors) Error() string {
+	messages := make([]string, len(e))
+	for i, fieldErr := range e {
+		messages[i] = fieldErr.Message
+	}
+	return strings.Join(messages, "; ")
+}
+
 // validateReportSubmission validates a report submission against OpenAPI spec constraints
-func validateReportSubmission(submission client.ReportSubmission) error {
+func validateReportSubmission(submission client.ReportSubmission) fieldErrors {
+	var errs fieldErrors
+
 	// Validate required fields (OpenAPI spec already enforces this via struct tags)
-	if submission.Check.Slug == "" {
-		return fmt.Errorf("check slug is required")
+	switch {
+	case submission.Check.Slug == "":
+		errs.add("check.slug", "check slug is required")
+	case !utils.IsValidSlug(submission.Check.Slug):
+		errs.add("check.slug", "check slug can only contain alphanumeric characters, hyphens, and underscores")
 	}
-	if submission.ComponentId == "" {
-		return fmt.Errorf("component ID is required")
+
+	switch {
+	case submission.ComponentId != "" && submission.ComponentIds != nil:
+		errs.add("component_ids", "component_id and component_ids are mutually exclusive")
+	case submission.ComponentId == "" && submission.ComponentIds == nil:
+		errs.add("component_id", "component ID is required")
+	case strings.TrimSpace(submission.ComponentId) != submission.ComponentId:
+		// Validate component ID format (no leading/trailing whitespace)
+		errs.add("component_id", "component ID cannot have leading or trailing whitespace")
+	case utils.ContainsControlCharacters(submission.ComponentId):
+		errs.add("component_id", "component ID cannot contain control characters such as newlines or tabs")
+	case submission.ComponentIds != nil:
+		validateComponentIDs(*submission.ComponentIds, &errs)
 	}
-	if submission.Timestamp.IsZero() {
-		return fmt.Errorf("timestamp is required")
+
+	switch {
+	case submission.Timestamp.IsZero():
+		errs.add("timestamp", "timestamp is required")
+	case submission.Timestamp.After(time.Now()):
+		errs.add("timestamp", "timestamp cannot be in the future")
 	}
 
-	// Validate timestamp is not in the future
-	if submission.Timestamp.After(time.Now()) {
-		return fmt.Errorf("timestamp cannot be in the future")
+	if submission.StartedAt != nil && !submission.Timestamp.IsZero() && submission.StartedAt.After(submission.Timestamp) {
+		errs.add("started_at", "started_at cannot be after timestamp")
+	}
+	if submission.DurationMs != nil && *submission.DurationMs < 0 {
+		errs.add("duration_ms", "duration_ms cannot be negative")
 	}
 
-	// Validate check slug format using existing utility
-	if !utils.IsValidSlug(submission.Check.Slug) {
-		return fmt.Errorf("check slug can only contain alphanumeric characters, hyphens, and underscores")
+	if submission.IdempotencyKey != nil {
+		if err := validateIdempotencyKey(*submission.IdempotencyKey); err != nil {
+			errs.add("idempotency_key", "%s", err)
+		} else if submission.ComponentIds != nil {
+			errs.add("idempotency_key", "idempotency_key is not supported with component_ids")
+		}
 	}
 
-	// Validate component ID format (no leading/trailing whitespace)
-	if strings.TrimSpace(submission.ComponentId) != submission.ComponentId {
-		return fmt.Errorf("component ID cannot have leading or trailing whitespace")
+	if submission.MissingComponents != nil {
+		switch *submission.MissingComponents {
+		case client.Reject, client.Skip:
+		default:
+			errs.add("missing_components", "missing_components must be one of: reject, skip")
+		}
 	}
 
 	// Validate status is one of the allowed values (OpenAPI enum already enforces this)
@@ -159,8 +766,55 @@ func validateReportSubmission(submission client.ReportSubmission) error {
 		client.ReportSubmissionStatusCompleted:
 		// Valid status
 	default:
-		return fmt.Errorf("status must be one of: pass, fail, disabled, skipped, unknown, error, completed")
+		errs.add("status", "status must be one of: pass, fail, disabled, skipped, unknown, error, completed")
 	}
 
+	return errs
+}
+
+// maxComponentIDs bounds the number of components a single submission may target
+const maxComponentIDs = 100
+
+// validateComponentIDs validates the component_ids of a multi-component submission
+func validateComponentIDs(componentIDs []string, errs *fieldErrors) {
+	if len(componentIDs) == 0 {
+		errs.add("component_ids", "component_ids must contain at least one component")
+		return
+	}
+	if len(componentIDs) > maxComponentIDs {
+		errs.add("component_ids", "component_ids cannot contain more than %d components", maxComponentIDs)
+		return
+	}
+
+	seen := make(map[string]bool, len(componentIDs))
+	for i, componentID := range componentIDs {
+		field := fmt.Sprintf("component_ids[%d]", i)
+		switch {
+		case componentID == "":
+			errs.add(field, "component_ids cannot contain empty values")
+		case len(componentID) > 255:
+			errs.add(field, "component ID %q cannot exceed 255 characters", componentID)
+		case strings.TrimSpace(componentID) != componentID:
+			errs.add(field, "component ID %q cannot have leading or trailing whitespace", componentID)
+		case utils.ContainsControlCharacters(componentID):
+			errs.add(field, "component ID %q cannot contain control characters such as newlines or tabs", componentID)
+		case seen[componentID]:
+			errs.add(field, "component ID %q is listed more than once", componentID)
+		}
+		seen[componentID] = true
+	}
+}
+
+// maxIdempotencyKeyLength bounds idempotency keys, matching the column size
+const maxIdempotencyKeyLength = 255
+
+// validateIdempotencyKey checks the length of a submitted idempotency key
+func validateIdempotencyKey(key string) error {
+	if key == "" {
+		return fmt.Errorf("idempotency_key cannot be empty")
+	}
+	if len(key) > maxIdempotencyKeyLength {
+		return fmt.Errorf("idempotency_key cannot exceed %d characters", maxIdempotencyKeyLength)
+	}
 	return nil
 }
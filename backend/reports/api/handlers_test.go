@@ -4,15 +4,21 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/doron-cohen/argus/backend/internal/storage"
 	"github.com/doron-cohen/argus/backend/internal/utils"
+	"github.com/doron-cohen/argus/backend/reports"
 	reportsclient "github.com/doron-cohen/argus/backend/reports/api/client"
 	"github.com/glebarez/sqlite"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"gorm.io/gorm"
@@ -47,7 +53,7 @@ func TestSubmitReport_Success(t *testing.T) {
 	}
 
 	// Create API server
-	server := NewAPIServer(repo)
+	server := NewAPIServer(repo, reports.Config{}, nil, nil)
 
 	// Create test component first
 	component := storage.Component{
@@ -110,7 +116,7 @@ func TestSubmitReport_Success(t *testing.T) {
 
 func TestSubmitReport_MissingRequiredFields(t *testing.T) {
 	mockRepo := NewMockRepository(t)
-	server := NewAPIServer(mockRepo.Repository)
+	server := NewAPIServer(mockRepo.Repository, reports.Config{}, nil, nil)
 
 	testCases := []struct {
 		name   string
@@ -159,7 +165,7 @@ func TestSubmitReport_MissingRequiredFields(t *testing.T) {
 
 func TestSubmitReport_InvalidJSON(t *testing.T) {
 	mockRepo := NewMockRepository(t)
-	server := NewAPIServer(mockRepo.Repository)
+	server := NewAPIServer(mockRepo.Repository, reports.Config{}, nil, nil)
 
 	// Test with invalid JSON
 	req := httptest.NewRequest("POST", "/reports", bytes.NewBufferString(`{"invalid": json`))
@@ -171,9 +177,77 @@ func TestSubmitReport_InvalidJSON(t *testing.T) {
 	assert.Equal(t, http.StatusBadRequest, w.Code)
 }
 
+func TestSubmitReport_BodyDecoding(t *testing.T) {
+	mockRepo := NewMockRepository(t)
+	require.NoError(t, mockRepo.CreateComponent(context.Background(), storage.Component{ComponentID: "body-decoding-service", Name: "Body Decoding"}))
+
+	valid := `{"check":{"slug":"body-decoding"},"component_id":"body-decoding-service","status":"pass","timestamp":"2024-01-15T10:30:00Z"}`
+	unknownField := strings.TrimSuffix(valid, "}") + `,"severity":"high"}`
+
+	testCases := []struct {
+		name           string
+		config         reports.Config
+		body           string
+		expectedStatus int
+		expectedCode   string
+		expectedError  string
+	}{
+		{name: "valid body", body: valid, expectedStatus: http.StatusOK},
+		{name: "trailing whitespace", body: valid + "\n", expectedStatus: http.StatusOK},
+		{name: "trailing garbage", body: valid + "garbage", expectedStatus: http.StatusBadRequest, expectedCode: "VALIDATION_ERROR", expectedError: "Invalid JSON format: request body must contain a single JSON value"},
+		{name: "second JSON object", body: valid + valid, expectedStatus: http.StatusBadRequest, expectedCode: "VALIDATION_ERROR", expectedError: "Invalid JSON format: request body must contain a single JSON value"},
+		{name: "oversized body", config: reports.Config{MaxBodyBytes: 64}, body: valid, expectedStatus: http.StatusRequestEntityTooLarge, expectedCode: "PAYLOAD_TOO_LARGE", expectedError: "request body cannot exceed 64 bytes"},
+		{name: "oversized trailing data", config: reports.Config{MaxBodyBytes: len(valid) + 4}, body: valid + strings.Repeat(" ", 8) + "{}", expectedStatus: http.StatusRequestEntityTooLarge, expectedCode: "PAYLOAD_TOO_LARGE"},
+		{name: "unknown field ignored by default", body: unknownField, expectedStatus: http.StatusOK},
+		{name: "unknown field in strict mode", config: reports.Config{StrictFields: true}, body: unknownField, expectedStatus: http.StatusBadRequest, expectedCode: "VALIDATION_ERROR", expectedError: `unknown field "severity"`},
+		{name: "known fields in strict mode", config: reports.Config{StrictFields: true}, body: valid, expectedStatus: http.StatusOK},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			server := NewAPIServer(mockRepo.Repository, tc.config, nil, nil)
+			req := httptest.NewRequest("POST", "/reports", strings.NewReader(tc.body))
+			w := httptest.NewRecorder()
+			server.SubmitReport(w, req)
+
+			require.Equal(t, tc.expectedStatus, w.Code, w.Body.String())
+			if tc.expectedCode == "" {
+				return
+			}
+			var errorResp reportsclient.Error
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &errorResp))
+			assert.Equal(t, tc.expectedCode, *errorResp.Code)
+			if tc.expectedError != "" {
+				assert.Equal(t, tc.expectedError, *errorResp.Error)
+			}
+		})
+	}
+}
+
+func TestSubmitReportsBatch_BodyLimit(t *testing.T) {
+	mockRepo := NewMockRepository(t)
+	server := NewAPIServer(mockRepo.Repository, reports.Config{MaxBodyBytes: 1}, nil, nil)
+
+	// Batches may hold up to maxBatchSize submissions' worth of body
+	req := httptest.NewRequest("POST", "/reports:batch", strings.NewReader("["+strings.Repeat(" ", maxBatchSize)+"]"))
+	w := httptest.NewRecorder()
+	server.SubmitReportsBatch(w, req)
+	assert.Equal(t, http.StatusRequestEntityTooLarge, w.Code, w.Body.String())
+
+	strict := NewAPIServer(mockRepo.Repository, reports.Config{StrictFields: true}, nil, nil)
+	req = httptest.NewRequest("POST", "/reports:batch", strings.NewReader(`[{"check":{"slug":"batch"},"component_id":"batch-service","status":"pass","timestamp":"2024-01-15T10:30:00Z","severity":"high"}]`))
+	w = httptest.NewRecorder()
+	strict.SubmitReportsBatch(w, req)
+	require.Equal(t, http.StatusMultiStatus, w.Code, w.Body.String())
+	var resp reportsclient.ReportBatchResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	require.Len(t, resp.Results, 1)
+	assert.Equal(t, `unknown field "severity"`, *resp.Results[0].Error)
+}
+
 func TestSubmitReport_ValidStatuses(t *testing.T) {
 	mockRepo := NewMockRepository(t)
-	server := NewAPIServer(mockRepo.Repository)
+	server := NewAPIServer(mockRepo.Repository, reports.Config{}, nil, nil)
 
 	// Create a test component first
 	component := storage.Component{
@@ -228,7 +302,7 @@ func TestSubmitReport_ValidStatuses(t *testing.T) {
 
 func TestSubmitReport_ValidationErrors(t *testing.T) {
 	mockRepo := NewMockRepository(t)
-	server := NewAPIServer(mockRepo.Repository)
+	server := NewAPIServer(mockRepo.Repository, reports.Config{}, nil, nil)
 
 	// Create a test component for valid cases
 	component := storage.Component{
@@ -337,6 +411,32 @@ func TestSubmitReport_ValidationErrors(t *testing.T) {
 			expectedStatus: http.StatusBadRequest,
 			expectedError:  "component ID cannot have leading or trailing whitespace",
 		},
+		{
+			name: "component_id_with_newline",
+			report: reportsclient.ReportSubmission{
+				Check: reportsclient.Check{
+					Slug: "unit-tests",
+				},
+				ComponentId: "auth-service\nvalidation",
+				Status:      reportsclient.ReportSubmissionStatusPass,
+				Timestamp:   time.Now(),
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  "component ID cannot contain control characters",
+		},
+		{
+			name: "component_id_with_tab",
+			report: reportsclient.ReportSubmission{
+				Check: reportsclient.Check{
+					Slug: "unit-tests",
+				},
+				ComponentId: "auth-service\tvalidation",
+				Status:      reportsclient.ReportSubmissionStatusPass,
+				Timestamp:   time.Now(),
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  "component ID cannot contain control characters",
+		},
 		{
 			name: "invalid_status",
 			report: reportsclient.ReportSubmission{
@@ -373,9 +473,186 @@ func TestSubmitReport_ValidationErrors(t *testing.T) {
 	}
 }
 
+func TestSubmitReport_FieldErrors(t *testing.T) {
+	mockRepo := NewMockRepository(t)
+	server := NewAPIServer(mockRepo.Repository, reports.Config{}, nil, nil)
+
+	body := `{"check":{"slug":"unit tests"},"component_ids":["a","","a"],"status":"green","timestamp":"2024-01-15 10:30:00","idempotency_key":"retry-1"}`
+	req := httptest.NewRequest("POST", "/reports/v1/reports", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	server.SubmitReport(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	var errorResp reportsclient.Error
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &errorResp))
+	assert.Equal(t, "VALIDATION_ERROR", *errorResp.Code)
+	require.NotNil(t, errorResp.FieldErrors)
+
+	fields := make(map[string]string)
+	for _, fieldErr := range *errorResp.FieldErrors {
+		fields[fieldErr.Field] = fieldErr.Message
+		// The top-level message still carries every problem for older clients
+		assert.Contains(t, *errorResp.Error, fieldErr.Message)
+	}
+	assert.Equal(t, map[string]string{
+		"check.slug":       "check slug can only contain alphanumeric characters, hyphens, and underscores",
+		"component_ids[1]": "component_ids cannot contain empty values",
+		"component_ids[2]": `component ID "a" is listed more than once`,
+		"timestamp":        "timestamp must include a timezone offset (e.g. 'Z' or '+02:00')",
+		"idempotency_key":  "idempotency_key is not supported with component_ids",
+		"status":           "status must be one of: pass, fail, disabled, skipped, unknown, error, completed",
+	}, fields)
+}
+
+func TestSubmitReport_JSONBLimits(t *testing.T) {
+	mockRepo := NewMockRepository(t)
+	require.NoError(t, mockRepo.CreateComponent(context.Background(), storage.Component{ComponentID: "jsonb-limits-service", Name: "JSONB Limits"}))
+
+	// Eleven levels of nesting, one more than allowed
+	tooDeep := map[string]interface{}{"level11": "value"}
+	for i := 10; i >= 1; i-- {
+		tooDeep = map[string]interface{}{fmt.Sprintf("level%d", i): tooDeep}
+	}
+
+	testCases := []struct {
+		name          string
+		config        reports.Config
+		details       map[string]interface{}
+		metadata      map[string]interface{}
+		expectedError string
+	}{
+		{
+			name:          "details too deep",
+			details:       tooDeep,
+			expectedError: "details cannot exceed 10 levels of nesting, got 11",
+		},
+		{
+			name:          "metadata too deep",
+			metadata:      tooDeep,
+			expectedError: "metadata cannot exceed 10 levels of nesting, got 11",
+		},
+		{
+			name:          "details over default size",
+			details:       map[string]interface{}{"log": strings.Repeat("x", utils.DefaultMaxJSONBBytes)},
+			expectedError: "details cannot exceed 65536 bytes, got 65546",
+		},
+		{
+			name:          "metadata over configured size",
+			config:        reports.Config{MaxJSONBBytes: 128},
+			metadata:      map[string]interface{}{"log": strings.Repeat("x", 200)},
+			expectedError: "metadata cannot exceed 128 bytes, got 210",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			server := NewAPIServer(mockRepo.Repository, tc.config, nil, nil)
+			report := reportsclient.ReportSubmission{
+				Check:       reportsclient.Check{Slug: "jsonb-limits"},
+				ComponentId: "jsonb-limits-service",
+				Status:      reportsclient.ReportSubmissionStatusPass,
+				Timestamp:   time.Now(),
+			}
+			if tc.details != nil {
+				report.Details = &tc.details
+			}
+			if tc.metadata != nil {
+				report.Metadata = &tc.metadata
+			}
+
+			body, err := json.Marshal(report)
+			require.NoError(t, err)
+			req := httptest.NewRequest("POST", "/reports", bytes.NewReader(body))
+			w := httptest.NewRecorder()
+			server.SubmitReport(w, req)
+
+			assert.Equal(t, http.StatusBadRequest, w.Code)
+			var errorResp reportsclient.Error
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &errorResp))
+			assert.Equal(t, "VALIDATION_ERROR", *errorResp.Code)
+			assert.Equal(t, tc.expectedError, *errorResp.Error)
+		})
+	}
+
+	// Nothing was stored for the rejected submissions
+	var count int64
+	require.NoError(t, mockRepo.DB.Model(&storage.CheckReport{}).
+		Joins("JOIN checks ON checks.id = check_reports.check_id").
+		Where("checks.slug = ?", "jsonb-limits").
+		Count(&count).Error)
+	assert.Equal(t, int64(0), count)
+}
+
+func TestSubmitReport_ConfiguredJSONBLimits(t *testing.T) {
+	mockRepo := NewMockRepository(t)
+	require.NoError(t, mockRepo.CreateComponent(context.Background(), storage.Component{ComponentID: "configured-limits-service", Name: "Configured Limits"}))
+	server := NewAPIServer(mockRepo.Repository, reports.Config{MaxJSONBBytes: 128, MaxDetailsBytes: 64, MaxNestingDepth: 3}, nil, nil)
+
+	// The serialized form of {"log":"..."} is 10 bytes longer than the log
+	sized := func(n int) map[string]interface{} {
+		return map[string]interface{}{"log": strings.Repeat("x", n-10)}
+	}
+	nested := func(levels int) map[string]interface{} {
+		data := map[string]interface{}{"value": 1}
+		for i := 1; i < levels; i++ {
+			data = map[string]interface{}{"nested": data}
+		}
+		return data
+	}
+
+	testCases := []struct {
+		name          string
+		details       map[string]interface{}
+		metadata      map[string]interface{}
+		expectedError string
+	}{
+		{name: "details at size limit", details: sized(64)},
+		{name: "details over size limit", details: sized(65), expectedError: "details cannot exceed 64 bytes, got 65"},
+		{name: "metadata uses the shared size limit", metadata: sized(128)},
+		{name: "metadata over the shared size limit", metadata: sized(129), expectedError: "metadata cannot exceed 128 bytes, got 129"},
+		{name: "details at nesting limit", details: nested(3)},
+		{name: "details over nesting limit", details: nested(4), expectedError: "details cannot exceed 3 levels of nesting, got 4"},
+		{name: "metadata over nesting limit", metadata: nested(4), expectedError: "metadata cannot exceed 3 levels of nesting, got 4"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			report := reportsclient.ReportSubmission{
+				Check:       reportsclient.Check{Slug: "configured-limits"},
+				ComponentId: "configured-limits-service",
+				Status:      reportsclient.ReportSubmissionStatusPass,
+				Timestamp:   time.Now(),
+			}
+			if tc.details != nil {
+				report.Details = &tc.details
+			}
+			if tc.metadata != nil {
+				report.Metadata = &tc.metadata
+			}
+
+			body, err := json.Marshal(report)
+			require.NoError(t, err)
+			req := httptest.NewRequest("POST", "/reports", bytes.NewReader(body))
+			w := httptest.NewRecorder()
+			server.SubmitReport(w, req)
+
+			if tc.expectedError == "" {
+				assert.Equal(t, http.StatusOK, w.Code, w.Body.String())
+				return
+			}
+			assert.Equal(t, http.StatusBadRequest, w.Code)
+			var errorResp reportsclient.Error
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &errorResp))
+			assert.Equal(t, tc.expectedError, *errorResp.Error)
+		})
+	}
+}
+
 func TestSubmitReport_ComponentNotFound(t *testing.T) {
 	mockRepo := NewMockRepository(t)
-	server := NewAPIServer(mockRepo.Repository)
+	server := NewAPIServer(mockRepo.Repository, reports.Config{}, nil, nil)
 
 	// Test with non-existent component
 	report := reportsclient.ReportSubmission{
@@ -405,3 +682,781 @@ func TestSubmitReport_ComponentNotFound(t *testing.T) {
 	assert.Equal(t, "NOT_FOUND", *errorResp.Code)
 	assert.Equal(t, "Component not found", *errorResp.Error)
 }
+
+func TestValidateReport_DoesNotPersist(t *testing.T) {
+	mockRepo := NewMockRepository(t)
+	server := NewAPIServer(mockRepo.Repository, reports.Config{}, nil, nil)
+
+	component := storage.Component{
+		ComponentID: "auth-service-validate",
+		Name:        "Auth Service",
+	}
+	if err := mockRepo.CreateComponent(context.Background(), component); err != nil {
+		t.Fatalf("Failed to create test component: %v", err)
+	}
+
+	var before int64
+	require.NoError(t, mockRepo.DB.Model(&storage.CheckReport{}).Count(&before).Error)
+
+	report := reportsclient.ReportSubmission{
+		Check: reportsclient.Check{
+			Slug: "validate-only-check",
+		},
+		ComponentId: "auth-service-validate",
+		Status:      reportsclient.ReportSubmissionStatusPass,
+		Timestamp:   time.Now().Add(-1 * time.Minute),
+	}
+
+	body, _ := json.Marshal(report)
+	req := httptest.NewRequest("POST", "/reports:validate", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	server.ValidateReport(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response reportsclient.ReportValidationResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.True(t, response.Valid)
+	assert.Equal(t, "auth-service-validate", *response.ComponentId)
+	assert.Equal(t, "validate-only-check", *response.CheckSlug)
+
+	// Nothing should have been written: no report and no auto-created check
+	var after int64
+	require.NoError(t, mockRepo.DB.Model(&storage.CheckReport{}).Count(&after).Error)
+	assert.Equal(t, before, after)
+
+	_, err := mockRepo.GetCheckBySlug(context.Background(), "validate-only-check")
+	assert.ErrorIs(t, err, storage.ErrCheckNotFound)
+}
+
+func TestValidateReport_MatchesSubmissionErrors(t *testing.T) {
+	mockRepo := NewMockRepository(t)
+	server := NewAPIServer(mockRepo.Repository, reports.Config{}, nil, nil)
+
+	testCases := []struct {
+		name   string
+		report reportsclient.ReportSubmission
+	}{
+		{
+			name: "invalid_slug",
+			report: reportsclient.ReportSubmission{
+				Check:       reportsclient.Check{Slug: "unit tests"},
+				ComponentId: "auth-service",
+				Status:      reportsclient.ReportSubmissionStatusPass,
+				Timestamp:   time.Now(),
+			},
+		},
+		{
+			name: "future_timestamp",
+			report: reportsclient.ReportSubmission{
+				Check:       reportsclient.Check{Slug: "unit-tests"},
+				ComponentId: "auth-service",
+				Status:      reportsclient.ReportSubmissionStatusPass,
+				Timestamp:   time.Now().Add(1 * time.Hour),
+			},
+		},
+		{
+			name: "component_not_found",
+			report: reportsclient.ReportSubmission{
+				Check:       reportsclient.Check{Slug: "unit-tests"},
+				ComponentId: "validate-missing-component",
+				Status:      reportsclient.ReportSubmissionStatusPass,
+				Timestamp:   time.Now(),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			body, _ := json.Marshal(tc.report)
+
+			submitReq := httptest.NewRequest("POST", "/reports", bytes.NewBuffer(body))
+			submitReq.Header.Set("Content-Type", "application/json")
+			submitW := httptest.NewRecorder()
+			server.SubmitReport(submitW, submitReq)
+
+			validateReq := httptest.NewRequest("POST", "/reports:validate", bytes.NewBuffer(body))
+			validateReq.Header.Set("Content-Type", "application/json")
+			validateW := httptest.NewRecorder()
+			server.ValidateReport(validateW, validateReq)
+
+			assert.NotEqual(t, http.StatusOK, validateW.Code)
+			assert.Equal(t, submitW.Code, validateW.Code)
+			assert.JSONEq(t, submitW.Body.String(), validateW.Body.String())
+		})
+	}
+}
+
+func TestSubmitReport_TimestampFormats(t *testing.T) {
+	mockRepo := NewMockRepository(t)
+
+	component := storage.Component{
+		ComponentID: "auth-service-timestamps",
+		Name:        "Auth Service",
+	}
+	if err := mockRepo.CreateComponent(context.Background(), component); err != nil {
+		t.Fatalf("Failed to create test component: %v", err)
+	}
+
+	past := time.Now().Add(-2 * time.Hour).UTC()
+
+	testCases := []struct {
+		name           string
+		timestamp      string
+		assumeUTC      bool
+		expectedStatus int
+		expectedError  string
+	}{
+		{
+			name:           "utc_z_suffix",
+			timestamp:      past.Format(time.RFC3339),
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "explicit_offset",
+			timestamp:      past.In(time.FixedZone("UTC+2", 2*60*60)).Format(time.RFC3339),
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "naive_rejected_by_default",
+			timestamp:      past.Format("2006-01-02T15:04:05"),
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  "timestamp must include a timezone offset",
+		},
+		{
+			name:           "naive_assumed_utc",
+			timestamp:      past.Format("2006-01-02T15:04:05"),
+			assumeUTC:      true,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "unparseable",
+			timestamp:      "yesterday",
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  "timestamp must be in RFC3339 format",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			server := NewAPIServer(mockRepo.Repository, reports.Config{AssumeUTC: tc.assumeUTC}, nil, nil)
+
+			body := `{"check":{"slug":"unit-tests"},"component_id":"auth-service-timestamps","status":"pass","timestamp":"` + tc.timestamp + `"}`
+			req := httptest.NewRequest("POST", "/reports", bytes.NewBufferString(body))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+
+			server.SubmitReport(w, req)
+
+			assert.Equal(t, tc.expectedStatus, w.Code)
+			if tc.expectedError != "" {
+				var errorResp reportsclient.Error
+				require.NoError(t, json.Unmarshal(w.Body.Bytes(), &errorResp))
+				assert.Equal(t, "VALIDATION_ERROR", *errorResp.Code)
+				assert.Contains(t, *errorResp.Error, tc.expectedError)
+			}
+		})
+	}
+}
+
+func TestSubmitReport_RunTiming(t *testing.T) {
+	mockRepo := NewMockRepository(t)
+	require.NoError(t, mockRepo.CreateComponent(context.Background(), storage.Component{ComponentID: "run-timing-service", Name: "Run Timing"}))
+	server := NewAPIServer(mockRepo.Repository, reports.Config{}, nil, nil)
+
+	timestamp := time.Now().Add(-time.Hour).UTC().Truncate(time.Second)
+	submit := func(t *testing.T, fields string) *httptest.ResponseRecorder {
+		body := `{"check":{"slug":"run-timing"},"component_id":"run-timing-service","status":"pass","timestamp":"` +
+			timestamp.Format(time.RFC3339) + `"` + fields + `}`
+		w := httptest.NewRecorder()
+		server.SubmitReport(w, httptest.NewRequest("POST", "/reports", bytes.NewBufferString(body)))
+		return w
+	}
+	storedReport := func(t *testing.T, w *httptest.ResponseRecorder) storage.CheckReport {
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+		var response reportsclient.ReportSubmissionResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		var report storage.CheckReport
+		require.NoError(t, mockRepo.DB.First(&report, "id = ?", *response.ReportId).Error)
+		return report
+	}
+
+	t.Run("stores started_at and duration_ms", func(t *testing.T) {
+		startedAt := timestamp.Add(-45 * time.Second)
+		report := storedReport(t, submit(t, `,"started_at":"`+startedAt.Format(time.RFC3339)+`","duration_ms":45000`))
+		require.NotNil(t, report.StartedAt)
+		assert.True(t, report.StartedAt.Equal(startedAt))
+		require.NotNil(t, report.DurationMs)
+		assert.Equal(t, int64(45000), *report.DurationMs)
+	})
+
+	t.Run("started_at equal to timestamp", func(t *testing.T) {
+		report := storedReport(t, submit(t, `,"started_at":"`+timestamp.Format(time.RFC3339)+`","duration_ms":0`))
+		require.NotNil(t, report.StartedAt)
+		require.NotNil(t, report.DurationMs)
+		assert.Zero(t, *report.DurationMs)
+	})
+
+	t.Run("both optional", func(t *testing.T) {
+		report := storedReport(t, submit(t, ``))
+		assert.Nil(t, report.StartedAt)
+		assert.Nil(t, report.DurationMs)
+	})
+
+	rejected := []struct {
+		name    string
+		fields  string
+		field   string
+		message string
+	}{
+		{"started_at after timestamp", `,"started_at":"` + timestamp.Add(time.Second).Format(time.RFC3339) + `"`, "started_at", "started_at cannot be after timestamp"},
+		{"naive started_at", `,"started_at":"` + timestamp.Format("2006-01-02T15:04:05") + `"`, "started_at", "started_at must include a timezone offset (e.g. 'Z' or '+02:00')"},
+		{"negative duration_ms", `,"duration_ms":-1`, "duration_ms", "duration_ms cannot be negative"},
+	}
+	for _, tc := range rejected {
+		t.Run(tc.name, func(t *testing.T) {
+			w := submit(t, tc.fields)
+			require.Equal(t, http.StatusBadRequest, w.Code)
+			var errorResp reportsclient.Error
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &errorResp))
+			require.NotNil(t, errorResp.FieldErrors)
+			assert.Equal(t, []reportsclient.FieldError{{Field: tc.field, Message: tc.message}}, *errorResp.FieldErrors)
+		})
+	}
+}
+
+func TestParseTimestamp_NaiveAssumedUTC(t *testing.T) {
+	timestamp, err := parseTimestamp(json.RawMessage(`"2024-01-15T10:30:00"`), "timestamp", true)
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC), timestamp)
+	assert.Equal(t, time.UTC, timestamp.Location())
+}
+
+// newAsyncSubmission builds a valid submission request body for the async ingestion tests
+func newAsyncSubmission(t *testing.T, componentID string) []byte {
+	report := reportsclient.ReportSubmission{
+		Check:       reportsclient.Check{Slug: "async-tests"},
+		ComponentId: componentID,
+		Status:      reportsclient.ReportSubmissionStatusPass,
+		Timestamp:   time.Now(),
+	}
+	body, err := json.Marshal(report)
+	require.NoError(t, err)
+	return body
+}
+
+func TestSubmitReport_AsyncEnqueue(t *testing.T) {
+	mockRepo := NewMockRepository(t)
+	require.NoError(t, mockRepo.CreateComponent(context.Background(), storage.Component{ComponentID: "async-enqueue-service", Name: "Async Enqueue Service"}))
+
+	// Workers are not started so the report stays queued
+	queue := reports.NewIngestQueue(mockRepo.Repository, reports.AsyncConfig{QueueSize: 10, Workers: 1}, nil)
+	server := NewAPIServer(mockRepo.Repository, reports.Config{}, queue, nil)
+
+	req := httptest.NewRequest("POST", "/reports", bytes.NewReader(newAsyncSubmission(t, "async-enqueue-service")))
+	w := httptest.NewRecorder()
+	server.SubmitReport(w, req)
+
+	assert.Equal(t, http.StatusAccepted, w.Code)
+	var response reportsclient.ReportSubmissionResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	require.NotNil(t, response.ReportId)
+	assert.NotEmpty(t, *response.ReportId)
+	assert.Equal(t, 1, queue.Len())
+
+	// Nothing is persisted until a worker drains the queue
+	var count int64
+	require.NoError(t, mockRepo.DB.Model(&storage.CheckReport{}).Where("id = ?", *response.ReportId).Count(&count).Error)
+	assert.Equal(t, int64(0), count)
+
+	t.Run("validation still runs synchronously", func(t *testing.T) {
+		req := httptest.NewRequest("POST", "/reports", bytes.NewReader(newAsyncSubmission(t, "async-missing-service")))
+		w := httptest.NewRecorder()
+		server.SubmitReport(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		assert.Equal(t, 1, queue.Len())
+	})
+}
+
+func TestSubmitReport_AsyncQueueFull(t *testing.T) {
+	mockRepo := NewMockRepository(t)
+	require.NoError(t, mockRepo.CreateComponent(context.Background(), storage.Component{ComponentID: "async-full-service", Name: "Async Full Service"}))
+
+	queue := reports.NewIngestQueue(mockRepo.Repository, reports.AsyncConfig{QueueSize: 1, Workers: 1}, nil)
+	server := NewAPIServer(mockRepo.Repository, reports.Config{}, queue, nil)
+
+	req := httptest.NewRequest("POST", "/reports", bytes.NewReader(newAsyncSubmission(t, "async-full-service")))
+	w := httptest.NewRecorder()
+	server.SubmitReport(w, req)
+	require.Equal(t, http.StatusAccepted, w.Code)
+
+	req = httptest.NewRequest("POST", "/reports", bytes.NewReader(newAsyncSubmission(t, "async-full-service")))
+	w = httptest.NewRecorder()
+	server.SubmitReport(w, req)
+
+	assert.Equal(t, http.StatusTooManyRequests, w.Code)
+	assert.Equal(t, "1", w.Header().Get("Retry-After"))
+	var response reportsclient.Error
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, "QUEUE_FULL", *response.Code)
+}
+
+func TestSubmitReport_AsyncPersists(t *testing.T) {
+	mockRepo := NewMockRepository(t)
+	require.NoError(t, mockRepo.CreateComponent(context.Background(), storage.Component{ComponentID: "async-persist-service", Name: "Async Persist Service"}))
+
+	queue := reports.NewIngestQueue(mockRepo.Repository, reports.AsyncConfig{QueueSize: 10, Workers: 2}, nil)
+	queue.Start()
+	server := NewAPIServer(mockRepo.Repository, reports.Config{}, queue, nil)
+
+	var reportIDs []string
+	for i := 0; i < 3; i++ {
+		req := httptest.NewRequest("POST", "/reports", bytes.NewReader(newAsyncSubmission(t, "async-persist-service")))
+		w := httptest.NewRecorder()
+		server.SubmitReport(w, req)
+		require.Equal(t, http.StatusAccepted, w.Code)
+
+		var response reportsclient.ReportSubmissionResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		reportIDs = append(reportIDs, *response.ReportId)
+	}
+
+	// The returned IDs are the IDs the reports are stored under
+	assert.Eventually(t, func() bool {
+		var count int64
+		if err := mockRepo.DB.Model(&storage.CheckReport{}).Where("id IN ?", reportIDs).Count(&count).Error; err != nil {
+			return false
+		}
+		return count == int64(len(reportIDs))
+	}, 5*time.Second, 20*time.Millisecond)
+
+	// Stopping drains the queue and rejects new submissions
+	queue.Stop()
+	req := httptest.NewRequest("POST", "/reports", bytes.NewReader(newAsyncSubmission(t, "async-persist-service")))
+	w := httptest.NewRecorder()
+	server.SubmitReport(w, req)
+	assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+}
+
+func newMultiComponentSubmission(t *testing.T, componentIDs []string, missing *reportsclient.ReportSubmissionMissingComponents) []byte {
+	report := reportsclient.ReportSubmission{
+		Check:             reportsclient.Check{Slug: "monorepo-lint"},
+		ComponentIds:      &componentIDs,
+		MissingComponents: missing,
+		Status:            reportsclient.ReportSubmissionStatusFail,
+		Timestamp:         time.Now(),
+		Details:           &map[string]interface{}{"warnings": float64(3)},
+	}
+	body, err := json.Marshal(report)
+	require.NoError(t, err)
+	return body
+}
+
+func TestSubmitReport_MultiComponent(t *testing.T) {
+	mockRepo := NewMockRepository(t)
+	ctx := context.Background()
+	for _, id := range []string{"multi-a", "multi-b", "multi-c"} {
+		require.NoError(t, mockRepo.CreateComponent(ctx, storage.Component{ComponentID: id, Name: id}))
+	}
+	server := NewAPIServer(mockRepo.Repository, reports.Config{}, nil, nil)
+
+	req := httptest.NewRequest("POST", "/reports", bytes.NewReader(newMultiComponentSubmission(t, []string{"multi-a", "multi-b", "multi-c"}, nil)))
+	w := httptest.NewRecorder()
+	server.SubmitReport(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	var response reportsclient.ReportSubmissionResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Nil(t, response.ReportId)
+	assert.Nil(t, response.SkippedComponents)
+	require.NotNil(t, response.ReportIds)
+	require.Len(t, *response.ReportIds, 3)
+
+	// Each component gets its own report sharing the check and details
+	var checkID uuid.UUID
+	for _, componentID := range []string{"multi-a", "multi-b", "multi-c"} {
+		reportID, ok := (*response.ReportIds)[componentID]
+		require.True(t, ok, componentID)
+
+		var report storage.CheckReport
+		require.NoError(t, mockRepo.DB.Preload("Component").Preload("Check").First(&report, "id = ?", reportID).Error)
+		assert.Equal(t, componentID, report.Component.ComponentID)
+		assert.Equal(t, "monorepo-lint", report.Check.Slug)
+		assert.Equal(t, storage.CheckStatusFail, report.Status)
+		assert.Equal(t, float64(3), report.Details["warnings"])
+		if checkID == uuid.Nil {
+			checkID = report.CheckID
+		}
+		assert.Equal(t, checkID, report.CheckID)
+	}
+}
+
+func TestSubmitReport_MultiComponentPartiallyMissing(t *testing.T) {
+	mockRepo := NewMockRepository(t)
+	ctx := context.Background()
+	for _, id := range []string{"partial-a", "partial-b"} {
+		require.NoError(t, mockRepo.CreateComponent(ctx, storage.Component{ComponentID: id, Name: id}))
+	}
+	server := NewAPIServer(mockRepo.Repository, reports.Config{}, nil, nil)
+	componentIDs := []string{"partial-a", "partial-missing", "partial-b"}
+
+	countReports := func() int64 {
+		var count int64
+		require.NoError(t, mockRepo.DB.Model(&storage.CheckReport{}).
+			Joins("JOIN components ON components.id = check_reports.component_id").
+			Where("components.component_id IN ?", componentIDs).
+			Count(&count).Error)
+		return count
+	}
+
+	t.Run("rejected by default", func(t *testing.T) {
+		req := httptest.NewRequest("POST", "/reports", bytes.NewReader(newMultiComponentSubmission(t, componentIDs, nil)))
+		w := httptest.NewRecorder()
+		server.SubmitReport(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		var response reportsclient.Error
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.Equal(t, "NOT_FOUND", *response.Code)
+		require.NotNil(t, response.Details)
+		assert.Equal(t, []interface{}{"partial-missing"}, (*response.Details)["missing_components"])
+
+		// Nothing is stored for the components that do exist
+		assert.Equal(t, int64(0), countReports())
+	})
+
+	t.Run("skipped when requested", func(t *testing.T) {
+		skip := reportsclient.Skip
+		req := httptest.NewRequest("POST", "/reports", bytes.NewReader(newMultiComponentSubmission(t, componentIDs, &skip)))
+		w := httptest.NewRecorder()
+		server.SubmitReport(w, req)
+
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+		var response reportsclient.ReportSubmissionResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		require.NotNil(t, response.ReportIds)
+		assert.Len(t, *response.ReportIds, 2)
+		assert.Contains(t, *response.ReportIds, "partial-a")
+		assert.Contains(t, *response.ReportIds, "partial-b")
+		require.NotNil(t, response.SkippedComponents)
+		assert.Equal(t, []string{"partial-missing"}, *response.SkippedComponents)
+		assert.Equal(t, int64(2), countReports())
+	})
+}
+
+func TestSubmitReport_MultiComponentValidation(t *testing.T) {
+	mockRepo := NewMockRepository(t)
+	server := NewAPIServer(mockRepo.Repository, reports.Config{}, nil, nil)
+
+	testCases := []struct {
+		name          string
+		body          string
+		expectedError string
+	}{
+		{
+			name:          "both component_id and component_ids",
+			body:          `{"check":{"slug":"lint"},"component_id":"a","component_ids":["b"],"status":"pass","timestamp":"2024-01-15T10:30:00Z"}`,
+			expectedError: "mutually exclusive",
+		},
+		{
+			name:          "empty component_ids",
+			body:          `{"check":{"slug":"lint"},"component_ids":[],"status":"pass","timestamp":"2024-01-15T10:30:00Z"}`,
+			expectedError: "at least one component",
+		},
+		{
+			name:          "duplicate component",
+			body:          `{"check":{"slug":"lint"},"component_ids":["a","a"],"status":"pass","timestamp":"2024-01-15T10:30:00Z"}`,
+			expectedError: "listed more than once",
+		},
+		{
+			name:          "whitespace in component",
+			body:          `{"check":{"slug":"lint"},"component_ids":["a "],"status":"pass","timestamp":"2024-01-15T10:30:00Z"}`,
+			expectedError: "leading or trailing whitespace",
+		},
+		{
+			name:          "control character in component",
+			body:          `{"check":{"slug":"lint"},"component_ids":["a\tb"],"status":"pass","timestamp":"2024-01-15T10:30:00Z"}`,
+			expectedError: "cannot contain control characters",
+		},
+		{
+			name:          "invalid missing policy",
+			body:          `{"check":{"slug":"lint"},"component_ids":["a"],"missing_components":"create","status":"pass","timestamp":"2024-01-15T10:30:00Z"}`,
+			expectedError: "missing_components must be one of",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest("POST", "/reports", strings.NewReader(tc.body))
+			w := httptest.NewRecorder()
+			server.SubmitReport(w, req)
+
+			assert.Equal(t, http.StatusBadRequest, w.Code)
+			var response reportsclient.Error
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+			assert.Contains(t, *response.Error, tc.expectedError)
+		})
+	}
+}
+
+func TestSubmitReportsBatch_AllCreated(t *testing.T) {
+	mockRepo := NewMockRepository(t)
+	ctx := context.Background()
+	for _, id := range []string{"batch-a", "batch-b"} {
+		require.NoError(t, mockRepo.CreateComponent(ctx, storage.Component{ComponentID: id, Name: id}))
+	}
+	server := NewAPIServer(mockRepo.Repository, reports.Config{}, nil, nil)
+
+	body := `[
+		{"check":{"slug":"batch-unit"},"component_id":"batch-a","status":"pass","timestamp":"2024-01-15T10:30:00Z"},
+		{"check":{"slug":"batch-unit"},"component_id":"batch-b","status":"fail","timestamp":"2024-01-15T10:31:00Z"},
+		{"check":{"slug":"batch-lint"},"component_id":"batch-a","status":"pass","timestamp":"2024-01-15T10:32:00Z"}
+	]`
+	passBefore := testutil.ToFloat64(reportSubmissionsTotal.WithLabelValues("pass"))
+	failBefore := testutil.ToFloat64(reportSubmissionsTotal.WithLabelValues("fail"))
+
+	req := httptest.NewRequest("POST", "/reports:batch", strings.NewReader(body))
+	w := httptest.NewRecorder()
+	server.SubmitReportsBatch(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	var response reportsclient.ReportBatchResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, 3, response.Succeeded)
+	assert.Equal(t, float64(2), testutil.ToFloat64(reportSubmissionsTotal.WithLabelValues("pass"))-passBefore)
+	assert.Equal(t, float64(1), testutil.ToFloat64(reportSubmissionsTotal.WithLabelValues("fail"))-failBefore)
+	assert.Equal(t, 0, response.Failed)
+	require.Len(t, response.Results, 3)
+
+	for i, result := range response.Results {
+		assert.Equal(t, i, result.Index)
+		assert.Equal(t, reportsclient.Created, result.Status)
+		require.NotNil(t, result.ReportId)
+
+		var report storage.CheckReport
+		require.NoError(t, mockRepo.DB.Preload("Component").First(&report, "id = ?", *result.ReportId).Error)
+	}
+
+	var report storage.CheckReport
+	require.NoError(t, mockRepo.DB.Preload("Component").Preload("Check").First(&report, "id = ?", *response.Results[1].ReportId).Error)
+	assert.Equal(t, "batch-b", report.Component.ComponentID)
+	assert.Equal(t, "batch-unit", report.Check.Slug)
+	assert.Equal(t, storage.CheckStatusFail, report.Status)
+}
+
+func TestSubmitReportsBatch_PartialSuccess(t *testing.T) {
+	mockRepo := NewMockRepository(t)
+	require.NoError(t, mockRepo.CreateComponent(context.Background(), storage.Component{ComponentID: "batch-partial", Name: "batch-partial"}))
+	server := NewAPIServer(mockRepo.Repository, reports.Config{}, nil, nil)
+
+	body := `[
+		{"check":{"slug":"batch-partial-check"},"component_id":"batch-partial","status":"pass","timestamp":"2024-01-15T10:30:00Z"},
+		{"check":{"slug":""},"component_id":"batch-partial","status":"pass","timestamp":"2024-01-15T10:30:00Z"},
+		{"check":{"slug":"batch-partial-check"},"component_id":"batch-partial-missing","status":"pass","timestamp":"2024-01-15T10:30:00Z"},
+		{"check":{"slug":"batch-partial-check"},"component_ids":["batch-partial"],"status":"pass","timestamp":"2024-01-15T10:30:00Z"},
+		"not a report"
+	]`
+	req := httptest.NewRequest("POST", "/reports:batch", strings.NewReader(body))
+	w := httptest.NewRecorder()
+	server.SubmitReportsBatch(w, req)
+
+	require.Equal(t, http.StatusMultiStatus, w.Code, w.Body.String())
+	var response reportsclient.ReportBatchResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, 1, response.Succeeded)
+	assert.Equal(t, 4, response.Failed)
+	require.Len(t, response.Results, 5)
+
+	assert.Equal(t, reportsclient.Created, response.Results[0].Status)
+	require.NotNil(t, response.Results[0].ReportId)
+
+	expectedCodes := []string{"VALIDATION_ERROR", "NOT_FOUND", "VALIDATION_ERROR", "VALIDATION_ERROR"}
+	for i, code := range expectedCodes {
+		result := response.Results[i+1]
+		assert.Equal(t, i+1, result.Index)
+		assert.Equal(t, reportsclient.Failed, result.Status)
+		assert.Nil(t, result.ReportId)
+		require.NotNil(t, result.Code)
+		assert.Equal(t, code, *result.Code)
+		require.NotNil(t, result.Error)
+	}
+
+	// Only the valid item is stored
+	var count int64
+	require.NoError(t, mockRepo.DB.Model(&storage.CheckReport{}).
+		Joins("JOIN checks ON checks.id = check_reports.check_id").
+		Where("checks.slug = ?", "batch-partial-check").
+		Count(&count).Error)
+	assert.Equal(t, int64(1), count)
+}
+
+func TestSubmitReportsBatch_InvalidBatch(t *testing.T) {
+	mockRepo := NewMockRepository(t)
+	server := NewAPIServer(mockRepo.Repository, reports.Config{}, nil, nil)
+
+	item := `{"check":{"slug":"lint"},"component_id":"a","status":"pass","timestamp":"2024-01-15T10:30:00Z"}`
+	oversized := "[" + strings.TrimSuffix(strings.Repeat(item+",", maxBatchSize+1), ",") + "]"
+
+	testCases := []struct {
+		name          string
+		body          string
+		expectedError string
+	}{
+		{name: "invalid JSON", body: `{"check":`, expectedError: "Invalid JSON format"},
+		{name: "not an array", body: item, expectedError: "Invalid JSON format"},
+		{name: "empty batch", body: `[]`, expectedError: "at least one report"},
+		{name: "oversized batch", body: oversized, expectedError: "more than 100 reports"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest("POST", "/reports:batch", strings.NewReader(tc.body))
+			w := httptest.NewRecorder()
+			server.SubmitReportsBatch(w, req)
+
+			assert.Equal(t, http.StatusBadRequest, w.Code)
+			var response reportsclient.Error
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+			assert.Equal(t, "VALIDATION_ERROR", *response.Code)
+			assert.Contains(t, *response.Error, tc.expectedError)
+		})
+	}
+}
+
+func TestDeleteComponentReports(t *testing.T) {
+	mockRepo := NewMockRepository(t)
+	ctx := context.Background()
+	handler := HandlerFromMux(NewAPIServer(mockRepo.Repository, reports.Config{}, nil, nil), chi.NewRouter())
+
+	for _, id := range []string{"retention-a", "retention-b"} {
+		require.NoError(t, mockRepo.CreateComponent(ctx, storage.Component{ComponentID: id, Name: id}))
+	}
+	now := time.Now().UTC().Truncate(time.Second)
+	for _, id := range []string{"retention-a", "retention-b"} {
+		for _, slug := range []string{"retention-lint", "retention-tests"} {
+			for _, age := range []time.Duration{0, 48 * time.Hour} {
+				_, err := mockRepo.CreateCheckReportFromSubmission(ctx, storage.CreateCheckReportInput{
+					ComponentID: id,
+					CheckSlug:   slug,
+					Status:      storage.CheckStatusPass,
+					Timestamp:   now.Add(-age),
+				})
+				require.NoError(t, err)
+			}
+		}
+	}
+
+	deleteReports := func(path string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, httptest.NewRequest("DELETE", path, nil))
+		return w
+	}
+	deletedCount := func(t *testing.T, w *httptest.ResponseRecorder) int64 {
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+		var response reportsclient.DeleteReportsResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		return response.Deleted
+	}
+
+	cutoff := now.Add(-24 * time.Hour).Format(time.RFC3339)
+	assert.Equal(t, int64(1), deletedCount(t, deleteReports("/components/retention-a/reports?check_slug=retention-lint&before="+cutoff)))
+	assert.Equal(t, int64(1), deletedCount(t, deleteReports("/components/retention-a/reports?before="+cutoff)))
+	assert.Equal(t, int64(2), deletedCount(t, deleteReports("/components/retention-a/reports")))
+
+	// The other component's reports of the same checks are untouched
+	_, total, err := mockRepo.GetCheckReportsForComponentWithPagination(ctx, "retention-b", nil, nil, nil, nil, 100, 0, false, storage.ReportOrder{})
+	require.NoError(t, err)
+	assert.Equal(t, int64(4), total)
+
+	t.Run("ComponentNotFound", func(t *testing.T) {
+		w := deleteReports("/components/no-such-component/reports")
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("EmptyCheckSlug", func(t *testing.T) {
+		w := deleteReports("/components/retention-b/reports?check_slug=")
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestSubmitReport_IdempotencyKey(t *testing.T) {
+	mockRepo := NewMockRepository(t)
+	require.NoError(t, mockRepo.CreateComponent(context.Background(), storage.Component{ComponentID: "idempotent-service", Name: "Idempotent Service"}))
+
+	submit := func(t *testing.T, server ServerInterface, key string, status reportsclient.ReportSubmissionStatus) (*httptest.ResponseRecorder, reportsclient.ReportSubmissionResponse) {
+		body, err := json.Marshal(reportsclient.ReportSubmission{
+			Check:          reportsclient.Check{Slug: "idempotent-tests"},
+			ComponentId:    "idempotent-service",
+			Status:         status,
+			Timestamp:      time.Now(),
+			IdempotencyKey: &key,
+		})
+		require.NoError(t, err)
+		w := httptest.NewRecorder()
+		server.SubmitReport(w, httptest.NewRequest("POST", "/reports", bytes.NewReader(body)))
+
+		var response reportsclient.ReportSubmissionResponse
+		if w.Code == http.StatusOK {
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		}
+		return w, response
+	}
+	countReports := func(t *testing.T) int64 {
+		var count int64
+		require.NoError(t, mockRepo.DB.Model(&storage.CheckReport{}).Where("idempotency_key IS NOT NULL").Count(&count).Error)
+		return count
+	}
+
+	t.Run("DuplicateReturnsOriginal", func(t *testing.T) {
+		server := NewAPIServer(mockRepo.Repository, reports.Config{}, nil, nil)
+
+		w, first := submit(t, server, "ci-run-1", reportsclient.ReportSubmissionStatusPass)
+		require.Equal(t, http.StatusOK, w.Code)
+		assert.Nil(t, first.Duplicate)
+
+		w, retry := submit(t, server, "ci-run-1", reportsclient.ReportSubmissionStatusFail)
+		require.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, *first.ReportId, *retry.ReportId)
+		require.NotNil(t, retry.Duplicate)
+		assert.True(t, *retry.Duplicate)
+		assert.Equal(t, "Report already submitted", *retry.Message)
+		assert.Equal(t, int64(1), countReports(t))
+	})
+
+	t.Run("StoredRightAwayWithAsyncIngestion", func(t *testing.T) {
+		// Workers are not started, so only a synchronous write stores the report
+		queue := reports.NewIngestQueue(mockRepo.Repository, reports.AsyncConfig{QueueSize: 10, Workers: 1}, nil)
+		server := NewAPIServer(mockRepo.Repository, reports.Config{}, queue, nil)
+
+		w, _ := submit(t, server, "ci-run-async", reportsclient.ReportSubmissionStatusPass)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, 0, queue.Len())
+		assert.Equal(t, int64(2), countReports(t))
+	})
+
+	t.Run("Validation", func(t *testing.T) {
+		server := NewAPIServer(mockRepo.Repository, reports.Config{}, nil, nil)
+
+		w, _ := submit(t, server, "", reportsclient.ReportSubmissionStatusPass)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, w.Body.String(), "idempotency_key cannot be empty")
+
+		w, _ = submit(t, server, strings.Repeat("k", 256), reportsclient.ReportSubmissionStatusPass)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, w.Body.String(), "idempotency_key cannot exceed 255 characters")
+
+		body := newMultiComponentSubmission(t, []string{"idempotent-service"}, nil)
+		var payload map[string]any
+		require.NoError(t, json.Unmarshal(body, &payload))
+		payload["idempotency_key"] = "ci-run-multi"
+		body, err := json.Marshal(payload)
+		require.NoError(t, err)
+		w = httptest.NewRecorder()
+		server.SubmitReport(w, httptest.NewRequest("POST", "/reports", bytes.NewReader(body)))
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, w.Body.String(), "idempotency_key is not supported with component_ids")
+	})
+}
@@ -0,0 +1,18 @@
+package api
+
+import (
+	"github.com/doron-cohen/argus/backend/internal/metrics"
+	"github.com/doron-cohen/argus/backend/internal/storage"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var reportSubmissionsTotal = promauto.With(metrics.Registry).NewCounterVec(prometheus.CounterOpts{
+	Name: "argus_report_submissions_total",
+	Help: "Check reports accepted through the reports API, by report status.",
+}, []string{"status"})
+
+// recordSubmissions counts accepted reports of the given status
+func recordSubmissions(status storage.CheckStatus, count int) {
+	reportSubmissionsTotal.WithLabelValues(string(status)).Add(float64(count))
+}
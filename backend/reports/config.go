@@ -0,0 +1,63 @@
+package reports
+
+import "time"
+
+// Config holds configuration for report ingestion
+type Config struct {
+	// AssumeUTC treats submitted timestamps without a timezone offset as UTC.
+	// When false, such timestamps are rejected as ambiguous.
+	AssumeUTC bool `yaml:"assume_utc"`
+
+	// MaxJSONBBytes bounds the serialized size of a report's details and metadata.
+	// Non-positive values use utils.DefaultMaxJSONBBytes (64KB).
+	MaxJSONBBytes int `yaml:"max_jsonb_bytes"`
+
+	// MaxDetailsBytes bounds the serialized size of a report's details on its own, for
+	// deployments whose reports carry large details. Non-positive values use MaxJSONBBytes.
+	MaxDetailsBytes int `yaml:"max_details_bytes"`
+
+	// MaxNestingDepth bounds the levels of nesting of a report's details and metadata.
+	// Non-positive values use utils.DefaultMaxJSONBDepth (10).
+	MaxNestingDepth int `yaml:"max_nesting_depth"`
+
+	// MaxBodyBytes bounds the size of a report submission's request body; larger bodies get 413.
+	// Batch bodies may hold up to the batch size limit times as much.
+	// Non-positive values use DefaultMaxBodyBytes.
+	MaxBodyBytes int `yaml:"max_body_bytes"`
+
+	// StrictFields rejects submissions carrying fields the API does not know, instead of ignoring them
+	StrictFields bool `yaml:"strict_fields"`
+
+	// Async configures queued ingestion. Submissions are synchronous unless enabled.
+	Async AsyncConfig `yaml:"async"`
+
+	// Retention deletes reports received longer ago than this window, e.g. "90d".
+	// Zero keeps reports forever.
+	Retention RetentionPeriod `yaml:"retention"`
+
+	// RetentionInterval is how often expired reports are pruned.
+	// Non-positive values use DefaultRetentionInterval.
+	RetentionInterval time.Duration `yaml:"retention_interval"`
+}
+
+// AsyncConfig holds configuration for asynchronous report ingestion
+type AsyncConfig struct {
+	// Enabled accepts validated submissions with 202 and persists them in the background
+	Enabled bool `yaml:"enabled"`
+	// QueueSize bounds the number of reports waiting to be persisted; submissions beyond it get 429
+	QueueSize int `yaml:"queue_size"`
+	// Workers is the number of goroutines persisting queued reports
+	Workers int `yaml:"workers"`
+}
+
+// Default async ingestion settings, used when the configured values are not positive
+const (
+	DefaultAsyncQueueSize = 1000
+	DefaultAsyncWorkers   = 4
+)
+
+// DefaultMaxBodyBytes bounds report submission bodies when no limit is configured
+const DefaultMaxBodyBytes = 1 << 20
+
+// DefaultRetentionInterval is how often expired reports are pruned when no interval is configured
+const DefaultRetentionInterval = 24 * time.Hour
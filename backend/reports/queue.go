@@ -0,0 +1,130 @@
+package reports
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"sync"
+
+	"github.com/doron-cohen/argus/backend/internal/storage"
+	"github.com/doron-cohen/argus/backend/notifications"
+	"github.com/google/uuid"
+)
+
+// ErrQueueFull is returned when the ingestion queue has no room for another report
+var ErrQueueFull = errors.New("report ingestion queue is full")
+
+// ErrQueueClosed is returned when enqueueing after the queue has been stopped
+var ErrQueueClosed = errors.New("report ingestion queue is closed")
+
+// IngestQueue persists validated reports asynchronously through a bounded
+// channel drained by a pool of workers
+type IngestQueue struct {
+	repo     *storage.Repository
+	notifier *notifications.TransitionNotifier
+	reports  chan storage.CreateCheckReportInput
+	workers  int
+
+	mu      sync.RWMutex
+	closed  bool
+	started bool
+	wg      sync.WaitGroup
+}
+
+// NewIngestQueue creates a new ingestion queue. Call Start to begin persisting.
+// notifier, which may be nil, is told about every report the workers persist.
+func NewIngestQueue(repo *storage.Repository, cfg AsyncConfig, notifier *notifications.TransitionNotifier) *IngestQueue {
+	queueSize := cfg.QueueSize
+	if queueSize <= 0 {
+		queueSize = DefaultAsyncQueueSize
+	}
+	workers := cfg.Workers
+	if workers <= 0 {
+		workers = DefaultAsyncWorkers
+	}
+
+	return &IngestQueue{
+		repo:     repo,
+		notifier: notifier,
+		reports:  make(chan storage.CreateCheckReportInput, queueSize),
+		workers:  workers,
+	}
+}
+
+// Start launches the persist workers
+func (q *IngestQueue) Start() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.started {
+		return
+	}
+	q.started = true
+
+	slog.Info("Starting report ingestion workers", "workers", q.workers, "queue_size", cap(q.reports))
+	for i := 0; i < q.workers; i++ {
+		q.wg.Add(1)
+		go q.work()
+	}
+}
+
+// Enqueue adds a report to the queue without blocking
+func (q *IngestQueue) Enqueue(input storage.CreateCheckReportInput) error {
+	q.mu.RLock()
+	defer q.mu.RUnlock()
+	if q.closed {
+		return ErrQueueClosed
+	}
+
+	select {
+	case q.reports <- input:
+		return nil
+	default:
+		return ErrQueueFull
+	}
+}
+
+// Len returns the number of reports waiting to be persisted
+func (q *IngestQueue) Len() int {
+	return len(q.reports)
+}
+
+// Stop stops accepting reports and waits for the workers to persist everything already queued
+func (q *IngestQueue) Stop() {
+	q.mu.Lock()
+	if q.closed {
+		q.mu.Unlock()
+		return
+	}
+	q.closed = true
+	close(q.reports)
+	q.mu.Unlock()
+
+	q.wg.Wait()
+}
+
+// work persists queued reports until the queue is closed and drained
+func (q *IngestQueue) work() {
+	defer q.wg.Done()
+	for input := range q.reports {
+		q.persist(input)
+	}
+}
+
+// persist stores a queued report and notifies transition webhooks about it
+func (q *IngestQueue) persist(input storage.CreateCheckReportInput) {
+	// Requests that enqueued the report are long gone, so use a background context
+	ctx := context.Background()
+	inputs := []storage.CreateCheckReportInput{input}
+	snapshot := q.notifier.Snapshot(ctx, inputs)
+	defer snapshot.Release()
+	reportID, err := q.repo.CreateCheckReportFromSubmission(ctx, input)
+	if err != nil {
+		slog.Error("Failed to persist queued report",
+			"report_id", input.ReportID,
+			"component_id", input.ComponentID,
+			"check_slug", input.CheckSlug,
+			"error", err)
+		return
+	}
+	q.notifier.Notify(snapshot, inputs, []uuid.UUID{reportID})
+}
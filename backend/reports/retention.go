@@ -0,0 +1,108 @@
+package reports
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/doron-cohen/argus/backend/internal/storage"
+	"gopkg.in/yaml.v3"
+)
+
+// RetentionPeriod is a duration that also accepts whole days, such as "90d"
+type RetentionPeriod time.Duration
+
+// ParseRetentionPeriod parses "<n>d" as n days and anything else as a Go duration
+func ParseRetentionPeriod(value string) (RetentionPeriod, error) {
+	value = strings.TrimSpace(value)
+	if days, ok := strings.CutSuffix(value, "d"); ok {
+		n, err := strconv.Atoi(days)
+		if err != nil || n < 0 {
+			return 0, fmt.Errorf("invalid retention period %q", value)
+		}
+		return RetentionPeriod(time.Duration(n) * 24 * time.Hour), nil
+	}
+
+	d, err := time.ParseDuration(value)
+	if err != nil || d < 0 {
+		return 0, fmt.Errorf("invalid retention period %q", value)
+	}
+	return RetentionPeriod(d), nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler
+func (p *RetentionPeriod) UnmarshalYAML(node *yaml.Node) error {
+	var value string
+	if err := node.Decode(&value); err != nil {
+		return err
+	}
+	parsed, err := ParseRetentionPeriod(value)
+	if err != nil {
+		return err
+	}
+	*p = parsed
+	return nil
+}
+
+// Pruner periodically deletes reports older than the retention window
+type Pruner struct {
+	repo      *storage.Repository
+	retention time.Duration
+	interval  time.Duration
+}
+
+// NewPruner creates a pruner for the configured retention policy
+func NewPruner(repo *storage.Repository, cfg Config) *Pruner {
+	interval := cfg.RetentionInterval
+	if interval <= 0 {
+		interval = DefaultRetentionInterval
+	}
+
+	return &Pruner{
+		repo:      repo,
+		retention: time.Duration(cfg.Retention),
+		interval:  interval,
+	}
+}
+
+// Start prunes once and then on every interval until ctx is cancelled.
+// It returns immediately when no retention is configured.
+func (p *Pruner) Start(ctx context.Context) {
+	if p.retention <= 0 {
+		slog.Info("Report retention disabled, keeping reports forever")
+		return
+	}
+
+	slog.Info("Starting report retention", "retention", p.retention, "interval", p.interval)
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	for {
+		_, _ = p.Prune(ctx) // Failures are logged and retried on the next tick
+
+		select {
+		case <-ctx.Done():
+			slog.Info("Stopping report retention")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Prune deletes the reports that are currently older than the retention window
+func (p *Pruner) Prune(ctx context.Context) (int64, error) {
+	cutoff := time.Now().Add(-p.retention)
+	pruned, err := p.repo.PruneReportsOlderThan(ctx, cutoff)
+	if err != nil {
+		if ctx.Err() == nil {
+			slog.Error("Failed to prune expired reports", "cutoff", cutoff, "error", err)
+		}
+		return 0, err
+	}
+
+	slog.Info("Pruned expired reports", "count", pruned, "cutoff", cutoff)
+	return pruned, nil
+}
@@ -209,14 +209,14 @@ func (s *Service) validateTimestamp(timestamp time.Time) error {
 func (s *Service) validateOptionalFields(input SubmitReportInput) error {
 	// Validate details (if provided)
 	if input.Details != nil {
-		if err := utils.ValidateJSONBField(*input.Details, "details"); err != nil {
+		if err := utils.ValidateJSONBField(*input.Details, "details", 0, 0); err != nil {
 			return err
 		}
 	}
 
 	// Validate metadata (if provided)
 	if input.Metadata != nil {
-		if err := utils.ValidateJSONBField(*input.Metadata, "metadata"); err != nil {
+		if err := utils.ValidateJSONBField(*input.Metadata, "metadata", 0, 0); err != nil {
 			return err
 		}
 	}
@@ -20,10 +20,18 @@ import (
 	"github.com/oapi-codegen/runtime"
 )
 
+// Defines values for PlannedChangeAction.
+const (
+	Create PlannedChangeAction = "create"
+	Delete PlannedChangeAction = "delete"
+	Update PlannedChangeAction = "update"
+)
+
 // Defines values for SyncSourceType.
 const (
 	Filesystem SyncSourceType = "filesystem"
 	Git        SyncSourceType = "git"
+	Http       SyncSourceType = "http"
 )
 
 // Defines values for SyncStatusStatus.
@@ -34,12 +42,28 @@ const (
 	Running   SyncStatusStatus = "running"
 )
 
+// ComponentSyncError defines model for ComponentSyncError.
+type ComponentSyncError struct {
+	// Component Name of the component from its manifest
+	Component string `json:"component"`
+	Error     string `json:"error"`
+}
+
 // Error defines model for Error.
 type Error struct {
 	Code    *string `json:"code,omitempty"`
 	Message *string `json:"message,omitempty"`
 }
 
+// FieldChange defines model for FieldChange.
+type FieldChange struct {
+	// New Value the sync would write
+	New interface{} `json:"new,omitempty"`
+
+	// Old Value currently in the catalog
+	Old interface{} `json:"old,omitempty"`
+}
+
 // FilesystemSourceConfig defines model for FilesystemSourceConfig.
 type FilesystemSourceConfig struct {
 	BasePath *string `json:"basePath,omitempty"`
@@ -50,19 +74,62 @@ type FilesystemSourceConfig struct {
 type GitSourceConfig struct {
 	BasePath *string `json:"basePath,omitempty"`
 	Branch   *string `json:"branch,omitempty"`
-	Url      *string `json:"url,omitempty"`
+
+	// Commit Fixed commit hash synced instead of a branch
+	Commit *string `json:"commit,omitempty"`
+
+	// Tag Fixed tag synced instead of a branch
+	Tag *string `json:"tag,omitempty"`
+
+	// Url Repository URL, with any embedded credentials redacted
+	Url *string `json:"url,omitempty"`
+}
+
+// HttpSourceConfig Manifest bundle served over HTTP. Configured request headers are not exposed.
+type HttpSourceConfig struct {
+	// Url Bundle URL, with any embedded credentials redacted
+	Url *string `json:"url,omitempty"`
+}
+
+// PlannedChange defines model for PlannedChange.
+type PlannedChange struct {
+	Action PlannedChangeAction `json:"action"`
+
+	// Changes For updates, the old and new value of each changed field, keyed by field name
+	Changes     *map[string]FieldChange `json:"changes,omitempty"`
+	ComponentId string                  `json:"componentId"`
+	Name        *string                 `json:"name,omitempty"`
+}
+
+// PlannedChangeAction defines model for PlannedChange.Action.
+type PlannedChangeAction string
+
+// SyncPlan defines model for SyncPlan.
+type SyncPlan struct {
+	Changes []PlannedChange `json:"changes"`
+
+	// HardDelete Whether deleted components would be removed permanently along with their reports and history
+	HardDelete bool `json:"hardDelete"`
+	SourceId   int  `json:"sourceId"`
+
+	// Unchanged Fetched components that already match the catalog
+	Unchanged int `json:"unchanged"`
 }
 
 // SyncSource defines model for SyncSource.
 type SyncSource struct {
 	Config *SyncSource_Config `json:"config,omitempty"`
 
+	// Enabled Whether the source is synced, periodically or when triggered
+	Enabled *bool `json:"enabled,omitempty"`
+
 	// Id Unique identifier for the source (index-based)
 	Id *int `json:"id,omitempty"`
 
 	// Interval Sync interval (e.g., "5m", "1h")
-	Interval *string         `json:"interval,omitempty"`
-	Type     *SyncSourceType `json:"type,omitempty"`
+	Interval   *string         `json:"interval,omitempty"`
+	LastStatus *SyncStatus     `json:"lastStatus,omitempty"`
+	Type       *SyncSourceType `json:"type,omitempty"`
 }
 
 // SyncSource_Config defines model for SyncSource.Config.
@@ -73,22 +140,62 @@ type SyncSource_Config struct {
 // SyncSourceType defines model for SyncSource.Type.
 type SyncSourceType string
 
+// SyncSourceUpdate defines model for SyncSourceUpdate.
+type SyncSourceUpdate struct {
+	Enabled bool `json:"enabled"`
+}
+
 // SyncStatus defines model for SyncStatus.
 type SyncStatus struct {
-	// ComponentsCount Number of components synced in last successful run
+	// Age Time since the last sync, rounded to the second (e.g., "2m30s"), null before the first sync
+	Age *string `json:"age"`
+
+	// ComponentErrors Components that could not be stored in the last run, capped at 50 entries (see failed for the total)
+	ComponentErrors *[]ComponentSyncError `json:"componentErrors,omitempty"`
+
+	// ComponentsCount Number of components fetched from the source in the last run
 	ComponentsCount *int `json:"componentsCount,omitempty"`
 
+	// Created Components created in the last run
+	Created *int `json:"created,omitempty"`
+
+	// Deleted Components removed in the last run because the source no longer provides them
+	Deleted *int `json:"deleted,omitempty"`
+
 	// Duration Duration of last sync operation
-	Duration  *string           `json:"duration"`
-	LastError *string           `json:"lastError"`
-	LastSync  *time.Time        `json:"lastSync"`
-	SourceId  *int              `json:"sourceId,omitempty"`
-	Status    *SyncStatusStatus `json:"status,omitempty"`
+	Duration *string `json:"duration"`
+
+	// Failed Fetched components that could not be stored in the last run
+	Failed    *int       `json:"failed,omitempty"`
+	LastError *string    `json:"lastError"`
+	LastSync  *time.Time `json:"lastSync"`
+
+	// NextSync When the next periodic sync is scheduled, null when the source is disabled or not synced periodically
+	NextSync *time.Time `json:"nextSync"`
+
+	// Skipped Existing components that were already up to date in the last run
+	Skipped  *int              `json:"skipped,omitempty"`
+	SourceId *int              `json:"sourceId,omitempty"`
+	Status   *SyncStatusStatus `json:"status,omitempty"`
+
+	// Updated Existing components whose fields changed in the last run
+	Updated *int `json:"updated,omitempty"`
 }
 
 // SyncStatusStatus defines model for SyncStatus.Status.
 type SyncStatusStatus string
 
+// SyncTriggerByUrlResponse defines model for SyncTriggerByUrlResponse.
+type SyncTriggerByUrlResponse struct {
+	// AlreadyRunning Indexes of matching sources that were already syncing
+	AlreadyRunning []int   `json:"alreadyRunning"`
+	Message        *string `json:"message,omitempty"`
+
+	// Triggered Indexes of the sources that were triggered
+	Triggered []int  `json:"triggered"`
+	Url       string `json:"url"`
+}
+
 // SyncTriggerResponse defines model for SyncTriggerResponse.
 type SyncTriggerResponse struct {
 	Message   *string `json:"message,omitempty"`
@@ -96,6 +203,15 @@ type SyncTriggerResponse struct {
 	Triggered *bool   `json:"triggered,omitempty"`
 }
 
+// TriggerSyncByUrlParams defines parameters for TriggerSyncByUrl.
+type TriggerSyncByUrlParams struct {
+	// Url Git repository URL to match against configured sources
+	Url string `form:"url" json:"url"`
+}
+
+// UpdateSyncSourceJSONRequestBody defines body for UpdateSyncSource for application/json ContentType.
+type UpdateSyncSourceJSONRequestBody = SyncSourceUpdate
+
 // AsGitSourceConfig returns the union data inside the SyncSource_Config as a GitSourceConfig
 func (t SyncSource_Config) AsGitSourceConfig() (GitSourceConfig, error) {
 	var body GitSourceConfig
@@ -148,6 +264,32 @@ func (t *SyncSource_Config) MergeFilesystemSourceConfig(v FilesystemSourceConfig
 	return err
 }
 
+// AsHttpSourceConfig returns the union data inside the SyncSource_Config as a HttpSourceConfig
+func (t SyncSource_Config) AsHttpSourceConfig() (HttpSourceConfig, error) {
+	var body HttpSourceConfig
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromHttpSourceConfig overwrites any union data inside the SyncSource_Config as the provided HttpSourceConfig
+func (t *SyncSource_Config) FromHttpSourceConfig(v HttpSourceConfig) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeHttpSourceConfig performs a merge with any union data inside the SyncSource_Config, using the provided HttpSourceConfig
+func (t *SyncSource_Config) MergeHttpSourceConfig(v HttpSourceConfig) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
 func (t SyncSource_Config) MarshalJSON() ([]byte, error) {
 	b, err := t.union.MarshalJSON()
 	return b, err
@@ -166,12 +308,21 @@ type ServerInterface interface {
 	// Get specific sync source details
 	// (GET /sources/{id})
 	GetSyncSource(w http.ResponseWriter, r *http.Request, id int)
+	// Enable or disable a sync source
+	// (PATCH /sources/{id})
+	UpdateSyncSource(w http.ResponseWriter, r *http.Request, id int)
+	// Preview the changes a sync of a source would make
+	// (POST /sources/{id}/plan)
+	PlanSyncSource(w http.ResponseWriter, r *http.Request, id int)
 	// Get sync status for specific source
 	// (GET /sources/{id}/status)
 	GetSyncSourceStatus(w http.ResponseWriter, r *http.Request, id int)
 	// Trigger manual sync for specific source
 	// (POST /sources/{id}/trigger)
 	TriggerSyncSource(w http.ResponseWriter, r *http.Request, id int)
+	// Trigger sync for all git sources pointing at a repository
+	// (POST /sync)
+	TriggerSyncByUrl(w http.ResponseWriter, r *http.Request, params TriggerSyncByUrlParams)
 }
 
 // Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
@@ -190,6 +341,18 @@ func (_ Unimplemented) GetSyncSource(w http.ResponseWriter, r *http.Request, id
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Enable or disable a sync source
+// (PATCH /sources/{id})
+func (_ Unimplemented) UpdateSyncSource(w http.ResponseWriter, r *http.Request, id int) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Preview the changes a sync of a source would make
+// (POST /sources/{id}/plan)
+func (_ Unimplemented) PlanSyncSource(w http.ResponseWriter, r *http.Request, id int) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Get sync status for specific source
 // (GET /sources/{id}/status)
 func (_ Unimplemented) GetSyncSourceStatus(w http.ResponseWriter, r *http.Request, id int) {
@@ -202,6 +365,12 @@ func (_ Unimplemented) TriggerSyncSource(w http.ResponseWriter, r *http.Request,
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Trigger sync for all git sources pointing at a repository
+// (POST /sync)
+func (_ Unimplemented) TriggerSyncByUrl(w http.ResponseWriter, r *http.Request, params TriggerSyncByUrlParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // ServerInterfaceWrapper converts contexts to parameters.
 type ServerInterfaceWrapper struct {
 	Handler            ServerInterface
@@ -250,6 +419,56 @@ func (siw *ServerInterfaceWrapper) GetSyncSource(w http.ResponseWriter, r *http.
 	handler.ServeHTTP(w, r)
 }
 
+// UpdateSyncSource operation middleware
+func (siw *ServerInterfaceWrapper) UpdateSyncSource(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateSyncSource(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PlanSyncSource operation middleware
+func (siw *ServerInterfaceWrapper) PlanSyncSource(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PlanSyncSource(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
 // GetSyncSourceStatus operation middleware
 func (siw *ServerInterfaceWrapper) GetSyncSourceStatus(w http.ResponseWriter, r *http.Request) {
 
@@ -300,6 +519,40 @@ func (siw *ServerInterfaceWrapper) TriggerSyncSource(w http.ResponseWriter, r *h
 	handler.ServeHTTP(w, r)
 }
 
+// TriggerSyncByUrl operation middleware
+func (siw *ServerInterfaceWrapper) TriggerSyncByUrl(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params TriggerSyncByUrlParams
+
+	// ------------- Required query parameter "url" -------------
+
+	if paramValue := r.URL.Query().Get("url"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "url"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "url", r.URL.Query(), &params.Url)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "url", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.TriggerSyncByUrl(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
 type UnescapedCookieParamError struct {
 	ParamName string
 	Err       error
@@ -419,12 +672,21 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/sources/{id}", wrapper.GetSyncSource)
 	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/sources/{id}", wrapper.UpdateSyncSource)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/sources/{id}/plan", wrapper.PlanSyncSource)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/sources/{id}/status", wrapper.GetSyncSourceStatus)
 	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/sources/{id}/trigger", wrapper.TriggerSyncSource)
 	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/sync", wrapper.TriggerSyncByUrl)
+	})
 
 	return r
 }
@@ -432,20 +694,44 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/9RWTY/bNhD9K8S0hxRQbOejh+oWpG0QoGiDbnvK7oEWR9oJ+KEMyaCGof9ekJQs19a6",
-	"DhI0zcmE+PFm3nt89B4aZ3pn0QYP9R58c49G5uFPzI7ToGfXIwfC/LlxCtNv2PUINfjAZDsYKjDoveyW",
-	"5oZq+uK277AJafXPpNHvfEBz4yI3+NLZlrpzuK30+EaG+0XIfnliCe8VhU8A2rK0zfJUZH1lCTc725Qa",
-	"llidqnIWf2uhfruHbxlbqOGb9SzRetRnfdrOUF1e/wDdw91QAamEq9A3TH0gZ6GGPy29jyhIoQ3UErJo",
-	"HYtwj8Ln/eIRWYV/PU6sqe+gAkOWTDRQbw6dkw3YIafW05A/SH0OlEgR07R4hKtuVYlb+N7cQvp9cn8L",
-	"6fgz2suHPaBNoG+howAVtIc24a66WpMgQ/RLmkw0vnTRhvPaf41miyxcK+alwu9sg0qQFVr6IHxsGvS+",
-	"jVpwtLBEjoosy4mnAD+OMwminJbYSjWWDRXYqLXcaoQ6cMQFntK2w02+anWiJC1uHRsZoAYlAz4OZPAa",
-	"vGKP19lSlz3hD7RPGpLSCYOjtem0KiugMaBK0krSqD5C1j+Yug75d/S9s37hzj0cWB/TRigwqI7O2Tqn",
-	"Udql2oZ8G1qXV1NITMIL7qIX+Sa8ePMaKviA7IsDNqsnq02CcT1a2RPU8Gy1WT2DEn65j3UpNo87zD49",
-	"WCR1AK8wzNnjE8MjJ3nL081mTKCAxeWy7zU1ef/6nS/GLEGSRhTQ5I2X8uYo62YOJLPcFQr+6fNfyIdy",
-	"jVIqRUZVnD41lnb4aIzkXWlHSK3Pl0xErPekhuvYyDyyNBiQfU5dSgXlh6UCK01WW2XO3kfKMhfnz4Rc",
-	"8sdw94lsX0vyOak3Mz1CYZCkfdLi+eb5ZyugBMsSdoG1LojWRasWFPQ9NtRScyzjXOepmus5Lf5d1DHQ",
-	"v3ppSxsPSpunT97l/5XCJ1XOik+ePRV5zNIc1c4vyDxm+he9v08/q8inr9RDah+emaN/FHr3BeVOwD/8",
-	"B8CpeakZpdqJ8Z/B6Hnyx06avTdSKoy0UY7PxLL/huHvAAAA//8XEi9TAA0AAA==",
+	"H4sIAAAAAAAC/9xaS3PcNhL+K13YPdhV9Gj8OuzcbMVxXKVkVbK0e4h8wBBNEjEJ0ACo0ZRr/vtWA3wN",
+	"iZnRJo6c3ZNHIdjd6P766wfzlaW6qrVC5SxbfWU2LbDi/ud59+DjVqXvjNGG/mttdI3GSfRn+pfpD4E2",
+	"NbJ2Uiu2Yr/wCkFn4AqE/hhkRlcgnYWKK5mhdSxhblsjWzHrjFQ52yUMO2WTJ7uEGfzSSIOCrX4dKe9e",
+	"+dQL0+vfMHUk7KDlAiMqElahtTzHuPqZ9B8lluK84CrHuQ6Fm7lf/sXLBr1X7FalsNFNKWBjpEOSp0tx",
+	"6JW0MQaVK7cgVfAqd7zUBw0r0W6tw+qjbkyK51plMp/buOYWL7kror6o4w9i+t5L9wcUrQ1XafxRqqtK",
+	"RvD1o7xHAeEpFNwW3p8oQCrrkAvCHodWcARkjueHhDqe/5fCGlPOhV1hra102mzh5uoigY10BXC1BazW",
+	"KARZb1CgcpKXFgwKnjoUc/Exd//kXD319772n9sEg3WjRIlg0dyhAH2HBn66vr5cQHizMSiA0orOFsgF",
+	"GgvcICjtAO9rbVEsWDKJZvTCb4Omb37Zy5IrhQfTjKdB/1eGqqk8MxjkDlnCmlqEHwJLdMg+zfQlLPVi",
+	"gyQhJMni5eWehr8bzNiK/e1sIMuzlinPxgywS6Z40gaCDTbxOatLAVwJULiBO5/WOgPkaQHBDAEZyUvg",
+	"M25RwHob/gbFK2QR1/QGfRDR7PHvnWTS1oP74mJkSqWAohHh08GL0mF10m37MR2izo3hW/q74Eb8EKI2",
+	"A9q/C3QFGghRFUN9sS2frhEMVpoAX6OpuArEyUut8gBNV6A0YLDWxlkfkkJaytXBy2utS+SKjLE+1YKP",
+	"K6lkRThb9ielcpij8Uyg2kBGyAVdWuxb6wrugJcGudhCxV1a7DH7XMEkcL1dA4zHJux58VA8A43EKmRH",
+	"LFrhPzO2+vV4RKcVYJecSpxohTr12oz5dp+oZVB8XaI4DBVfcP1rIG3L7gmBQ2ohU16WW9AGNgUqcEbm",
+	"ORoUUSjIiJIbJb80CNLzWybRQKb3VD6RSuD9M6qB4ilLTmCIfpo7HmFYihd0j+EJLvJFArfsdXXL6N/n",
+	"xS17GqtPJbfuo+OuOZmXHhHhZJ+UA7Hm0rGEZX3kCGHO1RFe3R1F203g5RnmRmGcen6C/O7kQVT3t50U",
+	"izzCJ9eyQrBSpaExI295iCRgdKOoeDntn1hMtRKD419UL5f2lj1NQDVlCWvMtAkyMmlaISxh9JDMZStn",
+	"GkyiTU4IhO9W7dzC8wlnpJ7mqESvEazTxvcqg/WmUQmkvK5RAHfwegmonJFo4YlFhIzLEkWPUqcdLwk4",
+	"D6LuyGAQ4e/hxXPdRAeEplqjofo3HIWs5Ug/J4xzdv9uLJY2oeqLo85rzzxIXltdjsrrqsxEHqwx5Y3F",
+	"8RWUBio/aKA2+k4KpFBiFVfdGN41Nfu6f2ifkN96nAIhnLc1/CTYQvAfXp8egLXoJehhP32dtMpTFKXL",
+	"6ivLtKm4YytGLPHMSd/7nJSg8L6XMCsCwV460pN+cB1VA7p2U1JB8Gm86Y4PBUNI6/mGigR5oh0PxvWD",
+	"Jb/TbvtZUp7OzX53L62TKp9FZIMG+7ahqYmdSOODovLwVsb2FNrRvxQl3cg0SpHtIclDkvSwirXYoQV+",
+	"4A03hbYY2l7bd8Wnb3ao4FyHav52e2PKK7S1VjY2QwRnXrU3mxn6geo3Wso636aRzcGTsZAQOoKDekI9",
+	"7uopeR5eQiRsaE+OWTnAd2zguLX5naa1k9/xgYIOjS1Npg7+dDxahwN1zDMPh/aeDyOdxsS2nW/KMu1P",
+	"S0e5zN6YvLHgG7I3lx9Ywu7Q2BCG5eL5YklqdI2K15Kt2MvFcvGShY2Kv8dZGxz6nWOkPF5I69rlWT+m",
+	"e8LqojoaY8jcpO8LEyh1GooEzTUeCT6VQWe3KrzRl44FnI/m8n5Yl6ojv5uri7AP6Gb2xa1iCesrDrmb",
+	"vUc3NHeWERhCAP39XiyX7UDh2lUhr+tSBhvPfrOhzoX24sED5KBvjtLdLol5U2eHnOmDbpuq4mYbrgO8",
+	"LOdHuqidfZViNwrdEW/4oBteoUNj/RAlySACAuvmcyYFGydQKBSDQ46BeffpD3r7oU6eO/Xj4B4Q6Lgs",
+	"/dDwavnqmxnQtpcR3V1T5SCjHj0SQVtjKrOuzs/trInII91dGKTHDUDXJ7eFjxq4FK0FrcrtAq5HSRrS",
+	"jgYk6hrIurb2JWA1cDBoHTfO/6tNq2UESr8TWsCbYHNXTzq9xxoTaQmyehOGlUwqaYvFLFHD4PXY6PTL",
+	"xbdabP8EYIYbBYjs27j7bokRbNqjmJAYyz8/MT6oO17KYaW7Jrf/RbLynR/aqYduYQt830dTij2ru2Wj",
+	"tu7QxLKXqlTxyGJOySVp4hs6S55zqawbr9h8FdWN819gKMW42jrq7uapQxvL/yNap+vEQrhHf8MXqop/",
+	"xu8Io4S9fozkuR6AtDf1dmsJbTpwTaF9afBO4iZgq3VhC27/7aiVOnbmDOzDwHW6rWj3W//zzUW7ajzU",
+	"XPjHk23qX6rHmFg59ByHGK0dPcakth/mdgT6rlTz4psGeTrUHYp2P5WBbdIUrc0a2qt8T9Z5tfzHIyiO",
+	"9XoB89K2SEpAmwOt3wSWrbfp/7BoeDvDHIZmuy+L19dWlAW8Q7OFXLqex/yS5ubqIqxD0CZgMOdGlL4v",
+	"ztpv5WQ0ffQAAusC2vlwuidpx8taG2qa1o3zMTD4rAfEvBqPssSvduZJsn+V99KB2fsgT22yN77vC8bD",
+	"YT/J4j2nHRdb+S8ddnV2lktXNOtFqquzrW7MM21y6lMcbf+e0Wd2Gd70WfqlQbMd0jQsRo7m6QWq3BVs",
+	"9Xz+TeWREnV/V3Y6Wwld063Yo/W7P0trSa82gFXtttCYEnooPBp5/KK71GgTwifrzdXFgezs05L2DENi",
+	"Wai1VKETdcBHkCWlu/8MADGbUFyvJQAA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -4,6 +4,7 @@
 package client
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
@@ -16,10 +17,18 @@ import (
 	"github.com/oapi-codegen/runtime"
 )
 
+// Defines values for PlannedChangeAction.
+const (
+	Create PlannedChangeAction = "create"
+	Delete PlannedChangeAction = "delete"
+	Update PlannedChangeAction = "update"
+)
+
 // Defines values for SyncSourceType.
 const (
 	Filesystem SyncSourceType = "filesystem"
 	Git        SyncSourceType = "git"
+	Http       SyncSourceType = "http"
 )
 
 // Defines values for SyncStatusStatus.
@@ -30,12 +39,28 @@ const (
 	Running   SyncStatusStatus = "running"
 )
 
+// ComponentSyncError defines model for ComponentSyncError.
+type ComponentSyncError struct {
+	// Component Name of the component from its manifest
+	Component string `json:"component"`
+	Error     string `json:"error"`
+}
+
 // Error defines model for Error.
 type Error struct {
 	Code    *string `json:"code,omitempty"`
 	Message *string `json:"message,omitempty"`
 }
 
+// FieldChange defines model for FieldChange.
+type FieldChange struct {
+	// New Value the sync would write
+	New interface{} `json:"new,omitempty"`
+
+	// Old Value currently in the catalog
+	Old interface{} `json:"old,omitempty"`
+}
+
 // FilesystemSourceConfig defines model for FilesystemSourceConfig.
 type FilesystemSourceConfig struct {
 	BasePath *string `json:"basePath,omitempty"`
@@ -46,19 +71,62 @@ type FilesystemSourceConfig struct {
 type GitSourceConfig struct {
 	BasePath *string `json:"basePath,omitempty"`
 	Branch   *string `json:"branch,omitempty"`
-	Url      *string `json:"url,omitempty"`
+
+	// Commit Fixed commit hash synced instead of a branch
+	Commit *string `json:"commit,omitempty"`
+
+	// Tag Fixed tag synced instead of a branch
+	Tag *string `json:"tag,omitempty"`
+
+	// Url Repository URL, with any embedded credentials redacted
+	Url *string `json:"url,omitempty"`
+}
+
+// HttpSourceConfig Manifest bundle served over HTTP. Configured request headers are not exposed.
+type HttpSourceConfig struct {
+	// Url Bundle URL, with any embedded credentials redacted
+	Url *string `json:"url,omitempty"`
+}
+
+// PlannedChange defines model for PlannedChange.
+type PlannedChange struct {
+	Action PlannedChangeAction `json:"action"`
+
+	// Changes For updates, the old and new value of each changed field, keyed by field name
+	Changes     *map[string]FieldChange `json:"changes,omitempty"`
+	ComponentId string                  `json:"componentId"`
+	Name        *string                 `json:"name,omitempty"`
+}
+
+// PlannedChangeAction defines model for PlannedChange.Action.
+type PlannedChangeAction string
+
+// SyncPlan defines model for SyncPlan.
+type SyncPlan struct {
+	Changes []PlannedChange `json:"changes"`
+
+	// HardDelete Whether deleted components would be removed permanently along with their reports and history
+	HardDelete bool `json:"hardDelete"`
+	SourceId   int  `json:"sourceId"`
+
+	// Unchanged Fetched components that already match the catalog
+	Unchanged int `json:"unchanged"`
 }
 
 // SyncSource defines model for SyncSource.
 type SyncSource struct {
 	Config *SyncSource_Config `json:"config,omitempty"`
 
+	// Enabled Whether the source is synced, periodically or when triggered
+	Enabled *bool `json:"enabled,omitempty"`
+
 	// Id Unique identifier for the source (index-based)
 	Id *int `json:"id,omitempty"`
 
 	// Interval Sync interval (e.g., "5m", "1h")
-	Interval *string         `json:"interval,omitempty"`
-	Type     *SyncSourceType `json:"type,omitempty"`
+	Interval   *string         `json:"interval,omitempty"`
+	LastStatus *SyncStatus     `json:"lastStatus,omitempty"`
+	Type       *SyncSourceType `json:"type,omitempty"`
 }
 
 // SyncSource_Config defines model for SyncSource.Config.
@@ -69,22 +137,62 @@ type SyncSource_Config struct {
 // SyncSourceType defines model for SyncSource.Type.
 type SyncSourceType string
 
+// SyncSourceUpdate defines model for SyncSourceUpdate.
+type SyncSourceUpdate struct {
+	Enabled bool `json:"enabled"`
+}
+
 // SyncStatus defines model for SyncStatus.
 type SyncStatus struct {
-	// ComponentsCount Number of components synced in last successful run
+	// Age Time since the last sync, rounded to the second (e.g., "2m30s"), null before the first sync
+	Age *string `json:"age"`
+
+	// ComponentErrors Components that could not be stored in the last run, capped at 50 entries (see failed for the total)
+	ComponentErrors *[]ComponentSyncError `json:"componentErrors,omitempty"`
+
+	// ComponentsCount Number of components fetched from the source in the last run
 	ComponentsCount *int `json:"componentsCount,omitempty"`
 
+	// Created Components created in the last run
+	Created *int `json:"created,omitempty"`
+
+	// Deleted Components removed in the last run because the source no longer provides them
+	Deleted *int `json:"deleted,omitempty"`
+
 	// Duration Duration of last sync operation
-	Duration  *string           `json:"duration"`
-	LastError *string           `json:"lastError"`
-	LastSync  *time.Time        `json:"lastSync"`
-	SourceId  *int              `json:"sourceId,omitempty"`
-	Status    *SyncStatusStatus `json:"status,omitempty"`
+	Duration *string `json:"duration"`
+
+	// Failed Fetched components that could not be stored in the last run
+	Failed    *int       `json:"failed,omitempty"`
+	LastError *string    `json:"lastError"`
+	LastSync  *time.Time `json:"lastSync"`
+
+	// NextSync When the next periodic sync is scheduled, null when the source is disabled or not synced periodically
+	NextSync *time.Time `json:"nextSync"`
+
+	// Skipped Existing components that were already up to date in the last run
+	Skipped  *int              `json:"skipped,omitempty"`
+	SourceId *int              `json:"sourceId,omitempty"`
+	Status   *SyncStatusStatus `json:"status,omitempty"`
+
+	// Updated Existing components whose fields changed in the last run
+	Updated *int `json:"updated,omitempty"`
 }
 
 // SyncStatusStatus defines model for SyncStatus.Status.
 type SyncStatusStatus string
 
+// SyncTriggerByUrlResponse defines model for SyncTriggerByUrlResponse.
+type SyncTriggerByUrlResponse struct {
+	// AlreadyRunning Indexes of matching sources that were already syncing
+	AlreadyRunning []int   `json:"alreadyRunning"`
+	Message        *string `json:"message,omitempty"`
+
+	// Triggered Indexes of the sources that were triggered
+	Triggered []int  `json:"triggered"`
+	Url       string `json:"url"`
+}
+
 // SyncTriggerResponse defines model for SyncTriggerResponse.
 type SyncTriggerResponse struct {
 	Message   *string `json:"message,omitempty"`
@@ -92,6 +200,15 @@ type SyncTriggerResponse struct {
 	Triggered *bool   `json:"triggered,omitempty"`
 }
 
+// TriggerSyncByUrlParams defines parameters for TriggerSyncByUrl.
+type TriggerSyncByUrlParams struct {
+	// Url Git repository URL to match against configured sources
+	Url string `form:"url" json:"url"`
+}
+
+// UpdateSyncSourceJSONRequestBody defines body for UpdateSyncSource for application/json ContentType.
+type UpdateSyncSourceJSONRequestBody = SyncSourceUpdate
+
 // AsGitSourceConfig returns the union data inside the SyncSource_Config as a GitSourceConfig
 func (t SyncSource_Config) AsGitSourceConfig() (GitSourceConfig, error) {
 	var body GitSourceConfig
@@ -144,6 +261,32 @@ func (t *SyncSource_Config) MergeFilesystemSourceConfig(v FilesystemSourceConfig
 	return err
 }
 
+// AsHttpSourceConfig returns the union data inside the SyncSource_Config as a HttpSourceConfig
+func (t SyncSource_Config) AsHttpSourceConfig() (HttpSourceConfig, error) {
+	var body HttpSourceConfig
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromHttpSourceConfig overwrites any union data inside the SyncSource_Config as the provided HttpSourceConfig
+func (t *SyncSource_Config) FromHttpSourceConfig(v HttpSourceConfig) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeHttpSourceConfig performs a merge with any union data inside the SyncSource_Config, using the provided HttpSourceConfig
+func (t *SyncSource_Config) MergeHttpSourceConfig(v HttpSourceConfig) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
 func (t SyncSource_Config) MarshalJSON() ([]byte, error) {
 	b, err := t.union.MarshalJSON()
 	return b, err
@@ -233,11 +376,22 @@ type ClientInterface interface {
 	// GetSyncSource request
 	GetSyncSource(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// UpdateSyncSourceWithBody request with any body
+	UpdateSyncSourceWithBody(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateSyncSource(ctx context.Context, id int, body UpdateSyncSourceJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PlanSyncSource request
+	PlanSyncSource(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetSyncSourceStatus request
 	GetSyncSourceStatus(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// TriggerSyncSource request
 	TriggerSyncSource(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// TriggerSyncByUrl request
+	TriggerSyncByUrl(ctx context.Context, params *TriggerSyncByUrlParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 }
 
 func (c *Client) GetSyncSources(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
@@ -264,6 +418,42 @@ func (c *Client) GetSyncSource(ctx context.Context, id int, reqEditors ...Reques
 	return c.Client.Do(req)
 }
 
+func (c *Client) UpdateSyncSourceWithBody(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateSyncSourceRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateSyncSource(ctx context.Context, id int, body UpdateSyncSourceJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateSyncSourceRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PlanSyncSource(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPlanSyncSourceRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) GetSyncSourceStatus(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewGetSyncSourceStatusRequest(c.Server, id)
 	if err != nil {
@@ -288,6 +478,18 @@ func (c *Client) TriggerSyncSource(ctx context.Context, id int, reqEditors ...Re
 	return c.Client.Do(req)
 }
 
+func (c *Client) TriggerSyncByUrl(ctx context.Context, params *TriggerSyncByUrlParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTriggerSyncByUrlRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 // NewGetSyncSourcesRequest generates requests for GetSyncSources
 func NewGetSyncSourcesRequest(server string) (*http.Request, error) {
 	var err error
@@ -349,6 +551,87 @@ func NewGetSyncSourceRequest(server string, id int) (*http.Request, error) {
 	return req, nil
 }
 
+// NewUpdateSyncSourceRequest calls the generic UpdateSyncSource builder with application/json body
+func NewUpdateSyncSourceRequest(server string, id int, body UpdateSyncSourceJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateSyncSourceRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewUpdateSyncSourceRequestWithBody generates requests for UpdateSyncSource with any type of body
+func NewUpdateSyncSourceRequestWithBody(server string, id int, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/sources/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPlanSyncSourceRequest generates requests for PlanSyncSource
+func NewPlanSyncSourceRequest(server string, id int) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/sources/%s/plan", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
 // NewGetSyncSourceStatusRequest generates requests for GetSyncSourceStatus
 func NewGetSyncSourceStatusRequest(server string, id int) (*http.Request, error) {
 	var err error
@@ -417,6 +700,51 @@ func NewTriggerSyncSourceRequest(server string, id int) (*http.Request, error) {
 	return req, nil
 }
 
+// NewTriggerSyncByUrlRequest generates requests for TriggerSyncByUrl
+func NewTriggerSyncByUrlRequest(server string, params *TriggerSyncByUrlParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/sync")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "url", runtime.ParamLocationQuery, params.Url); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
 func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
 	for _, r := range c.RequestEditors {
 		if err := r(ctx, req); err != nil {
@@ -466,11 +794,22 @@ type ClientWithResponsesInterface interface {
 	// GetSyncSourceWithResponse request
 	GetSyncSourceWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*GetSyncSourceResponse, error)
 
+	// UpdateSyncSourceWithBodyWithResponse request with any body
+	UpdateSyncSourceWithBodyWithResponse(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateSyncSourceResponse, error)
+
+	UpdateSyncSourceWithResponse(ctx context.Context, id int, body UpdateSyncSourceJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateSyncSourceResponse, error)
+
+	// PlanSyncSourceWithResponse request
+	PlanSyncSourceWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*PlanSyncSourceResponse, error)
+
 	// GetSyncSourceStatusWithResponse request
 	GetSyncSourceStatusWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*GetSyncSourceStatusResponse, error)
 
 	// TriggerSyncSourceWithResponse request
 	TriggerSyncSourceWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*TriggerSyncSourceResponse, error)
+
+	// TriggerSyncByUrlWithResponse request
+	TriggerSyncByUrlWithResponse(ctx context.Context, params *TriggerSyncByUrlParams, reqEditors ...RequestEditorFn) (*TriggerSyncByUrlResponse, error)
 }
 
 type GetSyncSourcesResponse struct {
@@ -518,6 +857,54 @@ func (r GetSyncSourceResponse) StatusCode() int {
 	return 0
 }
 
+type UpdateSyncSourceResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SyncSource
+	JSON400      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateSyncSourceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateSyncSourceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PlanSyncSourceResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SyncPlan
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PlanSyncSourceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PlanSyncSourceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
 type GetSyncSourceStatusResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
@@ -565,6 +952,30 @@ func (r TriggerSyncSourceResponse) StatusCode() int {
 	return 0
 }
 
+type TriggerSyncByUrlResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON202      *SyncTriggerByUrlResponse
+	JSON400      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r TriggerSyncByUrlResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r TriggerSyncByUrlResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
 // GetSyncSourcesWithResponse request returning *GetSyncSourcesResponse
 func (c *ClientWithResponses) GetSyncSourcesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetSyncSourcesResponse, error) {
 	rsp, err := c.GetSyncSources(ctx, reqEditors...)
@@ -583,6 +994,32 @@ func (c *ClientWithResponses) GetSyncSourceWithResponse(ctx context.Context, id
 	return ParseGetSyncSourceResponse(rsp)
 }
 
+// UpdateSyncSourceWithBodyWithResponse request with arbitrary body returning *UpdateSyncSourceResponse
+func (c *ClientWithResponses) UpdateSyncSourceWithBodyWithResponse(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateSyncSourceResponse, error) {
+	rsp, err := c.UpdateSyncSourceWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateSyncSourceResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateSyncSourceWithResponse(ctx context.Context, id int, body UpdateSyncSourceJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateSyncSourceResponse, error) {
+	rsp, err := c.UpdateSyncSource(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateSyncSourceResponse(rsp)
+}
+
+// PlanSyncSourceWithResponse request returning *PlanSyncSourceResponse
+func (c *ClientWithResponses) PlanSyncSourceWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*PlanSyncSourceResponse, error) {
+	rsp, err := c.PlanSyncSource(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePlanSyncSourceResponse(rsp)
+}
+
 // GetSyncSourceStatusWithResponse request returning *GetSyncSourceStatusResponse
 func (c *ClientWithResponses) GetSyncSourceStatusWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*GetSyncSourceStatusResponse, error) {
 	rsp, err := c.GetSyncSourceStatus(ctx, id, reqEditors...)
@@ -601,6 +1038,15 @@ func (c *ClientWithResponses) TriggerSyncSourceWithResponse(ctx context.Context,
 	return ParseTriggerSyncSourceResponse(rsp)
 }
 
+// TriggerSyncByUrlWithResponse request returning *TriggerSyncByUrlResponse
+func (c *ClientWithResponses) TriggerSyncByUrlWithResponse(ctx context.Context, params *TriggerSyncByUrlParams, reqEditors ...RequestEditorFn) (*TriggerSyncByUrlResponse, error) {
+	rsp, err := c.TriggerSyncByUrl(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTriggerSyncByUrlResponse(rsp)
+}
+
 // ParseGetSyncSourcesResponse parses an HTTP response from a GetSyncSourcesWithResponse call
 func ParseGetSyncSourcesResponse(rsp *http.Response) (*GetSyncSourcesResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
@@ -660,6 +1106,86 @@ func ParseGetSyncSourceResponse(rsp *http.Response) (*GetSyncSourceResponse, err
 	return response, nil
 }
 
+// ParseUpdateSyncSourceResponse parses an HTTP response from a UpdateSyncSourceWithResponse call
+func ParseUpdateSyncSourceResponse(rsp *http.Response) (*UpdateSyncSourceResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateSyncSourceResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SyncSource
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePlanSyncSourceResponse parses an HTTP response from a PlanSyncSourceWithResponse call
+func ParsePlanSyncSourceResponse(rsp *http.Response) (*PlanSyncSourceResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PlanSyncSourceResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SyncPlan
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
 // ParseGetSyncSourceStatusResponse parses an HTTP response from a GetSyncSourceStatusWithResponse call
 func ParseGetSyncSourceStatusResponse(rsp *http.Response) (*GetSyncSourceStatusResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
@@ -732,3 +1258,43 @@ func ParseTriggerSyncSourceResponse(rsp *http.Response) (*TriggerSyncSourceRespo
 
 	return response, nil
 }
+
+// ParseTriggerSyncByUrlResponse parses an HTTP response from a TriggerSyncByUrlWithResponse call
+func ParseTriggerSyncByUrlResponse(rsp *http.Response) (*TriggerSyncByUrlResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &TriggerSyncByUrlResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
+		var dest SyncTriggerByUrlResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON202 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
@@ -2,7 +2,11 @@ package api
 
 import (
 	"encoding/json"
+	"fmt"
 	"net/http"
+	"net/url"
+	"strings"
+	"time"
 
 	"github.com/doron-cohen/argus/backend/sync"
 )
@@ -19,7 +23,7 @@ func (s *SyncAPIServer) GetSyncSources(w http.ResponseWriter, r *http.Request) {
 	// Get all sources from the service
 	sources := s.Service.GetSources()
 
-	var apiSources []SyncSource
+	apiSources := make([]SyncSource, 0, len(sources))
 	for i, source := range sources {
 		apiSource := s.convertToAPISource(source, int64(i))
 		apiSources = append(apiSources, apiSource)
@@ -51,6 +55,39 @@ func (s *SyncAPIServer) GetSyncSource(w http.ResponseWriter, r *http.Request, id
 	}
 }
 
+func (s *SyncAPIServer) UpdateSyncSource(w http.ResponseWriter, r *http.Request, id int) {
+	// Decoded with a pointer so a missing field is rejected instead of read as false
+	var update struct {
+		Enabled *bool `json:"enabled"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&update); err != nil {
+		s.writeError(w, http.StatusBadRequest, "Invalid request body", "INVALID_REQUEST_BODY")
+		return
+	}
+	if update.Enabled == nil {
+		s.writeError(w, http.StatusBadRequest, "enabled is required", "INVALID_REQUEST_BODY")
+		return
+	}
+
+	if err := s.Service.SetSourceEnabled(id, *update.Enabled); err != nil {
+		s.writeError(w, http.StatusNotFound, "Source not found", "SOURCE_NOT_FOUND")
+		return
+	}
+
+	source, err := s.Service.GetSourceByIndex(id)
+	if err != nil {
+		s.writeError(w, http.StatusNotFound, "Source not found", "SOURCE_NOT_FOUND")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(s.convertToAPISource(source, int64(id))); err != nil {
+		http.Error(w, "failed to encode response", http.StatusInternalServerError)
+		return
+	}
+}
+
 func (s *SyncAPIServer) GetSyncSourceStatus(w http.ResponseWriter, r *http.Request, id int) {
 	// Get status for the source
 	status, err := s.Service.GetSourceStatus(id)
@@ -81,6 +118,10 @@ func (s *SyncAPIServer) TriggerSyncSource(w http.ResponseWriter, r *http.Request
 			s.writeError(w, http.StatusConflict, "Sync already running for this source", "SYNC_ALREADY_RUNNING")
 			return
 		}
+		if err == sync.ErrSourceDisabled {
+			s.writeError(w, http.StatusConflict, "Source is disabled", "SOURCE_DISABLED")
+			return
+		}
 		s.writeError(w, http.StatusInternalServerError, "Failed to trigger sync", "INTERNAL_ERROR")
 		return
 	}
@@ -99,11 +140,74 @@ func (s *SyncAPIServer) TriggerSyncSource(w http.ResponseWriter, r *http.Request
 	}
 }
 
+func (s *SyncAPIServer) TriggerSyncByUrl(w http.ResponseWriter, r *http.Request, params TriggerSyncByUrlParams) {
+	if strings.TrimSpace(params.Url) == "" {
+		s.writeError(w, http.StatusBadRequest, "url parameter is required", "INVALID_URL")
+		return
+	}
+
+	triggered, alreadyRunning, err := s.Service.TriggerSyncByURL(params.Url)
+	if err != nil {
+		if err == sync.ErrSourceNotFound {
+			s.writeError(w, http.StatusNotFound, "No source matches the URL", "SOURCE_NOT_FOUND")
+			return
+		}
+		s.writeError(w, http.StatusInternalServerError, "Failed to trigger sync", "INTERNAL_ERROR")
+		return
+	}
+
+	response := SyncTriggerByUrlResponse{
+		Message:        stringPtr(fmt.Sprintf("Sync triggered for %d source(s)", len(triggered))),
+		Url:            params.Url,
+		Triggered:      triggered,
+		AlreadyRunning: alreadyRunning,
+	}
+	if response.Triggered == nil {
+		response.Triggered = []int{}
+	}
+	if response.AlreadyRunning == nil {
+		response.AlreadyRunning = []int{}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusAccepted)
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		http.Error(w, "failed to encode response", http.StatusInternalServerError)
+		return
+	}
+}
+
+func (s *SyncAPIServer) PlanSyncSource(w http.ResponseWriter, r *http.Request, id int) {
+	source, err := s.Service.GetSourceByIndex(id)
+	if err != nil {
+		s.writeError(w, http.StatusNotFound, "Source not found", "SOURCE_NOT_FOUND")
+		return
+	}
+
+	plan, err := s.Service.PlanSync(r.Context(), source)
+	if err != nil {
+		s.writeError(w, http.StatusInternalServerError, fmt.Sprintf("Failed to plan sync: %v", err), "PLAN_FAILED")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(s.convertToAPIPlan(plan, id)); err != nil {
+		http.Error(w, "failed to encode response", http.StatusInternalServerError)
+		return
+	}
+}
+
 // Helper methods
 
 func (s *SyncAPIServer) convertToAPISource(source sync.SourceConfig, id int64) SyncSource {
 	apiSource := SyncSource{
-		Id: intPtr(int(id)),
+		Id:      intPtr(int(id)),
+		Enabled: boolPtr(s.Service.SourceEnabled(int(id))),
+	}
+	if status, err := s.Service.GetSourceStatus(int(id)); err == nil {
+		apiStatus := s.convertToAPIStatus(status, id)
+		apiSource.LastStatus = &apiStatus
 	}
 
 	// Set type and config based on source type
@@ -116,10 +220,17 @@ func (s *SyncAPIServer) convertToAPISource(source sync.SourceConfig, id int64) S
 			gitConfig := cfg.(*sync.GitSourceConfig)
 			apiSource.Type = (*SyncSourceType)(stringPtr("git"))
 			gitAPIConfig := GitSourceConfig{
-				Url:      stringPtr(gitConfig.URL),
+				Url:      stringPtr(redactURL(gitConfig.URL)),
 				Branch:   stringPtr(gitConfig.Branch),
 				BasePath: stringPtr(gitConfig.BasePath),
 			}
+			// A pinned source syncs its tag or commit, so report whichever is set
+			if gitConfig.Tag != "" {
+				gitAPIConfig.Tag = stringPtr(gitConfig.Tag)
+			}
+			if gitConfig.Commit != "" {
+				gitAPIConfig.Commit = stringPtr(gitConfig.Commit)
+			}
 			apiSource.Config = &SyncSource_Config{}
 			if err := apiSource.Config.FromGitSourceConfig(gitAPIConfig); err != nil {
 				// Log error but continue - this is a conversion issue
@@ -139,12 +250,44 @@ func (s *SyncAPIServer) convertToAPISource(source sync.SourceConfig, id int64) S
 				// The source config is already validated, so this shouldn't fail
 				return apiSource
 			}
+
+		case "http":
+			httpConfig := cfg.(*sync.HTTPSourceConfig)
+			apiSource.Type = (*SyncSourceType)(stringPtr("http"))
+			httpAPIConfig := HttpSourceConfig{
+				Url: stringPtr(redactURL(httpConfig.URL)),
+			}
+			apiSource.Config = &SyncSource_Config{}
+			if err := apiSource.Config.FromHttpSourceConfig(httpAPIConfig); err != nil {
+				// Log error but continue - this is a conversion issue
+				// The source config is already validated, so this shouldn't fail
+				return apiSource
+			}
 		}
 	}
 
 	return apiSource
 }
 
+// redactURL hides credentials embedded in a source URL. A username without a password is
+// hidden too on http(s) URLs, where it usually holds an access token; on ssh URLs it is the
+// login, such as git. Values that do not parse as URLs, like scp-style git addresses, carry
+// no credentials and are returned as is.
+func redactURL(raw string) string {
+	parsed, err := url.Parse(raw)
+	if err != nil || parsed.User == nil {
+		return raw
+	}
+	if _, hasPassword := parsed.User.Password(); hasPassword {
+		return parsed.Redacted()
+	}
+	if parsed.Scheme == "http" || parsed.Scheme == "https" {
+		parsed.User = url.User("xxxxx")
+		return parsed.String()
+	}
+	return raw
+}
+
 func (s *SyncAPIServer) convertToAPIStatus(status *sync.SourceStatus, id int64) SyncStatus {
 	apiStatus := SyncStatus{
 		SourceId: intPtr(int(id)),
@@ -171,10 +314,28 @@ func (s *SyncAPIServer) convertToAPIStatus(status *sync.SourceStatus, id int64)
 		apiStatus.LastSync = status.LastSync
 		apiStatus.LastError = status.LastError
 		apiStatus.ComponentsCount = &status.ComponentsCount
+		apiStatus.Created = &status.Created
+		apiStatus.Updated = &status.Updated
+		apiStatus.Skipped = &status.Skipped
+		apiStatus.Failed = &status.Failed
+		apiStatus.Deleted = &status.Deleted
+		componentErrors := make([]ComponentSyncError, 0, len(status.ComponentErrors))
+		for _, componentError := range status.ComponentErrors {
+			componentErrors = append(componentErrors, ComponentSyncError{
+				Component: componentError.Component,
+				Error:     componentError.Error,
+			})
+		}
+		apiStatus.ComponentErrors = &componentErrors
 		if status.Duration > 0 {
 			duration := status.Duration.String()
 			apiStatus.Duration = &duration
 		}
+		apiStatus.NextSync = status.NextSync
+		if status.LastSync != nil {
+			age := time.Since(*status.LastSync).Round(time.Second).String()
+			apiStatus.Age = &age
+		}
 	} else {
 		// Default status for unknown sources
 		idle := Idle
@@ -184,6 +345,34 @@ func (s *SyncAPIServer) convertToAPIStatus(status *sync.SourceStatus, id int64)
 	return apiStatus
 }
 
+func (s *SyncAPIServer) convertToAPIPlan(plan *sync.SyncPlan, id int) SyncPlan {
+	apiPlan := SyncPlan{
+		SourceId:   id,
+		Changes:    make([]PlannedChange, 0, len(plan.Changes)),
+		Unchanged:  plan.Unchanged,
+		HardDelete: plan.HardDelete,
+	}
+
+	for _, change := range plan.Changes {
+		apiChange := PlannedChange{
+			Action:      PlannedChangeAction(change.Action),
+			ComponentId: change.ComponentID,
+			Name:        stringPtr(change.Name),
+		}
+		if len(change.Changes) > 0 {
+			fields := make(map[string]FieldChange, len(change.Changes))
+			for field, value := range change.Changes {
+				values, _ := value.(map[string]interface{})
+				fields[field] = FieldChange{Old: values["old"], New: values["new"]}
+			}
+			apiChange.Changes = &fields
+		}
+		apiPlan.Changes = append(apiPlan.Changes, apiChange)
+	}
+
+	return apiPlan
+}
+
 func (s *SyncAPIServer) writeError(w http.ResponseWriter, statusCode int, message, code string) {
 	error := Error{
 		Message: &message,
@@ -10,15 +10,121 @@ import (
 const (
 	sourceTypeGit        = "git"
 	sourceTypeFilesystem = "filesystem"
+	sourceTypeHTTP       = "http"
 
 	// Minimum sync intervals to prevent system overload
 	MinFilesystemInterval = time.Second      // 1 second minimum for filesystem sources
 	MinGitInterval        = 10 * time.Second // 10 seconds minimum for git sources
+	MinHTTPInterval       = 10 * time.Second // 10 seconds minimum for http sources
+
+	// DefaultConcurrency is the number of components of a source processed at once when not configured
+	DefaultConcurrency = 4
+
+	// Default fetch retry policy, see Config.Retries
+	DefaultRetries      = 2
+	DefaultRetryBackoff = 5 * time.Second
 )
 
 // Config represents the sync configuration
 type Config struct {
-	Sources []SourceConfig `yaml:"sources"`
+	// DefaultInterval is inherited by sources that omit their own interval
+	DefaultInterval time.Duration  `yaml:"default_interval,omitempty"`
+	Sources         []SourceConfig `yaml:"sources"`
+	// Concurrency bounds how many components of a source are stored at once, defaults to DefaultConcurrency
+	Concurrency int `yaml:"concurrency,omitempty"`
+	// Retries is how many times a fetch failing with a transient error (network, timeout) is retried.
+	// Zero disables retries.
+	Retries int `yaml:"retries"`
+	// RetryBackoff is the wait before the first retry; it doubles on every retry
+	RetryBackoff time.Duration `yaml:"retry_backoff,omitempty"`
+	// CloneDir is where git sources are cloned, defaults to argus-sync under the system temp
+	// directory. It is removed on shutdown, so it must not hold anything else.
+	CloneDir string `yaml:"clone_dir,omitempty"`
+	// History records the fields each sync changes on a component, see GET /components/{id}/history.
+	// It adds a write per changed component; false stops recording without removing past entries.
+	History bool `yaml:"history"`
+}
+
+// UnmarshalYAML decodes the sync configuration and applies the default interval to
+// sources without one. This runs after all sources are decoded, since YAML field
+// order is not guaranteed.
+func (c *Config) UnmarshalYAML(node *yaml.Node) error {
+	type rawConfig Config
+	raw := rawConfig(*c)
+	if err := node.Decode(&raw); err != nil {
+		return err
+	}
+	*c = Config(raw)
+
+	if c.Concurrency < 0 {
+		return fmt.Errorf("sync concurrency must be positive, got %d", c.Concurrency)
+	}
+
+	if c.Retries < 0 {
+		return fmt.Errorf("sync retries must not be negative, got %d", c.Retries)
+	}
+	if c.RetryBackoff < 0 {
+		return fmt.Errorf("sync retry_backoff must be positive, got %v", c.RetryBackoff)
+	}
+
+	return c.applyDefaultInterval()
+}
+
+// retryBackoff returns the configured wait before the first retry, falling back to DefaultRetryBackoff
+func (c Config) retryBackoff() time.Duration {
+	if c.RetryBackoff <= 0 {
+		return DefaultRetryBackoff
+	}
+	return c.RetryBackoff
+}
+
+// concurrency returns the configured component concurrency, falling back to DefaultConcurrency
+func (c Config) concurrency() int {
+	if c.Concurrency <= 0 {
+		return DefaultConcurrency
+	}
+	return c.Concurrency
+}
+
+// applyDefaultInterval sets DefaultInterval on sources that omit an interval,
+// validating it against each source type's minimum
+func (c *Config) applyDefaultInterval() error {
+	if c.DefaultInterval == 0 {
+		return nil
+	}
+	if c.DefaultInterval < 0 {
+		return fmt.Errorf("sync default_interval must be positive, got %v", c.DefaultInterval)
+	}
+
+	for i := range c.Sources {
+		switch cfg := c.Sources[i].GetConfig().(type) {
+		case *GitSourceConfig:
+			if cfg.Interval != 0 {
+				continue
+			}
+			if c.DefaultInterval < MinGitInterval {
+				return fmt.Errorf("sync default_interval must be at least %v for git source %d, got %v", MinGitInterval, i, c.DefaultInterval)
+			}
+			cfg.Interval = c.DefaultInterval
+		case *FilesystemSourceConfig:
+			if cfg.Interval != 0 {
+				continue
+			}
+			if c.DefaultInterval < MinFilesystemInterval {
+				return fmt.Errorf("sync default_interval must be at least %v for filesystem source %d, got %v", MinFilesystemInterval, i, c.DefaultInterval)
+			}
+			cfg.Interval = c.DefaultInterval
+		case *HTTPSourceConfig:
+			if cfg.Interval != 0 {
+				continue
+			}
+			if c.DefaultInterval < MinHTTPInterval {
+				return fmt.Errorf("sync default_interval must be at least %v for http source %d, got %v", MinHTTPInterval, i, c.DefaultInterval)
+			}
+			cfg.Interval = c.DefaultInterval
+		}
+	}
+	return nil
 }
 
 // SourceTypeConfig is a regular interface for different source types
@@ -27,11 +133,13 @@ type SourceTypeConfig interface {
 	GetInterval() time.Duration
 	GetBasePath() string
 	GetSourceType() string
+	GetDefaults() ComponentDefaults
+	GetPrune() bool
 }
 
 // SourceConfigConstraint is a type constraint for compile-time type safety
 type SourceConfigConstraint interface {
-	*GitSourceConfig | *FilesystemSourceConfig
+	*GitSourceConfig | *FilesystemSourceConfig | *HTTPSourceConfig
 	SourceTypeConfig
 }
 
@@ -62,6 +170,13 @@ func (t *TypedSourceConfig[T]) UnmarshalYAML(node *yaml.Node) error {
 // This is needed for heterogeneous collections since we can't have []TypedSourceConfig[T] with mixed types
 type SourceConfig struct {
 	config SourceTypeConfig
+	// Enabled stops the source from syncing when set to false; unset means enabled
+	Enabled *bool
+}
+
+// IsEnabled reports whether the source is enabled in the configuration
+func (s SourceConfig) IsEnabled() bool {
+	return s.Enabled == nil || *s.Enabled
 }
 
 // GetConfig returns the underlying type-specific configuration
@@ -73,7 +188,8 @@ func (s *SourceConfig) GetConfig() SourceTypeConfig {
 func (s *SourceConfig) UnmarshalYAML(node *yaml.Node) error {
 	// First, decode just enough to determine the type
 	var typeInfo struct {
-		Type string `yaml:"type"`
+		Type    string `yaml:"type"`
+		Enabled *bool  `yaml:"enabled"`
 	}
 
 	if err := node.Decode(&typeInfo); err != nil {
@@ -87,6 +203,8 @@ func (s *SourceConfig) UnmarshalYAML(node *yaml.Node) error {
 		config = &GitSourceConfig{}
 	case sourceTypeFilesystem:
 		config = &FilesystemSourceConfig{}
+	case sourceTypeHTTP:
+		config = &HTTPSourceConfig{}
 	default:
 		return fmt.Errorf("unknown source type: %s", typeInfo.Type)
 	}
@@ -102,6 +220,7 @@ func (s *SourceConfig) UnmarshalYAML(node *yaml.Node) error {
 	}
 
 	s.config = config
+	s.Enabled = typeInfo.Enabled
 	return nil
 }
 
@@ -137,3 +256,14 @@ func NewFilesystemSourceConfig(path string, interval time.Duration) TypedSourceC
 		},
 	}
 }
+
+func NewHTTPSourceConfig(url string, headers map[string]string, interval time.Duration) TypedSourceConfig[*HTTPSourceConfig] {
+	return TypedSourceConfig[*HTTPSourceConfig]{
+		Config: &HTTPSourceConfig{
+			Type:     sourceTypeHTTP,
+			URL:      url,
+			Headers:  headers,
+			Interval: interval,
+		},
+	}
+}
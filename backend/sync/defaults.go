@@ -0,0 +1,47 @@
+package sync
+
+import (
+	"fmt"
+
+	"github.com/doron-cohen/argus/backend/internal/models"
+)
+
+// ComponentDefaults holds per-source values applied to components whose manifest omits them
+type ComponentDefaults struct {
+	Team      string            `yaml:"team,omitempty"`
+	Labels    map[string]string `yaml:"labels,omitempty"`
+	Lifecycle string            `yaml:"lifecycle,omitempty"`
+}
+
+// Validate ensures the defaults satisfy the same rules as manifest values
+func (d ComponentDefaults) Validate() error {
+	if err := models.ValidateLifecycle(d.Lifecycle); err != nil {
+		return fmt.Errorf("invalid defaults: %w", err)
+	}
+	if err := models.ValidateLabels(d.Labels); err != nil {
+		return fmt.Errorf("invalid defaults: %w", err)
+	}
+	return nil
+}
+
+// Apply fills fields the component leaves empty. Explicit manifest values always win;
+// default labels are only added for keys the manifest does not set.
+func (d ComponentDefaults) Apply(component models.Component) models.Component {
+	if component.Owners.Team == "" {
+		component.Owners.Team = d.Team
+	}
+	if component.Lifecycle == "" {
+		component.Lifecycle = d.Lifecycle
+	}
+	if len(d.Labels) > 0 {
+		labels := make(map[string]string, len(d.Labels)+len(component.Labels))
+		for key, value := range d.Labels {
+			labels[key] = value
+		}
+		for key, value := range component.Labels {
+			labels[key] = value
+		}
+		component.Labels = labels
+	}
+	return component
+}
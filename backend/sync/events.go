@@ -0,0 +1,71 @@
+package sync
+
+import (
+	"errors"
+	"log/slog"
+	"time"
+)
+
+// SyncEvent describes a finished sync run of a source
+type SyncEvent struct {
+	SourceIndex int // Index of the source in Config.Sources
+	Status      Status
+	Created     int
+	Updated     int
+	Deleted     int
+	Err         error // Why the run failed, nil when it completed
+	Time        time.Time
+}
+
+// eventBufferSize is how many events a subscriber may fall behind before further events are dropped for it
+const eventBufferSize = 16
+
+// Subscribe returns a channel that receives an event after every finished sync run.
+// Sends never block: events are dropped for a subscriber whose buffer is full,
+// so a slow consumer cannot stall syncing. Call Unsubscribe when done.
+func (s *Service) Subscribe() <-chan SyncEvent {
+	ch := make(chan SyncEvent, eventBufferSize)
+
+	s.subscribersMutex.Lock()
+	defer s.subscribersMutex.Unlock()
+	s.subscribers = append(s.subscribers, ch)
+	return ch
+}
+
+// Unsubscribe stops delivering events to a channel returned by Subscribe and closes it
+func (s *Service) Unsubscribe(events <-chan SyncEvent) {
+	s.subscribersMutex.Lock()
+	defer s.subscribersMutex.Unlock()
+	for i, ch := range s.subscribers {
+		if ch == events {
+			s.subscribers = append(s.subscribers[:i], s.subscribers[i+1:]...)
+			close(ch)
+			return
+		}
+	}
+}
+
+// publishSyncEvent sends the finished run's event to every subscriber
+func (s *Service) publishSyncEvent(index int, status *SourceStatus) {
+	event := SyncEvent{
+		SourceIndex: index,
+		Status:      status.Status,
+		Created:     status.Created,
+		Updated:     status.Updated,
+		Deleted:     status.Deleted,
+		Time:        *status.LastSync,
+	}
+	if status.Status == StatusFailed && status.LastError != nil {
+		event.Err = errors.New(*status.LastError)
+	}
+
+	s.subscribersMutex.Lock()
+	defer s.subscribersMutex.Unlock()
+	for _, ch := range s.subscribers {
+		select {
+		case ch <- event:
+		default:
+			slog.Warn("Dropping sync event for slow subscriber", "source_index", index)
+		}
+	}
+}
@@ -2,48 +2,54 @@ package sync
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"io/fs"
+	"log/slog"
 	"os"
+	"path"
 	"path/filepath"
+	"slices"
+	"strings"
 
 	"github.com/doron-cohen/argus/backend/internal/models"
 )
 
 // Manifest represents a loaded and parsed manifest
 type Manifest struct {
-	Path    string
-	Content *models.Manifest
+	Path     string
+	Content  *models.Manifest
+	Revision string // Content hash of the manifest file, see contentRevision
 }
 
+// DefaultManifestPatterns are the manifest file patterns used when a source does not configure any
+var DefaultManifestPatterns = []string{"manifest.yaml", "manifest.yml"}
+
 // LoadManifests loads all manifest.yaml and manifest.yml files from the given path
 // Returns a map of file paths to their parsed manifest content
-func LoadManifests(ctx context.Context, searchPath string) (map[string]Manifest, error) {
+// Parser options (e.g. strict field checking) are applied to every manifest
+func LoadManifests(ctx context.Context, searchPath string, opts ...models.ParserOption) (map[string]Manifest, error) {
+	return LoadManifestsMatching(ctx, searchPath, nil, opts...)
+}
+
+// LoadManifestsMatching loads the manifest files under searchPath that match the given patterns,
+// falling back to DefaultManifestPatterns when none are given. See selectManifestPaths for how
+// patterns are matched and which file wins when several match in one directory.
+func LoadManifestsMatching(ctx context.Context, searchPath string, patterns []string, opts ...models.ParserOption) (map[string]Manifest, error) {
 	// Check if search directory exists
 	if _, err := os.Stat(searchPath); os.IsNotExist(err) {
 		return nil, fmt.Errorf("directory %s does not exist", searchPath)
 	}
 
-	manifests := make(map[string]Manifest)
-	parser := models.NewParser()
-
-	// Load manifest.yaml files
-	yamlFiles, err := findManifestFiles(searchPath, "manifest.yaml")
-	if err != nil {
-		return nil, fmt.Errorf("failed to find manifest.yaml files: %w", err)
-	}
-
-	if err := loadManifestFiles(yamlFiles, searchPath, parser, manifests); err != nil {
-		return nil, err
-	}
-
-	// Load manifest.yml files
-	ymlFiles, err := findManifestFiles(searchPath, "manifest.yml")
+	files, err := listFiles(searchPath)
 	if err != nil {
-		return nil, fmt.Errorf("failed to find manifest.yml files: %w", err)
+		return nil, fmt.Errorf("failed to find manifest files: %w", err)
 	}
 
-	if err := loadManifestFiles(ymlFiles, searchPath, parser, manifests); err != nil {
+	manifests := make(map[string]Manifest)
+	parser := models.NewParser(opts...)
+	if err := loadManifestFiles(selectManifestPaths(files, patterns), searchPath, parser, manifests); err != nil {
 		return nil, err
 	}
 
@@ -70,15 +76,22 @@ func loadManifestFiles(filePaths []string, searchPath string, parser *models.Par
 		}
 
 		manifests[filePath] = Manifest{
-			Path:    filePath,
-			Content: parsedManifest,
+			Path:     filePath,
+			Content:  parsedManifest,
+			Revision: contentRevision(content),
 		}
 	}
 	return nil
 }
 
-// findManifestFiles recursively finds files with the given name using fs.WalkDir
-func findManifestFiles(searchPath, fileName string) ([]string, error) {
+// contentRevision identifies content by its sha256 hash, for sources without commits
+func contentRevision(content []byte) string {
+	sum := sha256.Sum256(content)
+	return "sha256:" + hex.EncodeToString(sum[:])
+}
+
+// listFiles recursively lists the regular files under searchPath, relative to it
+func listFiles(searchPath string) ([]string, error) {
 	var files []string
 
 	err := filepath.WalkDir(searchPath, func(path string, d fs.DirEntry, err error) error {
@@ -86,7 +99,12 @@ func findManifestFiles(searchPath, fileName string) ([]string, error) {
 			return err
 		}
 
-		if !d.IsDir() && d.Name() == fileName {
+		// Repository metadata never holds manifests
+		if d.IsDir() && d.Name() == ".git" {
+			return filepath.SkipDir
+		}
+
+		if !d.IsDir() {
 			// Get relative path from search directory
 			relPath, err := filepath.Rel(searchPath, path)
 			if err != nil {
@@ -101,6 +119,103 @@ func findManifestFiles(searchPath, fileName string) ([]string, error) {
 	return files, err
 }
 
+// selectManifestPaths returns the paths that match one of the manifest patterns, at most one per directory.
+//
+// A pattern without a slash matches the file name in any directory ("component.yaml").
+// A pattern with a slash matches the whole path relative to the source root, where "*"
+// matches within one path segment and "**" matches any number of directories ("**/service.yaml").
+//
+// Only one manifest is registered per directory. When several files in a directory match,
+// the file matching the earliest pattern in the list wins, and among files matching the
+// same pattern the lexically first wins. The others are skipped with a warning.
+func selectManifestPaths(paths []string, patterns []string) []string {
+	if len(patterns) == 0 {
+		patterns = DefaultManifestPatterns
+	}
+
+	type candidate struct {
+		path    string
+		pattern int
+	}
+	chosen := make(map[string]candidate)
+	var dirs []string
+
+	sorted := slices.Clone(paths)
+	slices.Sort(sorted)
+	for _, p := range sorted {
+		slashPath := filepath.ToSlash(p)
+		index := slices.IndexFunc(patterns, func(pattern string) bool {
+			return matchManifestPattern(pattern, slashPath)
+		})
+		if index < 0 {
+			continue
+		}
+
+		dir := path.Dir(slashPath)
+		current, exists := chosen[dir]
+		switch {
+		case !exists:
+			chosen[dir] = candidate{path: p, pattern: index}
+			dirs = append(dirs, dir)
+		case index < current.pattern:
+			slog.Warn("Skipping manifest, another file in the directory takes precedence", "skipped", current.path, "manifest", p)
+			chosen[dir] = candidate{path: p, pattern: index}
+		default:
+			slog.Warn("Skipping manifest, another file in the directory takes precedence", "skipped", p, "manifest", current.path)
+		}
+	}
+
+	selected := make([]string, 0, len(dirs))
+	for _, dir := range dirs {
+		selected = append(selected, chosen[dir].path)
+	}
+	return selected
+}
+
+// matchManifestPattern reports whether a slash-separated relative path matches a manifest pattern
+func matchManifestPattern(pattern, slashPath string) bool {
+	if !strings.Contains(pattern, "/") {
+		matched, _ := path.Match(pattern, path.Base(slashPath))
+		return matched
+	}
+	return matchSegments(strings.Split(pattern, "/"), strings.Split(slashPath, "/"))
+}
+
+// matchSegments matches path segments against pattern segments, where "**" matches zero or more segments
+func matchSegments(pattern, segments []string) bool {
+	if len(pattern) == 0 {
+		return len(segments) == 0
+	}
+	if pattern[0] == "**" {
+		for i := 0; i <= len(segments); i++ {
+			if matchSegments(pattern[1:], segments[i:]) {
+				return true
+			}
+		}
+		return false
+	}
+	if len(segments) == 0 {
+		return false
+	}
+	matched, _ := path.Match(pattern[0], segments[0])
+	return matched && matchSegments(pattern[1:], segments[1:])
+}
+
+// validateManifestPatterns rejects empty or malformed manifest patterns
+func validateManifestPatterns(patterns []string) error {
+	for _, pattern := range patterns {
+		if pattern == "" {
+			return fmt.Errorf("manifest_patterns cannot contain empty patterns")
+		}
+		for _, segment := range strings.Split(pattern, "/") {
+			if _, err := path.Match(segment, ""); err != nil {
+				return fmt.Errorf("invalid manifest pattern %q: %w", pattern, err)
+			}
+		}
+	}
+	return nil
+}
+
 // ComponentsFetcher defines the interface for fetching components from different sources
 type ComponentsFetcher interface {
 	// Fetch retrieves all components from the given source
@@ -111,9 +226,11 @@ type ComponentsFetcher interface {
 func NewFetcher(sourceType string) (ComponentsFetcher, error) {
 	switch sourceType {
 	case "git":
-		return NewGitFetcher(), nil
+		return NewGitFetcher(""), nil
 	case "filesystem":
 		return NewFilesystemFetcher(), nil
+	case "http":
+		return NewHTTPFetcher(), nil
 	default:
 		return nil, fmt.Errorf("unsupported source type: %s", sourceType)
 	}
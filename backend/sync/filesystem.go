@@ -12,9 +12,14 @@ import (
 
 // FilesystemSourceConfig holds filesystem-specific configuration
 type FilesystemSourceConfig struct {
-	Type     string        `yaml:"type"`
-	Interval time.Duration `yaml:"interval"`
-	Path     string        `yaml:"path"`
+	Type             string            `yaml:"type"`
+	Interval         time.Duration     `yaml:"interval"`
+	Path             string            `yaml:"path"`
+	StrictManifests  bool              `yaml:"strict_manifests,omitempty"`  // Reject manifests with unknown fields
+	ManifestPatterns []string          `yaml:"manifest_patterns,omitempty"` // Manifest file patterns, defaults to manifest.yaml and manifest.yml
+	Defaults         ComponentDefaults `yaml:"defaults,omitempty"`          // Applied when a manifest omits a field
+	Prune            bool              `yaml:"prune,omitempty"`             // Hard-delete components removed from the source
+	Watch            bool              `yaml:"watch,omitempty"`             // Also sync as soon as manifest files change
 }
 
 // Validate ensures the filesystem configuration is valid
@@ -31,6 +36,14 @@ func (f *FilesystemSourceConfig) Validate() error {
 		return fmt.Errorf("filesystem source interval must be at least %v, got %v", MinFilesystemInterval, interval)
 	}
 
+	if err := f.Defaults.Validate(); err != nil {
+		return err
+	}
+
+	if err := validateManifestPatterns(f.ManifestPatterns); err != nil {
+		return err
+	}
+
 	// Set default values if not provided
 	if f.Type == "" {
 		f.Type = sourceTypeFilesystem
@@ -52,6 +65,16 @@ func (f *FilesystemSourceConfig) GetBasePath() string {
 	return ""
 }
 
+// GetDefaults returns the component defaults for this source
+func (f *FilesystemSourceConfig) GetDefaults() ComponentDefaults {
+	return f.Defaults
+}
+
+// GetPrune reports whether components removed from this source are hard-deleted
+func (f *FilesystemSourceConfig) GetPrune() bool {
+	return f.Prune
+}
+
 // GetSourceType returns the source type
 func (f *FilesystemSourceConfig) GetSourceType() string {
 	return sourceTypeFilesystem
@@ -80,7 +103,7 @@ func (f *FilesystemFetcher) Fetch(ctx context.Context, source SourceConfig) ([]m
 	}
 
 	// Load all manifests directly
-	manifests, err := LoadManifests(ctx, rootPath)
+	manifests, err := LoadManifestsMatching(ctx, rootPath, filesystemConfig.ManifestPatterns, models.WithStrictFields(filesystemConfig.StrictManifests))
 	if err != nil {
 		return nil, fmt.Errorf("failed to load manifests: %w", err)
 	}
@@ -90,6 +113,7 @@ func (f *FilesystemFetcher) Fetch(ctx context.Context, source SourceConfig) ([]m
 	var components []models.Component
 	for _, manifest := range manifests {
 		component := manifest.Content.ToComponent()
+		component.SourceRevision = manifest.Revision
 		components = append(components, component)
 	}
 
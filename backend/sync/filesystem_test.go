@@ -4,6 +4,7 @@ import (
 	"context"
 	"os"
 	"path/filepath"
+	"sort"
 	"testing"
 	"time"
 
@@ -41,6 +42,18 @@ interval: 5s`,
 				Interval: 5 * time.Second,
 			},
 		},
+		{
+			name: "filesystem config with watch",
+			yamlSource: `type: filesystem
+path: /some/path
+watch: true`,
+			expectError: false,
+			expected: FilesystemSourceConfig{
+				Type:  "filesystem",
+				Path:  "/some/path",
+				Watch: true,
+			},
+		},
 		{
 			name: "filesystem config with interval too low",
 			yamlSource: `type: filesystem
@@ -66,6 +79,13 @@ url: https://github.com/user/repo`,
 			yamlSource:  `type: filesystem`,
 			expectError: true,
 		},
+		{
+			name: "malformed manifest pattern",
+			yamlSource: `type: filesystem
+path: /some/path
+manifest_patterns: ["[component.yaml"]`,
+			expectError: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -89,6 +109,7 @@ url: https://github.com/user/repo`,
 			assert.True(t, ok)
 			assert.Equal(t, tt.expected.Type, fsConfig.Type)
 			assert.Equal(t, tt.expected.Path, fsConfig.Path)
+			assert.Equal(t, tt.expected.Watch, fsConfig.Watch)
 			if tt.expected.Interval > 0 {
 				assert.Equal(t, tt.expected.Interval, fsConfig.Interval)
 			}
@@ -168,6 +189,100 @@ name: "platform-infrastructure"`
 	})
 }
 
+func TestLoadManifestsMatching(t *testing.T) {
+	tempDir := t.TempDir()
+	files := map[string]string{
+		"auth/component.yaml":            "version: v1\nname: auth-service",
+		"billing/.argus.yaml":            "version: v1\nname: billing-service",
+		"billing/component.yaml":         "version: v1\nname: billing-duplicate",
+		"platform/infra/service.yaml":    "version: v1\nname: infra-service",
+		"service.yaml":                   "version: v1\nname: root-service",
+		"legacy/manifest.yaml":           "version: v1\nname: legacy-service",
+		"auth/docs/example/unrelated.md": "not a manifest",
+	}
+	for name, content := range files {
+		fullPath := filepath.Join(tempDir, filepath.FromSlash(name))
+		require.NoError(t, os.MkdirAll(filepath.Dir(fullPath), 0750))
+		require.NoError(t, os.WriteFile(fullPath, []byte(content), 0600))
+	}
+	ctx := context.Background()
+
+	names := func(manifests map[string]Manifest) []string {
+		var result []string
+		for _, manifest := range manifests {
+			result = append(result, manifest.Content.Name)
+		}
+		sort.Strings(result)
+		return result
+	}
+
+	t.Run("earlier pattern wins within a directory", func(t *testing.T) {
+		manifests, err := LoadManifestsMatching(ctx, tempDir, []string{".argus.yaml", "component.yaml"})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"auth-service", "billing-service"}, names(manifests))
+		assert.Contains(t, manifests, filepath.Join("billing", ".argus.yaml"))
+	})
+
+	t.Run("recursive glob", func(t *testing.T) {
+		manifests, err := LoadManifestsMatching(ctx, tempDir, []string{"**/service.yaml"})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"infra-service", "root-service"}, names(manifests))
+	})
+
+	t.Run("anchored glob", func(t *testing.T) {
+		manifests, err := LoadManifestsMatching(ctx, tempDir, []string{"platform/*/service.yaml"})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"infra-service"}, names(manifests))
+	})
+
+	t.Run("defaults when no patterns are given", func(t *testing.T) {
+		manifests, err := LoadManifestsMatching(ctx, tempDir, nil)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"legacy-service"}, names(manifests))
+	})
+}
+
+func TestSelectManifestPaths(t *testing.T) {
+	tests := []struct {
+		name     string
+		paths    []string
+		patterns []string
+		expected []string
+	}{
+		{
+			name:     "default patterns prefer manifest.yaml",
+			paths:    []string{"svc/manifest.yml", "svc/manifest.yaml", "other/manifest.yml"},
+			expected: []string{"other/manifest.yml", "svc/manifest.yaml"},
+		},
+		{
+			name:     "a file matching several patterns is registered once",
+			paths:    []string{"svc/component.yaml"},
+			patterns: []string{"*.yaml", "component.yaml"},
+			expected: []string{"svc/component.yaml"},
+		},
+		{
+			name:     "lexically first file wins for the same pattern",
+			paths:    []string{"svc/b.yaml", "svc/a.yaml"},
+			patterns: []string{"*.yaml"},
+			expected: []string{"svc/a.yaml"},
+		},
+		{
+			name:     "double star matches the root directory",
+			paths:    []string{"service.yaml", "a/b/c/service.yaml", "a/b/c/other.yaml"},
+			patterns: []string{"**/service.yaml"},
+			expected: []string{"a/b/c/service.yaml", "service.yaml"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			selected := selectManifestPaths(tt.paths, tt.patterns)
+			sort.Strings(selected)
+			assert.Equal(t, tt.expected, selected)
+		})
+	}
+}
+
 func TestFilesystemFetcher(t *testing.T) {
 	// Create a temporary directory structure for testing
 	tempDir := t.TempDir()
@@ -197,6 +312,26 @@ name: "auth-service"`
 		assert.Equal(t, "auth-service", components[0].Name)
 	})
 
+	t.Run("strict manifests rejects unknown fields", func(t *testing.T) {
+		strictDir := t.TempDir()
+		typoManifest := `version: "v1"
+name: "billing-service"
+owners:
+  maintaners: ["alice@example.com"]`
+		require.NoError(t, os.WriteFile(filepath.Join(strictDir, "manifest.yaml"), []byte(typoManifest), 0600))
+
+		lenient := newSourceConfigFromYAMLOrPanic("type: filesystem\npath: " + strictDir)
+		components, err := fetcher.Fetch(ctx, lenient)
+		require.NoError(t, err)
+		assert.Len(t, components, 1)
+
+		strict := newSourceConfigFromYAMLOrPanic("type: filesystem\nstrict_manifests: true\npath: " + strictDir)
+		components, err = fetcher.Fetch(ctx, strict)
+		assert.Error(t, err)
+		assert.Nil(t, components)
+		assert.Contains(t, err.Error(), "maintaners")
+	})
+
 	t.Run("invalid filesystem config", func(t *testing.T) {
 		yamlSource := "type: git\nurl: https://github.com/user/repo"
 		var source SourceConfig
@@ -2,26 +2,61 @@ package sync
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
 	"fmt"
 	"log/slog"
 	"os"
 	"path/filepath"
+	"regexp"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/doron-cohen/argus/backend/internal/models"
 	"github.com/go-git/go-git/v5"
 	"github.com/go-git/go-git/v5/config"
 	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/transport"
+	githttp "github.com/go-git/go-git/v5/plumbing/transport/http"
+	gitssh "github.com/go-git/go-git/v5/plumbing/transport/ssh"
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/knownhosts"
 )
 
+// defaultTokenUsername is sent with token auth when no username is configured.
+// Git hosts ignore the username for token auth, but it must not be empty.
+const defaultTokenUsername = "x-access-token"
+
+// envReferencePattern matches a value that is entirely an environment variable reference, e.g. ${GITHUB_TOKEN}
+var envReferencePattern = regexp.MustCompile(`^\$\{([A-Za-z_][A-Za-z0-9_]*)\}$`)
+
 // GitSourceConfig holds git-specific configuration
 type GitSourceConfig struct {
-	Type     string        `yaml:"type"`
-	Interval time.Duration `yaml:"interval"`
-	URL      string        `yaml:"url"`
-	Branch   string        `yaml:"branch,omitempty"`
-	BasePath string        `yaml:"base_path,omitempty"`
+	Type             string            `yaml:"type"`
+	Interval         time.Duration     `yaml:"interval"`
+	URL              string            `yaml:"url"`
+	Branch           string            `yaml:"branch,omitempty"`
+	Tag              string            `yaml:"tag,omitempty"`    // Sync a fixed tag instead of a branch
+	Commit           string            `yaml:"commit,omitempty"` // Sync a fixed commit hash instead of a branch
+	BasePath         string            `yaml:"base_path,omitempty"`
+	StrictManifests  bool              `yaml:"strict_manifests,omitempty"`  // Reject manifests with unknown fields
+	ManifestPatterns []string          `yaml:"manifest_patterns,omitempty"` // Manifest file patterns, defaults to manifest.yaml and manifest.yml
+	Defaults         ComponentDefaults `yaml:"defaults,omitempty"`          // Applied when a manifest omits a field
+	Prune            bool              `yaml:"prune,omitempty"`             // Hard-delete components removed from the source
+
+	// Authentication for private repositories. Secret values may be given as an
+	// environment variable reference such as ${GITHUB_TOKEN}, resolved at sync time.
+	AuthToken        string `yaml:"auth_token,omitempty"`         // Token sent as HTTP basic auth password
+	AuthUsername     string `yaml:"auth_username,omitempty"`      // Username for token auth, defaults to x-access-token
+	SSHKeyPath       string `yaml:"ssh_key_path,omitempty"`       // Private key file for ssh URLs
+	SSHKeyPassphrase string `yaml:"ssh_key_passphrase,omitempty"` // Passphrase of an encrypted ssh key
+
+	// Host key verification for ssh URLs. Keys are checked against ~/.ssh/known_hosts, or the
+	// files listed in SSH_KNOWN_HOSTS, unless known_hosts names another file.
+	KnownHostsPath         string `yaml:"known_hosts,omitempty"`               // known_hosts file the host key must be listed in
+	InsecureSkipHostVerify bool   `yaml:"insecure_skip_host_verify,omitempty"` // Accept any host key, only for isolated labs
 }
 
 // Validate ensures the git configuration is valid
@@ -38,11 +73,45 @@ func (g *GitSourceConfig) Validate() error {
 		return fmt.Errorf("git source interval must be at least %v, got %v", MinGitInterval, interval)
 	}
 
+	if err := g.Defaults.Validate(); err != nil {
+		return err
+	}
+
+	if err := validateManifestPatterns(g.ManifestPatterns); err != nil {
+		return err
+	}
+
+	if g.AuthToken != "" && g.SSHKeyPath != "" {
+		return fmt.Errorf("git source cannot set both auth_token and ssh_key_path")
+	}
+	if (g.KnownHostsPath != "" || g.InsecureSkipHostVerify) && g.SSHKeyPath == "" {
+		return fmt.Errorf("git source known_hosts and insecure_skip_host_verify require ssh_key_path")
+	}
+	if g.KnownHostsPath != "" && g.InsecureSkipHostVerify {
+		return fmt.Errorf("git source cannot set both known_hosts and insecure_skip_host_verify")
+	}
+	if g.InsecureSkipHostVerify {
+		slog.Warn("Host key verification is disabled for git source", "url", g.URL)
+	}
+
+	refs := 0
+	for _, ref := range []string{g.Branch, g.Tag, g.Commit} {
+		if ref != "" {
+			refs++
+		}
+	}
+	if refs > 1 {
+		return fmt.Errorf("git source can set only one of branch, tag and commit")
+	}
+	if g.Commit != "" && !plumbing.IsHash(g.Commit) {
+		return fmt.Errorf("git source commit must be a full 40-character hash, got %q", g.Commit)
+	}
+
 	// Set default values if not provided
 	if g.Type == "" {
 		g.Type = sourceTypeGit
 	}
-	if g.Branch == "" {
+	if refs == 0 {
 		g.Branch = "main"
 	}
 
@@ -62,23 +131,148 @@ func (g *GitSourceConfig) GetBasePath() string {
 	return g.BasePath
 }
 
+// GetDefaults returns the component defaults for this source
+func (g *GitSourceConfig) GetDefaults() ComponentDefaults {
+	return g.Defaults
+}
+
+// GetPrune reports whether components removed from this source are hard-deleted
+func (g *GitSourceConfig) GetPrune() bool {
+	return g.Prune
+}
+
 // GetSourceType returns the source type
 func (g *GitSourceConfig) GetSourceType() string {
 	return sourceTypeGit
 }
 
+// AuthMethod builds the transport auth for this source, or nil when no auth is configured
+func (g *GitSourceConfig) AuthMethod() (transport.AuthMethod, error) {
+	switch {
+	case g.AuthToken != "":
+		token, err := resolveEnvReference(g.AuthToken)
+		if err != nil {
+			return nil, fmt.Errorf("auth_token: %w", err)
+		}
+		username := g.AuthUsername
+		if username == "" {
+			username = defaultTokenUsername
+		}
+		return &githttp.BasicAuth{Username: username, Password: token}, nil
+	case g.SSHKeyPath != "":
+		passphrase, err := resolveEnvReference(g.SSHKeyPassphrase)
+		if err != nil {
+			return nil, fmt.Errorf("ssh_key_passphrase: %w", err)
+		}
+		auth, err := gitssh.NewPublicKeysFromFile("git", g.SSHKeyPath, passphrase)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load ssh key %s: %w", g.SSHKeyPath, err)
+		}
+		auth.HostKeyCallback, err = g.hostKeyCallback()
+		if err != nil {
+			return nil, err
+		}
+		return auth, nil
+	default:
+		return nil, nil
+	}
+}
+
+// hostKeyCallback returns the check ssh host keys must pass. Hosts missing from the known_hosts
+// files and hosts whose key changed are rejected, unless verification is skipped.
+func (g *GitSourceConfig) hostKeyCallback() (ssh.HostKeyCallback, error) {
+	if g.InsecureSkipHostVerify {
+		return ssh.InsecureIgnoreHostKey(), nil
+	}
+
+	var files []string
+	if g.KnownHostsPath != "" {
+		files = append(files, g.KnownHostsPath)
+	}
+	callback, err := gitssh.NewKnownHostsCallback(files...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load known_hosts: %w", err)
+	}
+	return callback, nil
+}
+
+// resolveEnvReference returns the value of the referenced environment variable when value
+// is a ${NAME} reference, and value itself otherwise. An unset variable is an error.
+func resolveEnvReference(value string) (string, error) {
+	match := envReferencePattern.FindStringSubmatch(value)
+	if match == nil {
+		return value, nil
+	}
+	resolved, ok := os.LookupEnv(match[1])
+	if !ok || resolved == "" {
+		return "", fmt.Errorf("environment variable %s is not set", match[1])
+	}
+	return resolved, nil
+}
+
+// describeGitError replaces go-git's authentication and host key errors with an actionable message
+func describeGitError(action, url string, err error) error {
+	var keyErr *knownhosts.KeyError
+	switch {
+	case errors.As(err, &keyErr) && len(keyErr.Want) > 0:
+		return fmt.Errorf("failed to %s %s: host key does not match known_hosts, the host key changed or the connection is intercepted", action, url)
+	case errors.As(err, &keyErr):
+		return fmt.Errorf("failed to %s %s: host key is not in known_hosts, add the host's key or configure known_hosts for this source", action, url)
+	case errors.Is(err, transport.ErrAuthenticationRequired):
+		return fmt.Errorf("failed to %s %s: authentication required, configure auth_token or ssh_key_path for this source", action, url)
+	case errors.Is(err, transport.ErrAuthorizationFailed):
+		return fmt.Errorf("failed to %s %s: authentication failed, check the configured credentials", action, url)
+	default:
+		return fmt.Errorf("failed to %s %s: %w", action, url, err)
+	}
+}
+
 // GitFetcher implements ComponentsFetcher for git repositories
 type GitFetcher struct {
-	tempDir string
+	cloneDir string // Holds a clone per repository and is owned by the fetcher, see Cleanup
+
+	// Locks by clone, so sources sharing a clone never check it out at the same time
+	locksMutex sync.Mutex
+	locks      map[string]*sync.Mutex
 }
 
-// NewGitFetcher creates a new git fetcher
-func NewGitFetcher() *GitFetcher {
+// NewGitFetcher creates a git fetcher cloning into cloneDir, or into argus-sync under the
+// system temp directory when cloneDir is empty
+func NewGitFetcher(cloneDir string) *GitFetcher {
+	if cloneDir == "" {
+		cloneDir = filepath.Join(os.TempDir(), "argus-sync")
+	}
 	return &GitFetcher{
-		tempDir: os.TempDir(),
+		cloneDir: cloneDir,
 	}
 }
 
+// Cleanup removes the clone directory and every clone in it. Call it only once no fetch is
+// running; the next fetch clones again.
+func (g *GitFetcher) Cleanup() error {
+	if err := os.RemoveAll(g.cloneDir); err != nil {
+		return fmt.Errorf("failed to remove clone directory %s: %w", g.cloneDir, err)
+	}
+	return nil
+}
+
+// lockClone locks a clone directory and returns the function unlocking it
+func (g *GitFetcher) lockClone(repoDir string) func() {
+	g.locksMutex.Lock()
+	if g.locks == nil {
+		g.locks = make(map[string]*sync.Mutex)
+	}
+	lock, ok := g.locks[repoDir]
+	if !ok {
+		lock = &sync.Mutex{}
+		g.locks[repoDir] = lock
+	}
+	g.locksMutex.Unlock()
+
+	lock.Lock()
+	return lock.Unlock
+}
+
 // Fetch retrieves all components from a git repository
 func (g *GitFetcher) Fetch(ctx context.Context, source SourceConfig) ([]models.Component, error) {
 	cfg := source.GetConfig()
@@ -87,12 +281,20 @@ func (g *GitFetcher) Fetch(ctx context.Context, source SourceConfig) ([]models.C
 		return nil, fmt.Errorf("source is not a git config")
 	}
 
-	// Get repository directory
-	repoDir, err := g.ensureRepository(ctx, *gitConfig)
-	if err != nil {
+	// Sources sharing a clone take turns, so one never reads manifests of another's checkout
+	repoDir := g.repoDir(*gitConfig)
+	unlock := g.lockClone(repoDir)
+	defer unlock()
+
+	if err := g.ensureRepository(ctx, *gitConfig, repoDir); err != nil {
 		return nil, fmt.Errorf("failed to ensure repository: %w", err)
 	}
 
+	revision, err := g.GetLatestCommit(repoDir)
+	if err != nil {
+		return nil, err
+	}
+
 	// Determine search directory based on base path
 	searchDir := repoDir
 	if gitConfig.BasePath != "" {
@@ -104,7 +306,7 @@ func (g *GitFetcher) Fetch(ctx context.Context, source SourceConfig) ([]models.C
 	}
 
 	// Load all manifests directly
-	manifests, err := LoadManifests(ctx, searchDir)
+	manifests, err := LoadManifestsMatching(ctx, searchDir, gitConfig.ManifestPatterns, models.WithStrictFields(gitConfig.StrictManifests))
 	if err != nil {
 		return nil, fmt.Errorf("failed to load manifests: %w", err)
 	}
@@ -114,33 +316,60 @@ func (g *GitFetcher) Fetch(ctx context.Context, source SourceConfig) ([]models.C
 	var components []models.Component
 	for _, manifest := range manifests {
 		component := manifest.Content.ToComponent()
+		component.SourceRevision = revision
 		components = append(components, component)
 	}
 
 	return components, nil
 }
 
-// ensureRepository clones or updates the repository and returns the local path
-func (g *GitFetcher) ensureRepository(ctx context.Context, gitConfig GitSourceConfig) (string, error) {
-	// Create a safe directory name from the URL
-	dirName := g.sanitizeURL(gitConfig.URL)
-	repoDir := filepath.Join(g.tempDir, "argus-sync", dirName)
+// repoDir returns the local path a source's repository is cloned to. Every branch, tag or
+// commit gets its own clone, since updating a clone resets it to the source's reference.
+// Sanitizing can map different URLs or references to the same name, for example ones
+// differing only in case or punctuation, so a short hash of both is appended.
+func (g *GitFetcher) repoDir(gitConfig GitSourceConfig) string {
+	ref := gitConfig.Branch
+	switch {
+	case gitConfig.Tag != "":
+		ref = "tag_" + gitConfig.Tag
+	case gitConfig.Commit != "":
+		ref = "commit_" + gitConfig.Commit
+	}
+	sum := sha256.Sum256([]byte(gitConfig.URL + "@" + ref))
+	dirName := fmt.Sprintf("%s_%s_%s", g.sanitizeURL(gitConfig.URL), g.sanitizeURL(ref), hex.EncodeToString(sum[:4]))
+	return filepath.Join(g.cloneDir, dirName)
+}
 
+// ensureRepository clones the repository into repoDir, or updates the existing clone
+func (g *GitFetcher) ensureRepository(ctx context.Context, gitConfig GitSourceConfig, repoDir string) error {
 	// Check if directory exists and has a .git folder
 	gitDir := filepath.Join(repoDir, ".git")
 	if _, err := os.Stat(gitDir); os.IsNotExist(err) {
 		// Clone the repository
 		if err := g.cloneRepository(ctx, gitConfig, repoDir); err != nil {
-			return "", err
+			return err
 		}
 	} else {
 		// Update existing repository
 		if err := g.updateRepository(ctx, gitConfig, repoDir); err != nil {
-			return "", err
+			return err
 		}
 	}
 
-	return repoDir, nil
+	return nil
+}
+
+// GetLatestCommit returns the hash of the commit checked out in a local clone
+func (g *GitFetcher) GetLatestCommit(repoDir string) (string, error) {
+	repo, err := git.PlainOpen(repoDir)
+	if err != nil {
+		return "", fmt.Errorf("failed to open repository: %w", err)
+	}
+	head, err := repo.Head()
+	if err != nil {
+		return "", fmt.Errorf("failed to get checked out commit: %w", err)
+	}
+	return head.Hash().String(), nil
 }
 
 // cloneRepository clones the repository using go-git with optional sparse checkout
@@ -150,18 +379,38 @@ func (g *GitFetcher) cloneRepository(ctx context.Context, gitConfig GitSourceCon
 		return fmt.Errorf("failed to create parent directory: %w", err)
 	}
 
+	auth, err := gitConfig.AuthMethod()
+	if err != nil {
+		return fmt.Errorf("failed to configure git auth: %w", err)
+	}
+
 	// Clone options
 	cloneOptions := &git.CloneOptions{
 		URL:           gitConfig.URL,
-		ReferenceName: plumbing.ReferenceName(fmt.Sprintf("refs/heads/%s", gitConfig.Branch)),
+		Auth:          auth,
+		ReferenceName: gitConfig.referenceName(),
 		SingleBranch:  true,
 		Depth:         1,
 	}
+	if gitConfig.Commit != "" {
+		// A commit cannot be cloned by hash, so clone the history and check the commit out
+		cloneOptions = &git.CloneOptions{
+			URL:        gitConfig.URL,
+			Auth:       auth,
+			NoCheckout: true,
+		}
+	}
 
 	// Clone the repository
-	repo, err := git.PlainClone(repoDir, false, cloneOptions)
+	repo, err := git.PlainCloneContext(ctx, repoDir, false, cloneOptions)
 	if err != nil {
-		return fmt.Errorf("failed to clone repository %s: %w", gitConfig.URL, err)
+		return describeGitError("clone repository", gitConfig.URL, err)
+	}
+
+	if gitConfig.Commit != "" {
+		if err := checkoutCommit(repo, gitConfig); err != nil {
+			return err
+		}
 	}
 
 	// Set up sparse checkout if BasePath is specified
@@ -174,7 +423,7 @@ func (g *GitFetcher) cloneRepository(ctx context.Context, gitConfig GitSourceCon
 	return nil
 }
 
-// updateRepository pulls the latest changes using go-git
+// updateRepository pulls the latest changes of the branch or tag using go-git
 func (g *GitFetcher) updateRepository(ctx context.Context, gitConfig GitSourceConfig, repoDir string) error {
 	// Open the repository
 	repo, err := git.PlainOpen(repoDir)
@@ -188,28 +437,43 @@ func (g *GitFetcher) updateRepository(ctx context.Context, gitConfig GitSourceCo
 		return fmt.Errorf("failed to get worktree: %w", err)
 	}
 
+	// A pinned commit never changes, so there is nothing to update once it is checked out
+	if gitConfig.Commit != "" {
+		if head, err := repo.Head(); err == nil && head.Hash() == plumbing.NewHash(gitConfig.Commit) {
+			return nil
+		}
+	}
+
+	auth, err := gitConfig.AuthMethod()
+	if err != nil {
+		return fmt.Errorf("failed to configure git auth: %w", err)
+	}
+
 	// Fetch options
 	fetchOptions := &git.FetchOptions{
-		RefSpecs: []config.RefSpec{
-			config.RefSpec(fmt.Sprintf("refs/heads/%s:refs/remotes/origin/%s", gitConfig.Branch, gitConfig.Branch)),
-		},
+		Auth:     auth,
+		RefSpecs: []config.RefSpec{gitConfig.fetchRefSpec()},
 	}
 
 	// Fetch latest changes
-	err = repo.Fetch(fetchOptions)
+	err = repo.FetchContext(ctx, fetchOptions)
 	if err != nil && err != git.NoErrAlreadyUpToDate {
-		return fmt.Errorf("failed to fetch from repository: %w", err)
+		return describeGitError("fetch from repository", gitConfig.URL, err)
 	}
 
-	// Get the latest commit from the remote branch
-	remoteRef, err := repo.Reference(plumbing.ReferenceName(fmt.Sprintf("refs/remotes/origin/%s", gitConfig.Branch)), true)
+	if gitConfig.Commit != "" {
+		return checkoutCommit(repo, gitConfig)
+	}
+
+	// Get the latest commit of the fetched branch or tag
+	commit, err := resolveFetchedCommit(repo, gitConfig)
 	if err != nil {
-		return fmt.Errorf("failed to get remote reference: %w", err)
+		return err
 	}
 
 	// Reset to the latest commit
 	resetOptions := &git.ResetOptions{
-		Commit: remoteRef.Hash(),
+		Commit: commit,
 		Mode:   git.HardReset,
 	}
 
@@ -228,6 +492,63 @@ func (g *GitFetcher) updateRepository(ctx context.Context, gitConfig GitSourceCo
 	return nil
 }
 
+// referenceName returns the branch or tag reference a source clones
+func (g *GitSourceConfig) referenceName() plumbing.ReferenceName {
+	if g.Tag != "" {
+		return plumbing.NewTagReferenceName(g.Tag)
+	}
+	return plumbing.NewBranchReferenceName(g.Branch)
+}
+
+// fetchRefSpec returns the refspec fetched when updating an existing clone.
+// Tags are force-updated since a tag may be moved on the remote.
+func (g *GitSourceConfig) fetchRefSpec() config.RefSpec {
+	switch {
+	case g.Tag != "":
+		return config.RefSpec(fmt.Sprintf("+refs/tags/%s:refs/tags/%s", g.Tag, g.Tag))
+	case g.Commit != "":
+		return config.RefSpec("+refs/heads/*:refs/remotes/origin/*")
+	default:
+		return config.RefSpec(fmt.Sprintf("refs/heads/%s:refs/remotes/origin/%s", g.Branch, g.Branch))
+	}
+}
+
+// resolveFetchedCommit returns the commit the fetched branch or tag points to, peeling annotated tags
+func resolveFetchedCommit(repo *git.Repository, gitConfig GitSourceConfig) (plumbing.Hash, error) {
+	refName := plumbing.NewRemoteReferenceName("origin", gitConfig.Branch)
+	if gitConfig.Tag != "" {
+		refName = plumbing.NewTagReferenceName(gitConfig.Tag)
+	}
+
+	ref, err := repo.Reference(refName, true)
+	if err != nil {
+		return plumbing.ZeroHash, fmt.Errorf("failed to get remote reference: %w", err)
+	}
+
+	if tag, err := repo.TagObject(ref.Hash()); err == nil {
+		commit, err := tag.Commit()
+		if err != nil {
+			return plumbing.ZeroHash, fmt.Errorf("failed to resolve tag %s: %w", gitConfig.Tag, err)
+		}
+		return commit.Hash, nil
+	}
+	return ref.Hash(), nil
+}
+
+// checkoutCommit checks out the source's pinned commit
+func checkoutCommit(repo *git.Repository, gitConfig GitSourceConfig) error {
+	worktree, err := repo.Worktree()
+	if err != nil {
+		return fmt.Errorf("failed to get worktree: %w", err)
+	}
+
+	err = worktree.Checkout(&git.CheckoutOptions{Hash: plumbing.NewHash(gitConfig.Commit), Force: true})
+	if err != nil {
+		return fmt.Errorf("failed to check out commit %s of %s: %w", gitConfig.Commit, gitConfig.URL, err)
+	}
+	return nil
+}
+
 // setupSparseCheckout configures sparse checkout for the specified base path
 func (g *GitFetcher) setupSparseCheckout(repo *git.Repository, basePath string) error {
 	// Get the working tree
@@ -1,11 +1,32 @@
 package sync
 
 import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/doron-cohen/argus/backend/internal/models"
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	githttp "github.com/go-git/go-git/v5/plumbing/transport/http"
+	gitssh "github.com/go-git/go-git/v5/plumbing/transport/ssh"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/knownhosts"
 	"gopkg.in/yaml.v3"
 )
 
@@ -75,6 +96,30 @@ path: /some/path`,
 			yamlSource:  `type: git`,
 			expectError: true,
 		},
+		{
+			name: "both token and ssh key",
+			yamlSource: `type: git
+url: https://github.com/user/repo
+auth_token: secret
+ssh_key_path: /keys/id_ed25519`,
+			expectError: true,
+		},
+		{
+			name: "known_hosts without ssh key",
+			yamlSource: `type: git
+url: https://github.com/user/repo
+known_hosts: /etc/argus/known_hosts`,
+			expectError: true,
+		},
+		{
+			name: "both known_hosts and skipped host verification",
+			yamlSource: `type: git
+url: git@github.com:user/repo.git
+ssh_key_path: /keys/id_ed25519
+known_hosts: /etc/argus/known_hosts
+insecure_skip_host_verify: true`,
+			expectError: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -186,3 +231,465 @@ func TestNewFetcher_GitType(t *testing.T) {
 	assert.NotNil(t, fetcher)
 	assert.IsType(t, &GitFetcher{}, fetcher)
 }
+
+func TestGitSourceConfig_AuthMethod(t *testing.T) {
+	t.Run("no auth", func(t *testing.T) {
+		cfg := &GitSourceConfig{URL: "https://github.com/user/repo"}
+		auth, err := cfg.AuthMethod()
+		require.NoError(t, err)
+		assert.Nil(t, auth)
+	})
+
+	t.Run("literal token", func(t *testing.T) {
+		cfg := &GitSourceConfig{URL: "https://github.com/user/repo", AuthToken: "literal-token"}
+		auth, err := cfg.AuthMethod()
+		require.NoError(t, err)
+		assert.Equal(t, &githttp.BasicAuth{Username: "x-access-token", Password: "literal-token"}, auth)
+	})
+
+	t.Run("token from environment", func(t *testing.T) {
+		t.Setenv("ARGUS_TEST_GIT_TOKEN", "env-token")
+		cfg := &GitSourceConfig{URL: "https://github.com/user/repo", AuthToken: "${ARGUS_TEST_GIT_TOKEN}", AuthUsername: "ci-bot"}
+		auth, err := cfg.AuthMethod()
+		require.NoError(t, err)
+		assert.Equal(t, &githttp.BasicAuth{Username: "ci-bot", Password: "env-token"}, auth)
+	})
+
+	t.Run("unset environment variable", func(t *testing.T) {
+		cfg := &GitSourceConfig{URL: "https://github.com/user/repo", AuthToken: "${ARGUS_TEST_UNSET_TOKEN}"}
+		_, err := cfg.AuthMethod()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "environment variable ARGUS_TEST_UNSET_TOKEN is not set")
+	})
+
+	t.Run("ssh key", func(t *testing.T) {
+		// Without known_hosts, host keys are checked against the SSH_KNOWN_HOSTS files
+		t.Setenv("SSH_KNOWN_HOSTS", writeKnownHosts(t, "github.com:22", newHostKey(t).PublicKey()))
+
+		cfg := &GitSourceConfig{URL: "git@github.com:user/repo.git", SSHKeyPath: writeSSHKey(t)}
+		auth, err := cfg.AuthMethod()
+		require.NoError(t, err)
+		publicKeys, ok := auth.(*gitssh.PublicKeys)
+		require.True(t, ok)
+		assert.Equal(t, "git", publicKeys.User)
+		assert.NotNil(t, publicKeys.HostKeyCallback)
+	})
+
+	t.Run("missing known_hosts", func(t *testing.T) {
+		cfg := &GitSourceConfig{
+			URL:            "git@github.com:user/repo.git",
+			SSHKeyPath:     writeSSHKey(t),
+			KnownHostsPath: filepath.Join(t.TempDir(), "missing"),
+		}
+		_, err := cfg.AuthMethod()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to load known_hosts")
+	})
+
+	t.Run("missing ssh key", func(t *testing.T) {
+		cfg := &GitSourceConfig{URL: "git@github.com:user/repo.git", SSHKeyPath: filepath.Join(t.TempDir(), "missing")}
+		_, err := cfg.AuthMethod()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to load ssh key")
+	})
+}
+
+func TestGitFetcher_AuthErrors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") == "" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(http.StatusForbidden)
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		name          string
+		authToken     string
+		expectedError string
+	}{
+		{
+			name:          "missing credentials",
+			expectedError: "authentication required, configure auth_token or ssh_key_path",
+		},
+		{
+			name:          "rejected credentials",
+			authToken:     "wrong-token",
+			expectedError: "authentication failed, check the configured credentials",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fetcher := NewGitFetcher(t.TempDir())
+			cfg := &GitSourceConfig{Type: "git", URL: server.URL + "/private/repo.git", Branch: "main", AuthToken: tt.authToken}
+
+			_, err := fetcher.Fetch(context.Background(), NewSourceConfig(cfg))
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.expectedError)
+		})
+	}
+}
+
+// commitManifest writes a manifest for the component into the repository and commits it
+func commitManifest(t *testing.T, repo *git.Repository, dir, name string) plumbing.Hash {
+	manifest := fmt.Sprintf("version: v1\nname: %s\n", name)
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, name), 0750))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, name, "manifest.yaml"), []byte(manifest), 0600))
+
+	worktree, err := repo.Worktree()
+	require.NoError(t, err)
+	_, err = worktree.Add(name)
+	require.NoError(t, err)
+	hash, err := worktree.Commit("Add "+name, &git.CommitOptions{
+		Author: &object.Signature{Name: "test", Email: "test@example.com", When: time.Now()},
+	})
+	require.NoError(t, err)
+	return hash
+}
+
+func componentNames(components []models.Component) []string {
+	var names []string
+	for _, component := range components {
+		names = append(names, component.Name)
+	}
+	return names
+}
+
+func TestGitFetcher_PinnedReferences(t *testing.T) {
+	remoteDir := t.TempDir()
+	remote, err := git.PlainInit(remoteDir, false)
+	require.NoError(t, err)
+
+	first := commitManifest(t, remote, remoteDir, "first-service")
+	_, err = remote.CreateTag("v1.0.0", first, &git.CreateTagOptions{
+		Tagger:  &object.Signature{Name: "test", Email: "test@example.com", When: time.Now()},
+		Message: "v1.0.0",
+	})
+	require.NoError(t, err)
+	_, err = remote.CreateTag("lightweight", first, nil)
+	require.NoError(t, err)
+	commitManifest(t, remote, remoteDir, "second-service")
+
+	tests := []struct {
+		name string
+		cfg  GitSourceConfig
+	}{
+		{name: "annotated tag", cfg: GitSourceConfig{Tag: "v1.0.0"}},
+		{name: "lightweight tag", cfg: GitSourceConfig{Tag: "lightweight"}},
+		{name: "commit", cfg: GitSourceConfig{Commit: first.String()}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fetcher := NewGitFetcher(t.TempDir())
+			cfg := tt.cfg
+			cfg.Type = "git"
+			cfg.URL = remoteDir
+			require.NoError(t, cfg.Validate())
+			assert.Empty(t, cfg.Branch)
+
+			// The first fetch clones, the second updates the existing clone
+			for range 2 {
+				components, err := fetcher.Fetch(context.Background(), NewSourceConfig(&cfg))
+				require.NoError(t, err)
+				assert.Equal(t, []string{"first-service"}, componentNames(components))
+				assert.Equal(t, first.String(), components[0].SourceRevision)
+			}
+		})
+	}
+
+	t.Run("branch follows new commits", func(t *testing.T) {
+		head, err := remote.Head()
+		require.NoError(t, err)
+
+		fetcher := NewGitFetcher(t.TempDir())
+		cfg := &GitSourceConfig{Type: "git", URL: remoteDir, Branch: head.Name().Short()}
+		components, err := fetcher.Fetch(context.Background(), NewSourceConfig(cfg))
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []string{"first-service", "second-service"}, componentNames(components))
+	})
+}
+
+func TestGitSourceConfig_References(t *testing.T) {
+	hash := "0123456789abcdef0123456789abcdef01234567"
+	tests := []struct {
+		name          string
+		cfg           GitSourceConfig
+		expectedError string
+		branch        string
+	}{
+		{name: "defaults to main", branch: "main"},
+		{name: "tag", cfg: GitSourceConfig{Tag: "v1.0.0"}},
+		{name: "commit", cfg: GitSourceConfig{Commit: hash}},
+		{name: "branch and tag", cfg: GitSourceConfig{Branch: "main", Tag: "v1.0.0"}, expectedError: "only one of branch, tag and commit"},
+		{name: "tag and commit", cfg: GitSourceConfig{Tag: "v1.0.0", Commit: hash}, expectedError: "only one of branch, tag and commit"},
+		{name: "short commit", cfg: GitSourceConfig{Commit: "0123456"}, expectedError: "full 40-character hash"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := tt.cfg
+			cfg.Type = "git"
+			cfg.URL = "https://github.com/user/repo"
+			err := cfg.Validate()
+			if tt.expectedError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.branch, cfg.Branch)
+		})
+	}
+}
+
+func TestGitFetcher_Cleanup(t *testing.T) {
+	remoteDir := t.TempDir()
+	remote, err := git.PlainInit(remoteDir, false)
+	require.NoError(t, err)
+	commitManifest(t, remote, remoteDir, "cleanup-service")
+	head, err := remote.Head()
+	require.NoError(t, err)
+
+	cloneDir := filepath.Join(t.TempDir(), "clones")
+	fetcher := NewGitFetcher(cloneDir)
+	cfg := &GitSourceConfig{Type: "git", URL: remoteDir, Branch: head.Name().Short()}
+
+	_, err = fetcher.Fetch(context.Background(), NewSourceConfig(cfg))
+	require.NoError(t, err)
+	assert.DirExists(t, filepath.Join(fetcher.repoDir(*cfg), ".git"))
+
+	require.NoError(t, fetcher.Cleanup())
+	assert.NoDirExists(t, cloneDir)
+
+	// The next fetch clones again
+	components, err := fetcher.Fetch(context.Background(), NewSourceConfig(cfg))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"cleanup-service"}, componentNames(components))
+}
+
+func TestGitFetcher_SameRepositoryOnTwoBranches(t *testing.T) {
+	remoteDir := t.TempDir()
+	remote, err := git.PlainInit(remoteDir, false)
+	require.NoError(t, err)
+	commitManifest(t, remote, remoteDir, "main-service")
+	head, err := remote.Head()
+	require.NoError(t, err)
+
+	worktree, err := remote.Worktree()
+	require.NoError(t, err)
+	require.NoError(t, worktree.Checkout(&git.CheckoutOptions{Branch: plumbing.NewBranchReferenceName("feature"), Create: true}))
+	commitManifest(t, remote, remoteDir, "feature-service")
+
+	fetcher := NewGitFetcher(t.TempDir())
+	mainCfg := &GitSourceConfig{Type: "git", URL: remoteDir, Branch: head.Name().Short()}
+	featureCfg := &GitSourceConfig{Type: "git", URL: remoteDir, Branch: "feature"}
+	assert.NotEqual(t, fetcher.repoDir(*mainCfg), fetcher.repoDir(*featureCfg))
+
+	// Alternate between the sources, so each update runs after the other source's sync
+	for range 2 {
+		components, err := fetcher.Fetch(context.Background(), NewSourceConfig(mainCfg))
+		require.NoError(t, err)
+		assert.Equal(t, []string{"main-service"}, componentNames(components))
+
+		components, err = fetcher.Fetch(context.Background(), NewSourceConfig(featureCfg))
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []string{"main-service", "feature-service"}, componentNames(components))
+	}
+}
+
+func TestGitFetcher_repoDir(t *testing.T) {
+	fetcher := NewGitFetcher("/clones")
+
+	dirs := make(map[string]bool)
+	for _, cfg := range []GitSourceConfig{
+		{URL: "https://github.com/user/repo", Branch: "main"},
+		{URL: "https://github.com/user/repo", Branch: "release"},
+		{URL: "https://github.com/User/Repo", Branch: "main"},
+		{URL: "https://github.com/user.repo", Branch: "main"},
+		{URL: "https://github.com/user/repo", Branch: "feature/a"},
+		{URL: "https://github.com/user/repo", Branch: "feature_a"},
+		{URL: "https://github.com/user/repo", Tag: "main"},
+		{URL: "https://github.com/user/repo", Commit: "0123456789abcdef0123456789abcdef01234567"},
+	} {
+		dir := fetcher.repoDir(cfg)
+		assert.Equal(t, "/clones", filepath.Dir(dir))
+		assert.False(t, dirs[dir], "%+v shares clone %s", cfg, dir)
+		dirs[dir] = true
+	}
+
+	// A source always maps to the same clone
+	cfg := GitSourceConfig{URL: "https://github.com/user/repo", Branch: "main"}
+	assert.Equal(t, fetcher.repoDir(cfg), fetcher.repoDir(cfg))
+	assert.Contains(t, filepath.Base(fetcher.repoDir(cfg)), "github_com_user_repo_main_")
+}
+
+// writeSSHKey writes a new unencrypted ed25519 private key and returns its path
+func writeSSHKey(t *testing.T) string {
+	_, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKCS8PrivateKey(privateKey)
+	require.NoError(t, err)
+	keyPath := filepath.Join(t.TempDir(), "id_ed25519")
+	require.NoError(t, os.WriteFile(keyPath, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), 0600))
+	return keyPath
+}
+
+// newHostKey generates an ssh host key
+func newHostKey(t *testing.T) ssh.Signer {
+	_, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	signer, err := ssh.NewSignerFromKey(privateKey)
+	require.NoError(t, err)
+	return signer
+}
+
+// writeKnownHosts writes a known_hosts file listing key for addr and returns its path
+func writeKnownHosts(t *testing.T, addr string, key ssh.PublicKey) string {
+	path := filepath.Join(t.TempDir(), "known_hosts")
+	require.NoError(t, os.WriteFile(path, []byte(knownhosts.Line([]string{knownhosts.Normalize(addr)}, key)+"\n"), 0600))
+	return path
+}
+
+// startSSHGitServer serves the repositories under root over ssh by running git-upload-pack,
+// accepting any client key. It returns the server address.
+func startSSHGitServer(t *testing.T, root string, hostKey ssh.Signer) string {
+	if _, err := exec.LookPath("git-upload-pack"); err != nil {
+		t.Skip("git-upload-pack is not installed")
+	}
+
+	config := &ssh.ServerConfig{
+		PublicKeyCallback: func(ssh.ConnMetadata, ssh.PublicKey) (*ssh.Permissions, error) {
+			return nil, nil
+		},
+	}
+	config.AddHostKey(hostKey)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = listener.Close() })
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go serveSSHGit(conn, config, root)
+		}
+	}()
+	return listener.Addr().String()
+}
+
+// serveSSHGit handles one ssh connection of startSSHGitServer
+func serveSSHGit(conn net.Conn, config *ssh.ServerConfig, root string) {
+	_, channels, requests, err := ssh.NewServerConn(conn, config)
+	if err != nil {
+		return
+	}
+	go ssh.DiscardRequests(requests)
+
+	for newChannel := range channels {
+		channel, channelRequests, err := newChannel.Accept()
+		if err != nil {
+			return
+		}
+		go func() {
+			defer func() { _ = channel.Close() }()
+			for request := range channelRequests {
+				if request.Type != "exec" {
+					_ = request.Reply(false, nil)
+					continue
+				}
+				// The command looks like git-upload-pack '/catalog'
+				var payload struct{ Command string }
+				if err := ssh.Unmarshal(request.Payload, &payload); err != nil {
+					_ = request.Reply(false, nil)
+					return
+				}
+				service, repoPath, _ := strings.Cut(payload.Command, " ")
+				if service != "git-upload-pack" {
+					_ = request.Reply(false, nil)
+					return
+				}
+				_ = request.Reply(true, nil)
+
+				cmd := exec.Command(service, filepath.Join(root, strings.Trim(repoPath, "'/")))
+				cmd.Stdin = channel
+				cmd.Stdout = channel
+				cmd.Stderr = channel.Stderr()
+				exitStatus := uint32(0)
+				if err := cmd.Run(); err != nil {
+					exitStatus = 1
+				}
+				_, _ = channel.SendRequest("exit-status", false, ssh.Marshal(struct{ Status uint32 }{exitStatus}))
+				return
+			}
+		}()
+	}
+}
+
+func TestGitFetcher_SSHHostKeyVerification(t *testing.T) {
+	root := t.TempDir()
+	remoteDir := filepath.Join(root, "catalog")
+	remote, err := git.PlainInit(remoteDir, false)
+	require.NoError(t, err)
+	commitManifest(t, remote, remoteDir, "ssh-service")
+	head, err := remote.Head()
+	require.NoError(t, err)
+
+	hostKey := newHostKey(t)
+	addr := startSSHGitServer(t, root, hostKey)
+	keyPath := writeSSHKey(t)
+
+	tests := []struct {
+		name          string
+		knownHosts    string
+		insecure      bool
+		expectedError string
+	}{
+		{
+			name:       "known host",
+			knownHosts: writeKnownHosts(t, addr, hostKey.PublicKey()),
+		},
+		{
+			name:          "changed host key",
+			knownHosts:    writeKnownHosts(t, addr, newHostKey(t).PublicKey()),
+			expectedError: "host key does not match known_hosts",
+		},
+		{
+			name:          "unknown host",
+			knownHosts:    writeKnownHosts(t, "other.example.com:22", hostKey.PublicKey()),
+			expectedError: "host key is not in known_hosts",
+		},
+		{
+			name:     "verification skipped",
+			insecure: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &GitSourceConfig{
+				Type:                   "git",
+				URL:                    fmt.Sprintf("ssh://git@%s/catalog", addr),
+				Branch:                 head.Name().Short(),
+				SSHKeyPath:             keyPath,
+				KnownHostsPath:         tt.knownHosts,
+				InsecureSkipHostVerify: tt.insecure,
+			}
+			require.NoError(t, cfg.Validate())
+
+			components, err := NewGitFetcher(t.TempDir()).Fetch(context.Background(), NewSourceConfig(cfg))
+			if tt.expectedError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, []string{"ssh-service"}, componentNames(components))
+		})
+	}
+}
@@ -0,0 +1,288 @@
+package sync
+
+import (
+	"archive/tar"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"path"
+	"slices"
+	"time"
+
+	"github.com/doron-cohen/argus/backend/internal/models"
+)
+
+// maxHTTPBundleSize bounds the size of a downloaded manifest bundle
+const maxHTTPBundleSize = 32 << 20 // 32MB
+
+// HTTPSourceConfig holds configuration for manifests published at an HTTP(S) URL
+type HTTPSourceConfig struct {
+	Type             string            `yaml:"type"`
+	Interval         time.Duration     `yaml:"interval"`
+	URL              string            `yaml:"url"`
+	Headers          map[string]string `yaml:"headers,omitempty"`           // Sent with every request, e.g. Authorization
+	StrictManifests  bool              `yaml:"strict_manifests,omitempty"`  // Reject manifests with unknown fields
+	ManifestPatterns []string          `yaml:"manifest_patterns,omitempty"` // Manifest file patterns, defaults to manifest.yaml and manifest.yml
+	Defaults         ComponentDefaults `yaml:"defaults,omitempty"`          // Applied when a manifest omits a field
+	Prune            bool              `yaml:"prune,omitempty"`             // Hard-delete components removed from the source
+}
+
+// Validate ensures the HTTP configuration is valid
+func (h *HTTPSourceConfig) Validate() error {
+	if h.Type != sourceTypeHTTP {
+		return fmt.Errorf("expected type '%s', got '%s'", sourceTypeHTTP, h.Type)
+	}
+	if h.URL == "" {
+		return fmt.Errorf("http source requires url field")
+	}
+
+	parsed, err := url.Parse(h.URL)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return fmt.Errorf("http source url must be an absolute http or https URL, got %q", h.URL)
+	}
+
+	interval := h.GetInterval()
+	if interval < MinHTTPInterval {
+		return fmt.Errorf("http source interval must be at least %v, got %v", MinHTTPInterval, interval)
+	}
+
+	if err := h.Defaults.Validate(); err != nil {
+		return err
+	}
+
+	return validateManifestPatterns(h.ManifestPatterns)
+}
+
+// GetInterval returns the sync interval for this source
+func (h *HTTPSourceConfig) GetInterval() time.Duration {
+	if h.Interval == 0 {
+		return 5 * time.Minute // default
+	}
+	return h.Interval
+}
+
+// GetBasePath returns the base path for this source (always empty for http)
+func (h *HTTPSourceConfig) GetBasePath() string {
+	return ""
+}
+
+// GetDefaults returns the component defaults for this source
+func (h *HTTPSourceConfig) GetDefaults() ComponentDefaults {
+	return h.Defaults
+}
+
+// GetPrune reports whether components removed from this source are hard-deleted
+func (h *HTTPSourceConfig) GetPrune() bool {
+	return h.Prune
+}
+
+// GetSourceType returns the source type
+func (h *HTTPSourceConfig) GetSourceType() string {
+	return sourceTypeHTTP
+}
+
+// HTTPFetcher implements ComponentsFetcher for manifest bundles served over HTTP.
+// The response is either a tar archive (optionally gzipped) whose entries matching the
+// source's manifest patterns are parsed, or a newline-delimited list with one manifest per
+// line in JSON form. Blank lines and lines starting with '#' are ignored.
+type HTTPFetcher struct {
+	client *http.Client
+}
+
+// NewHTTPFetcher creates a new HTTP fetcher
+func NewHTTPFetcher() *HTTPFetcher {
+	return &HTTPFetcher{
+		client: &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+// Fetch downloads the manifest bundle and parses every manifest in it
+func (h *HTTPFetcher) Fetch(ctx context.Context, source SourceConfig) ([]models.Component, error) {
+	cfg := source.GetConfig()
+	httpConfig, ok := cfg.(*HTTPSourceConfig)
+	if !ok {
+		return nil, fmt.Errorf("source is not an http config")
+	}
+
+	body, err := h.download(ctx, *httpConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	parser := models.NewParser(models.WithStrictFields(httpConfig.StrictManifests))
+
+	var manifests []*models.Manifest
+	if isTarArchive(body) {
+		manifests, err = parseManifestArchive(body, httpConfig.ManifestPatterns, parser)
+	} else {
+		manifests, err = parseManifestList(body, parser)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to load manifests from %s: %w", httpConfig.URL, err)
+	}
+
+	slog.Debug("Found manifests", "count", len(manifests), "source", httpConfig.URL)
+
+	// Manifests in a bundle share the revision of the whole download
+	revision := contentRevision(body)
+	var components []models.Component
+	for _, manifest := range manifests {
+		component := manifest.ToComponent()
+		component.SourceRevision = revision
+		components = append(components, component)
+	}
+
+	return components, nil
+}
+
+// downloadStatusError is a bundle download answered with a non-200 status
+type downloadStatusError struct {
+	url        string
+	status     string
+	statusCode int
+}
+
+func (e *downloadStatusError) Error() string {
+	return fmt.Sprintf("failed to download %s: unexpected status %s", e.url, e.status)
+}
+
+// download fetches the bundle body, failing on non-200 responses and oversized bodies
+func (h *HTTPFetcher) download(ctx context.Context, httpConfig HTTPSourceConfig) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, httpConfig.URL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request for %s: %w", httpConfig.URL, err)
+	}
+	for name, value := range httpConfig.Headers {
+		req.Header.Set(name, value)
+	}
+
+	resp, err := h.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to download %s: %w", httpConfig.URL, err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, &downloadStatusError{url: httpConfig.URL, status: resp.Status, statusCode: resp.StatusCode}
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxHTTPBundleSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response from %s: %w", httpConfig.URL, err)
+	}
+	if len(body) > maxHTTPBundleSize {
+		return nil, fmt.Errorf("response from %s exceeds %d bytes", httpConfig.URL, maxHTTPBundleSize)
+	}
+
+	return body, nil
+}
+
+// isTarArchive reports whether the body looks like a gzip stream or a plain tar archive
+func isTarArchive(body []byte) bool {
+	if len(body) >= 2 && body[0] == 0x1f && body[1] == 0x8b {
+		return true
+	}
+	// POSIX tar headers carry the "ustar" magic at offset 257
+	return len(body) >= 262 && string(body[257:262]) == "ustar"
+}
+
+// parseManifestArchive parses the manifest files found in a tar or tar.gz archive.
+// Entries are selected with the same pattern and precedence rules as on-disk sources.
+func parseManifestArchive(body []byte, patterns []string, parser *models.Parser) ([]*models.Manifest, error) {
+	var reader io.Reader = bytes.NewReader(body)
+	if body[0] == 0x1f && body[1] == 0x8b {
+		gz, err := gzip.NewReader(reader)
+		if err != nil {
+			return nil, fmt.Errorf("malformed gzip archive: %w", err)
+		}
+		defer func() { _ = gz.Close() }()
+		reader = gz
+	}
+
+	if len(patterns) == 0 {
+		patterns = DefaultManifestPatterns
+	}
+
+	contents := make(map[string][]byte)
+	var names []string
+	archive := tar.NewReader(reader)
+	for {
+		header, err := archive.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("malformed tar archive: %w", err)
+		}
+
+		name := path.Clean(header.Name)
+		if header.Typeflag != tar.TypeReg || !slices.ContainsFunc(patterns, func(pattern string) bool {
+			return matchManifestPattern(pattern, name)
+		}) {
+			continue
+		}
+
+		content, err := io.ReadAll(archive)
+		if err != nil {
+			return nil, fmt.Errorf("malformed tar archive: failed to read %s: %w", header.Name, err)
+		}
+		contents[name] = content
+		names = append(names, name)
+	}
+
+	var manifests []*models.Manifest
+	for _, name := range selectManifestPaths(names, patterns) {
+		manifest, err := parseManifest(parser, contents[name])
+		if err != nil {
+			return nil, fmt.Errorf("manifest %s: %w", name, err)
+		}
+		manifests = append(manifests, manifest)
+	}
+
+	return manifests, nil
+}
+
+// parseManifestList parses a newline-delimited list with one manifest per line
+func parseManifestList(body []byte, parser *models.Parser) ([]*models.Manifest, error) {
+	var manifests []*models.Manifest
+	scanner := bufio.NewScanner(bytes.NewReader(body))
+	scanner.Buffer(make([]byte, 0, 64*1024), maxHTTPBundleSize)
+	lineNumber := 0
+	for scanner.Scan() {
+		lineNumber++
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 || line[0] == '#' {
+			continue
+		}
+
+		manifest, err := parseManifest(parser, line)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", lineNumber, err)
+		}
+		manifests = append(manifests, manifest)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read manifest list: %w", err)
+	}
+
+	return manifests, nil
+}
+
+// parseManifest parses and validates a single manifest document
+func parseManifest(parser *models.Parser, content []byte) (*models.Manifest, error) {
+	manifest, err := parser.Parse(content)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse manifest: %w", err)
+	}
+	if err := parser.Validate(manifest); err != nil {
+		return nil, fmt.Errorf("invalid manifest: %w", err)
+	}
+	return manifest, nil
+}
@@ -0,0 +1,214 @@
+package sync
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+func TestSourceConfig_HTTPConfig(t *testing.T) {
+	tests := []struct {
+		name        string
+		yamlSource  string
+		expectError bool
+		expected    HTTPSourceConfig
+	}{
+		{
+			name: "valid http config",
+			yamlSource: `type: http
+url: https://artifacts.example.com/manifests.tar.gz`,
+			expected: HTTPSourceConfig{
+				Type: "http",
+				URL:  "https://artifacts.example.com/manifests.tar.gz",
+			},
+		},
+		{
+			name: "http config with headers and interval",
+			yamlSource: `type: http
+url: https://artifacts.example.com/manifests.ndjson
+interval: 1m
+headers:
+  Authorization: Bearer token`,
+			expected: HTTPSourceConfig{
+				Type:     "http",
+				URL:      "https://artifacts.example.com/manifests.ndjson",
+				Interval: time.Minute,
+				Headers:  map[string]string{"Authorization": "Bearer token"},
+			},
+		},
+		{
+			name:        "missing url",
+			yamlSource:  `type: http`,
+			expectError: true,
+		},
+		{
+			name: "unsupported scheme",
+			yamlSource: `type: http
+url: ftp://artifacts.example.com/manifests.tar.gz`,
+			expectError: true,
+		},
+		{
+			name: "interval too low",
+			yamlSource: `type: http
+url: https://artifacts.example.com/manifests.tar.gz
+interval: 5s`,
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var source SourceConfig
+			err := yaml.Unmarshal([]byte(tt.yamlSource), &source)
+			if tt.expectError {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			httpConfig, ok := source.GetConfig().(*HTTPSourceConfig)
+			require.True(t, ok)
+			assert.Equal(t, tt.expected.URL, httpConfig.URL)
+			assert.Equal(t, tt.expected.Headers, httpConfig.Headers)
+			if tt.expected.Interval > 0 {
+				assert.Equal(t, tt.expected.Interval, httpConfig.Interval)
+			}
+		})
+	}
+}
+
+// newManifestTarball builds a gzipped tarball with the given file contents
+func newManifestTarball(t *testing.T, files map[string]string) []byte {
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	archive := tar.NewWriter(gz)
+	for name, content := range files {
+		require.NoError(t, archive.WriteHeader(&tar.Header{
+			Name:     name,
+			Mode:     0600,
+			Size:     int64(len(content)),
+			Typeflag: tar.TypeReg,
+		}))
+		_, err := archive.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, archive.Close())
+	require.NoError(t, gz.Close())
+	return buf.Bytes()
+}
+
+func newHTTPSource(url string, headers map[string]string) SourceConfig {
+	typed := NewHTTPSourceConfig(url, headers, time.Minute)
+	return NewSourceConfig(typed.GetConfig())
+}
+
+func componentIDs(t *testing.T, fetcher *HTTPFetcher, source SourceConfig) []string {
+	components, err := fetcher.Fetch(context.Background(), source)
+	require.NoError(t, err)
+	var ids []string
+	for _, component := range components {
+		ids = append(ids, component.GetIdentifier())
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+func TestHTTPFetcher(t *testing.T) {
+	tarball := newManifestTarball(t, map[string]string{
+		"services/auth/manifest.yaml":   "version: v1\nname: auth-service\n",
+		"services/billing/manifest.yml": "version: v1\nname: billing-service\n",
+		"services/auth/README.md":       "not a manifest",
+	})
+	list := "# generated by the artifact server\n" +
+		`{"version": "v1", "name": "auth-service"}` + "\n\n" +
+		`{"version": "v1", "name": "billing-service", "description": "Billing"}` + "\n"
+
+	var receivedAuth string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		receivedAuth = r.Header.Get("Authorization")
+		switch r.URL.Path {
+		case "/bundle.tar.gz":
+			_, _ = w.Write(tarball)
+		case "/manifests.ndjson":
+			_, _ = w.Write([]byte(list))
+		case "/broken.tar.gz":
+			_, _ = w.Write(tarball[:len(tarball)/2])
+		case "/invalid.ndjson":
+			_, _ = w.Write([]byte(`{"version": "v1"}` + "\n"))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	fetcher := NewHTTPFetcher()
+
+	t.Run("tarball", func(t *testing.T) {
+		source := newHTTPSource(server.URL+"/bundle.tar.gz", map[string]string{"Authorization": "Bearer secret"})
+		assert.Equal(t, []string{"auth-service", "billing-service"}, componentIDs(t, fetcher, source))
+		assert.Equal(t, "Bearer secret", receivedAuth)
+	})
+
+	t.Run("newline-delimited list", func(t *testing.T) {
+		source := newHTTPSource(server.URL+"/manifests.ndjson", nil)
+		assert.Equal(t, []string{"auth-service", "billing-service"}, componentIDs(t, fetcher, source))
+	})
+
+	t.Run("non-200 response", func(t *testing.T) {
+		source := newHTTPSource(server.URL+"/missing", nil)
+		_, err := fetcher.Fetch(context.Background(), source)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "unexpected status 404")
+	})
+
+	t.Run("malformed archive", func(t *testing.T) {
+		source := newHTTPSource(server.URL+"/broken.tar.gz", nil)
+		_, err := fetcher.Fetch(context.Background(), source)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "malformed")
+	})
+
+	t.Run("invalid manifest in list", func(t *testing.T) {
+		source := newHTTPSource(server.URL+"/invalid.ndjson", nil)
+		_, err := fetcher.Fetch(context.Background(), source)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "line 1")
+	})
+
+	t.Run("wrong config type", func(t *testing.T) {
+		_, err := fetcher.Fetch(context.Background(), newSourceConfigFromYAMLOrPanic("type: filesystem\npath: /tmp"))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "source is not an http config")
+	})
+}
+
+func TestService_SyncSource_HTTPErrorSurfacesInStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer server.Close()
+
+	service := NewService(&MockRepository{}, Config{})
+	status := service.SyncSource(context.Background(), newHTTPSource(server.URL+"/bundle.tar.gz", nil))
+
+	assert.Equal(t, StatusFailed, status.Status)
+	require.NotNil(t, status.LastError)
+	assert.Contains(t, *status.LastError, "unexpected status 502")
+}
+
+func TestNewFetcher_HTTPType(t *testing.T) {
+	fetcher, err := NewFetcher("http")
+
+	require.NoError(t, err)
+	assert.NotNil(t, fetcher)
+	assert.IsType(t, &HTTPFetcher{}, fetcher)
+}
@@ -0,0 +1,42 @@
+package sync
+
+import (
+	"strconv"
+
+	"github.com/doron-cohen/argus/backend/internal/metrics"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// Series are labeled by the source's index in the configuration, as in the sync API, and its
+// type. Source URLs are left out since they may carry credentials, and /metrics is not
+// authenticated.
+var (
+	syncRunsTotal = promauto.With(metrics.Registry).NewCounterVec(prometheus.CounterOpts{
+		Name: "argus_sync_runs_total",
+		Help: "Finished sync runs per source, by result (success or failure).",
+	}, []string{"source", "type", "result"})
+	syncDurationSeconds = promauto.With(metrics.Registry).NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "argus_sync_duration_seconds",
+		Help:    "Duration of sync runs per source.",
+		Buckets: metrics.DurationBuckets,
+	}, []string{"source", "type"})
+	syncComponentsTotal = promauto.With(metrics.Registry).NewCounterVec(prometheus.CounterOpts{
+		Name: "argus_sync_components_total",
+		Help: "Components changed by sync runs per source, by outcome (created, updated or deleted).",
+	}, []string{"source", "type", "outcome"})
+)
+
+// recordSyncMetrics records a finished sync run of the source at index
+func recordSyncMetrics(index int, sourceType string, status *SourceStatus) {
+	source := strconv.Itoa(index)
+	result := "success"
+	if status.Status == StatusFailed {
+		result = "failure"
+	}
+	syncRunsTotal.WithLabelValues(source, sourceType, result).Inc()
+	syncDurationSeconds.WithLabelValues(source, sourceType).Observe(status.Duration.Seconds())
+	syncComponentsTotal.WithLabelValues(source, sourceType, "created").Add(float64(status.Created))
+	syncComponentsTotal.WithLabelValues(source, sourceType, "updated").Add(float64(status.Updated))
+	syncComponentsTotal.WithLabelValues(source, sourceType, "deleted").Add(float64(status.Deleted))
+}
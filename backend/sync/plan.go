@@ -0,0 +1,124 @@
+package sync
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+
+	"github.com/doron-cohen/argus/backend/internal/storage"
+)
+
+// PlanAction is what a sync would do to a component
+type PlanAction string
+
+const (
+	PlanActionCreate PlanAction = "create"
+	PlanActionUpdate PlanAction = "update"
+	PlanActionDelete PlanAction = "delete"
+)
+
+// PlannedChange is a single component change a sync would make
+type PlannedChange struct {
+	Action      PlanAction
+	ComponentID string
+	Name        string
+	// Changes holds the old and new value of each updated field, as recorded in component history
+	Changes storage.JSONB
+}
+
+// SyncPlan lists the changes a sync of a source would make, without making them
+type SyncPlan struct {
+	Changes []PlannedChange
+	// Unchanged counts fetched components that already match the database
+	Unchanged int
+	// HardDelete is set when deleted components would be removed permanently, see SourceTypeConfig.GetPrune
+	HardDelete bool
+}
+
+// PlanSync fetches a source and compares its components against the database like SyncSource
+// does, but only reports what would change. All lookups run in a read-only transaction.
+func (s *Service) PlanSync(ctx context.Context, source SourceConfig) (*SyncPlan, error) {
+	cfg := source.GetConfig()
+	if cfg == nil {
+		return nil, fmt.Errorf("source has no configuration")
+	}
+
+	fetcher, err := s.getFetcher(cfg.GetSourceType())
+	if err != nil {
+		return nil, err
+	}
+
+	components, err := fetcher.Fetch(ctx, source)
+	if err != nil {
+		return nil, err
+	}
+
+	plan := &SyncPlan{HardDelete: cfg.GetPrune()}
+	sourceID := s.getSourceID(source)
+
+	err = s.repo.ReadOnly(ctx, func(tx *storage.Repository) error {
+		// Stored identifiers of the fetched components, which are kept by the sync
+		seen := make(map[string]bool, len(components))
+
+		for _, component := range components {
+			updated := s.toStorageComponent(component, source)
+
+			existing, err := tx.GetComponentByID(ctx, updated.ComponentID)
+			renamed := false
+			if errors.Is(err, storage.ErrComponentNotFound) {
+				// A component stored under a previous identifier is renamed rather than created
+				for _, previousID := range component.PreviousIDs {
+					existing, err = tx.GetComponentByID(ctx, previousID)
+					if !errors.Is(err, storage.ErrComponentNotFound) {
+						renamed = true
+						break
+					}
+				}
+			}
+			if errors.Is(err, storage.ErrComponentNotFound) {
+				plan.Changes = append(plan.Changes, PlannedChange{Action: PlanActionCreate, ComponentID: updated.ComponentID, Name: updated.Name})
+				continue
+			}
+			if err != nil {
+				return fmt.Errorf("failed to check existing component %s: %w", updated.ComponentID, err)
+			}
+			seen[existing.ComponentID] = true
+
+			// Maintainers edited through the API are not overwritten by sync
+			if existing.MaintainersManaged {
+				updated.Maintainers = existing.Maintainers
+			}
+
+			changes := storage.DiffComponents(*existing, updated)
+			if renamed {
+				changes["component_id"] = map[string]interface{}{"old": existing.ComponentID, "new": updated.ComponentID}
+			}
+			if len(changes) == 0 {
+				plan.Unchanged++
+				continue
+			}
+			plan.Changes = append(plan.Changes, PlannedChange{Action: PlanActionUpdate, ComponentID: existing.ComponentID, Name: updated.Name, Changes: changes})
+		}
+
+		// Components no longer in the source are deleted once no other source provides them
+		provided, err := tx.GetSourceComponents(ctx, sourceID)
+		if err != nil {
+			return fmt.Errorf("failed to list source components: %w", err)
+		}
+		for _, component := range provided {
+			if seen[component.ComponentID] || slices.ContainsFunc(component.Sources, func(id string) bool { return id != sourceID }) {
+				continue
+			}
+			plan.Changes = append(plan.Changes, PlannedChange{Action: PlanActionDelete, ComponentID: component.ComponentID, Name: component.Name})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	slog.Info("Planned sync", "source", s.getSourceInfo(source), "changes", len(plan.Changes), "unchanged", plan.Unchanged)
+	return plan, nil
+}
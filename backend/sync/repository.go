@@ -10,6 +10,13 @@ import (
 type Repository interface {
 	GetComponentByID(ctx context.Context, componentID string) (*storage.Component, error)
 	CreateComponent(ctx context.Context, component storage.Component) error
+	UpdateComponent(ctx context.Context, component storage.Component) error
+	RenameComponent(ctx context.Context, oldID string, newID string) error
+	CreateComponentHistory(ctx context.Context, entry storage.ComponentHistory) error
+	AddComponentSource(ctx context.Context, componentID string, sourceID string) error
+	PruneSourceComponents(ctx context.Context, sourceID string, seenIDs []string, hardDelete bool) ([]string, error)
+	// ReadOnly runs fn within a read-only transaction, used to plan a sync without writing
+	ReadOnly(ctx context.Context, fn func(tx *storage.Repository) error) error
 }
 
 // Ensure storage.Repository implements our interface
@@ -0,0 +1,100 @@
+package sync
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"net"
+	"net/http"
+	"syscall"
+	"time"
+
+	"github.com/doron-cohen/argus/backend/internal/models"
+	"github.com/doron-cohen/argus/backend/internal/tracing"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/transport"
+	githttp "github.com/go-git/go-git/v5/plumbing/transport/http"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// fetchWithRetry fetches a source, retrying transient failures with exponential backoff.
+// Permanent failures, such as rejected credentials or a missing base path, are returned at once.
+func (s *Service) fetchWithRetry(ctx context.Context, fetcher ComponentsFetcher, source SourceConfig) ([]models.Component, error) {
+	backoff := s.config.retryBackoff()
+
+	for attempt := 1; ; attempt++ {
+		fetchCtx, span := tracing.Start(ctx, "sync.Fetch", attribute.Int("argus.sync.attempt", attempt))
+		components, err := fetcher.Fetch(fetchCtx, source)
+		tracing.End(span, err)
+		if err == nil {
+			if attempt > 1 {
+				slog.Info("Fetched source after retry", "source", s.getSourceInfo(source), "attempts", attempt)
+			}
+			return components, nil
+		}
+
+		if attempt > s.config.Retries || ctx.Err() != nil || !isTransient(err) {
+			return nil, err
+		}
+
+		slog.Warn("Fetch attempt failed, retrying",
+			"source", s.getSourceInfo(source),
+			"attempt", attempt,
+			"max_attempts", s.config.Retries+1,
+			"backoff", backoff,
+			"error", err)
+
+		timer := time.NewTimer(backoff)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, err
+		}
+		backoff *= 2
+	}
+}
+
+// isTransient reports whether a fetch error is worth retrying: network failures, timeouts
+// and server-side HTTP errors. Anything unrecognized is treated as permanent.
+func isTransient(err error) bool {
+	switch {
+	case errors.Is(err, transport.ErrAuthenticationRequired),
+		errors.Is(err, transport.ErrAuthorizationFailed),
+		errors.Is(err, transport.ErrRepositoryNotFound),
+		errors.Is(err, context.Canceled):
+		return false
+	case errors.Is(err, io.ErrUnexpectedEOF),
+		errors.Is(err, syscall.ECONNRESET),
+		errors.Is(err, syscall.ECONNREFUSED):
+		return true
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		return true
+	}
+
+	var downloadErr *downloadStatusError
+	if errors.As(err, &downloadErr) {
+		return transientStatus(downloadErr.statusCode)
+	}
+
+	// go-git wraps failed requests and unexpected HTTP responses in an error that does not unwrap
+	var unexpected *plumbing.UnexpectedError
+	if errors.As(err, &unexpected) {
+		var gitErr *githttp.Err
+		if errors.As(unexpected.Err, &gitErr) && gitErr.Response != nil {
+			return transientStatus(gitErr.Response.StatusCode)
+		}
+		return isTransient(unexpected.Err)
+	}
+
+	return false
+}
+
+// transientStatus reports whether an HTTP status may succeed when retried
+func transientStatus(code int) bool {
+	return code >= 500 || code == http.StatusRequestTimeout || code == http.StatusTooManyRequests
+}
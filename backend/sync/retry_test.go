@@ -0,0 +1,98 @@
+package sync
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/doron-cohen/argus/backend/internal/models"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/transport"
+	githttp "github.com/go-git/go-git/v5/plumbing/transport/http"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsTransient(t *testing.T) {
+	testCases := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{name: "dial failure", err: fmt.Errorf("failed to clone: %w", &net.OpError{Op: "dial", Err: errors.New("connection refused")}), want: true},
+		{name: "timeout", err: fmt.Errorf("failed to download: %w", context.DeadlineExceeded), want: true},
+		{name: "download 503", err: &downloadStatusError{statusCode: http.StatusServiceUnavailable}, want: true},
+		{name: "download 429", err: &downloadStatusError{statusCode: http.StatusTooManyRequests}, want: true},
+		{name: "download 404", err: &downloadStatusError{statusCode: http.StatusNotFound}},
+		{name: "git 502", err: plumbing.NewUnexpectedError(&githttp.Err{Response: &http.Response{StatusCode: http.StatusBadGateway}}), want: true},
+		{name: "git request failure", err: plumbing.NewUnexpectedError(&net.DNSError{Err: "no such host", IsTemporary: true}), want: true},
+		{name: "authentication required", err: fmt.Errorf("failed to clone: %w", transport.ErrAuthenticationRequired)},
+		{name: "authorization failed", err: fmt.Errorf("failed to fetch: %w", transport.ErrAuthorizationFailed)},
+		{name: "canceled", err: context.Canceled},
+		{name: "missing base path", err: errors.New("base path services does not exist in repository")},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, isTransient(tc.err))
+		})
+	}
+}
+
+func TestService_SyncSource_RetriesTransientFetchErrors(t *testing.T) {
+	source := newSourceConfigFromYAMLOrPanic("type: git\nurl: https://github.com/test/repo")
+	transient := fmt.Errorf("failed to clone: %w", &net.OpError{Op: "dial", Err: errors.New("connection reset")})
+	ctx := context.Background()
+
+	newService := func(fetcher *MockFetcher, repo *MockRepository) *Service {
+		return &Service{
+			repo:     repo,
+			config:   Config{Retries: 2, RetryBackoff: time.Millisecond},
+			fetchers: map[string]ComponentsFetcher{"git": fetcher},
+		}
+	}
+
+	t.Run("succeeds after retry", func(t *testing.T) {
+		mockFetcher := &MockFetcher{}
+		mockRepo := &MockRepository{}
+		mockFetcher.On("Fetch", ctx, source).Return([]models.Component{}, transient).Once()
+		mockFetcher.On("Fetch", ctx, source).Return([]models.Component{}, nil).Once()
+		mockRepo.On("PruneSourceComponents", ctx, "git:https://github.com/test/repo@main", mock.Anything, false).Return([]string{}, nil)
+
+		status := newService(mockFetcher, mockRepo).SyncSource(ctx, source)
+
+		assert.Equal(t, StatusCompleted, status.Status)
+		assert.Nil(t, status.LastError)
+		mockFetcher.AssertNumberOfCalls(t, "Fetch", 2)
+	})
+
+	t.Run("gives up after retries", func(t *testing.T) {
+		mockFetcher := &MockFetcher{}
+		mockFetcher.On("Fetch", ctx, source).Return([]models.Component{}, transient)
+
+		status := newService(mockFetcher, &MockRepository{}).SyncSource(ctx, source)
+
+		assert.Equal(t, StatusFailed, status.Status)
+		require.NotNil(t, status.LastError)
+		assert.Contains(t, *status.LastError, "connection reset")
+		mockFetcher.AssertNumberOfCalls(t, "Fetch", 3)
+	})
+
+	t.Run("does not retry permanent errors", func(t *testing.T) {
+		mockFetcher := &MockFetcher{}
+		mockFetcher.On("Fetch", ctx, source).Return([]models.Component{}, transient).Once()
+		mockFetcher.On("Fetch", ctx, source).Return([]models.Component{}, errors.New("failed to clone: authentication failed")).Once()
+
+		status := newService(mockFetcher, &MockRepository{}).SyncSource(ctx, source)
+
+		assert.Equal(t, StatusFailed, status.Status)
+		require.NotNil(t, status.LastError)
+		assert.Contains(t, *status.LastError, "authentication failed")
+		mockFetcher.AssertNumberOfCalls(t, "Fetch", 2)
+	})
+}
@@ -5,17 +5,26 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"runtime/debug"
+	"slices"
+	"strings"
 	"sync"
 	"time"
 
 	"github.com/doron-cohen/argus/backend/internal/models"
 	"github.com/doron-cohen/argus/backend/internal/storage"
+	"github.com/doron-cohen/argus/backend/internal/tracing"
+	"github.com/doron-cohen/argus/backend/internal/utils"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 // Error definitions
 var (
 	ErrSourceNotFound     = errors.New("source not found")
 	ErrSyncAlreadyRunning = errors.New("sync already running for this source")
+	ErrNoSuccessfulSync   = errors.New("no source has completed a sync yet")
+	ErrSourceDisabled     = errors.New("source is disabled")
+	ErrShuttingDown       = errors.New("sync service is shutting down")
 )
 
 // SourceStatus represents the status of a sync source
@@ -23,10 +32,48 @@ type SourceStatus struct {
 	Status          Status
 	LastSync        *time.Time
 	LastError       *string
-	ComponentsCount int
+	ComponentsCount int                  // Components fetched from the source
+	Created         int                  // New components stored
+	Updated         int                  // Existing components whose fields changed
+	Skipped         int                  // Existing components left as they were
+	Failed          int                  // Components that could not be stored
+	Deleted         int                  // Components removed because the source no longer provides them
+	ComponentErrors []ComponentSyncError // First maxComponentErrors failures of the run, see Failed for the total
 	Duration        time.Duration
+	NextSync        *time.Time // When the periodic sync fires next; nil when the source is disabled or not scheduled
 }
 
+// ComponentSyncError records why a fetched component could not be stored
+type ComponentSyncError struct {
+	Component string
+	Error     string
+}
+
+// maxComponentErrors bounds how many component failures a status keeps
+const maxComponentErrors = 50
+
+// componentOutcome describes what processing a fetched component did
+type componentOutcome int
+
+const (
+	outcomeFailed componentOutcome = iota
+	outcomeCreated
+	outcomeUpdated
+	outcomeSkipped
+)
+
+// InitialSyncResult summarizes the first sync pass across all sources
+type InitialSyncResult struct {
+	Total     int
+	Succeeded int
+	Failed    int
+	Disabled  int             // Sources skipped because they are disabled
+	Statuses  []*SourceStatus // Indexed like Config.Sources, nil for disabled sources
+}
+
+// maxInitialSyncConcurrency bounds how many sources are synced at once during startup
+const maxInitialSyncConcurrency = 4
+
 // Status represents the sync status
 type Status string
 
@@ -47,19 +94,38 @@ type Service struct {
 	statusMutex sync.RWMutex
 	statuses    map[int]*SourceStatus
 	running     map[int]bool
+	synced      bool              // Set once any source completes a sync
+	nextSyncs   map[int]time.Time // Next tick of each source's periodic sync loop
+	// Sources enabled or disabled through SetSourceEnabled, overriding their configuration
+	enabledOverrides map[int]bool
+
+	// Syncs in flight, counted by source so Shutdown can wait for them and name the stragglers
+	syncs    sync.WaitGroup
+	inFlight map[string]int
+	stopping bool // Set by Shutdown, after which no sync starts
+
+	// Manual syncs run with ctx, which Shutdown cancels so they stop at their next chance.
+	// Created on first use, see manualSyncContextLocked.
+	ctx    context.Context
+	cancel context.CancelFunc
 
 	// Fetcher cache synchronization
 	fetchersMutex sync.RWMutex
+
+	// Channels receiving SyncEvents, see Subscribe
+	subscribersMutex sync.Mutex
+	subscribers      []chan SyncEvent
 }
 
 // NewService creates a new sync service
 func NewService(repo Repository, config Config) *Service {
 	return &Service{
-		repo:     repo,
-		config:   config,
-		fetchers: make(map[string]ComponentsFetcher),
-		statuses: make(map[int]*SourceStatus),
-		running:  make(map[int]bool),
+		repo:      repo,
+		config:    config,
+		fetchers:  make(map[string]ComponentsFetcher),
+		statuses:  make(map[int]*SourceStatus),
+		running:   make(map[int]bool),
+		nextSyncs: make(map[int]time.Time),
 	}
 }
 
@@ -87,15 +153,79 @@ func (s *Service) GetSourceStatus(index int) (*SourceStatus, error) {
 	s.statusMutex.RLock()
 	defer s.statusMutex.RUnlock()
 
-	status, exists := s.statuses[index]
-	if !exists {
-		// Return default status for sources that haven't been synced yet
-		return &SourceStatus{
-			Status: StatusIdle,
-		}, nil
+	// Copied so the next sync time can be filled in without touching the stored status
+	status := SourceStatus{Status: StatusIdle}
+	if stored, exists := s.statuses[index]; exists {
+		status = *stored
+	}
+	if next, scheduled := s.nextSyncs[index]; scheduled && s.sourceEnabledLocked(index) {
+		status.NextSync = &next
 	}
 
-	return status, nil
+	return &status, nil
+}
+
+// setNextSync records when a source's periodic sync fires next
+func (s *Service) setNextSync(index int, next time.Time) {
+	s.statusMutex.Lock()
+	defer s.statusMutex.Unlock()
+	s.nextSyncs[index] = next
+}
+
+// clearNextSync forgets a source's next sync once its sync loop stops
+func (s *Service) clearNextSync(index int) {
+	s.statusMutex.Lock()
+	defer s.statusMutex.Unlock()
+	delete(s.nextSyncs, index)
+}
+
+// SourceEnabled reports whether a source may sync, taking SetSourceEnabled into account.
+// Unknown indexes are reported as disabled.
+func (s *Service) SourceEnabled(index int) bool {
+	if index < 0 || index >= len(s.config.Sources) {
+		return false
+	}
+
+	s.statusMutex.RLock()
+	defer s.statusMutex.RUnlock()
+	return s.sourceEnabledLocked(index)
+}
+
+// sourceEnabledLocked is SourceEnabled for callers holding statusMutex
+func (s *Service) sourceEnabledLocked(index int) bool {
+	if enabled, ok := s.enabledOverrides[index]; ok {
+		return enabled
+	}
+	return s.config.Sources[index].IsEnabled()
+}
+
+// anySourceEnabledLocked reports whether any source may sync; callers hold statusMutex
+func (s *Service) anySourceEnabledLocked() bool {
+	for i := range s.config.Sources {
+		if s.sourceEnabledLocked(i) {
+			return true
+		}
+	}
+	return false
+}
+
+// SetSourceEnabled enables or disables a source for the running process. The change is
+// not written back to the configuration file, so a restart restores the configured value.
+// A sync already running when a source is disabled is allowed to finish.
+func (s *Service) SetSourceEnabled(index int, enabled bool) error {
+	if index < 0 || index >= len(s.config.Sources) {
+		return ErrSourceNotFound
+	}
+
+	s.statusMutex.Lock()
+	defer s.statusMutex.Unlock()
+	if s.enabledOverrides == nil {
+		s.enabledOverrides = make(map[int]bool)
+	}
+	s.enabledOverrides[index] = enabled
+
+	slog.Info("Updated sync source", "source", s.getSourceInfo(s.config.Sources[index]), "enabled", enabled)
+	return nil
 }
 
 // TriggerSync triggers a manual sync for a source
@@ -107,6 +237,14 @@ func (s *Service) TriggerSync(index int) error {
 	s.statusMutex.Lock()
 	defer s.statusMutex.Unlock()
 
+	if s.stopping {
+		return ErrShuttingDown
+	}
+
+	if !s.sourceEnabledLocked(index) {
+		return ErrSourceDisabled
+	}
+
 	// Check if sync is already running
 	if s.running[index] {
 		return ErrSyncAlreadyRunning
@@ -114,6 +252,7 @@ func (s *Service) TriggerSync(index int) error {
 
 	// Mark as running
 	s.running[index] = true
+	ctx := s.manualSyncContextLocked()
 
 	// Start sync in background
 	go func() {
@@ -124,7 +263,6 @@ func (s *Service) TriggerSync(index int) error {
 		}()
 
 		source := s.config.Sources[index]
-		ctx := context.Background()
 
 		// Update status to running
 		s.updateStatus(index, &SourceStatus{
@@ -139,7 +277,49 @@ func (s *Service) TriggerSync(index int) error {
 	return nil
 }
 
-// updateStatus updates the status for a source (thread-safe)
+// TriggerSyncByURL triggers a sync for every enabled git source whose URL matches, regardless
+// of branch or base path. It returns the indexes that were triggered and those skipped because
+// a sync was already running, or ErrSourceNotFound when no source matches. Disabled sources
+// are neither triggered nor reported.
+func (s *Service) TriggerSyncByURL(url string) (triggered []int, alreadyRunning []int, err error) {
+	target := normalizeGitURL(url)
+	matched := false
+	for i, source := range s.config.Sources {
+		gitConfig, ok := source.GetConfig().(*GitSourceConfig)
+		if !ok || normalizeGitURL(gitConfig.URL) != target {
+			continue
+		}
+		matched = true
+
+		if err := s.TriggerSync(i); err != nil {
+			if errors.Is(err, ErrSyncAlreadyRunning) {
+				alreadyRunning = append(alreadyRunning, i)
+				continue
+			}
+			if errors.Is(err, ErrSourceDisabled) {
+				continue
+			}
+			return triggered, alreadyRunning, err
+		}
+		triggered = append(triggered, i)
+	}
+
+	if !matched {
+		return nil, nil, ErrSourceNotFound
+	}
+	return triggered, alreadyRunning, nil
+}
+
+// normalizeGitURL strips a trailing slash and ".git" suffix so equivalent repository URLs compare equal
+func normalizeGitURL(url string) string {
+	url = strings.TrimSpace(url)
+	url = strings.TrimSuffix(url, "/")
+	return strings.TrimSuffix(url, ".git")
+}
+
+// updateStatus updates the status for a source (thread-safe).
+// Every sync run ends here, so finished runs are also recorded in the metrics
+// and published to subscribers.
 func (s *Service) updateStatus(index int, status *SourceStatus) {
 	// Set LastSync if not already set
 	if status.LastSync == nil {
@@ -147,12 +327,46 @@ func (s *Service) updateStatus(index int, status *SourceStatus) {
 		status.LastSync = &now
 	}
 
+	finished := status.Status == StatusCompleted || status.Status == StatusFailed
+	if finished {
+		sourceType := "unknown"
+		if cfg := s.config.Sources[index].GetConfig(); cfg != nil {
+			sourceType = cfg.GetSourceType()
+		}
+		recordSyncMetrics(index, sourceType, status)
+	}
+
 	s.statusMutex.Lock()
-	defer s.statusMutex.Unlock()
 	s.statuses[index] = status
+	if status.Status == StatusCompleted {
+		s.synced = true
+	}
+	s.statusMutex.Unlock()
+
+	// Published after the status is stored, so subscribers see it through GetSourceStatus
+	if finished {
+		s.publishSyncEvent(index, status)
+	}
+}
+
+// HealthCheck implements the health.Checker interface. The service is ready once a source
+// has completed a sync, or right away when no enabled sources are configured.
+func (s *Service) HealthCheck(ctx context.Context) error {
+	s.statusMutex.RLock()
+	defer s.statusMutex.RUnlock()
+
+	if !s.synced && s.anySourceEnabledLocked() {
+		return ErrNoSuccessfulSync
+	}
+	return nil
+}
+
+func (s *Service) Name() string {
+	return "sync"
 }
 
-// StartPeriodicSync starts the sync process if sources are configured
+// StartPeriodicSync runs the initial sync for all sources and then starts periodic sync.
+// Disabled sources are skipped until they are enabled through SetSourceEnabled.
 func (s *Service) StartPeriodicSync(ctx context.Context) {
 	if len(s.config.Sources) == 0 {
 		slog.Warn("No sync sources configured, skipping sync service startup")
@@ -161,17 +375,71 @@ func (s *Service) StartPeriodicSync(ctx context.Context) {
 
 	slog.Info("Starting sync service", "sources", len(s.config.Sources))
 
+	s.InitialSyncAll(ctx)
+
 	for i, source := range s.config.Sources {
-		// Initialize status for this source
+		go s.startSourceSync(ctx, source, i)
+	}
+}
+
+// InitialSyncAll runs the first sync of every enabled source with bounded concurrency
+// and logs a summary of how many sources are healthy
+func (s *Service) InitialSyncAll(ctx context.Context) InitialSyncResult {
+	result := InitialSyncResult{
+		Total:    len(s.config.Sources),
+		Statuses: make([]*SourceStatus, len(s.config.Sources)),
+	}
+
+	sem := make(chan struct{}, maxInitialSyncConcurrency)
+	var wg sync.WaitGroup
+	for i, source := range s.config.Sources {
+		if !s.SourceEnabled(i) {
+			slog.Info("Skipping disabled sync source", "source", s.getSourceInfo(source))
+			result.Disabled++
+			continue
+		}
+
 		s.updateStatus(i, &SourceStatus{
-			Status: StatusIdle,
+			Status: StatusRunning,
 		})
 
-		go s.startSourceSync(ctx, source, i)
+		wg.Add(1)
+		go func(index int, source SourceConfig) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			status := s.SyncSource(ctx, source)
+			if status.Status == StatusFailed {
+				slog.Error("Initial sync failed", "source", s.getSourceInfo(source), "error", *status.LastError)
+			}
+			s.updateStatus(index, status)
+			result.Statuses[index] = status
+		}(i, source)
 	}
+	wg.Wait()
+
+	for _, status := range result.Statuses {
+		if status == nil {
+			continue
+		}
+		if status.Status == StatusFailed {
+			result.Failed++
+		} else {
+			result.Succeeded++
+		}
+	}
+
+	slog.Info("Initial sync finished",
+		"healthy", fmt.Sprintf("%d/%d", result.Succeeded, result.Total-result.Disabled),
+		"failed", result.Failed,
+		"disabled", result.Disabled)
+
+	return result
 }
 
 // startSourceSync starts periodic sync for a single source
+// The initial sync is done by InitialSyncAll, so this only waits for ticks
 func (s *Service) startSourceSync(ctx context.Context, source SourceConfig, index int) {
 	interval := time.Duration(0)
 	if cfg := source.GetConfig(); cfg != nil {
@@ -182,37 +450,154 @@ func (s *Service) startSourceSync(ctx context.Context, source SourceConfig, inde
 	}
 	ticker := time.NewTicker(interval)
 	defer ticker.Stop()
+	s.setNextSync(index, time.Now().Add(interval))
+	defer s.clearNextSync(index)
 
 	sourceInfo := s.getSourceInfo(source)
 	slog.Info("Starting periodic sync for source", "source", sourceInfo, "interval", interval)
 
-	// Initial sync
-	status := s.SyncSource(ctx, source)
-	if status.Status == StatusFailed {
-		slog.Error("Initial sync failed", "source", sourceInfo, "error", *status.LastError)
+	// A nil channel never receives, so unwatched sources only sync on ticks
+	var changes <-chan struct{}
+	if cfg, ok := source.GetConfig().(*FilesystemSourceConfig); ok && cfg.Watch {
+		changes = s.watchSource(ctx, cfg)
 	}
-	s.updateStatus(index, status)
 
 	for {
 		select {
 		case <-ctx.Done():
 			slog.Info("Stopping sync for source", "source", sourceInfo)
 			return
-		case <-ticker.C:
-			status := s.SyncSource(ctx, source)
-			if status.Status == StatusFailed {
-				slog.Error("Sync failed", "source", sourceInfo, "error", *status.LastError)
+		case tick := <-ticker.C:
+			s.setNextSync(index, tick.Add(interval))
+			s.runScheduledSync(ctx, source, index)
+		case _, ok := <-changes:
+			if !ok {
+				if ctx.Err() == nil {
+					slog.Warn("Stopped watching source, falling back to periodic sync", "source", sourceInfo)
+				}
+				changes = nil
+				continue
 			}
-			s.updateStatus(index, status)
+			slog.Info("Manifest files changed, syncing source", "source", sourceInfo)
+			s.runScheduledSync(ctx, source, index)
+		}
+	}
+}
+
+// runScheduledSync syncs a source from its sync loop, unless the source is disabled
+func (s *Service) runScheduledSync(ctx context.Context, source SourceConfig, index int) {
+	if !s.SourceEnabled(index) {
+		return
+	}
+	status := s.SyncSource(ctx, source)
+	if status.Status == StatusFailed {
+		slog.Error("Sync failed", "source", s.getSourceInfo(source), "error", *status.LastError)
+	}
+	s.updateStatus(index, status)
+}
+
+// Shutdown stops new syncs from starting, cancels manual syncs and waits for running syncs to
+// finish, then removes what fetchers left on disk, such as git clones. If ctx is done first, the
+// sources still syncing are logged, the files are removed anyway and ctx's error is returned.
+// Cancel the context periodic syncs run with beforehand so they stop at their next chance too.
+func (s *Service) Shutdown(ctx context.Context) error {
+	s.statusMutex.Lock()
+	s.stopping = true
+	s.manualSyncContextLocked()
+	s.cancel()
+	s.statusMutex.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		s.syncs.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		s.cleanupFetchers()
+		return nil
+	case <-ctx.Done():
+		// The stragglers were cancelled and fail once they notice, so their files are not needed
+		slog.Warn("Shutting down with syncs still running", "sources", s.runningSources())
+		s.cleanupFetchers()
+		return ctx.Err()
+	}
+}
+
+// manualSyncContextLocked returns the context manual syncs run with, creating it on first use.
+// statusMutex must be held.
+func (s *Service) manualSyncContextLocked() context.Context {
+	if s.ctx == nil {
+		s.ctx, s.cancel = context.WithCancel(context.Background())
+	}
+	return s.ctx
+}
+
+// cleanupFetchers removes the files of fetchers that keep state on disk
+func (s *Service) cleanupFetchers() {
+	s.fetchersMutex.RLock()
+	defer s.fetchersMutex.RUnlock()
+	for sourceType, fetcher := range s.fetchers {
+		cleaner, ok := fetcher.(interface{ Cleanup() error })
+		if !ok {
+			continue
+		}
+		if err := cleaner.Cleanup(); err != nil {
+			slog.Error("Failed to clean up fetcher", "type", sourceType, "error", err)
 		}
 	}
 }
 
+// beginSync records a sync of a source as in flight, or reports false once Shutdown was called
+func (s *Service) beginSync(sourceInfo string) bool {
+	s.statusMutex.Lock()
+	defer s.statusMutex.Unlock()
+	if s.stopping {
+		return false
+	}
+	if s.inFlight == nil {
+		s.inFlight = make(map[string]int)
+	}
+	s.inFlight[sourceInfo]++
+	// Added under the lock that Shutdown sets stopping with, so no sync is added while it waits
+	s.syncs.Add(1)
+	return true
+}
+
+// endSync records that a sync started by beginSync finished
+func (s *Service) endSync(sourceInfo string) {
+	s.statusMutex.Lock()
+	s.inFlight[sourceInfo]--
+	if s.inFlight[sourceInfo] == 0 {
+		delete(s.inFlight, sourceInfo)
+	}
+	s.statusMutex.Unlock()
+	s.syncs.Done()
+}
+
+// runningSources returns the sources with a sync in flight, sorted
+func (s *Service) runningSources() []string {
+	s.statusMutex.RLock()
+	defer s.statusMutex.RUnlock()
+	sources := make([]string, 0, len(s.inFlight))
+	for source := range s.inFlight {
+		sources = append(sources, source)
+	}
+	slices.Sort(sources)
+	return sources
+}
+
 // SyncSource performs a full sync for a single source
 // Returns the status of the sync operation
 func (s *Service) SyncSource(ctx context.Context, source SourceConfig) *SourceStatus {
 	startTime := time.Now()
 	sourceInfo := s.getSourceInfo(source)
+	if !s.beginSync(sourceInfo) {
+		errorMsg := ErrShuttingDown.Error()
+		return &SourceStatus{Status: StatusFailed, LastError: &errorMsg}
+	}
+	defer s.endSync(sourceInfo)
 	cfg := source.GetConfig()
 	sourceType := "unknown"
 	if cfg != nil {
@@ -229,6 +614,23 @@ func (s *Service) SyncSource(ctx context.Context, source SourceConfig) *SourceSt
 		Duration:        time.Since(startTime),
 	}
 
+	ctx, span := tracing.Start(ctx, "sync.SyncSource",
+		attribute.String("argus.source.type", sourceType),
+		attribute.String("argus.source", sourceInfo))
+	defer func() {
+		span.SetAttributes(
+			attribute.Int("argus.sync.components", status.ComponentsCount),
+			attribute.Int("argus.sync.created", status.Created),
+			attribute.Int("argus.sync.updated", status.Updated),
+			attribute.Int("argus.sync.failed", status.Failed),
+			attribute.Int("argus.sync.deleted", status.Deleted))
+		var err error
+		if status.LastError != nil {
+			err = errors.New(*status.LastError)
+		}
+		tracing.End(span, err)
+	}()
+
 	// Skip sources with nil config (fig library limitation)
 	if cfg == nil {
 		slog.Warn("Skipping sync source with nil config", "source", sourceInfo)
@@ -245,8 +647,8 @@ func (s *Service) SyncSource(ctx context.Context, source SourceConfig) *SourceSt
 		return status
 	}
 
-	// Fetch all components from the source
-	components, err := fetcher.Fetch(ctx, source)
+	// Fetch all components from the source, retrying transient failures
+	components, err := s.fetchWithRetry(ctx, fetcher, source)
 	if err != nil {
 		status.Status = StatusFailed
 		errorMsg := err.Error()
@@ -257,61 +659,247 @@ func (s *Service) SyncSource(ctx context.Context, source SourceConfig) *SourceSt
 
 	slog.Info("Fetched components", "count", len(components), "source", sourceInfo)
 
-	// Process each component
-	created := 0
-	for _, component := range components {
-		if err := s.processComponent(ctx, component, source); err != nil {
+	// Process components concurrently, then tally the results in fetch order
+	outcomes, errs := s.processComponents(ctx, components, source)
+	seenIDs := make([]string, 0, len(components))
+	for i, component := range components {
+		// Components that fail to process still count as seen so they are not pruned
+		seenIDs = append(seenIDs, component.GetIdentifier())
+		outcome, err := outcomes[i], errs[i]
+		if err != nil {
 			slog.Error("Failed to process component",
 				"name", component.Name,
 				"source", sourceInfo,
 				"error", err)
+			status.Failed++
+			if len(status.ComponentErrors) < maxComponentErrors {
+				status.ComponentErrors = append(status.ComponentErrors, ComponentSyncError{
+					Component: component.Name,
+					Error:     err.Error(),
+				})
+			}
 			continue
 		}
-		created++
+		switch outcome {
+		case outcomeCreated:
+			status.Created++
+		case outcomeUpdated:
+			status.Updated++
+		case outcomeSkipped:
+			status.Skipped++
+		}
 	}
 
+	// Remove components that are no longer in the source
+	deleted, err := s.repo.PruneSourceComponents(ctx, s.getSourceID(source), seenIDs, cfg.GetPrune())
+	if err != nil {
+		slog.Error("Failed to prune removed components", "source", sourceInfo, "error", err)
+		status.Status = StatusFailed
+		errorMsg := fmt.Sprintf("failed to prune removed components: %v", err)
+		status.LastError = &errorMsg
+	}
+	for _, componentID := range deleted {
+		slog.Info("Deleted component removed from source", "id", componentID, "source", sourceInfo, "hard", cfg.GetPrune())
+	}
+	status.Deleted = len(deleted)
+
 	slog.Info("Sync completed",
 		"source", sourceInfo,
 		"total", len(components),
-		"created", created)
+		"created", status.Created,
+		"updated", status.Updated,
+		"skipped", status.Skipped,
+		"failed", status.Failed,
+		"deleted", status.Deleted)
 
 	status.ComponentsCount = len(components)
 	status.Duration = time.Since(startTime)
 	return status
 }
 
-// processComponent handles a single component (create only for now)
-func (s *Service) processComponent(ctx context.Context, component models.Component, source SourceConfig) error {
-	// Get the unique identifier for this component
-	componentID := component.GetIdentifier()
+// processComponents processes components with up to Config.Concurrency workers and returns
+// each component's outcome and error by index. Components sharing an identifier are handled
+// by one worker in fetch order, so a duplicate is never created twice.
+func (s *Service) processComponents(ctx context.Context, components []models.Component, source SourceConfig) ([]componentOutcome, []error) {
+	outcomes := make([]componentOutcome, len(components))
+	errs := make([]error, len(components))
+
+	// Group component indexes by identifier, folding case in case the repository does
+	var groups [][]int
+	groupIndexes := make(map[string]int)
+	for i, component := range components {
+		key := utils.NormalizeIdentifier(component.GetIdentifier(), true)
+		if group, ok := groupIndexes[key]; ok {
+			groups[group] = append(groups[group], i)
+			continue
+		}
+		groupIndexes[key] = len(groups)
+		groups = append(groups, []int{i})
+	}
 
-	// Check if component already exists by its unique identifier
-	existing, err := s.repo.GetComponentByID(ctx, componentID)
-	if err != nil && err != storage.ErrComponentNotFound {
-		return fmt.Errorf("failed to check existing component: %w", err)
+	jobs := make(chan []int)
+	var wg sync.WaitGroup
+	for range min(s.config.concurrency(), len(groups)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for group := range jobs {
+				for _, i := range group {
+					outcomes[i], errs[i] = s.processComponentSafely(ctx, components[i], source)
+				}
+			}
+		}()
+	}
+	for _, group := range groups {
+		jobs <- group
 	}
+	close(jobs)
+	wg.Wait()
 
-	if existing != nil {
-		// Component exists, skip for now (no updates)
-		slog.Debug("Component already exists, skipping", "id", componentID, "name", component.Name)
-		return nil
+	return outcomes, errs
+}
+
+// processComponentSafely runs processComponent, turning a panic into a failure of that component
+// so the rest of the run carries on
+func (s *Service) processComponentSafely(ctx context.Context, component models.Component, source SourceConfig) (outcome componentOutcome, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			slog.Error("Panic while processing component", "name", component.Name, "panic", r, "stack", string(debug.Stack()))
+			outcome, err = outcomeFailed, fmt.Errorf("panic while processing component: %v", r)
+		}
+	}()
+	return s.processComponent(ctx, component, source)
+}
+
+// toStorageComponent converts a fetched component, with the source's defaults applied, for storage
+func (s *Service) toStorageComponent(component models.Component, source SourceConfig) storage.Component {
+	// Fill fields the manifest omits from the source's defaults
+	if cfg := source.GetConfig(); cfg != nil {
+		component = cfg.GetDefaults().Apply(component)
 	}
 
-	// Create new component
-	storageComponent := storage.Component{
-		ComponentID: componentID,
+	return storage.Component{
+		ComponentID: component.GetIdentifier(),
 		Name:        component.Name,
 		Description: component.Description,
 		Maintainers: storage.StringArray(component.Owners.Maintainers),
-		Team:        component.Owners.Team,
+		Team:        strings.TrimSpace(component.Owners.Team),
+		Labels:      storage.StringMap(component.Labels),
+		Lifecycle:   component.Lifecycle,
+		Sources:     storage.StringArray{s.getSourceID(source)},
+
+		ExpectedChecks: storage.StringArray(component.Checks),
+		DependsOn:      storage.StringArray(component.DependsOn),
+
+		SourceRevision: component.SourceRevision,
+	}
+}
+
+// processComponent creates a component, or updates it when the manifest changed
+func (s *Service) processComponent(ctx context.Context, component models.Component, source SourceConfig) (componentOutcome, error) {
+	storageComponent := s.toStorageComponent(component, source)
+	componentID := storageComponent.ComponentID
+
+	renamed, err := s.renameComponent(ctx, componentID, component.PreviousIDs, source)
+	if err != nil {
+		return outcomeFailed, err
+	}
+
+	// Check if component already exists by its unique identifier
+	existing, err := s.repo.GetComponentByID(ctx, componentID)
+	if err != nil && err != storage.ErrComponentNotFound {
+		return outcomeFailed, fmt.Errorf("failed to check existing component: %w", err)
 	}
 
+	if existing != nil {
+		outcome, err := s.updateComponent(ctx, existing, storageComponent, source)
+		if renamed && outcome == outcomeSkipped {
+			outcome = outcomeUpdated
+		}
+		return outcome, err
+	}
+
+	// Create new component
 	if err := s.repo.CreateComponent(ctx, storageComponent); err != nil {
-		return fmt.Errorf("failed to create component: %w", err)
+		return outcomeFailed, fmt.Errorf("failed to create component: %w", err)
 	}
 
 	slog.Info("Created new component", "id", componentID, "name", component.Name)
-	return nil
+	return outcomeCreated, nil
+}
+
+// renameComponent moves a component stored under one of the previous identifiers of a manifest to
+// its current identifier, recording the rename in the component's history. It reports whether a
+// component was renamed.
+func (s *Service) renameComponent(ctx context.Context, componentID string, previousIDs []string, source SourceConfig) (bool, error) {
+	renamed := false
+	for _, previousID := range previousIDs {
+		err := s.repo.RenameComponent(ctx, previousID, componentID)
+		if errors.Is(err, storage.ErrComponentNotFound) {
+			continue
+		}
+		if err != nil {
+			return false, fmt.Errorf("failed to rename component %s: %w", previousID, err)
+		}
+		renamed = true
+		slog.Info("Renamed component", "from", previousID, "to", componentID)
+
+		component, err := s.repo.GetComponentByID(ctx, componentID)
+		if err != nil {
+			return false, fmt.Errorf("failed to load renamed component: %w", err)
+		}
+		s.recordHistory(ctx, storage.ComponentHistory{
+			ComponentID: component.ID,
+			Changes:     storage.JSONB{"component_id": map[string]interface{}{"old": previousID, "new": componentID}},
+			Source:      s.getSourceInfo(source),
+		}, componentID)
+	}
+	return renamed, nil
+}
+
+// updateComponent writes the fetched component over the existing one if any field differs,
+// and records what changed in the component's history
+func (s *Service) updateComponent(ctx context.Context, existing *storage.Component, updated storage.Component, source SourceConfig) (componentOutcome, error) {
+	if sourceID := s.getSourceID(source); !slices.Contains(existing.Sources, sourceID) {
+		if err := s.repo.AddComponentSource(ctx, existing.ComponentID, sourceID); err != nil {
+			return outcomeFailed, fmt.Errorf("failed to record component source: %w", err)
+		}
+	}
+
+	// Maintainers edited through the API are not overwritten by sync
+	if existing.MaintainersManaged {
+		updated.Maintainers = existing.Maintainers
+	}
+
+	changes := storage.DiffComponents(*existing, updated)
+	if len(changes) == 0 {
+		slog.Debug("Component unchanged, skipping", "id", existing.ComponentID, "name", updated.Name)
+		return outcomeSkipped, nil
+	}
+
+	if err := s.repo.UpdateComponent(ctx, updated); err != nil {
+		return outcomeFailed, fmt.Errorf("failed to update component: %w", err)
+	}
+
+	s.recordHistory(ctx, storage.ComponentHistory{
+		ComponentID: existing.ID,
+		Changes:     changes,
+		Source:      s.getSourceInfo(source),
+	}, existing.ComponentID)
+
+	slog.Info("Updated component", "id", existing.ComponentID, "name", updated.Name, "fields", len(changes))
+	return outcomeUpdated, nil
+}
+
+// recordHistory stores a history entry for a change sync made to a component, when history
+// is enabled. The change itself already succeeded, so a failure only loses the audit entry.
+func (s *Service) recordHistory(ctx context.Context, history storage.ComponentHistory, componentID string) {
+	if !s.config.History {
+		return
+	}
+	if err := s.repo.CreateComponentHistory(ctx, history); err != nil {
+		slog.Error("Failed to record component history", "id", componentID, "error", err)
+	}
 }
 
 // getFetcher returns a cached fetcher for the given type
@@ -333,15 +921,49 @@ func (s *Service) getFetcher(sourceType string) (ComponentsFetcher, error) {
 		return fetcher, nil
 	}
 
-	fetcher, err := NewFetcher(sourceType)
-	if err != nil {
-		return nil, err
+	var fetcher ComponentsFetcher
+	if sourceType == sourceTypeGit {
+		fetcher = NewGitFetcher(s.config.CloneDir)
+	} else {
+		var err error
+		fetcher, err = NewFetcher(sourceType)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	s.fetchers[sourceType] = fetcher
 	return fetcher, nil
 }
 
+// getSourceID returns a stable identifier for a source, used to track which components it provides.
+// Git sources are identified by URL, branch, tag or commit and base path, since one repository can
+// back several sources.
+func (s *Service) getSourceID(source SourceConfig) string {
+	cfg := source.GetConfig()
+	switch c := cfg.(type) {
+	case *GitSourceConfig:
+		ref := c.Branch
+		switch {
+		case c.Tag != "":
+			ref = "tag:" + c.Tag
+		case c.Commit != "":
+			ref = "commit:" + c.Commit
+		}
+		id := fmt.Sprintf("%s:%s@%s", sourceTypeGit, c.URL, ref)
+		if c.BasePath != "" {
+			id += ":" + c.BasePath
+		}
+		return id
+	case *FilesystemSourceConfig:
+		return fmt.Sprintf("%s:%s", sourceTypeFilesystem, c.Path)
+	case *HTTPSourceConfig:
+		return fmt.Sprintf("%s:%s", sourceTypeHTTP, c.URL)
+	default:
+		return "unknown"
+	}
+}
+
 // getSourceInfo returns a string representation of the source for logging
 func (s *Service) getSourceInfo(source SourceConfig) string {
 	cfg := source.GetConfig()
@@ -350,6 +972,8 @@ func (s *Service) getSourceInfo(source SourceConfig) string {
 		return c.URL
 	case *FilesystemSourceConfig:
 		return c.Path
+	case *HTTPSourceConfig:
+		return c.URL
 	default:
 		return "unknown"
 	}
@@ -0,0 +1,147 @@
+package sync
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"slices"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+)
+
+// watchDebounce is how long a watched source must go without changes before it is synced,
+// so a burst of writes, such as a branch checkout, triggers a single sync
+const watchDebounce = 500 * time.Millisecond
+
+// watchSource starts watching a filesystem source for manifest changes. It returns nil when the
+// watcher cannot be set up, for example when the inotify watch limit is reached, which leaves
+// the source to periodic sync.
+func (s *Service) watchSource(ctx context.Context, cfg *FilesystemSourceConfig) <-chan struct{} {
+	root, err := filepath.Abs(cfg.Path)
+	if err == nil {
+		var changes <-chan struct{}
+		changes, err = watchManifests(ctx, root, cfg.ManifestPatterns, watchDebounce)
+		if err == nil {
+			slog.Info("Watching source for manifest changes", "source", cfg.Path)
+			return changes
+		}
+	}
+	slog.Warn("Cannot watch source, falling back to periodic sync", "source", cfg.Path, "error", err)
+	return nil
+}
+
+// watchManifests watches the tree at root and signals on the returned channel once manifest
+// files under it, as selected by patterns, have stopped changing for debounce. Changes to
+// directories count too, since removing or renaming one can remove manifests. The channel is
+// closed when ctx is done or the watcher fails.
+func watchManifests(ctx context.Context, root string, patterns []string, debounce time.Duration) (<-chan struct{}, error) {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, err
+	}
+	// fsnotify watches single directories, so every directory of the tree gets its own watch
+	if err := addWatchTree(watcher, root); err != nil {
+		_ = watcher.Close()
+		return nil, err
+	}
+
+	signals := make(chan struct{}, 1)
+	go func() {
+		defer close(signals)
+		defer func() { _ = watcher.Close() }()
+
+		timer := time.NewTimer(debounce)
+		timer.Stop()
+		defer timer.Stop()
+
+		// Removed and renamed paths no longer exist, so whether they were directories is not known
+		watched := func(event fsnotify.Event) bool {
+			return event.Has(fsnotify.Remove) || event.Has(fsnotify.Rename) || isManifestPath(root, event.Name, patterns)
+		}
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case event, ok := <-watcher.Events:
+				if !ok {
+					return
+				}
+				if event.Has(fsnotify.Create) && filepath.Base(event.Name) != ".git" && isDir(event.Name) {
+					// Files created before the watch is added are missed, but the sync this
+					// change triggers rescans the whole tree
+					if err := addWatchTree(watcher, event.Name); err != nil {
+						slog.Warn("Failed to watch new directory", "path", event.Name, "error", err)
+					}
+					timer.Reset(debounce)
+					continue
+				}
+				if watched(event) {
+					timer.Reset(debounce)
+				}
+			case err, ok := <-watcher.Errors:
+				if !ok {
+					return
+				}
+				if errors.Is(err, fsnotify.ErrEventOverflow) {
+					// Events were dropped, so assume anything may have changed
+					timer.Reset(debounce)
+					continue
+				}
+				slog.Warn("Error watching source", "path", root, "error", err)
+			case <-timer.C:
+				// A signal already pending covers this change
+				select {
+				case signals <- struct{}{}:
+				default:
+				}
+			}
+		}
+	}()
+	return signals, nil
+}
+
+// addWatchTree watches root and every directory below it, skipping repository metadata like listFiles
+func addWatchTree(watcher *fsnotify.Watcher, root string) error {
+	return filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.IsDir() {
+			return nil
+		}
+		if d.Name() == ".git" && path != root {
+			return filepath.SkipDir
+		}
+		if err := watcher.Add(path); err != nil {
+			return fmt.Errorf("failed to watch %s: %w", path, err)
+		}
+		return nil
+	})
+}
+
+// isDir reports whether path is an existing directory
+func isDir(path string) bool {
+	info, err := os.Stat(path)
+	return err == nil && info.IsDir()
+}
+
+// isManifestPath reports whether a file under root matches one of the manifest patterns
+func isManifestPath(root string, path string, patterns []string) bool {
+	rel, err := filepath.Rel(root, path)
+	if err != nil {
+		return false
+	}
+	if len(patterns) == 0 {
+		patterns = DefaultManifestPatterns
+	}
+	slashPath := filepath.ToSlash(rel)
+	return slices.ContainsFunc(patterns, func(pattern string) bool {
+		return matchManifestPattern(pattern, slashPath)
+	})
+}
@@ -0,0 +1,103 @@
+package sync
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testWatchDebounce = 50 * time.Millisecond
+
+// expectSignal waits for one watch signal and then checks no second one follows
+func expectSignal(t *testing.T, signals <-chan struct{}) {
+	t.Helper()
+	select {
+	case <-signals:
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected a change signal")
+	}
+	expectNoSignal(t, signals)
+}
+
+func expectNoSignal(t *testing.T, signals <-chan struct{}) {
+	t.Helper()
+	select {
+	case <-signals:
+		t.Fatal("unexpected change signal")
+	case <-time.After(4 * testWatchDebounce):
+	}
+}
+
+func TestWatchManifests(t *testing.T) {
+	root := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(root, "services", "auth"), 0o755))
+
+	ctx, cancel := context.WithCancel(t.Context())
+	signals, err := watchManifests(ctx, root, nil, testWatchDebounce)
+	require.NoError(t, err)
+
+	t.Run("Burst of writes signals once", func(t *testing.T) {
+		manifest := filepath.Join(root, "services", "auth", "manifest.yaml")
+		for i := 0; i < 5; i++ {
+			require.NoError(t, os.WriteFile(manifest, []byte("version: v1\nname: auth\n"), 0o644))
+		}
+		expectSignal(t, signals)
+	})
+
+	t.Run("Other files are ignored", func(t *testing.T) {
+		require.NoError(t, os.WriteFile(filepath.Join(root, "services", "auth", "README.md"), []byte("docs"), 0o644))
+		expectNoSignal(t, signals)
+	})
+
+	t.Run("New directories are watched", func(t *testing.T) {
+		dir := filepath.Join(root, "services", "billing")
+		require.NoError(t, os.Mkdir(dir, 0o755))
+		expectSignal(t, signals)
+
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "manifest.yml"), []byte("version: v1\nname: billing\n"), 0o644))
+		expectSignal(t, signals)
+	})
+
+	t.Run("Removing a directory signals", func(t *testing.T) {
+		require.NoError(t, os.RemoveAll(filepath.Join(root, "services", "auth")))
+		expectSignal(t, signals)
+	})
+
+	cancel()
+	select {
+	case _, ok := <-signals:
+		assert.False(t, ok, "signals should be closed once the context is done")
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected signals to be closed")
+	}
+}
+
+func TestWatchManifests_MissingPath(t *testing.T) {
+	_, err := watchManifests(t.Context(), filepath.Join(t.TempDir(), "missing"), nil, testWatchDebounce)
+	assert.Error(t, err)
+}
+
+func TestIsManifestPath(t *testing.T) {
+	root := filepath.FromSlash("/repo")
+	tests := []struct {
+		path     string
+		patterns []string
+		want     bool
+	}{
+		{"/repo/services/auth/manifest.yaml", nil, true},
+		{"/repo/manifest.yml", nil, true},
+		{"/repo/services/auth/README.md", nil, false},
+		{"/repo/services/auth/service.yaml", []string{"**/service.yaml"}, true},
+		{"/repo/services/auth/manifest.yaml", []string{"**/service.yaml"}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			assert.Equal(t, tt.want, isManifestPath(root, filepath.FromSlash(tt.path), tt.patterns))
+		})
+	}
+}
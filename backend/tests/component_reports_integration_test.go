@@ -170,7 +170,7 @@ func TestComponentReportsWithStatusFilter(t *testing.T) {
 
 	// Test filtering by status
 	resp, err := apiClient.GetComponentReportsWithResponse(context.Background(), "auth-service", &client.GetComponentReportsParams{
-		Status: utils.ToPointer(client.GetComponentReportsParamsStatusPass),
+		Status: utils.ToPointer([]client.GetComponentReportsParamsStatus{client.GetComponentReportsParamsStatusPass}),
 	})
 	require.NoError(t, err)
 	require.Equal(t, http.StatusOK, resp.StatusCode())
@@ -194,7 +194,7 @@ func TestComponentReportsWithCheckFilter(t *testing.T) {
 
 	// Test filtering by check slug
 	resp, err := apiClient.GetComponentReportsWithResponse(context.Background(), "auth-service", &client.GetComponentReportsParams{
-		CheckSlug: utils.ToPointer("unit-tests"),
+		CheckSlug: &[]string{"unit-tests"},
 	})
 	require.NoError(t, err)
 	require.Equal(t, http.StatusOK, resp.StatusCode())
@@ -218,8 +218,8 @@ func TestComponentReportsWithStatusAndCheckFilter(t *testing.T) {
 
 	// Test filtering by both status and check
 	resp, err := apiClient.GetComponentReportsWithResponse(context.Background(), "auth-service", &client.GetComponentReportsParams{
-		Status:    utils.ToPointer(client.GetComponentReportsParamsStatusPass),
-		CheckSlug: utils.ToPointer("unit-tests"),
+		Status:    utils.ToPointer([]client.GetComponentReportsParamsStatus{client.GetComponentReportsParamsStatusPass}),
+		CheckSlug: &[]string{"unit-tests"},
 	})
 	require.NoError(t, err)
 	require.Equal(t, http.StatusOK, resp.StatusCode())
@@ -376,7 +376,7 @@ func TestComponentReportsWithLatestPerCheckAndFilters(t *testing.T) {
 
 	// Test latest_per_check=true with status filter
 	latestPerCheck := true
-	status := client.GetComponentReportsParamsStatusPass
+	status := []client.GetComponentReportsParamsStatus{client.GetComponentReportsParamsStatusPass}
 	resp, err := apiClient.GetComponentReportsWithResponse(context.Background(), "auth-service", &client.GetComponentReportsParams{
 		LatestPerCheck: &latestPerCheck,
 		Status:         &status,
@@ -395,10 +395,10 @@ func TestComponentReportsWithLatestPerCheckAndFilters(t *testing.T) {
 	}
 
 	// Test with check slug filter
-	checkSlug := "unit-tests"
+	checkSlugs := []string{"unit-tests"}
 	resp2, err := apiClient.GetComponentReportsWithResponse(context.Background(), "auth-service", &client.GetComponentReportsParams{
 		LatestPerCheck: &latestPerCheck,
-		CheckSlug:      &checkSlug,
+		CheckSlug:      &checkSlugs,
 	})
 	require.NoError(t, err)
 	require.Equal(t, http.StatusOK, resp2.StatusCode())
@@ -418,7 +418,7 @@ func TestComponentReportsWithLatestPerCheckPaginationAndFilters(t *testing.T) {
 
 	// Test latest_per_check=true with pagination and filters combined
 	latestPerCheck := true
-	status := client.GetComponentReportsParamsStatusPass
+	status := []client.GetComponentReportsParamsStatus{client.GetComponentReportsParamsStatusPass}
 	limit := 2
 	resp, err := apiClient.GetComponentReportsWithResponse(context.Background(), "auth-service", &client.GetComponentReportsParams{
 		LatestPerCheck: &latestPerCheck,
@@ -518,8 +518,8 @@ func TestLargeDataset(t *testing.T) {
 	testCases := []struct {
 		name        string
 		componentID string
-		status      *client.GetComponentReportsParamsStatus
-		checkSlug   *string
+		status      *[]client.GetComponentReportsParamsStatus
+		checkSlug   *[]string
 		limit       *int
 		offset      *int
 		minExpected int
@@ -534,22 +534,22 @@ func TestLargeDataset(t *testing.T) {
 		{
 			name:        "FilterByStatus",
 			componentID: "auth-service",
-			status:      utils.ToPointer(client.GetComponentReportsParamsStatusPass),
+			status:      utils.ToPointer([]client.GetComponentReportsParamsStatus{client.GetComponentReportsParamsStatusPass}),
 			minExpected: 50,
 			maxExpected: 150,
 		},
 		{
 			name:        "FilterByCheckSlug",
 			componentID: "auth-service",
-			checkSlug:   utils.ToPointer("unit-tests"),
+			checkSlug:   &[]string{"unit-tests"},
 			minExpected: 25,
 			maxExpected: 50,
 		},
 		{
 			name:        "CombinedFilters",
 			componentID: "auth-service",
-			status:      utils.ToPointer(client.GetComponentReportsParamsStatusPass),
-			checkSlug:   utils.ToPointer("integration-tests"),
+			status:      utils.ToPointer([]client.GetComponentReportsParamsStatus{client.GetComponentReportsParamsStatusPass}),
+			checkSlug:   &[]string{"integration-tests"},
 			minExpected: 25,
 			maxExpected: 50,
 		},
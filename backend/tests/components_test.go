@@ -29,7 +29,7 @@ func TestGetComponentsIntegration(t *testing.T) {
 	client, err := client.NewClientWithResponses("http://localhost:8080/api/catalog/v1")
 	require.NoError(t, err)
 
-	resp, err := client.GetComponentsWithResponse(context.Background())
+	resp, err := client.GetComponentsWithResponse(context.Background(), nil)
 	require.NoError(t, err)
 	require.Equal(t, http.StatusOK, resp.StatusCode())
 	require.NotNil(t, resp.JSON200)
@@ -48,7 +48,7 @@ func TestGetComponentByIdIntegration(t *testing.T) {
 	require.NoError(t, err)
 
 	// Test getting a non-existent component
-	resp, err := client.GetComponentByIdWithResponse(context.Background(), "non-existent-component")
+	resp, err := client.GetComponentByIdWithResponse(context.Background(), "non-existent-component", nil)
 	require.NoError(t, err)
 	require.Equal(t, http.StatusNotFound, resp.StatusCode())
 	require.NotNil(t, resp.JSON404)
@@ -86,7 +86,7 @@ func TestGetComponentByIdWithSyncIntegration(t *testing.T) {
 	require.NoError(t, err)
 
 	// Test getting an existing component
-	resp, err := apiClient.GetComponentByIdWithResponse(context.Background(), "auth-service")
+	resp, err := apiClient.GetComponentByIdWithResponse(context.Background(), "auth-service", nil)
 	require.NoError(t, err)
 	require.Equal(t, http.StatusOK, resp.StatusCode())
 	require.NotNil(t, resp.JSON200)
@@ -105,3 +105,68 @@ func TestGetComponentByIdWithSyncIntegration(t *testing.T) {
 	require.NotNil(t, component.Owners.Team)
 	require.Equal(t, "Security Team", *component.Owners.Team)
 }
+
+func TestDeleteComponentIntegration(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+	clearDatabase(t)
+
+	// A long interval keeps the source from restoring the component during the test
+	testConfig := TestConfig
+	testConfig.Admin.Token = "admin-secret"
+	fsConfig := sync.NewFilesystemSourceConfig(getTestDataPath(t), time.Hour)
+	testConfig.Sync = sync.Config{
+		Sources: []sync.SourceConfig{
+			sync.NewSourceConfig(fsConfig.GetConfig()),
+		},
+	}
+
+	stop, err := server.Start(testConfig)
+	require.NoError(t, err)
+	defer stop()
+
+	waitForSyncCompletion(t, 30*time.Second)
+
+	apiClient, err := client.NewClientWithResponses("http://localhost:8080/api/catalog/v1")
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	componentIDs := func() []string {
+		resp, err := apiClient.GetComponentsWithResponse(ctx, nil)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, resp.StatusCode())
+		require.NotNil(t, resp.JSON200)
+		var ids []string
+		for _, component := range *resp.JSON200 {
+			if component.Id != nil {
+				ids = append(ids, *component.Id)
+			}
+		}
+		return ids
+	}
+	require.Contains(t, componentIDs(), "auth-service")
+
+	// Deleting requires the admin token
+	resp, err := apiClient.DeleteComponentWithResponse(ctx, "auth-service")
+	require.NoError(t, err)
+	require.Equal(t, http.StatusUnauthorized, resp.StatusCode())
+	require.Contains(t, componentIDs(), "auth-service")
+
+	withToken := func(ctx context.Context, req *http.Request) error {
+		req.Header.Set("Authorization", "Bearer admin-secret")
+		return nil
+	}
+	resp, err = apiClient.DeleteComponentWithResponse(ctx, "auth-service", withToken)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusNoContent, resp.StatusCode())
+
+	require.NotContains(t, componentIDs(), "auth-service")
+	get, err := apiClient.GetComponentByIdWithResponse(ctx, "auth-service", nil)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusNotFound, get.StatusCode())
+
+	resp, err = apiClient.DeleteComponentWithResponse(ctx, "auth-service", withToken)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusNotFound, resp.StatusCode())
+}
@@ -0,0 +1,247 @@
+package integration
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/doron-cohen/argus/backend/internal/storage"
+	"github.com/glebarez/sqlite"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+// TestDialectParity runs the same logical queries against Postgres and SQLite
+// and asserts both dialects return identical results.
+func TestDialectParity(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+
+	clearDatabase(t)
+	ctx := context.Background()
+
+	postgresRepo, err := storage.ConnectAndMigrate(ctx, TestConfig.Storage)
+	require.NoError(t, err)
+	require.Equal(t, "postgres", storage.DialectFor(postgresRepo.DB).Name())
+
+	sqliteDB, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	require.NoError(t, err)
+	sqlDB, err := sqliteDB.DB()
+	require.NoError(t, err)
+	sqlDB.SetMaxOpenConns(1)
+	sqliteRepo := &storage.Repository{DB: sqliteDB}
+	require.NoError(t, sqliteRepo.Migrate(ctx))
+	require.Equal(t, "sqlite", storage.DialectFor(sqliteRepo.DB).Name())
+
+	now := time.Now().UTC().Truncate(time.Second)
+	seedDialectParityData(t, postgresRepo, now)
+	seedDialectParityData(t, sqliteRepo, now)
+
+	passStatus := storage.CheckStatusPass
+	failStatus := storage.CheckStatusFail
+	windowStart := now.Add(-4 * time.Hour)
+	windowEnd := now.Add(-90 * time.Minute)
+
+	reportQueries := []struct {
+		name           string
+		statuses       []storage.CheckStatus
+		checkSlugs     []string
+		since          *time.Time
+		before         *time.Time
+		limit, offset  int
+		latestPerCheck bool
+	}{
+		{name: "all reports", limit: 50},
+		{name: "paginated reports", limit: 2, offset: 1},
+		{name: "latest per check", limit: 50, latestPerCheck: true},
+		{name: "latest per check paginated", limit: 1, offset: 1, latestPerCheck: true},
+		{name: "latest passing per check", statuses: []storage.CheckStatus{passStatus}, limit: 50, latestPerCheck: true},
+		{name: "latest failing per check", statuses: []storage.CheckStatus{failStatus}, limit: 50, latestPerCheck: true},
+		{name: "latest per check by slug", checkSlugs: []string{"lint"}, limit: 50, latestPerCheck: true},
+		{name: "reports of several checks", checkSlugs: []string{"lint", "security"}, limit: 50},
+		{name: "latest per check of several checks", checkSlugs: []string{"lint", "security"}, limit: 50, latestPerCheck: true},
+		{name: "reports in a time window", since: &windowStart, before: &windowEnd, limit: 50},
+		{name: "latest per check in a time window", since: &windowStart, before: &windowEnd, limit: 50, latestPerCheck: true},
+		{name: "passing or failing reports", statuses: []storage.CheckStatus{passStatus, failStatus}, limit: 50},
+		{name: "latest passing or failing per check", statuses: []storage.CheckStatus{passStatus, failStatus}, limit: 50, latestPerCheck: true},
+	}
+
+	for _, q := range reportQueries {
+		t.Run(q.name, func(t *testing.T) {
+			pgReports, pgTotal, err := postgresRepo.GetCheckReportsForComponentWithPagination(ctx, "parity-service", q.statuses, q.checkSlugs, q.since, q.before, q.limit, q.offset, q.latestPerCheck, storage.ReportOrder{})
+			require.NoError(t, err)
+			liteReports, liteTotal, err := sqliteRepo.GetCheckReportsForComponentWithPagination(ctx, "parity-service", q.statuses, q.checkSlugs, q.since, q.before, q.limit, q.offset, q.latestPerCheck, storage.ReportOrder{})
+			require.NoError(t, err)
+
+			assert.Equal(t, pgTotal, liteTotal)
+			assert.Equal(t, reportKeys(pgReports), reportKeys(liteReports))
+		})
+	}
+
+	// Paging latest per check one report at a time ends exactly at the total on both databases,
+	// since handlers derive has_more from the rows a page returns
+	for _, repo := range []*storage.Repository{postgresRepo, sqliteRepo} {
+		t.Run("latest per check pages add up on "+storage.DialectFor(repo.DB).Name(), func(t *testing.T) {
+			var seen int
+			for offset := 0; ; offset++ {
+				reports, total, err := repo.GetCheckReportsForComponentWithPagination(ctx, "parity-service", nil, nil, nil, nil, 1, offset, true, storage.ReportOrder{})
+				require.NoError(t, err)
+				require.Equal(t, int64(3), total)
+				if len(reports) == 0 {
+					break
+				}
+				seen += len(reports)
+			}
+			assert.Equal(t, 3, seen)
+		})
+	}
+
+	// parity-tied's checks all reported at the same instant, so only the shared tie-breakers order
+	// them: paging one report at a time lists them by slug on both databases, in every order
+	for _, order := range []storage.ReportOrder{{}, {Ascending: true}, {Field: storage.ReportSortStatus}} {
+		t.Run(fmt.Sprintf("latest per check pages with tied timestamps by %+v", order), func(t *testing.T) {
+			pages := func(repo *storage.Repository) []string {
+				var keys []string
+				for offset := 0; offset < 4; offset++ {
+					reports, total, err := repo.GetCheckReportsForComponentWithPagination(ctx, "parity-tied", nil, nil, nil, nil, 1, offset, true, order)
+					require.NoError(t, err)
+					require.Equal(t, int64(3), total)
+					keys = append(keys, reportKeys(reports)...)
+				}
+				return keys
+			}
+
+			pgPages := pages(postgresRepo)
+			assert.Equal(t, pgPages, pages(sqliteRepo))
+			require.Len(t, pgPages, 3)
+			assert.True(t, sort.StringsAreSorted(pgPages), "tied checks are listed by slug: %v", pgPages)
+		})
+	}
+
+	componentQueries := []struct {
+		name  string
+		where func(storage.Dialect) any
+	}{
+		{"maintainer contains", func(d storage.Dialect) any { return d.JSONArrayContains("maintainers", "@bob") }},
+		{"maintainer missing", func(d storage.Dialect) any { return d.JSONArrayContains("maintainers", "@nobody") }},
+		{"text search", func(d storage.Dialect) any { return d.ContainsText([]string{"name", "description"}, "SERVICE") }},
+		{"text search with wildcard", func(d storage.Dialect) any { return d.ContainsText([]string{"name", "description"}, "100%") }},
+	}
+
+	for _, q := range componentQueries {
+		t.Run(q.name, func(t *testing.T) {
+			assert.Equal(t, componentIDs(t, postgresRepo, q.where), componentIDs(t, sqliteRepo, q.where))
+		})
+	}
+
+	for _, maxDepth := range []int{1, 2, 10} {
+		t.Run(fmt.Sprintf("impact to depth %d", maxDepth), func(t *testing.T) {
+			pgImpacted, err := postgresRepo.GetComponentImpact(ctx, "parity-service", maxDepth)
+			require.NoError(t, err)
+			liteImpacted, err := sqliteRepo.GetComponentImpact(ctx, "parity-service", maxDepth)
+			require.NoError(t, err)
+
+			assert.Equal(t, impactKeys(pgImpacted), impactKeys(liteImpacted))
+		})
+	}
+}
+
+func seedDialectParityData(t *testing.T, repo *storage.Repository, now time.Time) {
+	t.Helper()
+
+	components := []storage.Component{
+		{ComponentID: "parity-service", Name: "Parity Service", Description: "Keeps both databases honest", Maintainers: storage.StringArray{"alice@company.com", "@bob"}},
+		{ComponentID: "parity-billing", Name: "Parity Billing", Description: "Targets 100% uptime", Maintainers: storage.StringArray{"carol@company.com"}},
+		{ComponentID: "parity-empty", Name: "Parity Empty"},
+	}
+	for i := range components {
+		require.NoError(t, repo.DB.Create(&components[i]).Error)
+	}
+
+	// parity-billing and parity-checkout depend on each other, behind parity-service
+	dependents := []storage.Component{
+		{ComponentID: "parity-billing-ui", Name: "Parity Billing UI", DependsOn: storage.StringArray{"parity-billing"}},
+		{ComponentID: "parity-checkout", Name: "Parity Checkout", DependsOn: storage.StringArray{"parity-billing", "parity-service"}},
+	}
+	for _, component := range dependents {
+		require.NoError(t, repo.CreateComponent(context.Background(), component))
+	}
+	require.NoError(t, repo.DB.Create(&storage.ComponentDependency{ComponentID: components[1].ID, DependsOn: "parity-checkout"}).Error)
+
+	checks := map[string]*storage.Check{
+		"unit-tests": {Slug: "unit-tests", Name: "Unit Tests"},
+		"lint":       {Slug: "lint", Name: "Lint"},
+		"security":   {Slug: "security", Name: "Security"},
+	}
+	for _, slug := range []string{"unit-tests", "lint", "security"} {
+		require.NoError(t, repo.DB.Create(checks[slug]).Error)
+	}
+
+	reports := []struct {
+		slug   string
+		status storage.CheckStatus
+		age    time.Duration
+	}{
+		{"unit-tests", storage.CheckStatusPass, 5 * time.Hour},
+		{"unit-tests", storage.CheckStatusFail, 3 * time.Hour},
+		{"lint", storage.CheckStatusFail, 4 * time.Hour},
+		{"lint", storage.CheckStatusPass, 2 * time.Hour},
+		{"lint", storage.CheckStatusPass, 1 * time.Hour},
+		{"security", storage.CheckStatusPass, 6 * time.Hour},
+	}
+	for _, r := range reports {
+		report := storage.CheckReport{
+			CheckID:     checks[r.slug].ID,
+			ComponentID: components[0].ID,
+			Status:      r.status,
+			Timestamp:   now.Add(-r.age),
+		}
+		require.NoError(t, repo.DB.Create(&report).Error)
+	}
+
+	// parity-tied's checks, created out of slug order, all report at the same instant
+	tied := storage.Component{ComponentID: "parity-tied", Name: "Parity Tied"}
+	require.NoError(t, repo.DB.Create(&tied).Error)
+	for _, slug := range []string{"tied-zeta", "tied-alpha", "tied-mid"} {
+		check := storage.Check{Slug: slug, Name: slug}
+		require.NoError(t, repo.DB.Create(&check).Error)
+		report := storage.CheckReport{CheckID: check.ID, ComponentID: tied.ID, Status: storage.CheckStatusPass, Timestamp: now}
+		require.NoError(t, repo.DB.Create(&report).Error)
+	}
+}
+
+// reportKeys identifies reports by content since IDs differ between databases.
+func reportKeys(reports []storage.CheckReport) []string {
+	keys := make([]string, 0, len(reports))
+	for _, report := range reports {
+		keys = append(keys, report.Check.Slug+"|"+string(report.Status)+"|"+report.Timestamp.UTC().Format(time.RFC3339))
+	}
+	return keys
+}
+
+// impactKeys identifies impacted components by identifier and depth.
+func impactKeys(impacted []storage.ImpactedComponent) []string {
+	keys := make([]string, 0, len(impacted))
+	for _, component := range impacted {
+		keys = append(keys, fmt.Sprintf("%s|%d|%d", component.Component.ComponentID, component.Depth, component.Summary.TotalChecks))
+	}
+	return keys
+}
+
+func componentIDs(t *testing.T, repo *storage.Repository, where func(storage.Dialect) any) []string {
+	t.Helper()
+
+	var components []storage.Component
+	err := repo.DB.Where(where(storage.DialectFor(repo.DB))).Order("component_id").Find(&components).Error
+	require.NoError(t, err)
+
+	ids := make([]string, 0, len(components))
+	for _, component := range components {
+		ids = append(ids, component.ComponentID)
+	}
+	return ids
+}
@@ -30,7 +30,7 @@ func getTestRepositoryURL() string {
 // skipIfRepositoryNotAccessible checks if the repository is accessible and skips the test if not
 func skipIfRepositoryNotAccessible(t *testing.T) {
 	ctx := context.Background()
-	fetcher := sync.NewGitFetcher()
+	fetcher := sync.NewGitFetcher("")
 
 	gitConfig := sync.GitSourceConfig{
 		URL:    getTestRepositoryURL(),
@@ -77,7 +77,7 @@ func TestGitSyncIntegration(t *testing.T) {
 	require.NoError(t, err)
 
 	// Get components via API
-	resp, err := apiClient.GetComponentsWithResponse(context.Background())
+	resp, err := apiClient.GetComponentsWithResponse(context.Background(), nil)
 	require.NoError(t, err)
 	require.Equal(t, http.StatusOK, resp.StatusCode())
 	require.NotNil(t, resp.JSON200)
@@ -151,7 +151,7 @@ func TestMixedSourcesIntegration(t *testing.T) {
 	require.NoError(t, err)
 
 	// Get components via API
-	resp, err := apiClient.GetComponentsWithResponse(context.Background())
+	resp, err := apiClient.GetComponentsWithResponse(context.Background(), nil)
 	require.NoError(t, err)
 	require.Equal(t, http.StatusOK, resp.StatusCode())
 	require.NotNil(t, resp.JSON200)
@@ -0,0 +1,81 @@
+package integration
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/doron-cohen/argus/backend/internal/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+// TestCheckReportIndexes_QueryPlans checks that Postgres plans the component report
+// queries with the composite check_reports indexes instead of scanning the table.
+func TestCheckReportIndexes_QueryPlans(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+
+	clearDatabase(t)
+	ctx := context.Background()
+
+	repo, err := storage.ConnectAndMigrate(ctx, TestConfig.Storage)
+	require.NoError(t, err)
+
+	var checks []storage.Check
+	for _, slug := range []string{"lint", "unit-tests", "security-scan", "coverage"} {
+		check := storage.Check{Slug: slug, Name: slug}
+		require.NoError(t, repo.DB.Create(&check).Error)
+		checks = append(checks, check)
+	}
+
+	var target storage.Component
+	now := time.Now().UTC().Truncate(time.Second)
+	for i := 0; i < 20; i++ {
+		component := storage.Component{ComponentID: "plan-service-" + string(rune('a'+i)), Name: "Plan Service"}
+		require.NoError(t, repo.DB.Create(&component).Error)
+		if i == 0 {
+			target = component
+		}
+
+		var reports []storage.CheckReport
+		for j := 0; j < 100; j++ {
+			reports = append(reports, storage.CheckReport{
+				CheckID:     checks[j%len(checks)].ID,
+				ComponentID: component.ID,
+				Status:      storage.CheckStatusPass,
+				Timestamp:   now.Add(-time.Duration(j) * time.Minute),
+			})
+		}
+		require.NoError(t, repo.DB.CreateInBatches(reports, 100).Error)
+	}
+	require.NoError(t, repo.DB.Exec("ANALYZE check_reports").Error)
+
+	// The table is small, so rule out sequential scans to see which index the planner can use
+	explain := func(t *testing.T, query string, args ...interface{}) string {
+		var plan []string
+		err := repo.DB.Transaction(func(tx *gorm.DB) error {
+			if err := tx.Exec("SET LOCAL enable_seqscan = off").Error; err != nil {
+				return err
+			}
+			return tx.Raw("EXPLAIN "+query, args...).Scan(&plan).Error
+		})
+		require.NoError(t, err)
+		return strings.Join(plan, "\n")
+	}
+
+	t.Run("component listing", func(t *testing.T) {
+		plan := explain(t, "SELECT * FROM check_reports WHERE component_id = ? ORDER BY timestamp DESC, id DESC LIMIT 20", target.ID)
+		assert.Contains(t, plan, "idx_check_reports_component_timestamp")
+		assert.NotContains(t, plan, "Sort", "ordering should come from the index")
+	})
+
+	t.Run("latest per check subquery", func(t *testing.T) {
+		plan := explain(t, "SELECT DISTINCT ON (check_reports.check_id) check_reports.id FROM check_reports WHERE check_reports.component_id = ? ORDER BY check_reports.check_id, check_reports.timestamp DESC, check_reports.id DESC", target.ID)
+		assert.Contains(t, plan, "idx_check_reports_latest")
+		assert.NotContains(t, plan, "Sort", "ordering should come from the index")
+	})
+}
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/doron-cohen/argus/backend/internal/config"
+	"github.com/doron-cohen/argus/backend/internal/requestlog"
 	"github.com/doron-cohen/argus/backend/internal/server"
 	"github.com/doron-cohen/argus/backend/internal/storage"
 	"github.com/stretchr/testify/require"
@@ -40,6 +41,8 @@ func TestMain(m *testing.M) {
 	}
 
 	TestConfig = config.Config{
+		// Keep test output readable; request IDs are still assigned
+		Server: config.ServerConfig{RequestLog: requestlog.Config{Level: "off"}},
 		Storage: storage.Config{
 			Host:     host,
 			Port:     port.Int(),
@@ -47,6 +50,10 @@ func TestMain(m *testing.M) {
 			Password: "testpass",
 			DBName:   "testdb",
 			SSLMode:  "disable",
+
+			MaxOpenConns:    storage.DefaultMaxOpenConns,
+			MaxIdleConns:    storage.DefaultMaxIdleConns,
+			ConnMaxLifetime: storage.DefaultConnMaxLifetime,
 		},
 	}
 
@@ -7,6 +7,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/doron-cohen/argus/backend/auth"
 	"github.com/doron-cohen/argus/backend/internal/utils"
 	reportsclient "github.com/doron-cohen/argus/backend/reports/api/client"
 	"github.com/doron-cohen/argus/backend/sync"
@@ -384,3 +385,69 @@ func TestReportsAPI_InvalidRequests(t *testing.T) {
 		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
 	})
 }
+
+func TestReportsAPI_APIKeyAuthentication(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+
+	clearDatabase(t)
+
+	testConfig := TestConfig
+	fsConfig := sync.NewFilesystemSourceConfig(getTestDataPath(t), 1*time.Second)
+	testConfig.Sync = sync.Config{
+		Sources: []sync.SourceConfig{
+			sync.NewSourceConfig(fsConfig.GetConfig()),
+		},
+	}
+	testConfig.Auth = auth.Config{
+		APIKeys: []auth.APIKey{
+			{Name: "ci", Key: "ci-secret"},
+			{Name: "auth-team", Key: "auth-team-secret", ComponentPrefixes: []string{"auth-"}},
+		},
+	}
+
+	stop := startServerAndWaitForHealth(t, testConfig)
+	defer stop()
+	waitForSyncCompletion(t, 10*time.Second)
+
+	submit := func(t *testing.T, key string, componentID string) int {
+		client, err := reportsclient.NewClientWithResponses("http://localhost:8080/api/reports/v1",
+			reportsclient.WithRequestEditorFn(func(ctx context.Context, req *http.Request) error {
+				if key != "" {
+					req.Header.Set("Authorization", "Bearer "+key)
+				}
+				return nil
+			}))
+		require.NoError(t, err)
+
+		resp, err := client.SubmitReportWithResponse(context.Background(), reportsclient.ReportSubmission{
+			Check:       reportsclient.Check{Slug: "unit-tests"},
+			ComponentId: componentID,
+			Status:      reportsclient.ReportSubmissionStatusPass,
+			Timestamp:   time.Now(),
+		})
+		require.NoError(t, err)
+		return resp.StatusCode()
+	}
+
+	t.Run("MissingKey", func(t *testing.T) {
+		assert.Equal(t, http.StatusUnauthorized, submit(t, "", "auth-service"))
+	})
+
+	t.Run("InvalidKey", func(t *testing.T) {
+		assert.Equal(t, http.StatusUnauthorized, submit(t, "not-a-key", "auth-service"))
+	})
+
+	t.Run("UnscopedKey", func(t *testing.T) {
+		assert.Equal(t, http.StatusOK, submit(t, "ci-secret", "user-service"))
+	})
+
+	t.Run("ScopedKeyWithinScope", func(t *testing.T) {
+		assert.Equal(t, http.StatusOK, submit(t, "auth-team-secret", "auth-service"))
+	})
+
+	t.Run("ScopedKeyOutsideScope", func(t *testing.T) {
+		assert.Equal(t, http.StatusForbidden, submit(t, "auth-team-secret", "user-service"))
+	})
+}
@@ -2,7 +2,6 @@ package integration
 
 import (
 	"context"
-	"fmt"
 	"net/http"
 	"os"
 	"path/filepath"
@@ -20,16 +19,7 @@ import (
 // clearDatabase removes all components from the database to ensure test isolation
 func clearDatabase(t *testing.T) {
 	t.Helper()
-	dsn := fmt.Sprintf("host=%s port=%d user=%s password=%s dbname=%s sslmode=%s",
-		TestConfig.Storage.Host,
-		TestConfig.Storage.Port,
-		TestConfig.Storage.User,
-		TestConfig.Storage.Password,
-		TestConfig.Storage.DBName,
-		TestConfig.Storage.SSLMode,
-	)
-
-	repo, err := storage.ConnectAndMigrate(context.Background(), dsn)
+	repo, err := storage.ConnectAndMigrate(context.Background(), TestConfig.Storage)
 	require.NoError(t, err)
 
 	// Drop all tables to ensure clean state
@@ -76,7 +66,7 @@ func TestFilesystemSyncIntegration(t *testing.T) {
 	require.NoError(t, err)
 
 	// Get components via API
-	resp, err := apiClient.GetComponentsWithResponse(context.Background())
+	resp, err := apiClient.GetComponentsWithResponse(context.Background(), nil)
 	require.NoError(t, err)
 	require.Equal(t, http.StatusOK, resp.StatusCode())
 	require.NotNil(t, resp.JSON200)
@@ -158,7 +148,7 @@ func TestFilesystemSyncWithSpecificPath(t *testing.T) {
 	require.NoError(t, err)
 
 	// Get components via API
-	resp, err := apiClient.GetComponentsWithResponse(context.Background())
+	resp, err := apiClient.GetComponentsWithResponse(context.Background(), nil)
 	require.NoError(t, err)
 	require.Equal(t, http.StatusOK, resp.StatusCode())
 	require.NotNil(t, resp.JSON200)
@@ -214,7 +204,7 @@ func TestSyncWithNoSources(t *testing.T) {
 	require.NoError(t, err)
 
 	// Get components via API - should be empty since no sync occurred
-	resp, err := apiClient.GetComponentsWithResponse(context.Background())
+	resp, err := apiClient.GetComponentsWithResponse(context.Background(), nil)
 	require.NoError(t, err)
 	require.Equal(t, http.StatusOK, resp.StatusCode())
 	require.NotNil(t, resp.JSON200)
@@ -253,7 +243,7 @@ func TestSyncErrorHandling(t *testing.T) {
 	require.NoError(t, err)
 
 	// Get components via API - should be empty due to sync failures
-	resp, err := apiClient.GetComponentsWithResponse(context.Background())
+	resp, err := apiClient.GetComponentsWithResponse(context.Background(), nil)
 	require.NoError(t, err)
 	require.Equal(t, http.StatusOK, resp.StatusCode())
 	require.NotNil(t, resp.JSON200)
@@ -93,7 +93,7 @@ func TestSyncStatusComponentsCountBasic(t *testing.T) {
 		assert.Equal(t, 4, *status.ComponentsCount, "ComponentsCount should be 4 after successful sync")
 
 		// Verify components were actually created in the database
-		catalogResp, err := catalogClient.GetComponentsWithResponse(context.Background())
+		catalogResp, err := catalogClient.GetComponentsWithResponse(context.Background(), nil)
 		require.NoError(t, err)
 		require.Equal(t, http.StatusOK, catalogResp.StatusCode())
 		require.NotNil(t, catalogResp.JSON200)
@@ -251,7 +251,7 @@ func TestSyncStatusComponentsCountEdgeCases(t *testing.T) {
 		assert.Equal(t, 3, *status.ComponentsCount, "ComponentsCount should be 3 for services subdirectory")
 
 		// Verify components were actually created in the database
-		catalogResp, err := catalogClient.GetComponentsWithResponse(context.Background())
+		catalogResp, err := catalogClient.GetComponentsWithResponse(context.Background(), nil)
 		require.NoError(t, err)
 		require.Equal(t, http.StatusOK, catalogResp.StatusCode())
 		require.NotNil(t, catalogResp.JSON200)
@@ -525,7 +525,7 @@ func TestSyncStatusComponentsCountScheduledSync(t *testing.T) {
 		assert.Equal(t, 4, *status.ComponentsCount, "ComponentsCount should be 4 after scheduled sync")
 
 		// Verify components were actually created in the database
-		catalogResp, err := catalogClient.GetComponentsWithResponse(context.Background())
+		catalogResp, err := catalogClient.GetComponentsWithResponse(context.Background(), nil)
 		require.NoError(t, err)
 		require.Equal(t, http.StatusOK, catalogResp.StatusCode())
 		require.NotNil(t, catalogResp.JSON200)
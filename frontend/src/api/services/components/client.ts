@@ -123,6 +123,33 @@ export interface Pagination {
   total: number;
 }
 
+/**
+ * Number of checks whose latest report has each status
+ */
+export interface CheckStatusCounts {
+  completed: number;
+  disabled: number;
+  error: number;
+  fail: number;
+  pass: number;
+  skipped: number;
+  unknown: number;
+}
+
+/**
+ * Checks of a component counted by the status of their latest report
+ */
+export interface ComponentReportsSummary {
+  /**
+   * Timestamp of the most recent report, null when the component has no reports
+   * @nullable
+   */
+  latest_report_at?: string | null;
+  status_counts: CheckStatusCounts;
+  /** Number of checks that have reported for the component */
+  total_checks: number;
+}
+
 /**
  * Response containing component reports with pagination
  */
@@ -242,3 +269,31 @@ export const getComponentReports = async (
     },
   );
 };
+
+/**
+ * Count the component's checks by the status of their latest report, matching what
+latest_per_check=true returns from the reports endpoint.
+
+ * @summary Get a rollup of a component's check statuses
+ */
+export type getComponentReportsSummaryResponse = {
+  data: ComponentReportsSummary;
+  status: number;
+};
+
+export const getGetComponentReportsSummaryUrl = (componentId: string) => {
+  return `/api/catalog/v1/components/${componentId}/reports/summary`;
+};
+
+export const getComponentReportsSummary = async (
+  componentId: string,
+  options?: RequestInit,
+): Promise<getComponentReportsSummaryResponse> => {
+  return apiFetch<Promise<getComponentReportsSummaryResponse>>(
+    getGetComponentReportsSummaryUrl(componentId),
+    {
+      ...options,
+      method: "GET",
+    },
+  );
+};
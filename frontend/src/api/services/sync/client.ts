@@ -53,6 +53,10 @@ export interface FilesystemSourceConfig {
 export interface GitSourceConfig {
   basePath?: string;
   branch?: string;
+  /** Fixed commit hash synced instead of a branch */
+  commit?: string;
+  /** Fixed tag synced instead of a branch */
+  tag?: string;
   url?: string;
 }
 
@@ -0,0 +1,155 @@
+import { expect, fixture, html, oneEvent } from "@open-wc/testing";
+import "./history";
+
+// Import UI components used by component-report-history
+import "../../ui/components/ui-table.js";
+import "../../ui/components/ui-badge.js";
+import "../../ui/components/ui-button.js";
+
+import type {
+  CheckReport,
+  Pagination,
+} from "../../api/services/components/client";
+import type { ComponentReportHistory } from "./history";
+
+describe("component-report-history", () => {
+  const mockReports: CheckReport[] = [
+    {
+      id: "r1",
+      check_slug: "unit-tests",
+      status: "pass",
+      timestamp: "2024-01-15T10:30:00Z",
+    },
+    {
+      id: "r2",
+      check_slug: "unit-tests",
+      status: "fail",
+      timestamp: "2024-01-14T10:30:00Z",
+    },
+  ];
+
+  const page = (overrides: Partial<Pagination> = {}): Pagination => ({
+    total: 42,
+    limit: 20,
+    offset: 20,
+    has_more: true,
+    ...overrides,
+  });
+
+  it("component is defined", () => {
+    expect(customElements.get("component-report-history")).to.exist;
+  });
+
+  it("renders a row per report with status badge and timestamp", async () => {
+    const el = await fixture<ComponentReportHistory>(html`
+      <component-report-history
+        .reports=${mockReports}
+        .pagination=${page()}
+      ></component-report-history>
+    `);
+    await el.updateComplete;
+
+    const rows = el.shadowRoot?.querySelectorAll(
+      '[data-testid="history-row"]',
+    );
+    expect(rows?.length).to.equal(2);
+
+    const badge = rows?.[1].querySelector('[data-testid="history-status"]');
+    expect(badge?.getAttribute("status")).to.equal("fail");
+
+    const timestamp = rows?.[0].querySelector(
+      '[data-testid="history-timestamp"]',
+    );
+    expect(timestamp?.textContent?.trim()).to.equal(
+      new Date("2024-01-15T10:30:00Z").toLocaleString(),
+    );
+  });
+
+  it("shows the range of the current page", async () => {
+    const el = await fixture<ComponentReportHistory>(html`
+      <component-report-history
+        .reports=${mockReports}
+        .pagination=${page()}
+      ></component-report-history>
+    `);
+    await el.updateComplete;
+
+    const range = el.shadowRoot?.querySelector(
+      '[data-testid="history-range"]',
+    );
+    expect(range?.textContent).to.include("Showing 21–22 of 42");
+  });
+
+  it("requests the next and previous pages", async () => {
+    const el = await fixture<ComponentReportHistory>(html`
+      <component-report-history
+        .reports=${mockReports}
+        .pagination=${page()}
+      ></component-report-history>
+    `);
+    await el.updateComplete;
+
+    const next = el.shadowRoot?.querySelector(
+      '[data-testid="history-next"]',
+    ) as HTMLElement;
+    setTimeout(() => next.click());
+    const nextEvent = await oneEvent(el, "page-change");
+    expect(nextEvent.detail.offset).to.equal(40);
+
+    const previous = el.shadowRoot?.querySelector(
+      '[data-testid="history-previous"]',
+    ) as HTMLElement;
+    setTimeout(() => previous.click());
+    const previousEvent = await oneEvent(el, "page-change");
+    expect(previousEvent.detail.offset).to.equal(0);
+  });
+
+  it("disables navigation past the first and last pages", async () => {
+    const el = await fixture<ComponentReportHistory>(html`
+      <component-report-history
+        .reports=${mockReports}
+        .pagination=${page({ total: 2, offset: 0, has_more: false })}
+      ></component-report-history>
+    `);
+    await el.updateComplete;
+
+    const previous = el.shadowRoot?.querySelector(
+      '[data-testid="history-previous"]',
+    );
+    const next = el.shadowRoot?.querySelector('[data-testid="history-next"]');
+    expect(previous?.hasAttribute("disabled")).to.be.true;
+    expect(next?.hasAttribute("disabled")).to.be.true;
+  });
+
+  it("renders empty state without pagination when there are no reports", async () => {
+    const el = await fixture<ComponentReportHistory>(html`
+      <component-report-history
+        .reports=${[]}
+        .pagination=${page({ total: 0, offset: 0, has_more: false })}
+      ></component-report-history>
+    `);
+    await el.updateComplete;
+
+    const table = el.shadowRoot?.querySelector("ui-table");
+    expect(table?.empty).to.be.true;
+    expect(table?.emptyMessage).to.equal(
+      "No reports available for this component",
+    );
+    expect(
+      el.shadowRoot?.querySelector('[data-testid="history-pagination"]'),
+    ).to.not.exist;
+  });
+
+  it("passes the error message to the table", async () => {
+    const el = await fixture<ComponentReportHistory>(html`
+      <component-report-history
+        .errorMessage=${"Test error"}
+      ></component-report-history>
+    `);
+    await el.updateComplete;
+
+    const table = el.shadowRoot?.querySelector("ui-table");
+    expect(table?.errorMessage).to.equal("Test error");
+    expect(table?.empty).to.be.false;
+  });
+});
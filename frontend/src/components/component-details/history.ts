@@ -0,0 +1,150 @@
+import { LitElement, html } from "lit";
+import { customElement, property } from "lit/decorators.js";
+import type {
+  CheckReport,
+  Pagination,
+} from "../../api/services/components/client";
+import "../../ui/components/ui-table.js";
+import "../../ui/components/ui-badge.js";
+import "../../ui/components/ui-button.js";
+
+export interface PageChangeDetail {
+  offset: number;
+}
+
+@customElement("component-report-history")
+export class ComponentReportHistory extends LitElement {
+  @property({ type: Array, attribute: false })
+  reports: readonly CheckReport[] = [];
+
+  @property({ type: Object, attribute: false })
+  pagination: Pagination | null = null;
+
+  @property({ type: Boolean, attribute: false })
+  isLoading = false;
+
+  @property({ type: String, attribute: false })
+  errorMessage: string | null = null;
+
+  private formatTimestamp(timestamp: string): string {
+    return new Date(timestamp).toLocaleString();
+  }
+
+  private changePage(offset: number) {
+    this.dispatchEvent(
+      new CustomEvent<PageChangeDetail>("page-change", {
+        detail: { offset },
+        bubbles: true,
+        composed: true,
+      }),
+    );
+  }
+
+  render() {
+    return html`
+      <div data-testid="report-history">
+        <h3
+          class="u-text-lg u-font-semibold u-text-gray-900 u-mb-4"
+          data-testid="report-history-label"
+        >
+          Report History
+        </h3>
+        <ui-table
+          data-testid="report-history-table"
+          size="sm"
+          ?loading=${this.isLoading}
+          loading-message="Loading report history..."
+          ?empty=${!this.isLoading &&
+          !this.errorMessage &&
+          this.reports.length === 0}
+          empty-message="No reports available for this component"
+          error-message=${this.errorMessage || ""}
+          col-span="3"
+        >
+          <thead>
+            <tr>
+              <th scope="col">Check</th>
+              <th scope="col">Status</th>
+              <th scope="col">Timestamp</th>
+            </tr>
+          </thead>
+          <tbody>
+            ${this.reports.map((report) => this.renderReportRow(report))}
+          </tbody>
+        </ui-table>
+        ${this.renderPaginationControls()}
+      </div>
+    `;
+  }
+
+  private renderReportRow(report: CheckReport) {
+    return html`
+      <tr data-testid="history-row">
+        <td class="whitespace-nowrap" data-testid="history-check">
+          ${report.check_slug}
+        </td>
+        <td>
+          <ui-badge
+            status="${report.status}"
+            data-testid="history-status"
+          ></ui-badge>
+        </td>
+        <td class="whitespace-nowrap" data-testid="history-timestamp">
+          ${this.formatTimestamp(report.timestamp)}
+        </td>
+      </tr>
+    `;
+  }
+
+  private renderPaginationControls() {
+    const pagination = this.pagination;
+    if (!pagination || pagination.total === 0) {
+      return html``;
+    }
+
+    const first = pagination.offset + 1;
+    const last = pagination.offset + this.reports.length;
+    const previousOffset = Math.max(pagination.offset - pagination.limit, 0);
+    const nextOffset = pagination.offset + pagination.limit;
+    // The host still receives clicks around a disabled inner button
+    const previousDisabled = this.isLoading || pagination.offset === 0;
+    const nextDisabled = this.isLoading || !pagination.has_more;
+
+    return html`
+      <div
+        class="u-flex u-items-center u-justify-between u-mt-4"
+        data-testid="history-pagination"
+      >
+        <span class="u-text-sm u-text-muted" data-testid="history-range">
+          Showing ${first}–${last} of ${pagination.total}
+        </span>
+        <div class="u-flex u-gap-2">
+          <ui-button
+            variant="secondary"
+            size="sm"
+            ?disabled=${previousDisabled}
+            @click=${() => !previousDisabled && this.changePage(previousOffset)}
+            data-testid="history-previous"
+          >
+            Previous
+          </ui-button>
+          <ui-button
+            variant="secondary"
+            size="sm"
+            ?disabled=${nextDisabled}
+            @click=${() => !nextDisabled && this.changePage(nextOffset)}
+            data-testid="history-next"
+          >
+            Next
+          </ui-button>
+        </div>
+      </div>
+    `;
+  }
+}
+
+declare global {
+  interface HTMLElementTagNameMap {
+    "component-report-history": ComponentReportHistory;
+  }
+}
@@ -93,6 +93,24 @@ describe("component-details", () => {
     expect((uiAlert as any)?.message).to.equal("Test error");
   });
 
+  it("renders not found state when notFound is true", async () => {
+    const el = await fixture<ComponentDetails>(html`
+      <component-details .notFound=${true}></component-details>
+    `);
+    await el.updateComplete;
+
+    const notFound = el.shadowRoot?.querySelector(
+      '[data-testid="component-details-not-found"]',
+    );
+    expect(notFound).to.exist;
+    expect(notFound?.querySelector("ui-empty-state")?.title).to.equal(
+      "Component not found",
+    );
+    expect(
+      el.shadowRoot?.querySelector('[data-testid="component-details-error"]'),
+    ).to.not.exist;
+  });
+
   it("renders empty state when no component is provided", async () => {
     const el = await fixture(html` <component-details></component-details> `);
 
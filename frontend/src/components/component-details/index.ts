@@ -1,11 +1,16 @@
 import { LitElement, html } from "lit";
 import { customElement, property } from "lit/decorators.js";
 import type { Component } from "../../api/services/components/client";
-import type { CheckReport } from "../../api/services/components/client";
+import type {
+  CheckReport,
+  Pagination,
+} from "../../api/services/components/client";
 import "../../ui/components/ui-spinner.js";
 import "../../ui/components/ui-alert.js";
+import "../../ui/components/ui-empty-state.js";
 import "./metadata.js";
 import "./reports.js";
+import "./history.js";
 
 @customElement("component-details")
 export class ComponentDetails extends LitElement {
@@ -27,12 +32,34 @@ export class ComponentDetails extends LitElement {
   @property({ type: String, attribute: false })
   reportsErrorMessage: string | null = null;
 
+  @property({ type: Boolean, attribute: false })
+  notFound = false;
+
+  @property({ type: Array, attribute: false })
+  history: readonly CheckReport[] = [];
+
+  @property({ type: Object, attribute: false })
+  historyPagination: Pagination | null = null;
+
+  @property({ type: Boolean, attribute: false })
+  isHistoryLoading = false;
+
+  @property({ type: String, attribute: false })
+  historyErrorMessage: string | null = null;
+
+  @property({ type: Array, attribute: false })
+  recentReports: readonly CheckReport[] = [];
+
   render() {
     // Follow functional rendering model - return same result for same inputs
     if (this.isLoading) {
       return this.renderLoadingState();
     }
 
+    if (this.notFound) {
+      return this.renderNotFoundState();
+    }
+
     if (this.errorMessage) {
       return this.renderErrorState();
     }
@@ -69,6 +96,17 @@ export class ComponentDetails extends LitElement {
     `;
   }
 
+  private renderNotFoundState() {
+    return html`
+      <ui-card data-testid="component-details-not-found">
+        <ui-empty-state
+          title="Component not found"
+          description="No component with this ID exists in the catalog."
+        ></ui-empty-state>
+      </ui-card>
+    `;
+  }
+
   private renderEmptyState() {
     return html`
       <div class="u-text-center u-py-8 u-text-gray-500">
@@ -86,8 +124,17 @@ export class ComponentDetails extends LitElement {
             .reports=${this.reports}
             .isLoading=${this.isReportsLoading}
             .errorMessage=${this.reportsErrorMessage}
+            .recentReports=${this.recentReports}
           ></component-reports>
         </div>
+        <div class="u-mt-8">
+          <component-report-history
+            .reports=${this.history}
+            .pagination=${this.historyPagination}
+            .isLoading=${this.isHistoryLoading}
+            .errorMessage=${this.historyErrorMessage}
+          ></component-report-history>
+        </div>
       </div>
     `;
   }
@@ -9,6 +9,7 @@ import "../../ui/components/ui-alert.js";
 
 import type { CheckReport } from "../../api/services/components/client";
 import type { ComponentReports } from "./reports";
+import type { UiSparkline } from "../../ui/components/ui-sparkline";
 
 describe("component-reports", () => {
   const mockReports: CheckReport[] = [
@@ -154,4 +155,27 @@ describe("component-reports", () => {
       ); // Should be different from raw ISO
     });
   });
+
+  it("renders each check's trend from recent reports, oldest first", async () => {
+    const recentReports: CheckReport[] = [
+      { ...mockReports[0], id: "r4", status: "pass" },
+      { ...mockReports[1], id: "r5", status: "pass" },
+      { ...mockReports[0], id: "r6", status: "fail" },
+    ];
+    const el = await fixture<ComponentReports>(html`
+      <component-reports
+        .reports=${mockReports}
+        .recentReports=${recentReports}
+      ></component-reports>
+    `);
+    await el.updateComplete;
+
+    const trends = el.shadowRoot?.querySelectorAll(
+      '[data-testid="check-trend"]',
+    ) as NodeListOf<UiSparkline>;
+    expect(trends).to.have.length(3);
+    expect(trends[0].statuses).to.deep.equal(["fail", "pass"]);
+    expect(trends[1].statuses).to.deep.equal(["pass"]);
+    expect(trends[2].statuses).to.deep.equal([]);
+  });
 });
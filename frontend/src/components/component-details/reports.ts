@@ -5,6 +5,7 @@ import "../../ui/components/ui-card.js";
 import "../../ui/components/ui-badge.js";
 import "../../ui/components/ui-spinner.js";
 import "../../ui/components/ui-alert.js";
+import "../../ui/components/ui-sparkline.js";
 
 @customElement("component-reports")
 export class ComponentReports extends LitElement {
@@ -17,10 +18,21 @@ export class ComponentReports extends LitElement {
   @property({ type: String, attribute: false })
   errorMessage: string | null = null;
 
+  /** Recent reports of all checks, newest first, for each check's trend */
+  @property({ type: Array, attribute: false })
+  recentReports: readonly CheckReport[] = [];
+
   private formatTimestamp(timestamp: string): string {
     return new Date(timestamp).toLocaleString();
   }
 
+  private trendFor(checkSlug: string): string[] {
+    return this.recentReports
+      .filter((report) => report.check_slug === checkSlug)
+      .map((report) => report.status)
+      .reverse();
+  }
+
   render() {
     if (this.isLoading) {
       return this.renderLoadingState();
@@ -104,9 +116,18 @@ export class ComponentReports extends LitElement {
               data-testid="check-status"
             ></ui-badge>
           </div>
-          <span class="u-text-sm u-text-gray-500" data-testid="check-timestamp">
-            ${this.formatTimestamp(report.timestamp)}
-          </span>
+          <div class="u-flex u-items-center u-gap-3">
+            <ui-sparkline
+              .statuses=${this.trendFor(report.check_slug)}
+              data-testid="check-trend"
+            ></ui-sparkline>
+            <span
+              class="u-text-sm u-text-gray-500"
+              data-testid="check-timestamp"
+            >
+              ${this.formatTimestamp(report.timestamp)}
+            </span>
+          </div>
         </div>
       </ui-card>
     `;
@@ -188,4 +188,53 @@ describe("component-list", () => {
     );
     expect(maintainers?.textContent?.trim()).to.equal("");
   });
+
+  it("renders an overall health indicator from report summaries", async () => {
+    const counts = {
+      pass: 0,
+      fail: 0,
+      disabled: 0,
+      skipped: 0,
+      unknown: 0,
+      error: 0,
+      completed: 0,
+    };
+    const el = await fixture(html`
+      <component-list
+        .components=${mockComponents}
+        .summaries=${{
+          a: {
+            total_checks: 3,
+            status_counts: { ...counts, pass: 2, fail: 1 },
+          },
+          b: { total_checks: 0, status_counts: counts },
+        }}
+      ></component-list>
+    `);
+
+    const rows = el.shadowRoot?.querySelectorAll(
+      '[data-testid="component-row"]',
+    );
+    const badge = rows?.[0]?.querySelector('[data-testid="health-status"]');
+    expect(badge?.getAttribute("status")).to.equal("fail");
+    expect(
+      rows?.[0]
+        ?.querySelector('[data-testid="health-checks"]')
+        ?.textContent?.trim(),
+    ).to.equal("2/3 passing");
+
+    const health = rows?.[1]?.querySelector('[data-testid="component-health"]');
+    expect(health?.textContent?.trim()).to.equal("No reports");
+  });
+
+  it("leaves health empty while summaries are loading", async () => {
+    const el = await fixture(html`
+      <component-list .components=${mockComponents}></component-list>
+    `);
+
+    const health = el.shadowRoot?.querySelector(
+      '[data-testid="component-health"]',
+    );
+    expect(health?.textContent?.trim()).to.equal("");
+  });
 });
@@ -2,8 +2,12 @@ import { LitElement, html } from "lit";
 import { customElement, property } from "lit/decorators.js";
 import { nothing } from "lit";
 import { escapeHtml } from "../../utils";
-import type { Component } from "../../api/services/components/client";
+import type {
+  Component,
+  ComponentReportsSummary,
+} from "../../api/services/components/client";
 import "../../ui/components/ui-table.js";
+import "../../ui/components/ui-badge.js";
 
 @customElement("component-list")
 export class ComponentList extends LitElement {
@@ -16,6 +20,13 @@ export class ComponentList extends LitElement {
   @property({ type: String, attribute: false })
   error: string | null = null;
 
+  /**
+   * Report summaries keyed by component ID. A missing entry is still loading,
+   * null means the summary could not be loaded.
+   */
+  @property({ type: Object, attribute: false })
+  summaries: Record<string, ComponentReportsSummary | null> = {};
+
   render() {
     return html`
       <ui-table
@@ -25,7 +36,7 @@ export class ComponentList extends LitElement {
         ?empty=${!this.isLoading && !this.error && this.components.length === 0}
         empty-message="No components found"
         error-message=${this.error || ""}
-        col-span="5"
+        col-span="6"
       >
         ${this.renderTableHeader()} ${this.renderTableBody()}
       </ui-table>
@@ -41,6 +52,7 @@ export class ComponentList extends LitElement {
           <th scope="col" data-testid="header-description">Description</th>
           <th scope="col" data-testid="header-team">Team</th>
           <th scope="col" data-testid="header-maintainers">Maintainers</th>
+          <th scope="col" data-testid="header-health">Health</th>
         </tr>
       </thead>
     `;
@@ -99,9 +111,42 @@ export class ComponentList extends LitElement {
             ${comp.owners?.maintainers?.join(", ") || ""}
           </div>
         </td>
+        <td class="whitespace-nowrap" data-testid="component-health">
+          ${this.renderHealth(slug)}
+        </td>
       </tr>
     `;
   }
+
+  private renderHealth(slug: string) {
+    if (!(slug in this.summaries)) {
+      return nothing;
+    }
+
+    const summary = this.summaries[slug];
+    if (!summary || summary.total_checks === 0) {
+      return html`<span class="u-text-sm u-text-muted">No reports</span>`;
+    }
+
+    const counts = summary.status_counts;
+    const failing = counts.fail + counts.error + counts.unknown;
+    const passing = counts.pass + counts.completed;
+    const status =
+      failing > 0
+        ? "fail"
+        : passing === summary.total_checks
+          ? "pass"
+          : "skipped";
+
+    return html`
+      <div class="u-flex u-items-center u-gap-2">
+        <ui-badge status=${status} data-testid="health-status"></ui-badge>
+        <span class="u-text-sm u-text-muted" data-testid="health-checks">
+          ${passing}/${summary.total_checks} passing
+        </span>
+      </div>
+    `;
+  }
 }
 
 declare global {
@@ -5,8 +5,14 @@ import {
   setLatestReports,
   setReportsLoading,
   setReportsError,
+  setNotFound,
+  setReportHistory,
+  setRecentReports,
+  setHistoryLoading,
+  setHistoryError,
   componentDetails,
   error,
+  HISTORY_PAGE_SIZE,
   type ComponentReportsResponse,
 } from "./store";
 import {
@@ -20,7 +26,8 @@ export async function loadComponentDetails(componentId: string): Promise<void> {
     setError(null);
     const { status, data } = await getComponentById(componentId);
     if (status === 404) {
-      throw new Error(`Component not found: ${componentId}`);
+      setNotFound(true);
+      return;
     }
     if (status < 200 || status >= 300) {
       const message =
@@ -100,3 +107,48 @@ export async function loadComponentReports(componentId: string): Promise<void> {
     setReportsLoading(false);
   }
 }
+
+export async function loadReportHistory(
+  componentId: string,
+  offset = 0,
+): Promise<void> {
+  try {
+    setHistoryLoading(true);
+    setHistoryError(null);
+    const { status, data } = await getComponentReports(componentId, {
+      limit: HISTORY_PAGE_SIZE,
+      offset,
+    });
+
+    // Discard the page if the user navigated to another component meanwhile
+    const currentComponent = componentDetails.get();
+    if (
+      !currentComponent ||
+      (currentComponent.id !== componentId &&
+        currentComponent.name !== componentId)
+    ) {
+      return;
+    }
+
+    if (status < 200 || status >= 300) {
+      const message =
+        data && typeof data === "object" && (data as any).error
+          ? (data as any).error
+          : `HTTP ${status}`;
+      throw new Error(message);
+    }
+
+    const historyResponse: ComponentReportsResponse = data as any;
+    setReportHistory(historyResponse.reports, historyResponse.pagination);
+    if (offset === 0) {
+      setRecentReports(historyResponse.reports);
+    }
+  } catch (err) {
+    const errorMessage =
+      err instanceof Error ? err.message : "Failed to fetch report history";
+    setHistoryError(errorMessage);
+    console.error("Error fetching report history:", err);
+  } finally {
+    setHistoryLoading(false);
+  }
+}
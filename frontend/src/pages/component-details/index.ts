@@ -1,7 +1,11 @@
 import { LitElement, html } from "lit";
 import { customElement, property, state } from "lit/decorators.js";
-import { loadComponentDetails, loadComponentReports } from "./data";
-import { resetComponentDetails, resetReports } from "./store";
+import {
+  loadComponentDetails,
+  loadComponentReports,
+  loadReportHistory,
+} from "./data";
+import { resetComponentDetails, resetReports, resetHistory } from "./store";
 import "../../components/component-details";
 import "../../ui/primitives/page-container.js";
 import "../../ui/components/ui-page-header.js";
@@ -15,9 +19,19 @@ import {
   latestReports,
   reportsLoading,
   reportsError,
+  notFound,
+  reportHistory,
+  historyPagination,
+  historyLoading,
+  historyError,
+  recentReports,
   type Component,
   type CheckReport,
+  type Pagination,
 } from "./store";
+import type {
+  PageChangeDetail,
+} from "../../components/component-details/history";
 
 @customElement("component-details-page")
 export class ComponentDetailsPage extends LitElement {
@@ -42,6 +56,24 @@ export class ComponentDetailsPage extends LitElement {
   @state()
   reportsErrorMessage: string | null = null;
 
+  @state()
+  isNotFound = false;
+
+  @state()
+  history: readonly CheckReport[] = [];
+
+  @state()
+  historyPage: Pagination | null = null;
+
+  @state()
+  isHistoryLoading = false;
+
+  @state()
+  historyErrorMessage: string | null = null;
+
+  @state()
+  recent: readonly CheckReport[] = [];
+
   private unsubscribers: Array<() => void> = [];
 
   async connectedCallback(): Promise<void> {
@@ -103,6 +135,48 @@ export class ComponentDetailsPage extends LitElement {
       }),
     );
 
+    this.unsubscribers.push(
+      notFound.subscribe((value) => {
+        this.isNotFound = value;
+        this.requestUpdate();
+      }),
+    );
+
+    this.unsubscribers.push(
+      reportHistory.subscribe((value) => {
+        this.history = value;
+        this.requestUpdate();
+      }),
+    );
+
+    this.unsubscribers.push(
+      historyPagination.subscribe((value) => {
+        this.historyPage = value;
+        this.requestUpdate();
+      }),
+    );
+
+    this.unsubscribers.push(
+      historyLoading.subscribe((value) => {
+        this.isHistoryLoading = value;
+        this.requestUpdate();
+      }),
+    );
+
+    this.unsubscribers.push(
+      historyError.subscribe((value) => {
+        this.historyErrorMessage = value;
+        this.requestUpdate();
+      }),
+    );
+
+    this.unsubscribers.push(
+      recentReports.subscribe((value) => {
+        this.recent = value;
+        this.requestUpdate();
+      }),
+    );
+
     if (this.componentId) {
       void this.load();
     }
@@ -125,18 +199,28 @@ export class ComponentDetailsPage extends LitElement {
     try {
       resetComponentDetails();
       resetReports();
+      resetHistory();
       if (!this.componentId) return;
 
       await loadComponentDetails(this.componentId);
 
-      if (!errorStore.get()) {
-        await loadComponentReports(this.componentId);
+      if (!errorStore.get() && !notFound.get()) {
+        await Promise.all([
+          loadComponentReports(this.componentId),
+          loadReportHistory(this.componentId),
+        ]);
       }
     } catch (err) {
       console.error("[ComponentDetailsPage] Error loading data:", err);
     }
   }
 
+  private handlePageChange(event: CustomEvent<PageChangeDetail>): void {
+    if (this.componentId) {
+      void loadReportHistory(this.componentId, event.detail.offset);
+    }
+  }
+
   render() {
     return html`
       <ui-page-container max-width="xl" padding="lg">
@@ -174,6 +258,13 @@ export class ComponentDetailsPage extends LitElement {
         .reports=${this.reports}
         .isReportsLoading=${this.isReportsLoading}
         .reportsErrorMessage=${this.reportsErrorMessage}
+        .notFound=${this.isNotFound}
+        .history=${this.history}
+        .historyPagination=${this.historyPage}
+        .isHistoryLoading=${this.isHistoryLoading}
+        .historyErrorMessage=${this.historyErrorMessage}
+        .recentReports=${this.recent}
+        @page-change=${this.handlePageChange}
       ></component-details>
     `;
   }
@@ -3,11 +3,13 @@ import type {
   Component,
   CheckReport,
   ComponentReportsResponse,
+  Pagination,
 } from "../../api/services/components/client";
 export type {
   Component,
   CheckReport,
   ComponentReportsResponse,
+  Pagination,
 } from "../../api/services/components/client";
 export interface ApiError {
   error: string;
@@ -18,12 +20,22 @@ export interface ApiError {
 export const componentDetails = atom<Component | null>(null);
 export const loading = atom(false);
 export const error = atom<string | null>(null);
+export const notFound = atom(false);
 
 // Reports state
 export const latestReports = atom<CheckReport[]>([]);
 export const reportsLoading = atom(false);
 export const reportsError = atom<string | null>(null);
 
+// Report history state, one page at a time
+export const HISTORY_PAGE_SIZE = 20;
+export const reportHistory = atom<CheckReport[]>([]);
+export const historyPagination = atom<Pagination | null>(null);
+export const historyLoading = atom(false);
+export const historyError = atom<string | null>(null);
+// The first history page, newest first, kept while paging to draw check trends
+export const recentReports = atom<CheckReport[]>([]);
+
 // Actions
 export function setComponentDetails(component: Component | null) {
   componentDetails.set(component);
@@ -37,6 +49,10 @@ export function setError(errorMessage: string | null) {
   error.set(errorMessage);
 }
 
+export function setNotFound(isNotFound: boolean) {
+  notFound.set(isNotFound);
+}
+
 export function setLatestReports(reports: CheckReport[]) {
   latestReports.set(reports);
 }
@@ -49,11 +65,32 @@ export function setReportsError(errorMessage: string | null) {
   reportsError.set(errorMessage);
 }
 
+export function setReportHistory(
+  reports: CheckReport[],
+  pagination: Pagination | null,
+) {
+  reportHistory.set(reports);
+  historyPagination.set(pagination);
+}
+
+export function setRecentReports(reports: CheckReport[]) {
+  recentReports.set(reports);
+}
+
+export function setHistoryLoading(isLoading: boolean) {
+  historyLoading.set(isLoading);
+}
+
+export function setHistoryError(errorMessage: string | null) {
+  historyError.set(errorMessage);
+}
+
 // Reset state
 export function resetComponentDetails() {
   componentDetails.set(null);
   loading.set(false);
   error.set(null);
+  notFound.set(false);
 }
 
 export function resetReports() {
@@ -61,3 +98,11 @@ export function resetReports() {
   reportsLoading.set(false);
   reportsError.set(null);
 }
+
+export function resetHistory() {
+  reportHistory.set([]);
+  historyPagination.set(null);
+  historyLoading.set(false);
+  historyError.set(null);
+  recentReports.set([]);
+}
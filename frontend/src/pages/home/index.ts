@@ -2,13 +2,19 @@ import { LitElement, html } from "lit";
 import { customElement, state } from "lit/decorators.js";
 import {
   getComponents,
+  getComponentReportsSummary,
   type Component,
+  type ComponentReportsSummary,
 } from "../../api/services/components/client";
+import { forEachWithConcurrency } from "../../utils";
 import "../../components/component-list/index";
 import "../../ui/primitives/page-container.js";
 import "../../ui/components/ui-page-header.js";
 import "../../ui/primitives/ui-stack.js";
 
+// Report summaries requested at once while the catalog loads
+const SUMMARY_CONCURRENCY = 6;
+
 @customElement("home-page")
 export class HomePage extends LitElement {
   @state()
@@ -20,6 +26,9 @@ export class HomePage extends LitElement {
   @state()
   error: string | null = null;
 
+  @state()
+  summaries: Record<string, ComponentReportsSummary | null> = {};
+
   async connectedCallback(): Promise<void> {
     super.connectedCallback();
     await this.loadComponents();
@@ -44,6 +53,7 @@ export class HomePage extends LitElement {
         throw new Error(`HTTP ${statusCode}`);
       } else {
         this.components = componentsData;
+        void this.loadSummaries(componentsData);
       }
     } catch (err) {
       this.error =
@@ -54,6 +64,30 @@ export class HomePage extends LitElement {
     }
   }
 
+  // Summaries load after the table renders, so a slow one never holds it up.
+  // Only a few are requested at a time to keep large catalogs from flooding the API.
+  private async loadSummaries(components: Component[]): Promise<void> {
+    await forEachWithConcurrency(
+      components,
+      SUMMARY_CONCURRENCY,
+      async (component) => {
+        const slug = component.id || component.name;
+        let summary: ComponentReportsSummary | null = null;
+        try {
+          const { status, data } = await getComponentReportsSummary(
+            encodeURIComponent(slug),
+          );
+          if (status >= 200 && status < 300) {
+            summary = data;
+          }
+        } catch (err) {
+          console.error(`Error fetching report summary for ${slug}:`, err);
+        }
+        this.summaries = { ...this.summaries, [slug]: summary };
+      },
+    );
+  }
+
   render() {
     return html`
       <ui-page-container max-width="xl" padding="lg">
@@ -75,6 +109,7 @@ export class HomePage extends LitElement {
                 .components=${this.components}
                 .isLoading=${this.isLoading}
                 .error=${this.error}
+                .summaries=${this.summaries}
                 id="component-list"
               ></component-list>
             </div>
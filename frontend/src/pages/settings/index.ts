@@ -114,7 +114,12 @@ export class SettingsPage extends LitElement {
       const config = source.config as GitSourceConfig;
       const items = [
         { label: "Repository", value: config.url || "N/A" },
-        { label: "Branch", value: config.branch || "N/A" },
+        // Pinned sources sync a fixed tag or commit instead of a branch
+        config.tag
+          ? { label: "Tag", value: config.tag }
+          : config.commit
+            ? { label: "Commit", value: config.commit }
+            : { label: "Branch", value: config.branch || "N/A" },
         ...(config.basePath
           ? [{ label: "Base Path", value: config.basePath }]
           : []),
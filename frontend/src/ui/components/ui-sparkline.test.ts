@@ -0,0 +1,62 @@
+import { expect, fixture, html } from "@open-wc/testing";
+import "./ui-sparkline.js";
+import type { UiSparkline } from "./ui-sparkline";
+
+describe("ui-sparkline", () => {
+  function getBars(el: UiSparkline) {
+    return Array.from(
+      el.shadowRoot?.querySelectorAll('[data-testid="sparkline-bar"]') || [],
+    );
+  }
+
+  it("renders a bar per status, oldest first", async () => {
+    const el = await fixture<UiSparkline>(
+      html`<ui-sparkline
+        .statuses=${["pass", "fail", "skipped"]}
+      ></ui-sparkline>`,
+    );
+    const bars = getBars(el);
+    expect(bars).to.have.length(3);
+    expect(bars[0].classList.contains("pass")).to.be.true;
+    expect(bars[1].classList.contains("fail")).to.be.true;
+    expect(bars[2].classList.contains("other")).to.be.true;
+  });
+
+  it("groups error and unknown with failures", async () => {
+    const el = await fixture<UiSparkline>(
+      html`<ui-sparkline .statuses=${["error", "unknown"]}></ui-sparkline>`,
+    );
+    for (const bar of getBars(el)) {
+      expect(bar.classList.contains("fail")).to.be.true;
+    }
+  });
+
+  it("keeps only the most recent statuses", async () => {
+    const el = await fixture<UiSparkline>(
+      html`<ui-sparkline
+        max="2"
+        .statuses=${["fail", "pass", "pass"]}
+      ></ui-sparkline>`,
+    );
+    const bars = getBars(el);
+    expect(bars).to.have.length(2);
+    expect(bars.every((bar) => bar.classList.contains("pass"))).to.be.true;
+  });
+
+  it("describes the trend for assistive technology", async () => {
+    const el = await fixture<UiSparkline>(
+      html`<ui-sparkline .statuses=${["pass", "fail", "pass"]}></ui-sparkline>`,
+    );
+    const sparkline = el.shadowRoot?.querySelector('[role="img"]');
+    expect(sparkline?.getAttribute("aria-label")).to.equal(
+      "Last 3 reports: 2 passed, 1 failed",
+    );
+  });
+
+  it("renders a placeholder without statuses", async () => {
+    const el = await fixture<UiSparkline>(html`<ui-sparkline></ui-sparkline>`);
+    expect(getBars(el)).to.have.length(0);
+    expect(el.shadowRoot?.querySelector('[data-testid="sparkline-empty"]')).to
+      .exist;
+  });
+});
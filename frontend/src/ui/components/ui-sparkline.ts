@@ -0,0 +1,114 @@
+import { LitElement, html, css } from "lit";
+import { customElement, property } from "lit/decorators.js";
+
+type TrendVariant = "pass" | "fail" | "other";
+
+function trendVariant(status: string): TrendVariant {
+  switch ((status || "").toLowerCase()) {
+    case "pass":
+    case "completed":
+      return "pass";
+    case "fail":
+    case "error":
+    case "unknown":
+      return "fail";
+    default:
+      return "other";
+  }
+}
+
+@customElement("ui-sparkline")
+export class UiSparkline extends LitElement {
+  /** Statuses of the most recent reports, oldest first */
+  @property({ type: Array, attribute: false })
+  statuses: readonly string[] = [];
+
+  /** Number of most recent statuses drawn */
+  @property({ type: Number })
+  max = 10;
+
+  static styles = css`
+    :host {
+      display: inline-flex;
+      align-items: flex-end;
+      vertical-align: middle;
+    }
+
+    .sparkline {
+      display: inline-flex;
+      align-items: flex-end;
+      gap: 2px;
+      height: var(--space-4, 1rem);
+    }
+
+    .bar {
+      width: 4px;
+      border-radius: 1px;
+    }
+
+    .bar.pass {
+      height: 100%;
+      background-color: var(--color-success-fg, rgb(22 101 52));
+    }
+
+    .bar.fail {
+      height: 100%;
+      background-color: var(--color-danger-fg, rgb(220 38 38));
+    }
+
+    .bar.other {
+      height: 50%;
+      background-color: var(--color-fg-muted, rgb(107 114 128));
+    }
+
+    .empty {
+      font-size: var(--font-size-xs, 0.75rem);
+      color: var(--color-fg-muted, rgb(107 114 128));
+    }
+  `;
+
+  private get recent(): readonly string[] {
+    return this.statuses.slice(-Math.max(this.max, 1));
+  }
+
+  private get label(): string {
+    const recent = this.recent;
+    const passed = recent.filter((s) => trendVariant(s) === "pass").length;
+    const failed = recent.filter((s) => trendVariant(s) === "fail").length;
+    return `Last ${recent.length} reports: ${passed} passed, ${failed} failed`;
+  }
+
+  render() {
+    if (this.statuses.length === 0) {
+      return html`<span class="empty" data-testid="sparkline-empty">—</span>`;
+    }
+
+    return html`
+      <div
+        class="sparkline"
+        role="img"
+        aria-label=${this.label}
+        part="sparkline"
+      >
+        ${this.recent.map(
+          (status) =>
+            html`<span
+              class="bar ${trendVariant(status)}"
+              title=${status}
+              data-testid="sparkline-bar"
+            ></span>`,
+        )}
+      </div>
+    `;
+  }
+}
+
+declare global {
+  interface HTMLElementTagNameMap {
+    "ui-sparkline": UiSparkline;
+  }
+}
+
+if (!customElements.get("ui-sparkline")) {
+  customElements.define("ui-sparkline", UiSparkline);
+}
@@ -8,3 +8,21 @@ export function escapeHtml(unsafe: string | null | undefined): string {
     .replace(/\"/g, "&quot;")
     .replace(/'/g, "&#039;");
 }
+
+// Runs task on every item with at most `limit` tasks in flight at once,
+// so a long list never floods the API with parallel requests
+export async function forEachWithConcurrency<T>(
+  items: readonly T[],
+  limit: number,
+  task: (item: T) => Promise<void>,
+): Promise<void> {
+  let next = 0;
+  const worker = async (): Promise<void> => {
+    while (next < items.length) {
+      const item = items[next++];
+      await task(item);
+    }
+  };
+  const workers = Math.max(1, Math.min(limit, items.length));
+  await Promise.all(Array.from({ length: workers }, worker));
+}
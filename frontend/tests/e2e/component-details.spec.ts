@@ -80,15 +80,13 @@ test.describe("Component Details Page", () => {
   }) => {
     await page.goto("/components/non-existent-component");
 
-    // Wait for the error state to render, then assert error
-    await expect(page.getByTestId("component-details-error")).toBeVisible({});
+    // Wait for the not found state to render
+    const notFoundCard = page.getByTestId("component-details-not-found");
+    await expect(notFoundCard).toBeVisible({});
+    await expect(notFoundCard).toContainText("Component not found");
 
-    // Verify error state is displayed (check for error card and alert content)
-    const errorCard = page.getByTestId("component-details-error");
-    await expect(errorCard).toBeVisible();
-
-    // Check that the error alert contains the expected title
-    await expect(errorCard).toContainText("Error loading component");
+    // A missing component is not reported as a loading error
+    await expect(page.getByTestId("component-details-error")).toHaveCount(0);
   });
 
   test("should display back to components link", async ({
@@ -0,0 +1,35 @@
+import { describe, test, expect } from "bun:test";
+import { forEachWithConcurrency } from "../../src/utils.ts";
+
+describe("forEachWithConcurrency function", () => {
+  test("should run the task on every item", async () => {
+    const seen: number[] = [];
+    await forEachWithConcurrency([1, 2, 3, 4, 5], 2, async (item) => {
+      seen.push(item);
+    });
+    expect(seen.sort()).toEqual([1, 2, 3, 4, 5]);
+  });
+
+  test("should never run more tasks at once than the limit", async () => {
+    let inFlight = 0;
+    let maxInFlight = 0;
+    const items = Array.from({ length: 20 }, (_, i) => i);
+
+    await forEachWithConcurrency(items, 3, async () => {
+      inFlight++;
+      maxInFlight = Math.max(maxInFlight, inFlight);
+      await new Promise((resolve) => setTimeout(resolve, 1));
+      inFlight--;
+    });
+
+    expect(maxInFlight).toBe(3);
+  });
+
+  test("should handle an empty list", async () => {
+    let calls = 0;
+    await forEachWithConcurrency([], 4, async () => {
+      calls++;
+    });
+    expect(calls).toBe(0);
+  });
+});